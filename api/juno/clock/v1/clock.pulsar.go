@@ -2,21 +2,41 @@
 package clockv1
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
 var (
-	md_ClockContract                  protoreflect.MessageDescriptor
-	fd_ClockContract_contract_address protoreflect.FieldDescriptor
-	fd_ClockContract_is_jailed        protoreflect.FieldDescriptor
+	md_ClockContract                       protoreflect.MessageDescriptor
+	fd_ClockContract_contract_address      protoreflect.FieldDescriptor
+	fd_ClockContract_is_jailed             protoreflect.FieldDescriptor
+	fd_ClockContract_block_interval        protoreflect.FieldDescriptor
+	fd_ClockContract_time_interval         protoreflect.FieldDescriptor
+	fd_ClockContract_next_execution_height protoreflect.FieldDescriptor
+	fd_ClockContract_next_execution_time   protoreflect.FieldDescriptor
+	fd_ClockContract_phase                 protoreflect.FieldDescriptor
+	fd_ClockContract_last_error            protoreflect.FieldDescriptor
+	fd_ClockContract_failure_count         protoreflect.FieldDescriptor
+	fd_ClockContract_jail_height           protoreflect.FieldDescriptor
+	fd_ClockContract_gas_limit             protoreflect.FieldDescriptor
+	fd_ClockContract_gas_deposit           protoreflect.FieldDescriptor
+	fd_ClockContract_block_context         protoreflect.FieldDescriptor
+	fd_ClockContract_execution_count       protoreflect.FieldDescriptor
+	fd_ClockContract_last_success_height   protoreflect.FieldDescriptor
+	fd_ClockContract_last_failure_height   protoreflect.FieldDescriptor
+	fd_ClockContract_last_gas_used         protoreflect.FieldDescriptor
+	fd_ClockContract_total_gas_used        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -24,6 +44,22 @@ func init() {
 	md_ClockContract = File_juno_clock_v1_clock_proto.Messages().ByName("ClockContract")
 	fd_ClockContract_contract_address = md_ClockContract.Fields().ByName("contract_address")
 	fd_ClockContract_is_jailed = md_ClockContract.Fields().ByName("is_jailed")
+	fd_ClockContract_block_interval = md_ClockContract.Fields().ByName("block_interval")
+	fd_ClockContract_time_interval = md_ClockContract.Fields().ByName("time_interval")
+	fd_ClockContract_next_execution_height = md_ClockContract.Fields().ByName("next_execution_height")
+	fd_ClockContract_next_execution_time = md_ClockContract.Fields().ByName("next_execution_time")
+	fd_ClockContract_phase = md_ClockContract.Fields().ByName("phase")
+	fd_ClockContract_last_error = md_ClockContract.Fields().ByName("last_error")
+	fd_ClockContract_failure_count = md_ClockContract.Fields().ByName("failure_count")
+	fd_ClockContract_jail_height = md_ClockContract.Fields().ByName("jail_height")
+	fd_ClockContract_gas_limit = md_ClockContract.Fields().ByName("gas_limit")
+	fd_ClockContract_gas_deposit = md_ClockContract.Fields().ByName("gas_deposit")
+	fd_ClockContract_block_context = md_ClockContract.Fields().ByName("block_context")
+	fd_ClockContract_execution_count = md_ClockContract.Fields().ByName("execution_count")
+	fd_ClockContract_last_success_height = md_ClockContract.Fields().ByName("last_success_height")
+	fd_ClockContract_last_failure_height = md_ClockContract.Fields().ByName("last_failure_height")
+	fd_ClockContract_last_gas_used = md_ClockContract.Fields().ByName("last_gas_used")
+	fd_ClockContract_total_gas_used = md_ClockContract.Fields().ByName("total_gas_used")
 }
 
 var _ protoreflect.Message = (*fastReflection_ClockContract)(nil)
@@ -103,6 +139,2055 @@ func (x *fastReflection_ClockContract) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if x.BlockInterval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockInterval)
+		if !f(fd_ClockContract_block_interval, value) {
+			return
+		}
+	}
+	if x.TimeInterval != nil {
+		value := protoreflect.ValueOfMessage(x.TimeInterval.ProtoReflect())
+		if !f(fd_ClockContract_time_interval, value) {
+			return
+		}
+	}
+	if x.NextExecutionHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextExecutionHeight)
+		if !f(fd_ClockContract_next_execution_height, value) {
+			return
+		}
+	}
+	if x.NextExecutionTime != nil {
+		value := protoreflect.ValueOfMessage(x.NextExecutionTime.ProtoReflect())
+		if !f(fd_ClockContract_next_execution_time, value) {
+			return
+		}
+	}
+	if x.Phase != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Phase))
+		if !f(fd_ClockContract_phase, value) {
+			return
+		}
+	}
+	if x.LastError != "" {
+		value := protoreflect.ValueOfString(x.LastError)
+		if !f(fd_ClockContract_last_error, value) {
+			return
+		}
+	}
+	if x.FailureCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FailureCount)
+		if !f(fd_ClockContract_failure_count, value) {
+			return
+		}
+	}
+	if x.JailHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.JailHeight)
+		if !f(fd_ClockContract_jail_height, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_ClockContract_gas_limit, value) {
+			return
+		}
+	}
+	if x.GasDeposit != nil {
+		value := protoreflect.ValueOfMessage(x.GasDeposit.ProtoReflect())
+		if !f(fd_ClockContract_gas_deposit, value) {
+			return
+		}
+	}
+	if x.BlockContext != false {
+		value := protoreflect.ValueOfBool(x.BlockContext)
+		if !f(fd_ClockContract_block_context, value) {
+			return
+		}
+	}
+	if x.ExecutionCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecutionCount)
+		if !f(fd_ClockContract_execution_count, value) {
+			return
+		}
+	}
+	if x.LastSuccessHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastSuccessHeight)
+		if !f(fd_ClockContract_last_success_height, value) {
+			return
+		}
+	}
+	if x.LastFailureHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastFailureHeight)
+		if !f(fd_ClockContract_last_failure_height, value) {
+			return
+		}
+	}
+	if x.LastGasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastGasUsed)
+		if !f(fd_ClockContract_last_gas_used, value) {
+			return
+		}
+	}
+	if x.TotalGasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalGasUsed)
+		if !f(fd_ClockContract_total_gas_used, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ClockContract) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "juno.clock.v1.ClockContract.contract_address":
+		return x.ContractAddress != ""
+	case "juno.clock.v1.ClockContract.is_jailed":
+		return x.IsJailed != false
+	case "juno.clock.v1.ClockContract.block_interval":
+		return x.BlockInterval != uint64(0)
+	case "juno.clock.v1.ClockContract.time_interval":
+		return x.TimeInterval != nil
+	case "juno.clock.v1.ClockContract.next_execution_height":
+		return x.NextExecutionHeight != int64(0)
+	case "juno.clock.v1.ClockContract.next_execution_time":
+		return x.NextExecutionTime != nil
+	case "juno.clock.v1.ClockContract.phase":
+		return x.Phase != 0
+	case "juno.clock.v1.ClockContract.last_error":
+		return x.LastError != ""
+	case "juno.clock.v1.ClockContract.failure_count":
+		return x.FailureCount != uint64(0)
+	case "juno.clock.v1.ClockContract.jail_height":
+		return x.JailHeight != int64(0)
+	case "juno.clock.v1.ClockContract.gas_limit":
+		return x.GasLimit != uint64(0)
+	case "juno.clock.v1.ClockContract.gas_deposit":
+		return x.GasDeposit != nil
+	case "juno.clock.v1.ClockContract.block_context":
+		return x.BlockContext != false
+	case "juno.clock.v1.ClockContract.execution_count":
+		return x.ExecutionCount != uint64(0)
+	case "juno.clock.v1.ClockContract.last_success_height":
+		return x.LastSuccessHeight != int64(0)
+	case "juno.clock.v1.ClockContract.last_failure_height":
+		return x.LastFailureHeight != int64(0)
+	case "juno.clock.v1.ClockContract.last_gas_used":
+		return x.LastGasUsed != uint64(0)
+	case "juno.clock.v1.ClockContract.total_gas_used":
+		return x.TotalGasUsed != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockContract"))
+		}
+		panic(fmt.Errorf("message juno.clock.v1.ClockContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClockContract) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "juno.clock.v1.ClockContract.contract_address":
+		x.ContractAddress = ""
+	case "juno.clock.v1.ClockContract.is_jailed":
+		x.IsJailed = false
+	case "juno.clock.v1.ClockContract.block_interval":
+		x.BlockInterval = uint64(0)
+	case "juno.clock.v1.ClockContract.time_interval":
+		x.TimeInterval = nil
+	case "juno.clock.v1.ClockContract.next_execution_height":
+		x.NextExecutionHeight = int64(0)
+	case "juno.clock.v1.ClockContract.next_execution_time":
+		x.NextExecutionTime = nil
+	case "juno.clock.v1.ClockContract.phase":
+		x.Phase = 0
+	case "juno.clock.v1.ClockContract.last_error":
+		x.LastError = ""
+	case "juno.clock.v1.ClockContract.failure_count":
+		x.FailureCount = uint64(0)
+	case "juno.clock.v1.ClockContract.jail_height":
+		x.JailHeight = int64(0)
+	case "juno.clock.v1.ClockContract.gas_limit":
+		x.GasLimit = uint64(0)
+	case "juno.clock.v1.ClockContract.gas_deposit":
+		x.GasDeposit = nil
+	case "juno.clock.v1.ClockContract.block_context":
+		x.BlockContext = false
+	case "juno.clock.v1.ClockContract.execution_count":
+		x.ExecutionCount = uint64(0)
+	case "juno.clock.v1.ClockContract.last_success_height":
+		x.LastSuccessHeight = int64(0)
+	case "juno.clock.v1.ClockContract.last_failure_height":
+		x.LastFailureHeight = int64(0)
+	case "juno.clock.v1.ClockContract.last_gas_used":
+		x.LastGasUsed = uint64(0)
+	case "juno.clock.v1.ClockContract.total_gas_used":
+		x.TotalGasUsed = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockContract"))
+		}
+		panic(fmt.Errorf("message juno.clock.v1.ClockContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ClockContract) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "juno.clock.v1.ClockContract.contract_address":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "juno.clock.v1.ClockContract.is_jailed":
+		value := x.IsJailed
+		return protoreflect.ValueOfBool(value)
+	case "juno.clock.v1.ClockContract.block_interval":
+		value := x.BlockInterval
+		return protoreflect.ValueOfUint64(value)
+	case "juno.clock.v1.ClockContract.time_interval":
+		value := x.TimeInterval
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "juno.clock.v1.ClockContract.next_execution_height":
+		value := x.NextExecutionHeight
+		return protoreflect.ValueOfInt64(value)
+	case "juno.clock.v1.ClockContract.next_execution_time":
+		value := x.NextExecutionTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "juno.clock.v1.ClockContract.phase":
+		value := x.Phase
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "juno.clock.v1.ClockContract.last_error":
+		value := x.LastError
+		return protoreflect.ValueOfString(value)
+	case "juno.clock.v1.ClockContract.failure_count":
+		value := x.FailureCount
+		return protoreflect.ValueOfUint64(value)
+	case "juno.clock.v1.ClockContract.jail_height":
+		value := x.JailHeight
+		return protoreflect.ValueOfInt64(value)
+	case "juno.clock.v1.ClockContract.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "juno.clock.v1.ClockContract.gas_deposit":
+		value := x.GasDeposit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "juno.clock.v1.ClockContract.block_context":
+		value := x.BlockContext
+		return protoreflect.ValueOfBool(value)
+	case "juno.clock.v1.ClockContract.execution_count":
+		value := x.ExecutionCount
+		return protoreflect.ValueOfUint64(value)
+	case "juno.clock.v1.ClockContract.last_success_height":
+		value := x.LastSuccessHeight
+		return protoreflect.ValueOfInt64(value)
+	case "juno.clock.v1.ClockContract.last_failure_height":
+		value := x.LastFailureHeight
+		return protoreflect.ValueOfInt64(value)
+	case "juno.clock.v1.ClockContract.last_gas_used":
+		value := x.LastGasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "juno.clock.v1.ClockContract.total_gas_used":
+		value := x.TotalGasUsed
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockContract"))
+		}
+		panic(fmt.Errorf("message juno.clock.v1.ClockContract does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClockContract) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "juno.clock.v1.ClockContract.contract_address":
+		x.ContractAddress = value.Interface().(string)
+	case "juno.clock.v1.ClockContract.is_jailed":
+		x.IsJailed = value.Bool()
+	case "juno.clock.v1.ClockContract.block_interval":
+		x.BlockInterval = value.Uint()
+	case "juno.clock.v1.ClockContract.time_interval":
+		x.TimeInterval = value.Message().Interface().(*durationpb.Duration)
+	case "juno.clock.v1.ClockContract.next_execution_height":
+		x.NextExecutionHeight = value.Int()
+	case "juno.clock.v1.ClockContract.next_execution_time":
+		x.NextExecutionTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "juno.clock.v1.ClockContract.phase":
+		x.Phase = (ClockPhase)(value.Enum())
+	case "juno.clock.v1.ClockContract.last_error":
+		x.LastError = value.Interface().(string)
+	case "juno.clock.v1.ClockContract.failure_count":
+		x.FailureCount = value.Uint()
+	case "juno.clock.v1.ClockContract.jail_height":
+		x.JailHeight = value.Int()
+	case "juno.clock.v1.ClockContract.gas_limit":
+		x.GasLimit = value.Uint()
+	case "juno.clock.v1.ClockContract.gas_deposit":
+		x.GasDeposit = value.Message().Interface().(*v1beta1.Coin)
+	case "juno.clock.v1.ClockContract.block_context":
+		x.BlockContext = value.Bool()
+	case "juno.clock.v1.ClockContract.execution_count":
+		x.ExecutionCount = value.Uint()
+	case "juno.clock.v1.ClockContract.last_success_height":
+		x.LastSuccessHeight = value.Int()
+	case "juno.clock.v1.ClockContract.last_failure_height":
+		x.LastFailureHeight = value.Int()
+	case "juno.clock.v1.ClockContract.last_gas_used":
+		x.LastGasUsed = value.Uint()
+	case "juno.clock.v1.ClockContract.total_gas_used":
+		x.TotalGasUsed = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockContract"))
+		}
+		panic(fmt.Errorf("message juno.clock.v1.ClockContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClockContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "juno.clock.v1.ClockContract.time_interval":
+		if x.TimeInterval == nil {
+			x.TimeInterval = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.TimeInterval.ProtoReflect())
+	case "juno.clock.v1.ClockContract.next_execution_time":
+		if x.NextExecutionTime == nil {
+			x.NextExecutionTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.NextExecutionTime.ProtoReflect())
+	case "juno.clock.v1.ClockContract.gas_deposit":
+		if x.GasDeposit == nil {
+			x.GasDeposit = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.GasDeposit.ProtoReflect())
+	case "juno.clock.v1.ClockContract.contract_address":
+		panic(fmt.Errorf("field contract_address of message juno.clock.v1.ClockContract is not mutable"))
+	case "juno.clock.v1.ClockContract.is_jailed":
+		panic(fmt.Errorf("field is_jailed of message juno.clock.v1.ClockContract is not mutable"))
+	case "juno.clock.v1.ClockContract.block_interval":
+		panic(fmt.Errorf("field block_interval of message juno.clock.v1.ClockContract is not mutable"))
+	case "juno.clock.v1.ClockContract.next_execution_height":
+		panic(fmt.Errorf("field next_execution_height of message juno.clock.v1.ClockContract is not mutable"))
+	case "juno.clock.v1.ClockContract.phase":
+		panic(fmt.Errorf("field phase of message juno.clock.v1.ClockContract is not mutable"))
+	case "juno.clock.v1.ClockContract.last_error":
+		panic(fmt.Errorf("field last_error of message juno.clock.v1.ClockContract is not mutable"))
+	case "juno.clock.v1.ClockContract.failure_count":
+		panic(fmt.Errorf("field failure_count of message juno.clock.v1.ClockContract is not mutable"))
+	case "juno.clock.v1.ClockContract.jail_height":
+		panic(fmt.Errorf("field jail_height of message juno.clock.v1.ClockContract is not mutable"))
+	case "juno.clock.v1.ClockContract.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message juno.clock.v1.ClockContract is not mutable"))
+	case "juno.clock.v1.ClockContract.block_context":
+		panic(fmt.Errorf("field block_context of message juno.clock.v1.ClockContract is not mutable"))
+	case "juno.clock.v1.ClockContract.execution_count":
+		panic(fmt.Errorf("field execution_count of message juno.clock.v1.ClockContract is not mutable"))
+	case "juno.clock.v1.ClockContract.last_success_height":
+		panic(fmt.Errorf("field last_success_height of message juno.clock.v1.ClockContract is not mutable"))
+	case "juno.clock.v1.ClockContract.last_failure_height":
+		panic(fmt.Errorf("field last_failure_height of message juno.clock.v1.ClockContract is not mutable"))
+	case "juno.clock.v1.ClockContract.last_gas_used":
+		panic(fmt.Errorf("field last_gas_used of message juno.clock.v1.ClockContract is not mutable"))
+	case "juno.clock.v1.ClockContract.total_gas_used":
+		panic(fmt.Errorf("field total_gas_used of message juno.clock.v1.ClockContract is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockContract"))
+		}
+		panic(fmt.Errorf("message juno.clock.v1.ClockContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ClockContract) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "juno.clock.v1.ClockContract.contract_address":
+		return protoreflect.ValueOfString("")
+	case "juno.clock.v1.ClockContract.is_jailed":
+		return protoreflect.ValueOfBool(false)
+	case "juno.clock.v1.ClockContract.block_interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.clock.v1.ClockContract.time_interval":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "juno.clock.v1.ClockContract.next_execution_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "juno.clock.v1.ClockContract.next_execution_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "juno.clock.v1.ClockContract.phase":
+		return protoreflect.ValueOfEnum(0)
+	case "juno.clock.v1.ClockContract.last_error":
+		return protoreflect.ValueOfString("")
+	case "juno.clock.v1.ClockContract.failure_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.clock.v1.ClockContract.jail_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "juno.clock.v1.ClockContract.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.clock.v1.ClockContract.gas_deposit":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "juno.clock.v1.ClockContract.block_context":
+		return protoreflect.ValueOfBool(false)
+	case "juno.clock.v1.ClockContract.execution_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.clock.v1.ClockContract.last_success_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "juno.clock.v1.ClockContract.last_failure_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "juno.clock.v1.ClockContract.last_gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.clock.v1.ClockContract.total_gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockContract"))
+		}
+		panic(fmt.Errorf("message juno.clock.v1.ClockContract does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ClockContract) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in juno.clock.v1.ClockContract", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ClockContract) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClockContract) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ClockContract) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ClockContract) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ClockContract)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IsJailed {
+			n += 2
+		}
+		if x.BlockInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockInterval))
+		}
+		if x.TimeInterval != nil {
+			l = options.Size(x.TimeInterval)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NextExecutionHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextExecutionHeight))
+		}
+		if x.NextExecutionTime != nil {
+			l = options.Size(x.NextExecutionTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Phase != 0 {
+			n += 1 + runtime.Sov(uint64(x.Phase))
+		}
+		l = len(x.LastError)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FailureCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.FailureCount))
+		}
+		if x.JailHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.JailHeight))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		if x.GasDeposit != nil {
+			l = options.Size(x.GasDeposit)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockContext {
+			n += 2
+		}
+		if x.ExecutionCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutionCount))
+		}
+		if x.LastSuccessHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastSuccessHeight))
+		}
+		if x.LastFailureHeight != 0 {
+			n += 2 + runtime.Sov(uint64(x.LastFailureHeight))
+		}
+		if x.LastGasUsed != 0 {
+			n += 2 + runtime.Sov(uint64(x.LastGasUsed))
+		}
+		if x.TotalGasUsed != 0 {
+			n += 2 + runtime.Sov(uint64(x.TotalGasUsed))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ClockContract)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TotalGasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalGasUsed))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.LastGasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastGasUsed))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.LastFailureHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastFailureHeight))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.LastSuccessHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastSuccessHeight))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.ExecutionCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionCount))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.BlockContext {
+			i--
+			if x.BlockContext {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.GasDeposit != nil {
+			encoded, err := options.Marshal(x.GasDeposit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.JailHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.JailHeight))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.FailureCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FailureCount))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.LastError) > 0 {
+			i -= len(x.LastError)
+			copy(dAtA[i:], x.LastError)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastError)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Phase != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Phase))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.NextExecutionTime != nil {
+			encoded, err := options.Marshal(x.NextExecutionTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.NextExecutionHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextExecutionHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TimeInterval != nil {
+			encoded, err := options.Marshal(x.TimeInterval)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.BlockInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockInterval))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.IsJailed {
+			i--
+			if x.IsJailed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ClockContract)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ClockContract: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ClockContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsJailed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsJailed = bool(v != 0)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockInterval", wireType)
+				}
+				x.BlockInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockInterval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeInterval", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TimeInterval == nil {
+					x.TimeInterval = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TimeInterval); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextExecutionHeight", wireType)
+				}
+				x.NextExecutionHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextExecutionHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextExecutionTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NextExecutionTime == nil {
+					x.NextExecutionTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NextExecutionTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				}
+				x.Phase = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Phase |= ClockPhase(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastError = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
+				}
+				x.FailureCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FailureCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailHeight", wireType)
+				}
+				x.JailHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.JailHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GasDeposit == nil {
+					x.GasDeposit = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasDeposit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockContext", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BlockContext = bool(v != 0)
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionCount", wireType)
+				}
+				x.ExecutionCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastSuccessHeight", wireType)
+				}
+				x.LastSuccessHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastSuccessHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastFailureHeight", wireType)
+				}
+				x.LastFailureHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastFailureHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastGasUsed", wireType)
+				}
+				x.LastGasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastGasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalGasUsed", wireType)
+				}
+				x.TotalGasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalGasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ClockBudgetState_5_list)(nil)
+
+type _ClockBudgetState_5_list struct {
+	list *[]*SkippedClockContract
+}
+
+func (x *_ClockBudgetState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ClockBudgetState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ClockBudgetState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SkippedClockContract)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ClockBudgetState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SkippedClockContract)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ClockBudgetState_5_list) AppendMutable() protoreflect.Value {
+	v := new(SkippedClockContract)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ClockBudgetState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ClockBudgetState_5_list) NewElement() protoreflect.Value {
+	v := new(SkippedClockContract)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ClockBudgetState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ClockBudgetState                    protoreflect.MessageDescriptor
+	fd_ClockBudgetState_height             protoreflect.FieldDescriptor
+	fd_ClockBudgetState_gas_used           protoreflect.FieldDescriptor
+	fd_ClockBudgetState_begin_block_cursor protoreflect.FieldDescriptor
+	fd_ClockBudgetState_end_block_cursor   protoreflect.FieldDescriptor
+	fd_ClockBudgetState_skipped_contracts  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_juno_clock_v1_clock_proto_init()
+	md_ClockBudgetState = File_juno_clock_v1_clock_proto.Messages().ByName("ClockBudgetState")
+	fd_ClockBudgetState_height = md_ClockBudgetState.Fields().ByName("height")
+	fd_ClockBudgetState_gas_used = md_ClockBudgetState.Fields().ByName("gas_used")
+	fd_ClockBudgetState_begin_block_cursor = md_ClockBudgetState.Fields().ByName("begin_block_cursor")
+	fd_ClockBudgetState_end_block_cursor = md_ClockBudgetState.Fields().ByName("end_block_cursor")
+	fd_ClockBudgetState_skipped_contracts = md_ClockBudgetState.Fields().ByName("skipped_contracts")
+}
+
+var _ protoreflect.Message = (*fastReflection_ClockBudgetState)(nil)
+
+type fastReflection_ClockBudgetState ClockBudgetState
+
+func (x *ClockBudgetState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ClockBudgetState)(x)
+}
+
+func (x *ClockBudgetState) slowProtoReflect() protoreflect.Message {
+	mi := &file_juno_clock_v1_clock_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ClockBudgetState_messageType fastReflection_ClockBudgetState_messageType
+var _ protoreflect.MessageType = fastReflection_ClockBudgetState_messageType{}
+
+type fastReflection_ClockBudgetState_messageType struct{}
+
+func (x fastReflection_ClockBudgetState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ClockBudgetState)(nil)
+}
+func (x fastReflection_ClockBudgetState_messageType) New() protoreflect.Message {
+	return new(fastReflection_ClockBudgetState)
+}
+func (x fastReflection_ClockBudgetState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ClockBudgetState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ClockBudgetState) Descriptor() protoreflect.MessageDescriptor {
+	return md_ClockBudgetState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ClockBudgetState) Type() protoreflect.MessageType {
+	return _fastReflection_ClockBudgetState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ClockBudgetState) New() protoreflect.Message {
+	return new(fastReflection_ClockBudgetState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ClockBudgetState) Interface() protoreflect.ProtoMessage {
+	return (*ClockBudgetState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ClockBudgetState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ClockBudgetState_height, value) {
+			return
+		}
+	}
+	if x.GasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasUsed)
+		if !f(fd_ClockBudgetState_gas_used, value) {
+			return
+		}
+	}
+	if x.BeginBlockCursor != "" {
+		value := protoreflect.ValueOfString(x.BeginBlockCursor)
+		if !f(fd_ClockBudgetState_begin_block_cursor, value) {
+			return
+		}
+	}
+	if x.EndBlockCursor != "" {
+		value := protoreflect.ValueOfString(x.EndBlockCursor)
+		if !f(fd_ClockBudgetState_end_block_cursor, value) {
+			return
+		}
+	}
+	if len(x.SkippedContracts) != 0 {
+		value := protoreflect.ValueOfList(&_ClockBudgetState_5_list{list: &x.SkippedContracts})
+		if !f(fd_ClockBudgetState_skipped_contracts, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ClockBudgetState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "juno.clock.v1.ClockBudgetState.height":
+		return x.Height != int64(0)
+	case "juno.clock.v1.ClockBudgetState.gas_used":
+		return x.GasUsed != uint64(0)
+	case "juno.clock.v1.ClockBudgetState.begin_block_cursor":
+		return x.BeginBlockCursor != ""
+	case "juno.clock.v1.ClockBudgetState.end_block_cursor":
+		return x.EndBlockCursor != ""
+	case "juno.clock.v1.ClockBudgetState.skipped_contracts":
+		return len(x.SkippedContracts) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockBudgetState"))
+		}
+		panic(fmt.Errorf("message juno.clock.v1.ClockBudgetState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClockBudgetState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "juno.clock.v1.ClockBudgetState.height":
+		x.Height = int64(0)
+	case "juno.clock.v1.ClockBudgetState.gas_used":
+		x.GasUsed = uint64(0)
+	case "juno.clock.v1.ClockBudgetState.begin_block_cursor":
+		x.BeginBlockCursor = ""
+	case "juno.clock.v1.ClockBudgetState.end_block_cursor":
+		x.EndBlockCursor = ""
+	case "juno.clock.v1.ClockBudgetState.skipped_contracts":
+		x.SkippedContracts = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockBudgetState"))
+		}
+		panic(fmt.Errorf("message juno.clock.v1.ClockBudgetState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ClockBudgetState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "juno.clock.v1.ClockBudgetState.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "juno.clock.v1.ClockBudgetState.gas_used":
+		value := x.GasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "juno.clock.v1.ClockBudgetState.begin_block_cursor":
+		value := x.BeginBlockCursor
+		return protoreflect.ValueOfString(value)
+	case "juno.clock.v1.ClockBudgetState.end_block_cursor":
+		value := x.EndBlockCursor
+		return protoreflect.ValueOfString(value)
+	case "juno.clock.v1.ClockBudgetState.skipped_contracts":
+		if len(x.SkippedContracts) == 0 {
+			return protoreflect.ValueOfList(&_ClockBudgetState_5_list{})
+		}
+		listValue := &_ClockBudgetState_5_list{list: &x.SkippedContracts}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockBudgetState"))
+		}
+		panic(fmt.Errorf("message juno.clock.v1.ClockBudgetState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClockBudgetState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "juno.clock.v1.ClockBudgetState.height":
+		x.Height = value.Int()
+	case "juno.clock.v1.ClockBudgetState.gas_used":
+		x.GasUsed = value.Uint()
+	case "juno.clock.v1.ClockBudgetState.begin_block_cursor":
+		x.BeginBlockCursor = value.Interface().(string)
+	case "juno.clock.v1.ClockBudgetState.end_block_cursor":
+		x.EndBlockCursor = value.Interface().(string)
+	case "juno.clock.v1.ClockBudgetState.skipped_contracts":
+		lv := value.List()
+		clv := lv.(*_ClockBudgetState_5_list)
+		x.SkippedContracts = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockBudgetState"))
+		}
+		panic(fmt.Errorf("message juno.clock.v1.ClockBudgetState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClockBudgetState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "juno.clock.v1.ClockBudgetState.skipped_contracts":
+		if x.SkippedContracts == nil {
+			x.SkippedContracts = []*SkippedClockContract{}
+		}
+		value := &_ClockBudgetState_5_list{list: &x.SkippedContracts}
+		return protoreflect.ValueOfList(value)
+	case "juno.clock.v1.ClockBudgetState.height":
+		panic(fmt.Errorf("field height of message juno.clock.v1.ClockBudgetState is not mutable"))
+	case "juno.clock.v1.ClockBudgetState.gas_used":
+		panic(fmt.Errorf("field gas_used of message juno.clock.v1.ClockBudgetState is not mutable"))
+	case "juno.clock.v1.ClockBudgetState.begin_block_cursor":
+		panic(fmt.Errorf("field begin_block_cursor of message juno.clock.v1.ClockBudgetState is not mutable"))
+	case "juno.clock.v1.ClockBudgetState.end_block_cursor":
+		panic(fmt.Errorf("field end_block_cursor of message juno.clock.v1.ClockBudgetState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockBudgetState"))
+		}
+		panic(fmt.Errorf("message juno.clock.v1.ClockBudgetState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ClockBudgetState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "juno.clock.v1.ClockBudgetState.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "juno.clock.v1.ClockBudgetState.gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.clock.v1.ClockBudgetState.begin_block_cursor":
+		return protoreflect.ValueOfString("")
+	case "juno.clock.v1.ClockBudgetState.end_block_cursor":
+		return protoreflect.ValueOfString("")
+	case "juno.clock.v1.ClockBudgetState.skipped_contracts":
+		list := []*SkippedClockContract{}
+		return protoreflect.ValueOfList(&_ClockBudgetState_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockBudgetState"))
+		}
+		panic(fmt.Errorf("message juno.clock.v1.ClockBudgetState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ClockBudgetState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in juno.clock.v1.ClockBudgetState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ClockBudgetState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClockBudgetState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ClockBudgetState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ClockBudgetState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ClockBudgetState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.GasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasUsed))
+		}
+		l = len(x.BeginBlockCursor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EndBlockCursor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.SkippedContracts) > 0 {
+			for _, e := range x.SkippedContracts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ClockBudgetState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SkippedContracts) > 0 {
+			for iNdEx := len(x.SkippedContracts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SkippedContracts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.EndBlockCursor) > 0 {
+			i -= len(x.EndBlockCursor)
+			copy(dAtA[i:], x.EndBlockCursor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EndBlockCursor)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.BeginBlockCursor) > 0 {
+			i -= len(x.BeginBlockCursor)
+			copy(dAtA[i:], x.BeginBlockCursor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BeginBlockCursor)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.GasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ClockBudgetState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ClockBudgetState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ClockBudgetState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+				}
+				x.GasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BeginBlockCursor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BeginBlockCursor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndBlockCursor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EndBlockCursor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SkippedContracts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SkippedContracts = append(x.SkippedContracts, &SkippedClockContract{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SkippedContracts[len(x.SkippedContracts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SkippedClockContract                  protoreflect.MessageDescriptor
+	fd_SkippedClockContract_contract_address protoreflect.FieldDescriptor
+	fd_SkippedClockContract_phase            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_juno_clock_v1_clock_proto_init()
+	md_SkippedClockContract = File_juno_clock_v1_clock_proto.Messages().ByName("SkippedClockContract")
+	fd_SkippedClockContract_contract_address = md_SkippedClockContract.Fields().ByName("contract_address")
+	fd_SkippedClockContract_phase = md_SkippedClockContract.Fields().ByName("phase")
+}
+
+var _ protoreflect.Message = (*fastReflection_SkippedClockContract)(nil)
+
+type fastReflection_SkippedClockContract SkippedClockContract
+
+func (x *SkippedClockContract) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SkippedClockContract)(x)
+}
+
+func (x *SkippedClockContract) slowProtoReflect() protoreflect.Message {
+	mi := &file_juno_clock_v1_clock_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SkippedClockContract_messageType fastReflection_SkippedClockContract_messageType
+var _ protoreflect.MessageType = fastReflection_SkippedClockContract_messageType{}
+
+type fastReflection_SkippedClockContract_messageType struct{}
+
+func (x fastReflection_SkippedClockContract_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SkippedClockContract)(nil)
+}
+func (x fastReflection_SkippedClockContract_messageType) New() protoreflect.Message {
+	return new(fastReflection_SkippedClockContract)
+}
+func (x fastReflection_SkippedClockContract_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SkippedClockContract
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SkippedClockContract) Descriptor() protoreflect.MessageDescriptor {
+	return md_SkippedClockContract
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SkippedClockContract) Type() protoreflect.MessageType {
+	return _fastReflection_SkippedClockContract_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SkippedClockContract) New() protoreflect.Message {
+	return new(fastReflection_SkippedClockContract)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SkippedClockContract) Interface() protoreflect.ProtoMessage {
+	return (*SkippedClockContract)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SkippedClockContract) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_SkippedClockContract_contract_address, value) {
+			return
+		}
+	}
+	if x.Phase != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Phase))
+		if !f(fd_SkippedClockContract_phase, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -116,17 +2201,17 @@ func (x *fastReflection_ClockContract) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ClockContract) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SkippedClockContract) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "juno.clock.v1.ClockContract.contract_address":
+	case "juno.clock.v1.SkippedClockContract.contract_address":
 		return x.ContractAddress != ""
-	case "juno.clock.v1.ClockContract.is_jailed":
-		return x.IsJailed != false
+	case "juno.clock.v1.SkippedClockContract.phase":
+		return x.Phase != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockContract"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.SkippedClockContract"))
 		}
-		panic(fmt.Errorf("message juno.clock.v1.ClockContract does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.clock.v1.SkippedClockContract does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -136,17 +2221,17 @@ func (x *fastReflection_ClockContract) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ClockContract) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SkippedClockContract) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "juno.clock.v1.ClockContract.contract_address":
+	case "juno.clock.v1.SkippedClockContract.contract_address":
 		x.ContractAddress = ""
-	case "juno.clock.v1.ClockContract.is_jailed":
-		x.IsJailed = false
+	case "juno.clock.v1.SkippedClockContract.phase":
+		x.Phase = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockContract"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.SkippedClockContract"))
 		}
-		panic(fmt.Errorf("message juno.clock.v1.ClockContract does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.clock.v1.SkippedClockContract does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -156,19 +2241,19 @@ func (x *fastReflection_ClockContract) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ClockContract) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SkippedClockContract) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "juno.clock.v1.ClockContract.contract_address":
+	case "juno.clock.v1.SkippedClockContract.contract_address":
 		value := x.ContractAddress
 		return protoreflect.ValueOfString(value)
-	case "juno.clock.v1.ClockContract.is_jailed":
-		value := x.IsJailed
-		return protoreflect.ValueOfBool(value)
+	case "juno.clock.v1.SkippedClockContract.phase":
+		value := x.Phase
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockContract"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.SkippedClockContract"))
 		}
-		panic(fmt.Errorf("message juno.clock.v1.ClockContract does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message juno.clock.v1.SkippedClockContract does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -182,17 +2267,17 @@ func (x *fastReflection_ClockContract) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ClockContract) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SkippedClockContract) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "juno.clock.v1.ClockContract.contract_address":
+	case "juno.clock.v1.SkippedClockContract.contract_address":
 		x.ContractAddress = value.Interface().(string)
-	case "juno.clock.v1.ClockContract.is_jailed":
-		x.IsJailed = value.Bool()
+	case "juno.clock.v1.SkippedClockContract.phase":
+		x.Phase = (ClockPhase)(value.Enum())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockContract"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.SkippedClockContract"))
 		}
-		panic(fmt.Errorf("message juno.clock.v1.ClockContract does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.clock.v1.SkippedClockContract does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -206,44 +2291,44 @@ func (x *fastReflection_ClockContract) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ClockContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SkippedClockContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "juno.clock.v1.ClockContract.contract_address":
-		panic(fmt.Errorf("field contract_address of message juno.clock.v1.ClockContract is not mutable"))
-	case "juno.clock.v1.ClockContract.is_jailed":
-		panic(fmt.Errorf("field is_jailed of message juno.clock.v1.ClockContract is not mutable"))
+	case "juno.clock.v1.SkippedClockContract.contract_address":
+		panic(fmt.Errorf("field contract_address of message juno.clock.v1.SkippedClockContract is not mutable"))
+	case "juno.clock.v1.SkippedClockContract.phase":
+		panic(fmt.Errorf("field phase of message juno.clock.v1.SkippedClockContract is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockContract"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.SkippedClockContract"))
 		}
-		panic(fmt.Errorf("message juno.clock.v1.ClockContract does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.clock.v1.SkippedClockContract does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ClockContract) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SkippedClockContract) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "juno.clock.v1.ClockContract.contract_address":
+	case "juno.clock.v1.SkippedClockContract.contract_address":
 		return protoreflect.ValueOfString("")
-	case "juno.clock.v1.ClockContract.is_jailed":
-		return protoreflect.ValueOfBool(false)
+	case "juno.clock.v1.SkippedClockContract.phase":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.ClockContract"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.SkippedClockContract"))
 		}
-		panic(fmt.Errorf("message juno.clock.v1.ClockContract does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.clock.v1.SkippedClockContract does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ClockContract) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SkippedClockContract) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in juno.clock.v1.ClockContract", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in juno.clock.v1.SkippedClockContract", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -251,7 +2336,7 @@ func (x *fastReflection_ClockContract) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ClockContract) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SkippedClockContract) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -262,7 +2347,7 @@ func (x *fastReflection_ClockContract) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ClockContract) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SkippedClockContract) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -274,7 +2359,7 @@ func (x *fastReflection_ClockContract) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ClockContract) IsValid() bool {
+func (x *fastReflection_SkippedClockContract) IsValid() bool {
 	return x != nil
 }
 
@@ -284,9 +2369,9 @@ func (x *fastReflection_ClockContract) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ClockContract) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SkippedClockContract) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ClockContract)
+		x := input.Message.Interface().(*SkippedClockContract)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -302,8 +2387,8 @@ func (x *fastReflection_ClockContract) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.IsJailed {
-			n += 2
+		if x.Phase != 0 {
+			n += 1 + runtime.Sov(uint64(x.Phase))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -315,7 +2400,7 @@ func (x *fastReflection_ClockContract) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ClockContract)
+		x := input.Message.Interface().(*SkippedClockContract)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -334,13 +2419,8 @@ func (x *fastReflection_ClockContract) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.IsJailed {
-			i--
-			if x.IsJailed {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+		if x.Phase != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Phase))
 			i--
 			dAtA[i] = 0x10
 		}
@@ -362,7 +2442,7 @@ func (x *fastReflection_ClockContract) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ClockContract)
+		x := input.Message.Interface().(*SkippedClockContract)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -394,10 +2474,10 @@ func (x *fastReflection_ClockContract) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ClockContract: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SkippedClockContract: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ClockContract: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SkippedClockContract: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -434,9 +2514,9 @@ func (x *fastReflection_ClockContract) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsJailed", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 				}
-				var v int
+				x.Phase = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -446,12 +2526,11 @@ func (x *fastReflection_ClockContract) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					x.Phase |= ClockPhase(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.IsJailed = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -500,8 +2579,66 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This object is used to store the contract address and the
-// jail status of the contract.
+// ClockPhase defines the block phases in which a clock contract is executed.
+type ClockPhase int32
+
+const (
+	// CLOCK_PHASE_UNSPECIFIED defaults to executing at the end of the block.
+	ClockPhase_CLOCK_PHASE_UNSPECIFIED ClockPhase = 0
+	// CLOCK_PHASE_END_BLOCK executes the contract at the end of the block.
+	ClockPhase_CLOCK_PHASE_END_BLOCK ClockPhase = 1
+	// CLOCK_PHASE_BEGIN_BLOCK executes the contract at the beginning of the block.
+	ClockPhase_CLOCK_PHASE_BEGIN_BLOCK ClockPhase = 2
+	// CLOCK_PHASE_BEGIN_AND_END_BLOCK executes the contract at both the
+	// beginning and the end of the block.
+	ClockPhase_CLOCK_PHASE_BEGIN_AND_END_BLOCK ClockPhase = 3
+)
+
+// Enum value maps for ClockPhase.
+var (
+	ClockPhase_name = map[int32]string{
+		0: "CLOCK_PHASE_UNSPECIFIED",
+		1: "CLOCK_PHASE_END_BLOCK",
+		2: "CLOCK_PHASE_BEGIN_BLOCK",
+		3: "CLOCK_PHASE_BEGIN_AND_END_BLOCK",
+	}
+	ClockPhase_value = map[string]int32{
+		"CLOCK_PHASE_UNSPECIFIED":         0,
+		"CLOCK_PHASE_END_BLOCK":           1,
+		"CLOCK_PHASE_BEGIN_BLOCK":         2,
+		"CLOCK_PHASE_BEGIN_AND_END_BLOCK": 3,
+	}
+)
+
+func (x ClockPhase) Enum() *ClockPhase {
+	p := new(ClockPhase)
+	*p = x
+	return p
+}
+
+func (x ClockPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClockPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_juno_clock_v1_clock_proto_enumTypes[0].Descriptor()
+}
+
+func (ClockPhase) Type() protoreflect.EnumType {
+	return &file_juno_clock_v1_clock_proto_enumTypes[0]
+}
+
+func (x ClockPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClockPhase.Descriptor instead.
+func (ClockPhase) EnumDescriptor() ([]byte, []int) {
+	return file_juno_clock_v1_clock_proto_rawDescGZIP(), []int{0}
+}
+
+// This object is used to store the contract address, the
+// jail status and the execution schedule of the contract.
 type ClockContract struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -511,6 +2648,42 @@ type ClockContract struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	// The jail status of the contract.
 	IsJailed bool `protobuf:"varint,2,opt,name=is_jailed,json=isJailed,proto3" json:"is_jailed,omitempty"`
+	// The number of blocks between executions. Zero means every block.
+	BlockInterval uint64 `protobuf:"varint,3,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// The minimum block time between executions. Zero means no time interval.
+	TimeInterval *durationpb.Duration `protobuf:"bytes,4,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
+	// The height at which the contract is next due for execution.
+	NextExecutionHeight int64 `protobuf:"varint,5,opt,name=next_execution_height,json=nextExecutionHeight,proto3" json:"next_execution_height,omitempty"`
+	// The block time at which the contract is next due for execution.
+	NextExecutionTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_execution_time,json=nextExecutionTime,proto3" json:"next_execution_time,omitempty"`
+	// The block phases in which the contract is executed.
+	Phase ClockPhase `protobuf:"varint,7,opt,name=phase,proto3,enum=juno.clock.v1.ClockPhase" json:"phase,omitempty"`
+	// The error of the last failed execution.
+	LastError string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The number of consecutive failed executions. Reset on a successful
+	// execution or when the contract is unjailed.
+	FailureCount uint64 `protobuf:"varint,9,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	// The height at which the contract was jailed.
+	JailHeight int64 `protobuf:"varint,10,opt,name=jail_height,json=jailHeight,proto3" json:"jail_height,omitempty"`
+	// The custom gas limit of the contract. Zero uses the module's
+	// contract_gas_limit.
+	GasLimit uint64 `protobuf:"varint,11,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// The deposit which pays for the gas used by the contract when it has a
+	// custom gas limit.
+	GasDeposit *v1beta1.Coin `protobuf:"bytes,12,opt,name=gas_deposit,json=gasDeposit,proto3" json:"gas_deposit,omitempty"`
+	// Whether the sudo message includes the block context. Contracts which do
+	// not opt in receive the empty clock message.
+	BlockContext bool `protobuf:"varint,13,opt,name=block_context,json=blockContext,proto3" json:"block_context,omitempty"`
+	// The number of times the contract has been executed.
+	ExecutionCount uint64 `protobuf:"varint,14,opt,name=execution_count,json=executionCount,proto3" json:"execution_count,omitempty"`
+	// The height of the last successful execution.
+	LastSuccessHeight int64 `protobuf:"varint,15,opt,name=last_success_height,json=lastSuccessHeight,proto3" json:"last_success_height,omitempty"`
+	// The height of the last failed execution.
+	LastFailureHeight int64 `protobuf:"varint,16,opt,name=last_failure_height,json=lastFailureHeight,proto3" json:"last_failure_height,omitempty"`
+	// The gas used by the last execution.
+	LastGasUsed uint64 `protobuf:"varint,17,opt,name=last_gas_used,json=lastGasUsed,proto3" json:"last_gas_used,omitempty"`
+	// The total gas used by all executions.
+	TotalGasUsed uint64 `protobuf:"varint,18,opt,name=total_gas_used,json=totalGasUsed,proto3" json:"total_gas_used,omitempty"`
 }
 
 func (x *ClockContract) Reset() {
@@ -547,31 +2720,363 @@ func (x *ClockContract) GetIsJailed() bool {
 	return false
 }
 
+func (x *ClockContract) GetBlockInterval() uint64 {
+	if x != nil {
+		return x.BlockInterval
+	}
+	return 0
+}
+
+func (x *ClockContract) GetTimeInterval() *durationpb.Duration {
+	if x != nil {
+		return x.TimeInterval
+	}
+	return nil
+}
+
+func (x *ClockContract) GetNextExecutionHeight() int64 {
+	if x != nil {
+		return x.NextExecutionHeight
+	}
+	return 0
+}
+
+func (x *ClockContract) GetNextExecutionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextExecutionTime
+	}
+	return nil
+}
+
+func (x *ClockContract) GetPhase() ClockPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return ClockPhase_CLOCK_PHASE_UNSPECIFIED
+}
+
+func (x *ClockContract) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ClockContract) GetFailureCount() uint64 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *ClockContract) GetJailHeight() int64 {
+	if x != nil {
+		return x.JailHeight
+	}
+	return 0
+}
+
+func (x *ClockContract) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *ClockContract) GetGasDeposit() *v1beta1.Coin {
+	if x != nil {
+		return x.GasDeposit
+	}
+	return nil
+}
+
+func (x *ClockContract) GetBlockContext() bool {
+	if x != nil {
+		return x.BlockContext
+	}
+	return false
+}
+
+func (x *ClockContract) GetExecutionCount() uint64 {
+	if x != nil {
+		return x.ExecutionCount
+	}
+	return 0
+}
+
+func (x *ClockContract) GetLastSuccessHeight() int64 {
+	if x != nil {
+		return x.LastSuccessHeight
+	}
+	return 0
+}
+
+func (x *ClockContract) GetLastFailureHeight() int64 {
+	if x != nil {
+		return x.LastFailureHeight
+	}
+	return 0
+}
+
+func (x *ClockContract) GetLastGasUsed() uint64 {
+	if x != nil {
+		return x.LastGasUsed
+	}
+	return 0
+}
+
+func (x *ClockContract) GetTotalGasUsed() uint64 {
+	if x != nil {
+		return x.TotalGasUsed
+	}
+	return 0
+}
+
+// ClockBudgetState tracks the gas used by clock contracts in the current block
+// and the round-robin position of each block phase.
+type ClockBudgetState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The height of the block the gas usage and skipped contracts belong to.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The gas used by clock contracts in the block.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// The address of the first contract skipped at the beginning of a block.
+	BeginBlockCursor string `protobuf:"bytes,3,opt,name=begin_block_cursor,json=beginBlockCursor,proto3" json:"begin_block_cursor,omitempty"`
+	// The address of the first contract skipped at the end of a block.
+	EndBlockCursor string `protobuf:"bytes,4,opt,name=end_block_cursor,json=endBlockCursor,proto3" json:"end_block_cursor,omitempty"`
+	// The contracts which were due but skipped in the block because the block
+	// gas limit was reached.
+	SkippedContracts []*SkippedClockContract `protobuf:"bytes,5,rep,name=skipped_contracts,json=skippedContracts,proto3" json:"skipped_contracts,omitempty"`
+}
+
+func (x *ClockBudgetState) Reset() {
+	*x = ClockBudgetState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_juno_clock_v1_clock_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClockBudgetState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClockBudgetState) ProtoMessage() {}
+
+// Deprecated: Use ClockBudgetState.ProtoReflect.Descriptor instead.
+func (*ClockBudgetState) Descriptor() ([]byte, []int) {
+	return file_juno_clock_v1_clock_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClockBudgetState) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ClockBudgetState) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *ClockBudgetState) GetBeginBlockCursor() string {
+	if x != nil {
+		return x.BeginBlockCursor
+	}
+	return ""
+}
+
+func (x *ClockBudgetState) GetEndBlockCursor() string {
+	if x != nil {
+		return x.EndBlockCursor
+	}
+	return ""
+}
+
+func (x *ClockBudgetState) GetSkippedContracts() []*SkippedClockContract {
+	if x != nil {
+		return x.SkippedContracts
+	}
+	return nil
+}
+
+// SkippedClockContract is a contract which was due but not executed because
+// the block gas limit was reached. It is executed in a following block.
+type SkippedClockContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address of the contract.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The block phase in which the contract was skipped.
+	Phase ClockPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=juno.clock.v1.ClockPhase" json:"phase,omitempty"`
+}
+
+func (x *SkippedClockContract) Reset() {
+	*x = SkippedClockContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_juno_clock_v1_clock_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SkippedClockContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkippedClockContract) ProtoMessage() {}
+
+// Deprecated: Use SkippedClockContract.ProtoReflect.Descriptor instead.
+func (*SkippedClockContract) Descriptor() ([]byte, []int) {
+	return file_juno_clock_v1_clock_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SkippedClockContract) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *SkippedClockContract) GetPhase() ClockPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return ClockPhase_CLOCK_PHASE_UNSPECIFIED
+}
+
 var File_juno_clock_v1_clock_proto protoreflect.FileDescriptor
 
 var file_juno_clock_v1_clock_proto_rawDesc = []byte{
 	0x0a, 0x19, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x76, 0x31, 0x2f,
 	0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6a, 0x75, 0x6e,
-	0x6f, 0x2e, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x2e, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71, 0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x43, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69,
-	0x73, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x69, 0x73, 0x4a, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x42, 0x9d, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d,
-	0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x42, 0x0a,
-	0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6a,
-	0x75, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6c, 0x6f,
-	0x63, 0x6b, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a, 0x43, 0x58, 0xaa, 0x02, 0x0d, 0x4a, 0x75, 0x6e,
-	0x6f, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x4a, 0x75, 0x6e,
-	0x6f, 0x5c, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x4a, 0x75, 0x6e,
-	0x6f, 0x5c, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x4a, 0x75, 0x6e, 0x6f, 0x3a, 0x3a, 0x43,
-	0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x06, 0x0a,
+	0x0d, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x43,
+	0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4a, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x48, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98,
+	0xdf, 0x1f, 0x01, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x32, 0x0a, 0x15, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x54, 0x0a, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
+	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x70,
+	0x68, 0x61, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6a, 0x75, 0x6e,
+	0x6f, 0x2e, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
+	0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6a, 0x61, 0x69, 0x6c, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x40,
+	0x0a, 0x0b, 0x67, 0x61, 0x73, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x67, 0x61, 0x73, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e,
+	0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6c, 0x61, 0x73,
+	0x74, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2e,
+	0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6c, 0x61, 0x73,
+	0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22,
+	0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x47, 0x61, 0x73, 0x55, 0x73,
+	0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x67, 0x61, 0x73, 0x5f,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x22, 0xa9, 0x02, 0x0a, 0x10, 0x43, 0x6c, 0x6f,
+	0x63, 0x6b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64,
+	0x12, 0x46, 0x0a, 0x12, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x42, 0x0a, 0x10, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x65, 0x6e,
+	0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x56, 0x0a, 0x11,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x43, 0x0a,
+	0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68,
+	0x61, 0x73, 0x65, 0x2a, 0xf9, 0x01, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x68, 0x61,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x50, 0x48, 0x41, 0x53,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a,
+	0x19, 0x8a, 0x9d, 0x20, 0x15, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x68, 0x61, 0x73, 0x65, 0x55,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x43, 0x4c,
+	0x4f, 0x43, 0x4b, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x4c,
+	0x4f, 0x43, 0x4b, 0x10, 0x01, 0x1a, 0x16, 0x8a, 0x9d, 0x20, 0x12, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
+	0x50, 0x68, 0x61, 0x73, 0x65, 0x45, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x35, 0x0a,
+	0x17, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x50, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x42, 0x45, 0x47,
+	0x49, 0x4e, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x02, 0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14,
+	0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x68, 0x61, 0x73, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x43, 0x0a, 0x1f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x50, 0x48,
+	0x41, 0x53, 0x45, 0x5f, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x45, 0x4e,
+	0x44, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x03, 0x1a, 0x1e, 0x8a, 0x9d, 0x20, 0x1a, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x68, 0x61, 0x73, 0x65, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x41, 0x6e,
+	0x64, 0x45, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42,
+	0x9d, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x6f, 0x63, 0x6b,
+	0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a, 0x43,
+	0x58, 0xaa, 0x02, 0x0d, 0x4a, 0x75, 0x6e, 0x6f, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x0d, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x19, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f,
+	0x4a, 0x75, 0x6e, 0x6f, 0x3a, 0x3a, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -586,16 +3091,29 @@ func file_juno_clock_v1_clock_proto_rawDescGZIP() []byte {
 	return file_juno_clock_v1_clock_proto_rawDescData
 }
 
-var file_juno_clock_v1_clock_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_juno_clock_v1_clock_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_juno_clock_v1_clock_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_juno_clock_v1_clock_proto_goTypes = []interface{}{
-	(*ClockContract)(nil), // 0: juno.clock.v1.ClockContract
+	(ClockPhase)(0),               // 0: juno.clock.v1.ClockPhase
+	(*ClockContract)(nil),         // 1: juno.clock.v1.ClockContract
+	(*ClockBudgetState)(nil),      // 2: juno.clock.v1.ClockBudgetState
+	(*SkippedClockContract)(nil),  // 3: juno.clock.v1.SkippedClockContract
+	(*durationpb.Duration)(nil),   // 4: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*v1beta1.Coin)(nil),          // 6: cosmos.base.v1beta1.Coin
 }
 var file_juno_clock_v1_clock_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: juno.clock.v1.ClockContract.time_interval:type_name -> google.protobuf.Duration
+	5, // 1: juno.clock.v1.ClockContract.next_execution_time:type_name -> google.protobuf.Timestamp
+	0, // 2: juno.clock.v1.ClockContract.phase:type_name -> juno.clock.v1.ClockPhase
+	6, // 3: juno.clock.v1.ClockContract.gas_deposit:type_name -> cosmos.base.v1beta1.Coin
+	3, // 4: juno.clock.v1.ClockBudgetState.skipped_contracts:type_name -> juno.clock.v1.SkippedClockContract
+	0, // 5: juno.clock.v1.SkippedClockContract.phase:type_name -> juno.clock.v1.ClockPhase
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_juno_clock_v1_clock_proto_init() }
@@ -616,19 +3134,44 @@ func file_juno_clock_v1_clock_proto_init() {
 				return nil
 			}
 		}
+		file_juno_clock_v1_clock_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClockBudgetState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_juno_clock_v1_clock_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkippedClockContract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_juno_clock_v1_clock_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_juno_clock_v1_clock_proto_goTypes,
 		DependencyIndexes: file_juno_clock_v1_clock_proto_depIdxs,
+		EnumInfos:         file_juno_clock_v1_clock_proto_enumTypes,
 		MessageInfos:      file_juno_clock_v1_clock_proto_msgTypes,
 	}.Build()
 	File_juno_clock_v1_clock_proto = out.File
@@ -4,6 +4,7 @@ package clockv1
 import (
 	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -452,12 +453,20 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 var (
 	md_Params                    protoreflect.MessageDescriptor
 	fd_Params_contract_gas_limit protoreflect.FieldDescriptor
+	fd_Params_max_strikes        protoreflect.FieldDescriptor
+	fd_Params_jail_duration      protoreflect.FieldDescriptor
+	fd_Params_gas_deposit_price  protoreflect.FieldDescriptor
+	fd_Params_block_gas_limit    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_juno_clock_v1_genesis_proto_init()
 	md_Params = File_juno_clock_v1_genesis_proto.Messages().ByName("Params")
 	fd_Params_contract_gas_limit = md_Params.Fields().ByName("contract_gas_limit")
+	fd_Params_max_strikes = md_Params.Fields().ByName("max_strikes")
+	fd_Params_jail_duration = md_Params.Fields().ByName("jail_duration")
+	fd_Params_gas_deposit_price = md_Params.Fields().ByName("gas_deposit_price")
+	fd_Params_block_gas_limit = md_Params.Fields().ByName("block_gas_limit")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -531,6 +540,30 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxStrikes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxStrikes)
+		if !f(fd_Params_max_strikes, value) {
+			return
+		}
+	}
+	if x.JailDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.JailDuration)
+		if !f(fd_Params_jail_duration, value) {
+			return
+		}
+	}
+	if x.GasDepositPrice != "" {
+		value := protoreflect.ValueOfString(x.GasDepositPrice)
+		if !f(fd_Params_gas_deposit_price, value) {
+			return
+		}
+	}
+	if x.BlockGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockGasLimit)
+		if !f(fd_Params_block_gas_limit, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -548,6 +581,14 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "juno.clock.v1.Params.contract_gas_limit":
 		return x.ContractGasLimit != uint64(0)
+	case "juno.clock.v1.Params.max_strikes":
+		return x.MaxStrikes != uint64(0)
+	case "juno.clock.v1.Params.jail_duration":
+		return x.JailDuration != uint64(0)
+	case "juno.clock.v1.Params.gas_deposit_price":
+		return x.GasDepositPrice != ""
+	case "juno.clock.v1.Params.block_gas_limit":
+		return x.BlockGasLimit != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.Params"))
@@ -566,6 +607,14 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "juno.clock.v1.Params.contract_gas_limit":
 		x.ContractGasLimit = uint64(0)
+	case "juno.clock.v1.Params.max_strikes":
+		x.MaxStrikes = uint64(0)
+	case "juno.clock.v1.Params.jail_duration":
+		x.JailDuration = uint64(0)
+	case "juno.clock.v1.Params.gas_deposit_price":
+		x.GasDepositPrice = ""
+	case "juno.clock.v1.Params.block_gas_limit":
+		x.BlockGasLimit = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.Params"))
@@ -585,6 +634,18 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "juno.clock.v1.Params.contract_gas_limit":
 		value := x.ContractGasLimit
 		return protoreflect.ValueOfUint64(value)
+	case "juno.clock.v1.Params.max_strikes":
+		value := x.MaxStrikes
+		return protoreflect.ValueOfUint64(value)
+	case "juno.clock.v1.Params.jail_duration":
+		value := x.JailDuration
+		return protoreflect.ValueOfUint64(value)
+	case "juno.clock.v1.Params.gas_deposit_price":
+		value := x.GasDepositPrice
+		return protoreflect.ValueOfString(value)
+	case "juno.clock.v1.Params.block_gas_limit":
+		value := x.BlockGasLimit
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.Params"))
@@ -607,6 +668,14 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "juno.clock.v1.Params.contract_gas_limit":
 		x.ContractGasLimit = value.Uint()
+	case "juno.clock.v1.Params.max_strikes":
+		x.MaxStrikes = value.Uint()
+	case "juno.clock.v1.Params.jail_duration":
+		x.JailDuration = value.Uint()
+	case "juno.clock.v1.Params.gas_deposit_price":
+		x.GasDepositPrice = value.Interface().(string)
+	case "juno.clock.v1.Params.block_gas_limit":
+		x.BlockGasLimit = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.Params"))
@@ -629,6 +698,14 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 	switch fd.FullName() {
 	case "juno.clock.v1.Params.contract_gas_limit":
 		panic(fmt.Errorf("field contract_gas_limit of message juno.clock.v1.Params is not mutable"))
+	case "juno.clock.v1.Params.max_strikes":
+		panic(fmt.Errorf("field max_strikes of message juno.clock.v1.Params is not mutable"))
+	case "juno.clock.v1.Params.jail_duration":
+		panic(fmt.Errorf("field jail_duration of message juno.clock.v1.Params is not mutable"))
+	case "juno.clock.v1.Params.gas_deposit_price":
+		panic(fmt.Errorf("field gas_deposit_price of message juno.clock.v1.Params is not mutable"))
+	case "juno.clock.v1.Params.block_gas_limit":
+		panic(fmt.Errorf("field block_gas_limit of message juno.clock.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.Params"))
@@ -644,6 +721,14 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "juno.clock.v1.Params.contract_gas_limit":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.clock.v1.Params.max_strikes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.clock.v1.Params.jail_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.clock.v1.Params.gas_deposit_price":
+		return protoreflect.ValueOfString("")
+	case "juno.clock.v1.Params.block_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.clock.v1.Params"))
@@ -716,6 +801,19 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.ContractGasLimit != 0 {
 			n += 1 + runtime.Sov(uint64(x.ContractGasLimit))
 		}
+		if x.MaxStrikes != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxStrikes))
+		}
+		if x.JailDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.JailDuration))
+		}
+		l = len(x.GasDepositPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockGasLimit))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -745,6 +843,28 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.BlockGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockGasLimit))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.GasDepositPrice) > 0 {
+			i -= len(x.GasDepositPrice)
+			copy(dAtA[i:], x.GasDepositPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GasDepositPrice)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.JailDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.JailDuration))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxStrikes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxStrikes))
+			i--
+			dAtA[i] = 0x10
+		}
 		if x.ContractGasLimit != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractGasLimit))
 			i--
@@ -818,6 +938,95 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxStrikes", wireType)
+				}
+				x.MaxStrikes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxStrikes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+				}
+				x.JailDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.JailDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasDepositPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GasDepositPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockGasLimit", wireType)
+				}
+				x.BlockGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -911,6 +1120,20 @@ type Params struct {
 
 	// contract_gas_limit defines the maximum amount of gas that can be used by a contract.
 	ContractGasLimit uint64 `protobuf:"varint,1,opt,name=contract_gas_limit,json=contractGasLimit,proto3" json:"contract_gas_limit,omitempty"`
+	// max_strikes defines the number of consecutive failed executions a contract
+	// is allowed before it is jailed. Zero jails a contract on its first failure.
+	MaxStrikes uint64 `protobuf:"varint,2,opt,name=max_strikes,json=maxStrikes,proto3" json:"max_strikes,omitempty"`
+	// jail_duration defines the number of blocks after which a jailed contract is
+	// automatically unjailed. Zero disables automatic unjailing.
+	JailDuration uint64 `protobuf:"varint,3,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty"`
+	// gas_deposit_price defines the price per unit of gas, in the bond denom,
+	// which is debited from the gas deposit of contracts with a custom gas
+	// limit. Empty disables custom gas limits.
+	GasDepositPrice string `protobuf:"bytes,4,opt,name=gas_deposit_price,json=gasDepositPrice,proto3" json:"gas_deposit_price,omitempty"`
+	// block_gas_limit defines the total amount of gas that can be used by all
+	// contracts in a block. Contracts beyond the limit are executed in the
+	// following blocks in round-robin order. Zero disables the limit.
+	BlockGasLimit uint64 `protobuf:"varint,5,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -940,6 +1163,34 @@ func (x *Params) GetContractGasLimit() uint64 {
 	return 0
 }
 
+func (x *Params) GetMaxStrikes() uint64 {
+	if x != nil {
+		return x.MaxStrikes
+	}
+	return 0
+}
+
+func (x *Params) GetJailDuration() uint64 {
+	if x != nil {
+		return x.JailDuration
+	}
+	return 0
+}
+
+func (x *Params) GetGasDepositPrice() string {
+	if x != nil {
+		return x.GasDepositPrice
+	}
+	return ""
+}
+
+func (x *Params) GetBlockGasLimit() uint64 {
+	if x != nil {
+		return x.BlockGasLimit
+	}
+	return 0
+}
+
 var File_juno_clock_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_juno_clock_v1_genesis_proto_rawDesc = []byte{
@@ -947,27 +1198,39 @@ var file_juno_clock_v1_genesis_proto_rawDesc = []byte{
 	0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6a,
 	0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d,
 	0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x48, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x6f,
-	0x63, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde,
-	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
-	0x3c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x47,
-	0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0x9f, 0x01,
-	0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x6f, 0x63, 0x6b,
-	0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x63, 0x6c, 0x6f, 0x63, 0x6b,
-	0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a, 0x43,
-	0x58, 0xaa, 0x02, 0x0d, 0x4a, 0x75, 0x6e, 0x6f, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x0d, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x19, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f,
-	0x4a, 0x75, 0x6e, 0x6f, 0x3a, 0x3a, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x48, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xe6, 0x01, 0x0a, 0x06, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x47, 0x61, 0x73, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6b,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6a, 0x61, 0x69,
+	0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x11, 0x67, 0x61, 0x73,
+	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x0f, 0x67, 0x61, 0x73, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67,
+	0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x42, 0x9f, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x6a, 0x75, 0x6e, 0x6f,
+	0x2e, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6a, 0x75, 0x6e, 0x6f,
+	0x2f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x4a, 0x43, 0x58, 0xaa, 0x02, 0x0d, 0x4a, 0x75, 0x6e, 0x6f, 0x2e, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x4a, 0x75, 0x6e, 0x6f, 0x3a, 0x3a, 0x43, 0x6c, 0x6f, 0x63,
+	0x6b, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -19,9 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_ClockContracts_FullMethodName = "/juno.clock.v1.Query/ClockContracts"
-	Query_ClockContract_FullMethodName  = "/juno.clock.v1.Query/ClockContract"
-	Query_Params_FullMethodName         = "/juno.clock.v1.Query/Params"
+	Query_ClockContracts_FullMethodName        = "/juno.clock.v1.Query/ClockContracts"
+	Query_ClockContract_FullMethodName         = "/juno.clock.v1.Query/ClockContract"
+	Query_ClockSchedule_FullMethodName         = "/juno.clock.v1.Query/ClockSchedule"
+	Query_SkippedClockContracts_FullMethodName = "/juno.clock.v1.Query/SkippedClockContracts"
+	Query_ClockContractStats_FullMethodName    = "/juno.clock.v1.Query/ClockContractStats"
+	Query_ClockStats_FullMethodName            = "/juno.clock.v1.Query/ClockStats"
+	Query_Params_FullMethodName                = "/juno.clock.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +38,14 @@ type QueryClient interface {
 	ClockContracts(ctx context.Context, in *QueryClockContractsRequest, opts ...grpc.CallOption) (*QueryClockContractsResponse, error)
 	// ClockContract
 	ClockContract(ctx context.Context, in *QueryClockContractRequest, opts ...grpc.CallOption) (*QueryClockContractResponse, error)
+	// ClockSchedule
+	ClockSchedule(ctx context.Context, in *QueryClockScheduleRequest, opts ...grpc.CallOption) (*QueryClockScheduleResponse, error)
+	// SkippedClockContracts
+	SkippedClockContracts(ctx context.Context, in *QuerySkippedClockContractsRequest, opts ...grpc.CallOption) (*QuerySkippedClockContractsResponse, error)
+	// ClockContractStats
+	ClockContractStats(ctx context.Context, in *QueryClockContractStatsRequest, opts ...grpc.CallOption) (*QueryClockContractStatsResponse, error)
+	// ClockStats
+	ClockStats(ctx context.Context, in *QueryClockStatsRequest, opts ...grpc.CallOption) (*QueryClockStatsResponse, error)
 	// Params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -66,6 +78,46 @@ func (c *queryClient) ClockContract(ctx context.Context, in *QueryClockContractR
 	return out, nil
 }
 
+func (c *queryClient) ClockSchedule(ctx context.Context, in *QueryClockScheduleRequest, opts ...grpc.CallOption) (*QueryClockScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryClockScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_ClockSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SkippedClockContracts(ctx context.Context, in *QuerySkippedClockContractsRequest, opts ...grpc.CallOption) (*QuerySkippedClockContractsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySkippedClockContractsResponse)
+	err := c.cc.Invoke(ctx, Query_SkippedClockContracts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClockContractStats(ctx context.Context, in *QueryClockContractStatsRequest, opts ...grpc.CallOption) (*QueryClockContractStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryClockContractStatsResponse)
+	err := c.cc.Invoke(ctx, Query_ClockContractStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClockStats(ctx context.Context, in *QueryClockStatsRequest, opts ...grpc.CallOption) (*QueryClockStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryClockStatsResponse)
+	err := c.cc.Invoke(ctx, Query_ClockStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryParamsResponse)
@@ -86,6 +138,14 @@ type QueryServer interface {
 	ClockContracts(context.Context, *QueryClockContractsRequest) (*QueryClockContractsResponse, error)
 	// ClockContract
 	ClockContract(context.Context, *QueryClockContractRequest) (*QueryClockContractResponse, error)
+	// ClockSchedule
+	ClockSchedule(context.Context, *QueryClockScheduleRequest) (*QueryClockScheduleResponse, error)
+	// SkippedClockContracts
+	SkippedClockContracts(context.Context, *QuerySkippedClockContractsRequest) (*QuerySkippedClockContractsResponse, error)
+	// ClockContractStats
+	ClockContractStats(context.Context, *QueryClockContractStatsRequest) (*QueryClockContractStatsResponse, error)
+	// ClockStats
+	ClockStats(context.Context, *QueryClockStatsRequest) (*QueryClockStatsResponse, error)
 	// Params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -104,6 +164,18 @@ func (UnimplementedQueryServer) ClockContracts(context.Context, *QueryClockContr
 func (UnimplementedQueryServer) ClockContract(context.Context, *QueryClockContractRequest) (*QueryClockContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClockContract not implemented")
 }
+func (UnimplementedQueryServer) ClockSchedule(context.Context, *QueryClockScheduleRequest) (*QueryClockScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClockSchedule not implemented")
+}
+func (UnimplementedQueryServer) SkippedClockContracts(context.Context, *QuerySkippedClockContractsRequest) (*QuerySkippedClockContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkippedClockContracts not implemented")
+}
+func (UnimplementedQueryServer) ClockContractStats(context.Context, *QueryClockContractStatsRequest) (*QueryClockContractStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClockContractStats not implemented")
+}
+func (UnimplementedQueryServer) ClockStats(context.Context, *QueryClockStatsRequest) (*QueryClockStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClockStats not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -164,6 +236,78 @@ func _Query_ClockContract_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClockSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClockScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClockSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClockSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClockSchedule(ctx, req.(*QueryClockScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SkippedClockContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySkippedClockContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SkippedClockContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SkippedClockContracts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SkippedClockContracts(ctx, req.(*QuerySkippedClockContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClockContractStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClockContractStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClockContractStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClockContractStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClockContractStats(ctx, req.(*QueryClockContractStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClockStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClockStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClockStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClockStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClockStats(ctx, req.(*QueryClockStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -197,6 +341,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClockContract",
 			Handler:    _Query_ClockContract_Handler,
 		},
+		{
+			MethodName: "ClockSchedule",
+			Handler:    _Query_ClockSchedule_Handler,
+		},
+		{
+			MethodName: "SkippedClockContracts",
+			Handler:    _Query_SkippedClockContracts_Handler,
+		},
+		{
+			MethodName: "ClockContractStats",
+			Handler:    _Query_ClockContractStats_Handler,
+		},
+		{
+			MethodName: "ClockStats",
+			Handler:    _Query_ClockStats_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
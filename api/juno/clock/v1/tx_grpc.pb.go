@@ -22,6 +22,8 @@ const (
 	Msg_RegisterClockContract_FullMethodName   = "/juno.clock.v1.Msg/RegisterClockContract"
 	Msg_UnregisterClockContract_FullMethodName = "/juno.clock.v1.Msg/UnregisterClockContract"
 	Msg_UnjailClockContract_FullMethodName     = "/juno.clock.v1.Msg/UnjailClockContract"
+	Msg_DepositClockGas_FullMethodName         = "/juno.clock.v1.Msg/DepositClockGas"
+	Msg_WithdrawClockGas_FullMethodName        = "/juno.clock.v1.Msg/WithdrawClockGas"
 	Msg_UpdateParams_FullMethodName            = "/juno.clock.v1.Msg/UpdateParams"
 )
 
@@ -40,6 +42,12 @@ type MsgClient interface {
 	// UnjailClockContract defines the endpoint for
 	// unjailing a clock contract.
 	UnjailClockContract(ctx context.Context, in *MsgUnjailClockContract, opts ...grpc.CallOption) (*MsgUnjailClockContractResponse, error)
+	// DepositClockGas defines the endpoint for
+	// topping up the gas deposit of a clock contract.
+	DepositClockGas(ctx context.Context, in *MsgDepositClockGas, opts ...grpc.CallOption) (*MsgDepositClockGasResponse, error)
+	// WithdrawClockGas defines the endpoint for
+	// withdrawing from the gas deposit of a clock contract.
+	WithdrawClockGas(ctx context.Context, in *MsgWithdrawClockGas, opts ...grpc.CallOption) (*MsgWithdrawClockGasResponse, error)
 	// UpdateParams defines a governance operation for updating the x/clock module
 	// parameters. The authority is hard-coded to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -83,6 +91,26 @@ func (c *msgClient) UnjailClockContract(ctx context.Context, in *MsgUnjailClockC
 	return out, nil
 }
 
+func (c *msgClient) DepositClockGas(ctx context.Context, in *MsgDepositClockGas, opts ...grpc.CallOption) (*MsgDepositClockGasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDepositClockGasResponse)
+	err := c.cc.Invoke(ctx, Msg_DepositClockGas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawClockGas(ctx context.Context, in *MsgWithdrawClockGas, opts ...grpc.CallOption) (*MsgWithdrawClockGasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgWithdrawClockGasResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawClockGas_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateParamsResponse)
@@ -108,6 +136,12 @@ type MsgServer interface {
 	// UnjailClockContract defines the endpoint for
 	// unjailing a clock contract.
 	UnjailClockContract(context.Context, *MsgUnjailClockContract) (*MsgUnjailClockContractResponse, error)
+	// DepositClockGas defines the endpoint for
+	// topping up the gas deposit of a clock contract.
+	DepositClockGas(context.Context, *MsgDepositClockGas) (*MsgDepositClockGasResponse, error)
+	// WithdrawClockGas defines the endpoint for
+	// withdrawing from the gas deposit of a clock contract.
+	WithdrawClockGas(context.Context, *MsgWithdrawClockGas) (*MsgWithdrawClockGasResponse, error)
 	// UpdateParams defines a governance operation for updating the x/clock module
 	// parameters. The authority is hard-coded to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -130,6 +164,12 @@ func (UnimplementedMsgServer) UnregisterClockContract(context.Context, *MsgUnreg
 func (UnimplementedMsgServer) UnjailClockContract(context.Context, *MsgUnjailClockContract) (*MsgUnjailClockContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnjailClockContract not implemented")
 }
+func (UnimplementedMsgServer) DepositClockGas(context.Context, *MsgDepositClockGas) (*MsgDepositClockGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositClockGas not implemented")
+}
+func (UnimplementedMsgServer) WithdrawClockGas(context.Context, *MsgWithdrawClockGas) (*MsgWithdrawClockGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawClockGas not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -208,6 +248,42 @@ func _Msg_UnjailClockContract_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DepositClockGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositClockGas)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositClockGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DepositClockGas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositClockGas(ctx, req.(*MsgDepositClockGas))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawClockGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawClockGas)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawClockGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawClockGas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawClockGas(ctx, req.(*MsgWithdrawClockGas))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -245,6 +321,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnjailClockContract",
 			Handler:    _Msg_UnjailClockContract_Handler,
 		},
+		{
+			MethodName: "DepositClockGas",
+			Handler:    _Msg_DepositClockGas_Handler,
+		},
+		{
+			MethodName: "WithdrawClockGas",
+			Handler:    _Msg_WithdrawClockGas_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -107,11 +107,521 @@ func (x *_GenesisState_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*ContractStatus
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractStatus)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ContractStatus)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(ContractStatus)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(ContractStatus)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*Subscription
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Subscription)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Subscription)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(Subscription)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(Subscription)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*Subscription
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Subscription)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Subscription)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(Subscription)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(Subscription)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*QueuedHook
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueuedHook)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueuedHook)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(QueuedHook)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(QueuedHook)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field DistributionContractAddresses as it is not of Message kind"))
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field SlashingContractAddresses as it is not of Message kind"))
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*Subscription
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Subscription)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Subscription)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(Subscription)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(Subscription)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*Subscription
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Subscription)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Subscription)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(Subscription)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(Subscription)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*BankHook
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BankHook)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BankHook)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(BankHook)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(BankHook)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*Registration
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Registration)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Registration)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(Registration)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(Registration)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                            protoreflect.MessageDescriptor
-	fd_GenesisState_params                     protoreflect.FieldDescriptor
-	fd_GenesisState_staking_contract_addresses protoreflect.FieldDescriptor
-	fd_GenesisState_gov_contract_addresses     protoreflect.FieldDescriptor
+	md_GenesisState                                 protoreflect.MessageDescriptor
+	fd_GenesisState_params                          protoreflect.FieldDescriptor
+	fd_GenesisState_staking_contract_addresses      protoreflect.FieldDescriptor
+	fd_GenesisState_gov_contract_addresses          protoreflect.FieldDescriptor
+	fd_GenesisState_contract_statuses               protoreflect.FieldDescriptor
+	fd_GenesisState_staking_subscriptions           protoreflect.FieldDescriptor
+	fd_GenesisState_gov_subscriptions               protoreflect.FieldDescriptor
+	fd_GenesisState_queued_hooks                    protoreflect.FieldDescriptor
+	fd_GenesisState_distribution_contract_addresses protoreflect.FieldDescriptor
+	fd_GenesisState_slashing_contract_addresses     protoreflect.FieldDescriptor
+	fd_GenesisState_distribution_subscriptions      protoreflect.FieldDescriptor
+	fd_GenesisState_slashing_subscriptions          protoreflect.FieldDescriptor
+	fd_GenesisState_bank_hooks                      protoreflect.FieldDescriptor
+	fd_GenesisState_registrations                   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -120,6 +630,16 @@ func init() {
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_staking_contract_addresses = md_GenesisState.Fields().ByName("staking_contract_addresses")
 	fd_GenesisState_gov_contract_addresses = md_GenesisState.Fields().ByName("gov_contract_addresses")
+	fd_GenesisState_contract_statuses = md_GenesisState.Fields().ByName("contract_statuses")
+	fd_GenesisState_staking_subscriptions = md_GenesisState.Fields().ByName("staking_subscriptions")
+	fd_GenesisState_gov_subscriptions = md_GenesisState.Fields().ByName("gov_subscriptions")
+	fd_GenesisState_queued_hooks = md_GenesisState.Fields().ByName("queued_hooks")
+	fd_GenesisState_distribution_contract_addresses = md_GenesisState.Fields().ByName("distribution_contract_addresses")
+	fd_GenesisState_slashing_contract_addresses = md_GenesisState.Fields().ByName("slashing_contract_addresses")
+	fd_GenesisState_distribution_subscriptions = md_GenesisState.Fields().ByName("distribution_subscriptions")
+	fd_GenesisState_slashing_subscriptions = md_GenesisState.Fields().ByName("slashing_subscriptions")
+	fd_GenesisState_bank_hooks = md_GenesisState.Fields().ByName("bank_hooks")
+	fd_GenesisState_registrations = md_GenesisState.Fields().ByName("registrations")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -205,6 +725,66 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.ContractStatuses) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.ContractStatuses})
+		if !f(fd_GenesisState_contract_statuses, value) {
+			return
+		}
+	}
+	if len(x.StakingSubscriptions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.StakingSubscriptions})
+		if !f(fd_GenesisState_staking_subscriptions, value) {
+			return
+		}
+	}
+	if len(x.GovSubscriptions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.GovSubscriptions})
+		if !f(fd_GenesisState_gov_subscriptions, value) {
+			return
+		}
+	}
+	if len(x.QueuedHooks) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.QueuedHooks})
+		if !f(fd_GenesisState_queued_hooks, value) {
+			return
+		}
+	}
+	if len(x.DistributionContractAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.DistributionContractAddresses})
+		if !f(fd_GenesisState_distribution_contract_addresses, value) {
+			return
+		}
+	}
+	if len(x.SlashingContractAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.SlashingContractAddresses})
+		if !f(fd_GenesisState_slashing_contract_addresses, value) {
+			return
+		}
+	}
+	if len(x.DistributionSubscriptions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.DistributionSubscriptions})
+		if !f(fd_GenesisState_distribution_subscriptions, value) {
+			return
+		}
+	}
+	if len(x.SlashingSubscriptions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.SlashingSubscriptions})
+		if !f(fd_GenesisState_slashing_subscriptions, value) {
+			return
+		}
+	}
+	if len(x.BankHooks) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.BankHooks})
+		if !f(fd_GenesisState_bank_hooks, value) {
+			return
+		}
+	}
+	if len(x.Registrations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.Registrations})
+		if !f(fd_GenesisState_registrations, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -226,6 +806,26 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.StakingContractAddresses) != 0
 	case "juno.cwhooks.v1.GenesisState.gov_contract_addresses":
 		return len(x.GovContractAddresses) != 0
+	case "juno.cwhooks.v1.GenesisState.contract_statuses":
+		return len(x.ContractStatuses) != 0
+	case "juno.cwhooks.v1.GenesisState.staking_subscriptions":
+		return len(x.StakingSubscriptions) != 0
+	case "juno.cwhooks.v1.GenesisState.gov_subscriptions":
+		return len(x.GovSubscriptions) != 0
+	case "juno.cwhooks.v1.GenesisState.queued_hooks":
+		return len(x.QueuedHooks) != 0
+	case "juno.cwhooks.v1.GenesisState.distribution_contract_addresses":
+		return len(x.DistributionContractAddresses) != 0
+	case "juno.cwhooks.v1.GenesisState.slashing_contract_addresses":
+		return len(x.SlashingContractAddresses) != 0
+	case "juno.cwhooks.v1.GenesisState.distribution_subscriptions":
+		return len(x.DistributionSubscriptions) != 0
+	case "juno.cwhooks.v1.GenesisState.slashing_subscriptions":
+		return len(x.SlashingSubscriptions) != 0
+	case "juno.cwhooks.v1.GenesisState.bank_hooks":
+		return len(x.BankHooks) != 0
+	case "juno.cwhooks.v1.GenesisState.registrations":
+		return len(x.Registrations) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.cwhooks.v1.GenesisState"))
@@ -248,6 +848,26 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.StakingContractAddresses = nil
 	case "juno.cwhooks.v1.GenesisState.gov_contract_addresses":
 		x.GovContractAddresses = nil
+	case "juno.cwhooks.v1.GenesisState.contract_statuses":
+		x.ContractStatuses = nil
+	case "juno.cwhooks.v1.GenesisState.staking_subscriptions":
+		x.StakingSubscriptions = nil
+	case "juno.cwhooks.v1.GenesisState.gov_subscriptions":
+		x.GovSubscriptions = nil
+	case "juno.cwhooks.v1.GenesisState.queued_hooks":
+		x.QueuedHooks = nil
+	case "juno.cwhooks.v1.GenesisState.distribution_contract_addresses":
+		x.DistributionContractAddresses = nil
+	case "juno.cwhooks.v1.GenesisState.slashing_contract_addresses":
+		x.SlashingContractAddresses = nil
+	case "juno.cwhooks.v1.GenesisState.distribution_subscriptions":
+		x.DistributionSubscriptions = nil
+	case "juno.cwhooks.v1.GenesisState.slashing_subscriptions":
+		x.SlashingSubscriptions = nil
+	case "juno.cwhooks.v1.GenesisState.bank_hooks":
+		x.BankHooks = nil
+	case "juno.cwhooks.v1.GenesisState.registrations":
+		x.Registrations = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.cwhooks.v1.GenesisState"))
@@ -279,6 +899,66 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_3_list{list: &x.GovContractAddresses}
 		return protoreflect.ValueOfList(listValue)
+	case "juno.cwhooks.v1.GenesisState.contract_statuses":
+		if len(x.ContractStatuses) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.ContractStatuses}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.cwhooks.v1.GenesisState.staking_subscriptions":
+		if len(x.StakingSubscriptions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.StakingSubscriptions}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.cwhooks.v1.GenesisState.gov_subscriptions":
+		if len(x.GovSubscriptions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.GovSubscriptions}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.cwhooks.v1.GenesisState.queued_hooks":
+		if len(x.QueuedHooks) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.QueuedHooks}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.cwhooks.v1.GenesisState.distribution_contract_addresses":
+		if len(x.DistributionContractAddresses) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.DistributionContractAddresses}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.cwhooks.v1.GenesisState.slashing_contract_addresses":
+		if len(x.SlashingContractAddresses) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.SlashingContractAddresses}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.cwhooks.v1.GenesisState.distribution_subscriptions":
+		if len(x.DistributionSubscriptions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.DistributionSubscriptions}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.cwhooks.v1.GenesisState.slashing_subscriptions":
+		if len(x.SlashingSubscriptions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.SlashingSubscriptions}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.cwhooks.v1.GenesisState.bank_hooks":
+		if len(x.BankHooks) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.BankHooks}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.cwhooks.v1.GenesisState.registrations":
+		if len(x.Registrations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.Registrations}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.cwhooks.v1.GenesisState"))
@@ -309,6 +989,46 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_3_list)
 		x.GovContractAddresses = *clv.list
+	case "juno.cwhooks.v1.GenesisState.contract_statuses":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.ContractStatuses = *clv.list
+	case "juno.cwhooks.v1.GenesisState.staking_subscriptions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.StakingSubscriptions = *clv.list
+	case "juno.cwhooks.v1.GenesisState.gov_subscriptions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.GovSubscriptions = *clv.list
+	case "juno.cwhooks.v1.GenesisState.queued_hooks":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.QueuedHooks = *clv.list
+	case "juno.cwhooks.v1.GenesisState.distribution_contract_addresses":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.DistributionContractAddresses = *clv.list
+	case "juno.cwhooks.v1.GenesisState.slashing_contract_addresses":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.SlashingContractAddresses = *clv.list
+	case "juno.cwhooks.v1.GenesisState.distribution_subscriptions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.DistributionSubscriptions = *clv.list
+	case "juno.cwhooks.v1.GenesisState.slashing_subscriptions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.SlashingSubscriptions = *clv.list
+	case "juno.cwhooks.v1.GenesisState.bank_hooks":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.BankHooks = *clv.list
+	case "juno.cwhooks.v1.GenesisState.registrations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.Registrations = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.cwhooks.v1.GenesisState"))
@@ -346,6 +1066,66 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_3_list{list: &x.GovContractAddresses}
 		return protoreflect.ValueOfList(value)
+	case "juno.cwhooks.v1.GenesisState.contract_statuses":
+		if x.ContractStatuses == nil {
+			x.ContractStatuses = []*ContractStatus{}
+		}
+		value := &_GenesisState_4_list{list: &x.ContractStatuses}
+		return protoreflect.ValueOfList(value)
+	case "juno.cwhooks.v1.GenesisState.staking_subscriptions":
+		if x.StakingSubscriptions == nil {
+			x.StakingSubscriptions = []*Subscription{}
+		}
+		value := &_GenesisState_5_list{list: &x.StakingSubscriptions}
+		return protoreflect.ValueOfList(value)
+	case "juno.cwhooks.v1.GenesisState.gov_subscriptions":
+		if x.GovSubscriptions == nil {
+			x.GovSubscriptions = []*Subscription{}
+		}
+		value := &_GenesisState_6_list{list: &x.GovSubscriptions}
+		return protoreflect.ValueOfList(value)
+	case "juno.cwhooks.v1.GenesisState.queued_hooks":
+		if x.QueuedHooks == nil {
+			x.QueuedHooks = []*QueuedHook{}
+		}
+		value := &_GenesisState_7_list{list: &x.QueuedHooks}
+		return protoreflect.ValueOfList(value)
+	case "juno.cwhooks.v1.GenesisState.distribution_contract_addresses":
+		if x.DistributionContractAddresses == nil {
+			x.DistributionContractAddresses = []string{}
+		}
+		value := &_GenesisState_8_list{list: &x.DistributionContractAddresses}
+		return protoreflect.ValueOfList(value)
+	case "juno.cwhooks.v1.GenesisState.slashing_contract_addresses":
+		if x.SlashingContractAddresses == nil {
+			x.SlashingContractAddresses = []string{}
+		}
+		value := &_GenesisState_9_list{list: &x.SlashingContractAddresses}
+		return protoreflect.ValueOfList(value)
+	case "juno.cwhooks.v1.GenesisState.distribution_subscriptions":
+		if x.DistributionSubscriptions == nil {
+			x.DistributionSubscriptions = []*Subscription{}
+		}
+		value := &_GenesisState_10_list{list: &x.DistributionSubscriptions}
+		return protoreflect.ValueOfList(value)
+	case "juno.cwhooks.v1.GenesisState.slashing_subscriptions":
+		if x.SlashingSubscriptions == nil {
+			x.SlashingSubscriptions = []*Subscription{}
+		}
+		value := &_GenesisState_11_list{list: &x.SlashingSubscriptions}
+		return protoreflect.ValueOfList(value)
+	case "juno.cwhooks.v1.GenesisState.bank_hooks":
+		if x.BankHooks == nil {
+			x.BankHooks = []*BankHook{}
+		}
+		value := &_GenesisState_12_list{list: &x.BankHooks}
+		return protoreflect.ValueOfList(value)
+	case "juno.cwhooks.v1.GenesisState.registrations":
+		if x.Registrations == nil {
+			x.Registrations = []*Registration{}
+		}
+		value := &_GenesisState_13_list{list: &x.Registrations}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.cwhooks.v1.GenesisState"))
@@ -368,6 +1148,36 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "juno.cwhooks.v1.GenesisState.gov_contract_addresses":
 		list := []string{}
 		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "juno.cwhooks.v1.GenesisState.contract_statuses":
+		list := []*ContractStatus{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "juno.cwhooks.v1.GenesisState.staking_subscriptions":
+		list := []*Subscription{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "juno.cwhooks.v1.GenesisState.gov_subscriptions":
+		list := []*Subscription{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "juno.cwhooks.v1.GenesisState.queued_hooks":
+		list := []*QueuedHook{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "juno.cwhooks.v1.GenesisState.distribution_contract_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "juno.cwhooks.v1.GenesisState.slashing_contract_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "juno.cwhooks.v1.GenesisState.distribution_subscriptions":
+		list := []*Subscription{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "juno.cwhooks.v1.GenesisState.slashing_subscriptions":
+		list := []*Subscription{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "juno.cwhooks.v1.GenesisState.bank_hooks":
+		list := []*BankHook{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "juno.cwhooks.v1.GenesisState.registrations":
+		list := []*Registration{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.cwhooks.v1.GenesisState"))
@@ -453,6 +1263,66 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.ContractStatuses) > 0 {
+			for _, e := range x.ContractStatuses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.StakingSubscriptions) > 0 {
+			for _, e := range x.StakingSubscriptions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.GovSubscriptions) > 0 {
+			for _, e := range x.GovSubscriptions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.QueuedHooks) > 0 {
+			for _, e := range x.QueuedHooks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DistributionContractAddresses) > 0 {
+			for _, s := range x.DistributionContractAddresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SlashingContractAddresses) > 0 {
+			for _, s := range x.SlashingContractAddresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DistributionSubscriptions) > 0 {
+			for _, e := range x.DistributionSubscriptions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SlashingSubscriptions) > 0 {
+			for _, e := range x.SlashingSubscriptions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BankHooks) > 0 {
+			for _, e := range x.BankHooks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Registrations) > 0 {
+			for _, e := range x.Registrations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -482,6 +1352,152 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Registrations) > 0 {
+			for iNdEx := len(x.Registrations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Registrations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.BankHooks) > 0 {
+			for iNdEx := len(x.BankHooks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BankHooks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.SlashingSubscriptions) > 0 {
+			for iNdEx := len(x.SlashingSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SlashingSubscriptions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.DistributionSubscriptions) > 0 {
+			for iNdEx := len(x.DistributionSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DistributionSubscriptions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.SlashingContractAddresses) > 0 {
+			for iNdEx := len(x.SlashingContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.SlashingContractAddresses[iNdEx])
+				copy(dAtA[i:], x.SlashingContractAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SlashingContractAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.DistributionContractAddresses) > 0 {
+			for iNdEx := len(x.DistributionContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DistributionContractAddresses[iNdEx])
+				copy(dAtA[i:], x.DistributionContractAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DistributionContractAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.QueuedHooks) > 0 {
+			for iNdEx := len(x.QueuedHooks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.QueuedHooks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.GovSubscriptions) > 0 {
+			for iNdEx := len(x.GovSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.GovSubscriptions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.StakingSubscriptions) > 0 {
+			for iNdEx := len(x.StakingSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StakingSubscriptions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ContractStatuses) > 0 {
+			for iNdEx := len(x.ContractStatuses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ContractStatuses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.GovContractAddresses) > 0 {
 			for iNdEx := len(x.GovContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.GovContractAddresses[iNdEx])
@@ -544,28 +1560,328 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakingContractAddresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StakingContractAddresses = append(x.StakingContractAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GovContractAddresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GovContractAddresses = append(x.GovContractAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractStatuses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractStatuses = append(x.ContractStatuses, &ContractStatus{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ContractStatuses[len(x.ContractStatuses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakingSubscriptions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StakingSubscriptions = append(x.StakingSubscriptions, &Subscription{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StakingSubscriptions[len(x.StakingSubscriptions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GovSubscriptions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GovSubscriptions = append(x.GovSubscriptions, &Subscription{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GovSubscriptions[len(x.GovSubscriptions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueuedHooks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QueuedHooks = append(x.QueuedHooks, &QueuedHook{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QueuedHooks[len(x.QueuedHooks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DistributionContractAddresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.DistributionContractAddresses = append(x.DistributionContractAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashingContractAddresses", wireType)
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashingContractAddresses = append(x.SlashingContractAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 10:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DistributionSubscriptions", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -592,18 +1908,50 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.DistributionSubscriptions = append(x.DistributionSubscriptions, &Subscription{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DistributionSubscriptions[len(x.DistributionSubscriptions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashingSubscriptions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashingSubscriptions = append(x.SlashingSubscriptions, &Subscription{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SlashingSubscriptions[len(x.SlashingSubscriptions)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 12:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakingContractAddresses", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankHooks", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -613,29 +1961,31 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.StakingContractAddresses = append(x.StakingContractAddresses, string(dAtA[iNdEx:postIndex]))
+				x.BankHooks = append(x.BankHooks, &BankHook{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BankHooks[len(x.BankHooks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 3:
+			case 13:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GovContractAddresses", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Registrations", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -645,23 +1995,25 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.GovContractAddresses = append(x.GovContractAddresses, string(dAtA[iNdEx:postIndex]))
+				x.Registrations = append(x.Registrations, &Registration{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Registrations[len(x.Registrations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -699,14 +2051,20 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Params                    protoreflect.MessageDescriptor
-	fd_Params_contract_gas_limit protoreflect.FieldDescriptor
+	md_Params                     protoreflect.MessageDescriptor
+	fd_Params_contract_gas_limit  protoreflect.FieldDescriptor
+	fd_Params_max_strikes         protoreflect.FieldDescriptor
+	fd_Params_jail_duration       protoreflect.FieldDescriptor
+	fd_Params_end_block_gas_limit protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_juno_cwhooks_v1_genesis_proto_init()
 	md_Params = File_juno_cwhooks_v1_genesis_proto.Messages().ByName("Params")
 	fd_Params_contract_gas_limit = md_Params.Fields().ByName("contract_gas_limit")
+	fd_Params_max_strikes = md_Params.Fields().ByName("max_strikes")
+	fd_Params_jail_duration = md_Params.Fields().ByName("jail_duration")
+	fd_Params_end_block_gas_limit = md_Params.Fields().ByName("end_block_gas_limit")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -780,6 +2138,24 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxStrikes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxStrikes)
+		if !f(fd_Params_max_strikes, value) {
+			return
+		}
+	}
+	if x.JailDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.JailDuration)
+		if !f(fd_Params_jail_duration, value) {
+			return
+		}
+	}
+	if x.EndBlockGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EndBlockGasLimit)
+		if !f(fd_Params_end_block_gas_limit, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -797,6 +2173,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "juno.cwhooks.v1.Params.contract_gas_limit":
 		return x.ContractGasLimit != uint64(0)
+	case "juno.cwhooks.v1.Params.max_strikes":
+		return x.MaxStrikes != uint64(0)
+	case "juno.cwhooks.v1.Params.jail_duration":
+		return x.JailDuration != uint64(0)
+	case "juno.cwhooks.v1.Params.end_block_gas_limit":
+		return x.EndBlockGasLimit != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.cwhooks.v1.Params"))
@@ -815,6 +2197,12 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "juno.cwhooks.v1.Params.contract_gas_limit":
 		x.ContractGasLimit = uint64(0)
+	case "juno.cwhooks.v1.Params.max_strikes":
+		x.MaxStrikes = uint64(0)
+	case "juno.cwhooks.v1.Params.jail_duration":
+		x.JailDuration = uint64(0)
+	case "juno.cwhooks.v1.Params.end_block_gas_limit":
+		x.EndBlockGasLimit = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.cwhooks.v1.Params"))
@@ -834,6 +2222,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "juno.cwhooks.v1.Params.contract_gas_limit":
 		value := x.ContractGasLimit
 		return protoreflect.ValueOfUint64(value)
+	case "juno.cwhooks.v1.Params.max_strikes":
+		value := x.MaxStrikes
+		return protoreflect.ValueOfUint64(value)
+	case "juno.cwhooks.v1.Params.jail_duration":
+		value := x.JailDuration
+		return protoreflect.ValueOfUint64(value)
+	case "juno.cwhooks.v1.Params.end_block_gas_limit":
+		value := x.EndBlockGasLimit
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.cwhooks.v1.Params"))
@@ -856,6 +2253,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "juno.cwhooks.v1.Params.contract_gas_limit":
 		x.ContractGasLimit = value.Uint()
+	case "juno.cwhooks.v1.Params.max_strikes":
+		x.MaxStrikes = value.Uint()
+	case "juno.cwhooks.v1.Params.jail_duration":
+		x.JailDuration = value.Uint()
+	case "juno.cwhooks.v1.Params.end_block_gas_limit":
+		x.EndBlockGasLimit = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.cwhooks.v1.Params"))
@@ -878,6 +2281,12 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 	switch fd.FullName() {
 	case "juno.cwhooks.v1.Params.contract_gas_limit":
 		panic(fmt.Errorf("field contract_gas_limit of message juno.cwhooks.v1.Params is not mutable"))
+	case "juno.cwhooks.v1.Params.max_strikes":
+		panic(fmt.Errorf("field max_strikes of message juno.cwhooks.v1.Params is not mutable"))
+	case "juno.cwhooks.v1.Params.jail_duration":
+		panic(fmt.Errorf("field jail_duration of message juno.cwhooks.v1.Params is not mutable"))
+	case "juno.cwhooks.v1.Params.end_block_gas_limit":
+		panic(fmt.Errorf("field end_block_gas_limit of message juno.cwhooks.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.cwhooks.v1.Params"))
@@ -893,6 +2302,12 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "juno.cwhooks.v1.Params.contract_gas_limit":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.cwhooks.v1.Params.max_strikes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.cwhooks.v1.Params.jail_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.cwhooks.v1.Params.end_block_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.cwhooks.v1.Params"))
@@ -965,6 +2380,15 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.ContractGasLimit != 0 {
 			n += 1 + runtime.Sov(uint64(x.ContractGasLimit))
 		}
+		if x.MaxStrikes != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxStrikes))
+		}
+		if x.JailDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.JailDuration))
+		}
+		if x.EndBlockGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndBlockGasLimit))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -994,6 +2418,21 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.EndBlockGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndBlockGasLimit))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.JailDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.JailDuration))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxStrikes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxStrikes))
+			i--
+			dAtA[i] = 0x10
+		}
 		if x.ContractGasLimit != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ContractGasLimit))
 			i--
@@ -1067,6 +2506,63 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxStrikes", wireType)
+				}
+				x.MaxStrikes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxStrikes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+				}
+				x.JailDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.JailDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndBlockGasLimit", wireType)
+				}
+				x.EndBlockGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndBlockGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1127,6 +2623,30 @@ type GenesisState struct {
 	StakingContractAddresses []string `protobuf:"bytes,2,rep,name=staking_contract_addresses,json=stakingContractAddresses,proto3" json:"staking_contract_addresses,omitempty"`
 	// gov_contract_addresses
 	GovContractAddresses []string `protobuf:"bytes,3,rep,name=gov_contract_addresses,json=govContractAddresses,proto3" json:"gov_contract_addresses,omitempty"`
+	// contract_statuses are the failures and jail statuses of hook contracts
+	ContractStatuses []*ContractStatus `protobuf:"bytes,4,rep,name=contract_statuses,json=contractStatuses,proto3" json:"contract_statuses,omitempty"`
+	// staking_subscriptions are the subscriptions of staking contracts which filter
+	// their events or receive them deferred
+	StakingSubscriptions []*Subscription `protobuf:"bytes,5,rep,name=staking_subscriptions,json=stakingSubscriptions,proto3" json:"staking_subscriptions,omitempty"`
+	// gov_subscriptions are the subscriptions of governance contracts which filter
+	// their events or receive them deferred
+	GovSubscriptions []*Subscription `protobuf:"bytes,6,rep,name=gov_subscriptions,json=govSubscriptions,proto3" json:"gov_subscriptions,omitempty"`
+	// queued_hooks are the hooks waiting to be delivered to deferred contracts
+	QueuedHooks []*QueuedHook `protobuf:"bytes,7,rep,name=queued_hooks,json=queuedHooks,proto3" json:"queued_hooks,omitempty"`
+	// distribution_contract_addresses
+	DistributionContractAddresses []string `protobuf:"bytes,8,rep,name=distribution_contract_addresses,json=distributionContractAddresses,proto3" json:"distribution_contract_addresses,omitempty"`
+	// slashing_contract_addresses
+	SlashingContractAddresses []string `protobuf:"bytes,9,rep,name=slashing_contract_addresses,json=slashingContractAddresses,proto3" json:"slashing_contract_addresses,omitempty"`
+	// distribution_subscriptions are the subscriptions of distribution contracts which
+	// filter their events or receive them deferred
+	DistributionSubscriptions []*Subscription `protobuf:"bytes,10,rep,name=distribution_subscriptions,json=distributionSubscriptions,proto3" json:"distribution_subscriptions,omitempty"`
+	// slashing_subscriptions are the subscriptions of slashing contracts which filter
+	// their events or receive them deferred
+	SlashingSubscriptions []*Subscription `protobuf:"bytes,11,rep,name=slashing_subscriptions,json=slashingSubscriptions,proto3" json:"slashing_subscriptions,omitempty"`
+	// bank_hooks are the contracts attached to token factory denoms
+	BankHooks []*BankHook `protobuf:"bytes,12,rep,name=bank_hooks,json=bankHooks,proto3" json:"bank_hooks,omitempty"`
+	// registrations record who registered each contract and when
+	Registrations []*Registration `protobuf:"bytes,13,rep,name=registrations,proto3" json:"registrations,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1170,6 +2690,76 @@ func (x *GenesisState) GetGovContractAddresses() []string {
 	return nil
 }
 
+func (x *GenesisState) GetContractStatuses() []*ContractStatus {
+	if x != nil {
+		return x.ContractStatuses
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStakingSubscriptions() []*Subscription {
+	if x != nil {
+		return x.StakingSubscriptions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetGovSubscriptions() []*Subscription {
+	if x != nil {
+		return x.GovSubscriptions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetQueuedHooks() []*QueuedHook {
+	if x != nil {
+		return x.QueuedHooks
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDistributionContractAddresses() []string {
+	if x != nil {
+		return x.DistributionContractAddresses
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSlashingContractAddresses() []string {
+	if x != nil {
+		return x.SlashingContractAddresses
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDistributionSubscriptions() []*Subscription {
+	if x != nil {
+		return x.DistributionSubscriptions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSlashingSubscriptions() []*Subscription {
+	if x != nil {
+		return x.SlashingSubscriptions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBankHooks() []*BankHook {
+	if x != nil {
+		return x.BankHooks
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRegistrations() []*Registration {
+	if x != nil {
+		return x.Registrations
+	}
+	return nil
+}
+
 // Params defines the set of module parameters.
 type Params struct {
 	state         protoimpl.MessageState
@@ -1178,6 +2768,15 @@ type Params struct {
 
 	// contract_gas_limit is the contract call gas limit
 	ContractGasLimit uint64 `protobuf:"varint,1,opt,name=contract_gas_limit,json=contractGasLimit,proto3" json:"contract_gas_limit,omitempty"`
+	// max_strikes is the number of consecutive failed executions a contract is
+	// allowed before it is jailed. Zero jails a contract on its first failure.
+	MaxStrikes uint64 `protobuf:"varint,2,opt,name=max_strikes,json=maxStrikes,proto3" json:"max_strikes,omitempty"`
+	// jail_duration is the number of blocks after which a jailed contract is
+	// automatically unjailed. Zero keeps contracts jailed until they are unjailed.
+	JailDuration uint64 `protobuf:"varint,3,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty"`
+	// end_block_gas_limit is the gas the module spends per block on delivering
+	// queued hooks to deferred contracts. Zero disables deferred delivery.
+	EndBlockGasLimit uint64 `protobuf:"varint,4,opt,name=end_block_gas_limit,json=endBlockGasLimit,proto3" json:"end_block_gas_limit,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1207,6 +2806,27 @@ func (x *Params) GetContractGasLimit() uint64 {
 	return 0
 }
 
+func (x *Params) GetMaxStrikes() uint64 {
+	if x != nil {
+		return x.MaxStrikes
+	}
+	return 0
+}
+
+func (x *Params) GetJailDuration() uint64 {
+	if x != nil {
+		return x.JailDuration
+	}
+	return 0
+}
+
+func (x *Params) GetEndBlockGasLimit() uint64 {
+	if x != nil {
+		return x.EndBlockGasLimit
+	}
+	return 0
+}
+
 var File_juno_cwhooks_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_juno_cwhooks_v1_genesis_proto_rawDesc = []byte{
@@ -1217,37 +2837,100 @@ var file_juno_cwhooks_v1_genesis_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
 	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf2, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x77, 0x68,
-	0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09,
-	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x56, 0x0a, 0x1a, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x63, 0x77, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xbf, 0x08, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x77, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x56, 0x0a, 0x1a, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x18,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x16, 0x67, 0x6f, 0x76, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x14, 0x67, 0x6f, 0x76, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x77, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x15,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x75,
+	0x6e, 0x6f, 0x2e, 0x63, 0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x14, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x67, 0x6f, 0x76, 0x5f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x67, 0x6f, 0x76, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x44, 0x0a, 0x0c, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x64, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x60,
+	0x0a, 0x1f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x1d, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x58, 0x0a, 0x1b, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e,
 	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x09, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x18, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x16, 0x67, 0x6f, 0x76,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x14, 0x67, 0x6f, 0x76, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
-	0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xad, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e,
-	0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x76, 0x31, 0x42,
-	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x63, 0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x76,
-	0x31, 0x3b, 0x63, 0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a, 0x43,
-	0x58, 0xaa, 0x02, 0x0f, 0x4a, 0x75, 0x6e, 0x6f, 0x2e, 0x43, 0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x43, 0x77, 0x68, 0x6f, 0x6f,
-	0x6b, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x43, 0x77, 0x68,
-	0x6f, 0x6f, 0x6b, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x4a, 0x75, 0x6e, 0x6f, 0x3a, 0x3a, 0x43, 0x77, 0x68, 0x6f,
-	0x6f, 0x6b, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x19, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x62, 0x0a, 0x1a, 0x64, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x19, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5a,
+	0x0a, 0x16, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x15, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0a, 0x62, 0x61,
+	0x6e, 0x6b, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x6e, 0x6b, 0x48, 0x6f, 0x6f, 0x6b, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x09, 0x62, 0x61, 0x6e, 0x6b, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x49, 0x0a, 0x0d, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x67, 0x61, 0x73,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x72, 0x69, 0x6b, 0x65, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6a, 0x61, 0x69, 0x6c, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x13, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xad, 0x01, 0x0a, 0x13, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x63, 0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x76,
+	0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x63, 0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x2f, 0x76, 0x31, 0x3b, 0x63, 0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x4a, 0x43, 0x58, 0xaa, 0x02, 0x0f, 0x4a, 0x75, 0x6e, 0x6f, 0x2e, 0x43, 0x77, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x43, 0x77, 0x68,
+	0x6f, 0x6f, 0x6b, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x43,
+	0x77, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x4a, 0x75, 0x6e, 0x6f, 0x3a, 0x3a, 0x43, 0x77,
+	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1264,16 +2947,29 @@ func file_juno_cwhooks_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_juno_cwhooks_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_juno_cwhooks_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: juno.cwhooks.v1.GenesisState
-	(*Params)(nil),       // 1: juno.cwhooks.v1.Params
+	(*GenesisState)(nil),   // 0: juno.cwhooks.v1.GenesisState
+	(*Params)(nil),         // 1: juno.cwhooks.v1.Params
+	(*ContractStatus)(nil), // 2: juno.cwhooks.v1.ContractStatus
+	(*Subscription)(nil),   // 3: juno.cwhooks.v1.Subscription
+	(*QueuedHook)(nil),     // 4: juno.cwhooks.v1.QueuedHook
+	(*BankHook)(nil),       // 5: juno.cwhooks.v1.BankHook
+	(*Registration)(nil),   // 6: juno.cwhooks.v1.Registration
 }
 var file_juno_cwhooks_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: juno.cwhooks.v1.GenesisState.params:type_name -> juno.cwhooks.v1.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: juno.cwhooks.v1.GenesisState.contract_statuses:type_name -> juno.cwhooks.v1.ContractStatus
+	3, // 2: juno.cwhooks.v1.GenesisState.staking_subscriptions:type_name -> juno.cwhooks.v1.Subscription
+	3, // 3: juno.cwhooks.v1.GenesisState.gov_subscriptions:type_name -> juno.cwhooks.v1.Subscription
+	4, // 4: juno.cwhooks.v1.GenesisState.queued_hooks:type_name -> juno.cwhooks.v1.QueuedHook
+	3, // 5: juno.cwhooks.v1.GenesisState.distribution_subscriptions:type_name -> juno.cwhooks.v1.Subscription
+	3, // 6: juno.cwhooks.v1.GenesisState.slashing_subscriptions:type_name -> juno.cwhooks.v1.Subscription
+	5, // 7: juno.cwhooks.v1.GenesisState.bank_hooks:type_name -> juno.cwhooks.v1.BankHook
+	6, // 8: juno.cwhooks.v1.GenesisState.registrations:type_name -> juno.cwhooks.v1.Registration
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_juno_cwhooks_v1_genesis_proto_init() }
@@ -1281,6 +2977,7 @@ func file_juno_cwhooks_v1_genesis_proto_init() {
 	if File_juno_cwhooks_v1_genesis_proto != nil {
 		return
 	}
+	file_juno_cwhooks_v1_cwhooks_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_juno_cwhooks_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
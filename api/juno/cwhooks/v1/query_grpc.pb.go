@@ -19,9 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName              = "/juno.cwhooks.v1.Query/Params"
-	Query_StakingContracts_FullMethodName    = "/juno.cwhooks.v1.Query/StakingContracts"
-	Query_GovernanceContracts_FullMethodName = "/juno.cwhooks.v1.Query/GovernanceContracts"
+	Query_Params_FullMethodName                = "/juno.cwhooks.v1.Query/Params"
+	Query_StakingContracts_FullMethodName      = "/juno.cwhooks.v1.Query/StakingContracts"
+	Query_GovernanceContracts_FullMethodName   = "/juno.cwhooks.v1.Query/GovernanceContracts"
+	Query_ContractStatus_FullMethodName        = "/juno.cwhooks.v1.Query/ContractStatus"
+	Query_Subscriptions_FullMethodName         = "/juno.cwhooks.v1.Query/Subscriptions"
+	Query_DistributionContracts_FullMethodName = "/juno.cwhooks.v1.Query/DistributionContracts"
+	Query_SlashingContracts_FullMethodName     = "/juno.cwhooks.v1.Query/SlashingContracts"
+	Query_BankHooks_FullMethodName             = "/juno.cwhooks.v1.Query/BankHooks"
+	Query_BankHook_FullMethodName              = "/juno.cwhooks.v1.Query/BankHook"
+	Query_ContractRegistrations_FullMethodName = "/juno.cwhooks.v1.Query/ContractRegistrations"
+	Query_Registrations_FullMethodName         = "/juno.cwhooks.v1.Query/Registrations"
+	Query_QueuedHooks_FullMethodName           = "/juno.cwhooks.v1.Query/QueuedHooks"
 )
 
 // QueryClient is the client API for Query service.
@@ -36,6 +45,24 @@ type QueryClient interface {
 	StakingContracts(ctx context.Context, in *QueryStakingContractsRequest, opts ...grpc.CallOption) (*QueryStakingContractsResponse, error)
 	// GovernanceContracts
 	GovernanceContracts(ctx context.Context, in *QueryGovernanceContractsRequest, opts ...grpc.CallOption) (*QueryGovernanceContractsResponse, error)
+	// ContractStatus
+	ContractStatus(ctx context.Context, in *QueryContractStatusRequest, opts ...grpc.CallOption) (*QueryContractStatusResponse, error)
+	// Subscriptions
+	Subscriptions(ctx context.Context, in *QuerySubscriptionsRequest, opts ...grpc.CallOption) (*QuerySubscriptionsResponse, error)
+	// DistributionContracts
+	DistributionContracts(ctx context.Context, in *QueryDistributionContractsRequest, opts ...grpc.CallOption) (*QueryDistributionContractsResponse, error)
+	// SlashingContracts
+	SlashingContracts(ctx context.Context, in *QuerySlashingContractsRequest, opts ...grpc.CallOption) (*QuerySlashingContractsResponse, error)
+	// BankHooks
+	BankHooks(ctx context.Context, in *QueryBankHooksRequest, opts ...grpc.CallOption) (*QueryBankHooksResponse, error)
+	// BankHook
+	BankHook(ctx context.Context, in *QueryBankHookRequest, opts ...grpc.CallOption) (*QueryBankHookResponse, error)
+	// ContractRegistrations
+	ContractRegistrations(ctx context.Context, in *QueryContractRegistrationsRequest, opts ...grpc.CallOption) (*QueryContractRegistrationsResponse, error)
+	// Registrations
+	Registrations(ctx context.Context, in *QueryRegistrationsRequest, opts ...grpc.CallOption) (*QueryRegistrationsResponse, error)
+	// QueuedHooks
+	QueuedHooks(ctx context.Context, in *QueryQueuedHooksRequest, opts ...grpc.CallOption) (*QueryQueuedHooksResponse, error)
 }
 
 type queryClient struct {
@@ -76,6 +103,96 @@ func (c *queryClient) GovernanceContracts(ctx context.Context, in *QueryGovernan
 	return out, nil
 }
 
+func (c *queryClient) ContractStatus(ctx context.Context, in *QueryContractStatusRequest, opts ...grpc.CallOption) (*QueryContractStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryContractStatusResponse)
+	err := c.cc.Invoke(ctx, Query_ContractStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Subscriptions(ctx context.Context, in *QuerySubscriptionsRequest, opts ...grpc.CallOption) (*QuerySubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySubscriptionsResponse)
+	err := c.cc.Invoke(ctx, Query_Subscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributionContracts(ctx context.Context, in *QueryDistributionContractsRequest, opts ...grpc.CallOption) (*QueryDistributionContractsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDistributionContractsResponse)
+	err := c.cc.Invoke(ctx, Query_DistributionContracts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashingContracts(ctx context.Context, in *QuerySlashingContractsRequest, opts ...grpc.CallOption) (*QuerySlashingContractsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySlashingContractsResponse)
+	err := c.cc.Invoke(ctx, Query_SlashingContracts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BankHooks(ctx context.Context, in *QueryBankHooksRequest, opts ...grpc.CallOption) (*QueryBankHooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBankHooksResponse)
+	err := c.cc.Invoke(ctx, Query_BankHooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BankHook(ctx context.Context, in *QueryBankHookRequest, opts ...grpc.CallOption) (*QueryBankHookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBankHookResponse)
+	err := c.cc.Invoke(ctx, Query_BankHook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractRegistrations(ctx context.Context, in *QueryContractRegistrationsRequest, opts ...grpc.CallOption) (*QueryContractRegistrationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryContractRegistrationsResponse)
+	err := c.cc.Invoke(ctx, Query_ContractRegistrations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Registrations(ctx context.Context, in *QueryRegistrationsRequest, opts ...grpc.CallOption) (*QueryRegistrationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRegistrationsResponse)
+	err := c.cc.Invoke(ctx, Query_Registrations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedHooks(ctx context.Context, in *QueryQueuedHooksRequest, opts ...grpc.CallOption) (*QueryQueuedHooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryQueuedHooksResponse)
+	err := c.cc.Invoke(ctx, Query_QueuedHooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -88,6 +205,24 @@ type QueryServer interface {
 	StakingContracts(context.Context, *QueryStakingContractsRequest) (*QueryStakingContractsResponse, error)
 	// GovernanceContracts
 	GovernanceContracts(context.Context, *QueryGovernanceContractsRequest) (*QueryGovernanceContractsResponse, error)
+	// ContractStatus
+	ContractStatus(context.Context, *QueryContractStatusRequest) (*QueryContractStatusResponse, error)
+	// Subscriptions
+	Subscriptions(context.Context, *QuerySubscriptionsRequest) (*QuerySubscriptionsResponse, error)
+	// DistributionContracts
+	DistributionContracts(context.Context, *QueryDistributionContractsRequest) (*QueryDistributionContractsResponse, error)
+	// SlashingContracts
+	SlashingContracts(context.Context, *QuerySlashingContractsRequest) (*QuerySlashingContractsResponse, error)
+	// BankHooks
+	BankHooks(context.Context, *QueryBankHooksRequest) (*QueryBankHooksResponse, error)
+	// BankHook
+	BankHook(context.Context, *QueryBankHookRequest) (*QueryBankHookResponse, error)
+	// ContractRegistrations
+	ContractRegistrations(context.Context, *QueryContractRegistrationsRequest) (*QueryContractRegistrationsResponse, error)
+	// Registrations
+	Registrations(context.Context, *QueryRegistrationsRequest) (*QueryRegistrationsResponse, error)
+	// QueuedHooks
+	QueuedHooks(context.Context, *QueryQueuedHooksRequest) (*QueryQueuedHooksResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +242,33 @@ func (UnimplementedQueryServer) StakingContracts(context.Context, *QueryStakingC
 func (UnimplementedQueryServer) GovernanceContracts(context.Context, *QueryGovernanceContractsRequest) (*QueryGovernanceContractsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GovernanceContracts not implemented")
 }
+func (UnimplementedQueryServer) ContractStatus(context.Context, *QueryContractStatusRequest) (*QueryContractStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractStatus not implemented")
+}
+func (UnimplementedQueryServer) Subscriptions(context.Context, *QuerySubscriptionsRequest) (*QuerySubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscriptions not implemented")
+}
+func (UnimplementedQueryServer) DistributionContracts(context.Context, *QueryDistributionContractsRequest) (*QueryDistributionContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionContracts not implemented")
+}
+func (UnimplementedQueryServer) SlashingContracts(context.Context, *QuerySlashingContractsRequest) (*QuerySlashingContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashingContracts not implemented")
+}
+func (UnimplementedQueryServer) BankHooks(context.Context, *QueryBankHooksRequest) (*QueryBankHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BankHooks not implemented")
+}
+func (UnimplementedQueryServer) BankHook(context.Context, *QueryBankHookRequest) (*QueryBankHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BankHook not implemented")
+}
+func (UnimplementedQueryServer) ContractRegistrations(context.Context, *QueryContractRegistrationsRequest) (*QueryContractRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractRegistrations not implemented")
+}
+func (UnimplementedQueryServer) Registrations(context.Context, *QueryRegistrationsRequest) (*QueryRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Registrations not implemented")
+}
+func (UnimplementedQueryServer) QueuedHooks(context.Context, *QueryQueuedHooksRequest) (*QueryQueuedHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedHooks not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -182,6 +344,168 @@ func _Query_GovernanceContracts_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ContractStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractStatus(ctx, req.(*QueryContractStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Subscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Subscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Subscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Subscriptions(ctx, req.(*QuerySubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DistributionContracts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionContracts(ctx, req.(*QueryDistributionContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashingContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashingContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashingContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashingContracts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashingContracts(ctx, req.(*QuerySlashingContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BankHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBankHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BankHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BankHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BankHooks(ctx, req.(*QueryBankHooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BankHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBankHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BankHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BankHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BankHook(ctx, req.(*QueryBankHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContractRegistrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractRegistrations(ctx, req.(*QueryContractRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Registrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Registrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Registrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Registrations(ctx, req.(*QueryRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueuedHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedHooks(ctx, req.(*QueryQueuedHooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +525,42 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GovernanceContracts",
 			Handler:    _Query_GovernanceContracts_Handler,
 		},
+		{
+			MethodName: "ContractStatus",
+			Handler:    _Query_ContractStatus_Handler,
+		},
+		{
+			MethodName: "Subscriptions",
+			Handler:    _Query_Subscriptions_Handler,
+		},
+		{
+			MethodName: "DistributionContracts",
+			Handler:    _Query_DistributionContracts_Handler,
+		},
+		{
+			MethodName: "SlashingContracts",
+			Handler:    _Query_SlashingContracts_Handler,
+		},
+		{
+			MethodName: "BankHooks",
+			Handler:    _Query_BankHooks_Handler,
+		},
+		{
+			MethodName: "BankHook",
+			Handler:    _Query_BankHook_Handler,
+		},
+		{
+			MethodName: "ContractRegistrations",
+			Handler:    _Query_ContractRegistrations_Handler,
+		},
+		{
+			MethodName: "Registrations",
+			Handler:    _Query_Registrations_Handler,
+		},
+		{
+			MethodName: "QueuedHooks",
+			Handler:    _Query_QueuedHooks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "juno/cwhooks/v1/query.proto",
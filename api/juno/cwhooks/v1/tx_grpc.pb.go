@@ -19,11 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_UpdateParams_FullMethodName         = "/juno.cwhooks.v1.Msg/UpdateParams"
-	Msg_RegisterStaking_FullMethodName      = "/juno.cwhooks.v1.Msg/RegisterStaking"
-	Msg_UnregisterStaking_FullMethodName    = "/juno.cwhooks.v1.Msg/UnregisterStaking"
-	Msg_RegisterGovernance_FullMethodName   = "/juno.cwhooks.v1.Msg/RegisterGovernance"
-	Msg_UnregisterGovernance_FullMethodName = "/juno.cwhooks.v1.Msg/UnregisterGovernance"
+	Msg_UpdateParams_FullMethodName           = "/juno.cwhooks.v1.Msg/UpdateParams"
+	Msg_RegisterStaking_FullMethodName        = "/juno.cwhooks.v1.Msg/RegisterStaking"
+	Msg_UnregisterStaking_FullMethodName      = "/juno.cwhooks.v1.Msg/UnregisterStaking"
+	Msg_RegisterGovernance_FullMethodName     = "/juno.cwhooks.v1.Msg/RegisterGovernance"
+	Msg_UnregisterGovernance_FullMethodName   = "/juno.cwhooks.v1.Msg/UnregisterGovernance"
+	Msg_UnjailContract_FullMethodName         = "/juno.cwhooks.v1.Msg/UnjailContract"
+	Msg_RegisterDistribution_FullMethodName   = "/juno.cwhooks.v1.Msg/RegisterDistribution"
+	Msg_UnregisterDistribution_FullMethodName = "/juno.cwhooks.v1.Msg/UnregisterDistribution"
+	Msg_RegisterSlashing_FullMethodName       = "/juno.cwhooks.v1.Msg/RegisterSlashing"
+	Msg_UnregisterSlashing_FullMethodName     = "/juno.cwhooks.v1.Msg/UnregisterSlashing"
+	Msg_RegisterBank_FullMethodName           = "/juno.cwhooks.v1.Msg/RegisterBank"
+	Msg_UnregisterBank_FullMethodName         = "/juno.cwhooks.v1.Msg/UnregisterBank"
 )
 
 // MsgClient is the client API for Msg service.
@@ -43,6 +50,20 @@ type MsgClient interface {
 	RegisterGovernance(ctx context.Context, in *MsgRegisterGovernance, opts ...grpc.CallOption) (*MsgRegisterGovernanceResponse, error)
 	// UnregisterGovernance.
 	UnregisterGovernance(ctx context.Context, in *MsgUnregisterGovernance, opts ...grpc.CallOption) (*MsgUnregisterGovernanceResponse, error)
+	// UnjailContract.
+	UnjailContract(ctx context.Context, in *MsgUnjailContract, opts ...grpc.CallOption) (*MsgUnjailContractResponse, error)
+	// RegisterDistribution.
+	RegisterDistribution(ctx context.Context, in *MsgRegisterDistribution, opts ...grpc.CallOption) (*MsgRegisterDistributionResponse, error)
+	// UnregisterDistribution.
+	UnregisterDistribution(ctx context.Context, in *MsgUnregisterDistribution, opts ...grpc.CallOption) (*MsgUnregisterDistributionResponse, error)
+	// RegisterSlashing.
+	RegisterSlashing(ctx context.Context, in *MsgRegisterSlashing, opts ...grpc.CallOption) (*MsgRegisterSlashingResponse, error)
+	// UnregisterSlashing.
+	UnregisterSlashing(ctx context.Context, in *MsgUnregisterSlashing, opts ...grpc.CallOption) (*MsgUnregisterSlashingResponse, error)
+	// RegisterBank attaches a contract to a token factory denom.
+	RegisterBank(ctx context.Context, in *MsgRegisterBank, opts ...grpc.CallOption) (*MsgRegisterBankResponse, error)
+	// UnregisterBank removes the contract of a token factory denom.
+	UnregisterBank(ctx context.Context, in *MsgUnregisterBank, opts ...grpc.CallOption) (*MsgUnregisterBankResponse, error)
 }
 
 type msgClient struct {
@@ -103,6 +124,76 @@ func (c *msgClient) UnregisterGovernance(ctx context.Context, in *MsgUnregisterG
 	return out, nil
 }
 
+func (c *msgClient) UnjailContract(ctx context.Context, in *MsgUnjailContract, opts ...grpc.CallOption) (*MsgUnjailContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnjailContractResponse)
+	err := c.cc.Invoke(ctx, Msg_UnjailContract_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterDistribution(ctx context.Context, in *MsgRegisterDistribution, opts ...grpc.CallOption) (*MsgRegisterDistributionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRegisterDistributionResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterDistribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnregisterDistribution(ctx context.Context, in *MsgUnregisterDistribution, opts ...grpc.CallOption) (*MsgUnregisterDistributionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnregisterDistributionResponse)
+	err := c.cc.Invoke(ctx, Msg_UnregisterDistribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterSlashing(ctx context.Context, in *MsgRegisterSlashing, opts ...grpc.CallOption) (*MsgRegisterSlashingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRegisterSlashingResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterSlashing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnregisterSlashing(ctx context.Context, in *MsgUnregisterSlashing, opts ...grpc.CallOption) (*MsgUnregisterSlashingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnregisterSlashingResponse)
+	err := c.cc.Invoke(ctx, Msg_UnregisterSlashing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterBank(ctx context.Context, in *MsgRegisterBank, opts ...grpc.CallOption) (*MsgRegisterBankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRegisterBankResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnregisterBank(ctx context.Context, in *MsgUnregisterBank, opts ...grpc.CallOption) (*MsgUnregisterBankResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnregisterBankResponse)
+	err := c.cc.Invoke(ctx, Msg_UnregisterBank_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -120,6 +211,20 @@ type MsgServer interface {
 	RegisterGovernance(context.Context, *MsgRegisterGovernance) (*MsgRegisterGovernanceResponse, error)
 	// UnregisterGovernance.
 	UnregisterGovernance(context.Context, *MsgUnregisterGovernance) (*MsgUnregisterGovernanceResponse, error)
+	// UnjailContract.
+	UnjailContract(context.Context, *MsgUnjailContract) (*MsgUnjailContractResponse, error)
+	// RegisterDistribution.
+	RegisterDistribution(context.Context, *MsgRegisterDistribution) (*MsgRegisterDistributionResponse, error)
+	// UnregisterDistribution.
+	UnregisterDistribution(context.Context, *MsgUnregisterDistribution) (*MsgUnregisterDistributionResponse, error)
+	// RegisterSlashing.
+	RegisterSlashing(context.Context, *MsgRegisterSlashing) (*MsgRegisterSlashingResponse, error)
+	// UnregisterSlashing.
+	UnregisterSlashing(context.Context, *MsgUnregisterSlashing) (*MsgUnregisterSlashingResponse, error)
+	// RegisterBank attaches a contract to a token factory denom.
+	RegisterBank(context.Context, *MsgRegisterBank) (*MsgRegisterBankResponse, error)
+	// UnregisterBank removes the contract of a token factory denom.
+	UnregisterBank(context.Context, *MsgUnregisterBank) (*MsgUnregisterBankResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -145,6 +250,27 @@ func (UnimplementedMsgServer) RegisterGovernance(context.Context, *MsgRegisterGo
 func (UnimplementedMsgServer) UnregisterGovernance(context.Context, *MsgUnregisterGovernance) (*MsgUnregisterGovernanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnregisterGovernance not implemented")
 }
+func (UnimplementedMsgServer) UnjailContract(context.Context, *MsgUnjailContract) (*MsgUnjailContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailContract not implemented")
+}
+func (UnimplementedMsgServer) RegisterDistribution(context.Context, *MsgRegisterDistribution) (*MsgRegisterDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDistribution not implemented")
+}
+func (UnimplementedMsgServer) UnregisterDistribution(context.Context, *MsgUnregisterDistribution) (*MsgUnregisterDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterDistribution not implemented")
+}
+func (UnimplementedMsgServer) RegisterSlashing(context.Context, *MsgRegisterSlashing) (*MsgRegisterSlashingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSlashing not implemented")
+}
+func (UnimplementedMsgServer) UnregisterSlashing(context.Context, *MsgUnregisterSlashing) (*MsgUnregisterSlashingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterSlashing not implemented")
+}
+func (UnimplementedMsgServer) RegisterBank(context.Context, *MsgRegisterBank) (*MsgRegisterBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBank not implemented")
+}
+func (UnimplementedMsgServer) UnregisterBank(context.Context, *MsgUnregisterBank) (*MsgUnregisterBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterBank not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -256,6 +382,132 @@ func _Msg_UnregisterGovernance_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UnjailContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnjailContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailContract(ctx, req.(*MsgUnjailContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterDistribution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterDistribution(ctx, req.(*MsgRegisterDistribution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnregisterDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterDistribution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnregisterDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterDistribution(ctx, req.(*MsgUnregisterDistribution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterSlashing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSlashing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSlashing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterSlashing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSlashing(ctx, req.(*MsgRegisterSlashing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnregisterSlashing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterSlashing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterSlashing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnregisterSlashing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterSlashing(ctx, req.(*MsgUnregisterSlashing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterBank)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterBank(ctx, req.(*MsgRegisterBank))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnregisterBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterBank)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnregisterBank_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterBank(ctx, req.(*MsgUnregisterBank))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -283,6 +535,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnregisterGovernance",
 			Handler:    _Msg_UnregisterGovernance_Handler,
 		},
+		{
+			MethodName: "UnjailContract",
+			Handler:    _Msg_UnjailContract_Handler,
+		},
+		{
+			MethodName: "RegisterDistribution",
+			Handler:    _Msg_RegisterDistribution_Handler,
+		},
+		{
+			MethodName: "UnregisterDistribution",
+			Handler:    _Msg_UnregisterDistribution_Handler,
+		},
+		{
+			MethodName: "RegisterSlashing",
+			Handler:    _Msg_RegisterSlashing_Handler,
+		},
+		{
+			MethodName: "UnregisterSlashing",
+			Handler:    _Msg_UnregisterSlashing_Handler,
+		},
+		{
+			MethodName: "RegisterBank",
+			Handler:    _Msg_RegisterBank_Handler,
+		},
+		{
+			MethodName: "UnregisterBank",
+			Handler:    _Msg_UnregisterBank_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "juno/cwhooks/v1/tx.proto",
@@ -2,22 +2,237 @@
 package feepayv1
 
 import (
+	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_FeePayContract_7_list)(nil)
+
+type _FeePayContract_7_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeePayContract_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeePayContract_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeePayContract_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeePayContract_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeePayContract_7_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayContract_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeePayContract_7_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayContract_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeePayContract_8_list)(nil)
+
+type _FeePayContract_8_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeePayContract_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeePayContract_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeePayContract_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeePayContract_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeePayContract_8_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayContract_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeePayContract_8_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayContract_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeePayContract_9_list)(nil)
+
+type _FeePayContract_9_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeePayContract_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeePayContract_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeePayContract_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeePayContract_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeePayContract_9_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayContract_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeePayContract_9_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayContract_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeePayContract_10_list)(nil)
+
+type _FeePayContract_10_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeePayContract_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeePayContract_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeePayContract_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeePayContract_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeePayContract_10_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayContract_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeePayContract_10_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayContract_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_FeePayContract                  protoreflect.MessageDescriptor
-	fd_FeePayContract_contract_address protoreflect.FieldDescriptor
-	fd_FeePayContract_balance          protoreflect.FieldDescriptor
-	fd_FeePayContract_wallet_limit     protoreflect.FieldDescriptor
+	md_FeePayContract                       protoreflect.MessageDescriptor
+	fd_FeePayContract_contract_address      protoreflect.FieldDescriptor
+	fd_FeePayContract_balance               protoreflect.FieldDescriptor
+	fd_FeePayContract_wallet_limit          protoreflect.FieldDescriptor
+	fd_FeePayContract_limit_window_blocks   protoreflect.FieldDescriptor
+	fd_FeePayContract_limit_window_duration protoreflect.FieldDescriptor
+	fd_FeePayContract_eligibility_query     protoreflect.FieldDescriptor
+	fd_FeePayContract_balances              protoreflect.FieldDescriptor
+	fd_FeePayContract_wallet_spend_limit    protoreflect.FieldDescriptor
+	fd_FeePayContract_contract_spend_limit  protoreflect.FieldDescriptor
+	fd_FeePayContract_total_shares          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -26,6 +241,13 @@ func init() {
 	fd_FeePayContract_contract_address = md_FeePayContract.Fields().ByName("contract_address")
 	fd_FeePayContract_balance = md_FeePayContract.Fields().ByName("balance")
 	fd_FeePayContract_wallet_limit = md_FeePayContract.Fields().ByName("wallet_limit")
+	fd_FeePayContract_limit_window_blocks = md_FeePayContract.Fields().ByName("limit_window_blocks")
+	fd_FeePayContract_limit_window_duration = md_FeePayContract.Fields().ByName("limit_window_duration")
+	fd_FeePayContract_eligibility_query = md_FeePayContract.Fields().ByName("eligibility_query")
+	fd_FeePayContract_balances = md_FeePayContract.Fields().ByName("balances")
+	fd_FeePayContract_wallet_spend_limit = md_FeePayContract.Fields().ByName("wallet_spend_limit")
+	fd_FeePayContract_contract_spend_limit = md_FeePayContract.Fields().ByName("contract_spend_limit")
+	fd_FeePayContract_total_shares = md_FeePayContract.Fields().ByName("total_shares")
 }
 
 var _ protoreflect.Message = (*fastReflection_FeePayContract)(nil)
@@ -111,6 +333,48 @@ func (x *fastReflection_FeePayContract) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if x.LimitWindowBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LimitWindowBlocks)
+		if !f(fd_FeePayContract_limit_window_blocks, value) {
+			return
+		}
+	}
+	if x.LimitWindowDuration != nil {
+		value := protoreflect.ValueOfMessage(x.LimitWindowDuration.ProtoReflect())
+		if !f(fd_FeePayContract_limit_window_duration, value) {
+			return
+		}
+	}
+	if x.EligibilityQuery != false {
+		value := protoreflect.ValueOfBool(x.EligibilityQuery)
+		if !f(fd_FeePayContract_eligibility_query, value) {
+			return
+		}
+	}
+	if len(x.Balances) != 0 {
+		value := protoreflect.ValueOfList(&_FeePayContract_7_list{list: &x.Balances})
+		if !f(fd_FeePayContract_balances, value) {
+			return
+		}
+	}
+	if len(x.WalletSpendLimit) != 0 {
+		value := protoreflect.ValueOfList(&_FeePayContract_8_list{list: &x.WalletSpendLimit})
+		if !f(fd_FeePayContract_wallet_spend_limit, value) {
+			return
+		}
+	}
+	if len(x.ContractSpendLimit) != 0 {
+		value := protoreflect.ValueOfList(&_FeePayContract_9_list{list: &x.ContractSpendLimit})
+		if !f(fd_FeePayContract_contract_spend_limit, value) {
+			return
+		}
+	}
+	if len(x.TotalShares) != 0 {
+		value := protoreflect.ValueOfList(&_FeePayContract_10_list{list: &x.TotalShares})
+		if !f(fd_FeePayContract_total_shares, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -132,6 +396,20 @@ func (x *fastReflection_FeePayContract) Has(fd protoreflect.FieldDescriptor) boo
 		return x.Balance != uint64(0)
 	case "juno.feepay.v1.FeePayContract.wallet_limit":
 		return x.WalletLimit != uint64(0)
+	case "juno.feepay.v1.FeePayContract.limit_window_blocks":
+		return x.LimitWindowBlocks != uint64(0)
+	case "juno.feepay.v1.FeePayContract.limit_window_duration":
+		return x.LimitWindowDuration != nil
+	case "juno.feepay.v1.FeePayContract.eligibility_query":
+		return x.EligibilityQuery != false
+	case "juno.feepay.v1.FeePayContract.balances":
+		return len(x.Balances) != 0
+	case "juno.feepay.v1.FeePayContract.wallet_spend_limit":
+		return len(x.WalletSpendLimit) != 0
+	case "juno.feepay.v1.FeePayContract.contract_spend_limit":
+		return len(x.ContractSpendLimit) != 0
+	case "juno.feepay.v1.FeePayContract.total_shares":
+		return len(x.TotalShares) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayContract"))
@@ -154,6 +432,20 @@ func (x *fastReflection_FeePayContract) Clear(fd protoreflect.FieldDescriptor) {
 		x.Balance = uint64(0)
 	case "juno.feepay.v1.FeePayContract.wallet_limit":
 		x.WalletLimit = uint64(0)
+	case "juno.feepay.v1.FeePayContract.limit_window_blocks":
+		x.LimitWindowBlocks = uint64(0)
+	case "juno.feepay.v1.FeePayContract.limit_window_duration":
+		x.LimitWindowDuration = nil
+	case "juno.feepay.v1.FeePayContract.eligibility_query":
+		x.EligibilityQuery = false
+	case "juno.feepay.v1.FeePayContract.balances":
+		x.Balances = nil
+	case "juno.feepay.v1.FeePayContract.wallet_spend_limit":
+		x.WalletSpendLimit = nil
+	case "juno.feepay.v1.FeePayContract.contract_spend_limit":
+		x.ContractSpendLimit = nil
+	case "juno.feepay.v1.FeePayContract.total_shares":
+		x.TotalShares = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayContract"))
@@ -179,6 +471,39 @@ func (x *fastReflection_FeePayContract) Get(descriptor protoreflect.FieldDescrip
 	case "juno.feepay.v1.FeePayContract.wallet_limit":
 		value := x.WalletLimit
 		return protoreflect.ValueOfUint64(value)
+	case "juno.feepay.v1.FeePayContract.limit_window_blocks":
+		value := x.LimitWindowBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "juno.feepay.v1.FeePayContract.limit_window_duration":
+		value := x.LimitWindowDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "juno.feepay.v1.FeePayContract.eligibility_query":
+		value := x.EligibilityQuery
+		return protoreflect.ValueOfBool(value)
+	case "juno.feepay.v1.FeePayContract.balances":
+		if len(x.Balances) == 0 {
+			return protoreflect.ValueOfList(&_FeePayContract_7_list{})
+		}
+		listValue := &_FeePayContract_7_list{list: &x.Balances}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.feepay.v1.FeePayContract.wallet_spend_limit":
+		if len(x.WalletSpendLimit) == 0 {
+			return protoreflect.ValueOfList(&_FeePayContract_8_list{})
+		}
+		listValue := &_FeePayContract_8_list{list: &x.WalletSpendLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.feepay.v1.FeePayContract.contract_spend_limit":
+		if len(x.ContractSpendLimit) == 0 {
+			return protoreflect.ValueOfList(&_FeePayContract_9_list{})
+		}
+		listValue := &_FeePayContract_9_list{list: &x.ContractSpendLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.feepay.v1.FeePayContract.total_shares":
+		if len(x.TotalShares) == 0 {
+			return protoreflect.ValueOfList(&_FeePayContract_10_list{})
+		}
+		listValue := &_FeePayContract_10_list{list: &x.TotalShares}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayContract"))
@@ -205,6 +530,28 @@ func (x *fastReflection_FeePayContract) Set(fd protoreflect.FieldDescriptor, val
 		x.Balance = value.Uint()
 	case "juno.feepay.v1.FeePayContract.wallet_limit":
 		x.WalletLimit = value.Uint()
+	case "juno.feepay.v1.FeePayContract.limit_window_blocks":
+		x.LimitWindowBlocks = value.Uint()
+	case "juno.feepay.v1.FeePayContract.limit_window_duration":
+		x.LimitWindowDuration = value.Message().Interface().(*durationpb.Duration)
+	case "juno.feepay.v1.FeePayContract.eligibility_query":
+		x.EligibilityQuery = value.Bool()
+	case "juno.feepay.v1.FeePayContract.balances":
+		lv := value.List()
+		clv := lv.(*_FeePayContract_7_list)
+		x.Balances = *clv.list
+	case "juno.feepay.v1.FeePayContract.wallet_spend_limit":
+		lv := value.List()
+		clv := lv.(*_FeePayContract_8_list)
+		x.WalletSpendLimit = *clv.list
+	case "juno.feepay.v1.FeePayContract.contract_spend_limit":
+		lv := value.List()
+		clv := lv.(*_FeePayContract_9_list)
+		x.ContractSpendLimit = *clv.list
+	case "juno.feepay.v1.FeePayContract.total_shares":
+		lv := value.List()
+		clv := lv.(*_FeePayContract_10_list)
+		x.TotalShares = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayContract"))
@@ -225,12 +572,45 @@ func (x *fastReflection_FeePayContract) Set(fd protoreflect.FieldDescriptor, val
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_FeePayContract) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "juno.feepay.v1.FeePayContract.limit_window_duration":
+		if x.LimitWindowDuration == nil {
+			x.LimitWindowDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.LimitWindowDuration.ProtoReflect())
+	case "juno.feepay.v1.FeePayContract.balances":
+		if x.Balances == nil {
+			x.Balances = []*v1beta1.Coin{}
+		}
+		value := &_FeePayContract_7_list{list: &x.Balances}
+		return protoreflect.ValueOfList(value)
+	case "juno.feepay.v1.FeePayContract.wallet_spend_limit":
+		if x.WalletSpendLimit == nil {
+			x.WalletSpendLimit = []*v1beta1.Coin{}
+		}
+		value := &_FeePayContract_8_list{list: &x.WalletSpendLimit}
+		return protoreflect.ValueOfList(value)
+	case "juno.feepay.v1.FeePayContract.contract_spend_limit":
+		if x.ContractSpendLimit == nil {
+			x.ContractSpendLimit = []*v1beta1.Coin{}
+		}
+		value := &_FeePayContract_9_list{list: &x.ContractSpendLimit}
+		return protoreflect.ValueOfList(value)
+	case "juno.feepay.v1.FeePayContract.total_shares":
+		if x.TotalShares == nil {
+			x.TotalShares = []*v1beta1.Coin{}
+		}
+		value := &_FeePayContract_10_list{list: &x.TotalShares}
+		return protoreflect.ValueOfList(value)
 	case "juno.feepay.v1.FeePayContract.contract_address":
 		panic(fmt.Errorf("field contract_address of message juno.feepay.v1.FeePayContract is not mutable"))
 	case "juno.feepay.v1.FeePayContract.balance":
 		panic(fmt.Errorf("field balance of message juno.feepay.v1.FeePayContract is not mutable"))
 	case "juno.feepay.v1.FeePayContract.wallet_limit":
 		panic(fmt.Errorf("field wallet_limit of message juno.feepay.v1.FeePayContract is not mutable"))
+	case "juno.feepay.v1.FeePayContract.limit_window_blocks":
+		panic(fmt.Errorf("field limit_window_blocks of message juno.feepay.v1.FeePayContract is not mutable"))
+	case "juno.feepay.v1.FeePayContract.eligibility_query":
+		panic(fmt.Errorf("field eligibility_query of message juno.feepay.v1.FeePayContract is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayContract"))
@@ -250,6 +630,25 @@ func (x *fastReflection_FeePayContract) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "juno.feepay.v1.FeePayContract.wallet_limit":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.feepay.v1.FeePayContract.limit_window_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.feepay.v1.FeePayContract.limit_window_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "juno.feepay.v1.FeePayContract.eligibility_query":
+		return protoreflect.ValueOfBool(false)
+	case "juno.feepay.v1.FeePayContract.balances":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeePayContract_7_list{list: &list})
+	case "juno.feepay.v1.FeePayContract.wallet_spend_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeePayContract_8_list{list: &list})
+	case "juno.feepay.v1.FeePayContract.contract_spend_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeePayContract_9_list{list: &list})
+	case "juno.feepay.v1.FeePayContract.total_shares":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeePayContract_10_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayContract"))
@@ -329,6 +728,40 @@ func (x *fastReflection_FeePayContract) ProtoMethods() *protoiface.Methods {
 		if x.WalletLimit != 0 {
 			n += 1 + runtime.Sov(uint64(x.WalletLimit))
 		}
+		if x.LimitWindowBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.LimitWindowBlocks))
+		}
+		if x.LimitWindowDuration != nil {
+			l = options.Size(x.LimitWindowDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EligibilityQuery {
+			n += 2
+		}
+		if len(x.Balances) > 0 {
+			for _, e := range x.Balances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.WalletSpendLimit) > 0 {
+			for _, e := range x.WalletSpendLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ContractSpendLimit) > 0 {
+			for _, e := range x.ContractSpendLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TotalShares) > 0 {
+			for _, e := range x.TotalShares {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -358,6 +791,99 @@ func (x *fastReflection_FeePayContract) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.TotalShares) > 0 {
+			for iNdEx := len(x.TotalShares) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalShares[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.ContractSpendLimit) > 0 {
+			for iNdEx := len(x.ContractSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ContractSpendLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.WalletSpendLimit) > 0 {
+			for iNdEx := len(x.WalletSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.WalletSpendLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.Balances) > 0 {
+			for iNdEx := len(x.Balances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Balances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.EligibilityQuery {
+			i--
+			if x.EligibilityQuery {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.LimitWindowDuration != nil {
+			encoded, err := options.Marshal(x.LimitWindowDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.LimitWindowBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LimitWindowBlocks))
+			i--
+			dAtA[i] = 0x20
+		}
 		if x.WalletLimit != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.WalletLimit))
 			i--
@@ -494,46 +1020,310 @@ func (x *fastReflection_FeePayContract) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LimitWindowBlocks", wireType)
+				}
+				x.LimitWindowBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LimitWindowBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LimitWindowDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LimitWindowDuration == nil {
+					x.LimitWindowDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LimitWindowDuration); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EligibilityQuery", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EligibilityQuery = bool(v != 0)
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Balances = append(x.Balances, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balances[len(x.Balances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletSpendLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletSpendLimit = append(x.WalletSpendLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WalletSpendLimit[len(x.WalletSpendLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractSpendLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractSpendLimit = append(x.ContractSpendLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ContractSpendLimit[len(x.ContractSpendLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalShares = append(x.TotalShares, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalShares[len(x.TotalShares)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FeePayWalletUsage_5_list)(nil)
+
+type _FeePayWalletUsage_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeePayWalletUsage_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeePayWalletUsage_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeePayWalletUsage_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeePayWalletUsage_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeePayWalletUsage_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayWalletUsage_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeePayWalletUsage_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayWalletUsage_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_FeePayWalletUsage                  protoreflect.MessageDescriptor
 	fd_FeePayWalletUsage_contract_address protoreflect.FieldDescriptor
 	fd_FeePayWalletUsage_wallet_address   protoreflect.FieldDescriptor
 	fd_FeePayWalletUsage_uses             protoreflect.FieldDescriptor
+	fd_FeePayWalletUsage_window           protoreflect.FieldDescriptor
+	fd_FeePayWalletUsage_spent            protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -542,6 +1332,8 @@ func init() {
 	fd_FeePayWalletUsage_contract_address = md_FeePayWalletUsage.Fields().ByName("contract_address")
 	fd_FeePayWalletUsage_wallet_address = md_FeePayWalletUsage.Fields().ByName("wallet_address")
 	fd_FeePayWalletUsage_uses = md_FeePayWalletUsage.Fields().ByName("uses")
+	fd_FeePayWalletUsage_window = md_FeePayWalletUsage.Fields().ByName("window")
+	fd_FeePayWalletUsage_spent = md_FeePayWalletUsage.Fields().ByName("spent")
 }
 
 var _ protoreflect.Message = (*fastReflection_FeePayWalletUsage)(nil)
@@ -627,6 +1419,18 @@ func (x *fastReflection_FeePayWalletUsage) Range(f func(protoreflect.FieldDescri
 			return
 		}
 	}
+	if x.Window != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Window)
+		if !f(fd_FeePayWalletUsage_window, value) {
+			return
+		}
+	}
+	if len(x.Spent) != 0 {
+		value := protoreflect.ValueOfList(&_FeePayWalletUsage_5_list{list: &x.Spent})
+		if !f(fd_FeePayWalletUsage_spent, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -648,11 +1452,1380 @@ func (x *fastReflection_FeePayWalletUsage) Has(fd protoreflect.FieldDescriptor)
 		return x.WalletAddress != ""
 	case "juno.feepay.v1.FeePayWalletUsage.uses":
 		return x.Uses != uint64(0)
+	case "juno.feepay.v1.FeePayWalletUsage.window":
+		return x.Window != uint64(0)
+	case "juno.feepay.v1.FeePayWalletUsage.spent":
+		return len(x.Spent) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayWalletUsage"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayWalletUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeePayWalletUsage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "juno.feepay.v1.FeePayWalletUsage.contract_address":
+		x.ContractAddress = ""
+	case "juno.feepay.v1.FeePayWalletUsage.wallet_address":
+		x.WalletAddress = ""
+	case "juno.feepay.v1.FeePayWalletUsage.uses":
+		x.Uses = uint64(0)
+	case "juno.feepay.v1.FeePayWalletUsage.window":
+		x.Window = uint64(0)
+	case "juno.feepay.v1.FeePayWalletUsage.spent":
+		x.Spent = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayWalletUsage"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayWalletUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeePayWalletUsage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "juno.feepay.v1.FeePayWalletUsage.contract_address":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "juno.feepay.v1.FeePayWalletUsage.wallet_address":
+		value := x.WalletAddress
+		return protoreflect.ValueOfString(value)
+	case "juno.feepay.v1.FeePayWalletUsage.uses":
+		value := x.Uses
+		return protoreflect.ValueOfUint64(value)
+	case "juno.feepay.v1.FeePayWalletUsage.window":
+		value := x.Window
+		return protoreflect.ValueOfUint64(value)
+	case "juno.feepay.v1.FeePayWalletUsage.spent":
+		if len(x.Spent) == 0 {
+			return protoreflect.ValueOfList(&_FeePayWalletUsage_5_list{})
+		}
+		listValue := &_FeePayWalletUsage_5_list{list: &x.Spent}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayWalletUsage"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayWalletUsage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeePayWalletUsage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "juno.feepay.v1.FeePayWalletUsage.contract_address":
+		x.ContractAddress = value.Interface().(string)
+	case "juno.feepay.v1.FeePayWalletUsage.wallet_address":
+		x.WalletAddress = value.Interface().(string)
+	case "juno.feepay.v1.FeePayWalletUsage.uses":
+		x.Uses = value.Uint()
+	case "juno.feepay.v1.FeePayWalletUsage.window":
+		x.Window = value.Uint()
+	case "juno.feepay.v1.FeePayWalletUsage.spent":
+		lv := value.List()
+		clv := lv.(*_FeePayWalletUsage_5_list)
+		x.Spent = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayWalletUsage"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayWalletUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeePayWalletUsage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "juno.feepay.v1.FeePayWalletUsage.spent":
+		if x.Spent == nil {
+			x.Spent = []*v1beta1.Coin{}
+		}
+		value := &_FeePayWalletUsage_5_list{list: &x.Spent}
+		return protoreflect.ValueOfList(value)
+	case "juno.feepay.v1.FeePayWalletUsage.contract_address":
+		panic(fmt.Errorf("field contract_address of message juno.feepay.v1.FeePayWalletUsage is not mutable"))
+	case "juno.feepay.v1.FeePayWalletUsage.wallet_address":
+		panic(fmt.Errorf("field wallet_address of message juno.feepay.v1.FeePayWalletUsage is not mutable"))
+	case "juno.feepay.v1.FeePayWalletUsage.uses":
+		panic(fmt.Errorf("field uses of message juno.feepay.v1.FeePayWalletUsage is not mutable"))
+	case "juno.feepay.v1.FeePayWalletUsage.window":
+		panic(fmt.Errorf("field window of message juno.feepay.v1.FeePayWalletUsage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayWalletUsage"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayWalletUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeePayWalletUsage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "juno.feepay.v1.FeePayWalletUsage.contract_address":
+		return protoreflect.ValueOfString("")
+	case "juno.feepay.v1.FeePayWalletUsage.wallet_address":
+		return protoreflect.ValueOfString("")
+	case "juno.feepay.v1.FeePayWalletUsage.uses":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.feepay.v1.FeePayWalletUsage.window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.feepay.v1.FeePayWalletUsage.spent":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeePayWalletUsage_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayWalletUsage"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayWalletUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeePayWalletUsage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in juno.feepay.v1.FeePayWalletUsage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeePayWalletUsage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeePayWalletUsage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeePayWalletUsage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeePayWalletUsage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeePayWalletUsage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.WalletAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Uses != 0 {
+			n += 1 + runtime.Sov(uint64(x.Uses))
+		}
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
+		}
+		if len(x.Spent) > 0 {
+			for _, e := range x.Spent {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeePayWalletUsage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Spent) > 0 {
+			for iNdEx := len(x.Spent) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Spent[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Uses != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Uses))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.WalletAddress) > 0 {
+			i -= len(x.WalletAddress)
+			copy(dAtA[i:], x.WalletAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeePayWalletUsage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeePayWalletUsage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeePayWalletUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+				}
+				x.Uses = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Uses |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				x.Window = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Window |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Spent = append(x.Spent, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Spent[len(x.Spent)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FeePayContractSpend_3_list)(nil)
+
+type _FeePayContractSpend_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeePayContractSpend_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeePayContractSpend_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeePayContractSpend_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeePayContractSpend_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeePayContractSpend_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayContractSpend_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeePayContractSpend_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayContractSpend_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FeePayContractSpend                  protoreflect.MessageDescriptor
+	fd_FeePayContractSpend_contract_address protoreflect.FieldDescriptor
+	fd_FeePayContractSpend_window           protoreflect.FieldDescriptor
+	fd_FeePayContractSpend_spent            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_juno_feepay_v1_feepay_proto_init()
+	md_FeePayContractSpend = File_juno_feepay_v1_feepay_proto.Messages().ByName("FeePayContractSpend")
+	fd_FeePayContractSpend_contract_address = md_FeePayContractSpend.Fields().ByName("contract_address")
+	fd_FeePayContractSpend_window = md_FeePayContractSpend.Fields().ByName("window")
+	fd_FeePayContractSpend_spent = md_FeePayContractSpend.Fields().ByName("spent")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeePayContractSpend)(nil)
+
+type fastReflection_FeePayContractSpend FeePayContractSpend
+
+func (x *FeePayContractSpend) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeePayContractSpend)(x)
+}
+
+func (x *FeePayContractSpend) slowProtoReflect() protoreflect.Message {
+	mi := &file_juno_feepay_v1_feepay_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeePayContractSpend_messageType fastReflection_FeePayContractSpend_messageType
+var _ protoreflect.MessageType = fastReflection_FeePayContractSpend_messageType{}
+
+type fastReflection_FeePayContractSpend_messageType struct{}
+
+func (x fastReflection_FeePayContractSpend_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeePayContractSpend)(nil)
+}
+func (x fastReflection_FeePayContractSpend_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeePayContractSpend)
+}
+func (x fastReflection_FeePayContractSpend_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeePayContractSpend
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeePayContractSpend) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeePayContractSpend
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeePayContractSpend) Type() protoreflect.MessageType {
+	return _fastReflection_FeePayContractSpend_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeePayContractSpend) New() protoreflect.Message {
+	return new(fastReflection_FeePayContractSpend)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeePayContractSpend) Interface() protoreflect.ProtoMessage {
+	return (*FeePayContractSpend)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeePayContractSpend) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_FeePayContractSpend_contract_address, value) {
+			return
+		}
+	}
+	if x.Window != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Window)
+		if !f(fd_FeePayContractSpend_window, value) {
+			return
+		}
+	}
+	if len(x.Spent) != 0 {
+		value := protoreflect.ValueOfList(&_FeePayContractSpend_3_list{list: &x.Spent})
+		if !f(fd_FeePayContractSpend_spent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeePayContractSpend) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "juno.feepay.v1.FeePayContractSpend.contract_address":
+		return x.ContractAddress != ""
+	case "juno.feepay.v1.FeePayContractSpend.window":
+		return x.Window != uint64(0)
+	case "juno.feepay.v1.FeePayContractSpend.spent":
+		return len(x.Spent) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayContractSpend"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayContractSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeePayContractSpend) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "juno.feepay.v1.FeePayContractSpend.contract_address":
+		x.ContractAddress = ""
+	case "juno.feepay.v1.FeePayContractSpend.window":
+		x.Window = uint64(0)
+	case "juno.feepay.v1.FeePayContractSpend.spent":
+		x.Spent = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayContractSpend"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayContractSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeePayContractSpend) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "juno.feepay.v1.FeePayContractSpend.contract_address":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "juno.feepay.v1.FeePayContractSpend.window":
+		value := x.Window
+		return protoreflect.ValueOfUint64(value)
+	case "juno.feepay.v1.FeePayContractSpend.spent":
+		if len(x.Spent) == 0 {
+			return protoreflect.ValueOfList(&_FeePayContractSpend_3_list{})
+		}
+		listValue := &_FeePayContractSpend_3_list{list: &x.Spent}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayContractSpend"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayContractSpend does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeePayContractSpend) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "juno.feepay.v1.FeePayContractSpend.contract_address":
+		x.ContractAddress = value.Interface().(string)
+	case "juno.feepay.v1.FeePayContractSpend.window":
+		x.Window = value.Uint()
+	case "juno.feepay.v1.FeePayContractSpend.spent":
+		lv := value.List()
+		clv := lv.(*_FeePayContractSpend_3_list)
+		x.Spent = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayContractSpend"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayContractSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeePayContractSpend) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "juno.feepay.v1.FeePayContractSpend.spent":
+		if x.Spent == nil {
+			x.Spent = []*v1beta1.Coin{}
+		}
+		value := &_FeePayContractSpend_3_list{list: &x.Spent}
+		return protoreflect.ValueOfList(value)
+	case "juno.feepay.v1.FeePayContractSpend.contract_address":
+		panic(fmt.Errorf("field contract_address of message juno.feepay.v1.FeePayContractSpend is not mutable"))
+	case "juno.feepay.v1.FeePayContractSpend.window":
+		panic(fmt.Errorf("field window of message juno.feepay.v1.FeePayContractSpend is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayContractSpend"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayContractSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeePayContractSpend) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "juno.feepay.v1.FeePayContractSpend.contract_address":
+		return protoreflect.ValueOfString("")
+	case "juno.feepay.v1.FeePayContractSpend.window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.feepay.v1.FeePayContractSpend.spent":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeePayContractSpend_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayContractSpend"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayContractSpend does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeePayContractSpend) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in juno.feepay.v1.FeePayContractSpend", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeePayContractSpend) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeePayContractSpend) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeePayContractSpend) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeePayContractSpend) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeePayContractSpend)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Window != 0 {
+			n += 1 + runtime.Sov(uint64(x.Window))
+		}
+		if len(x.Spent) > 0 {
+			for _, e := range x.Spent {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeePayContractSpend)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Spent) > 0 {
+			for iNdEx := len(x.Spent) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Spent[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.Window != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Window))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeePayContractSpend)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeePayContractSpend: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeePayContractSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+				}
+				x.Window = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Window |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Spent = append(x.Spent, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Spent[len(x.Spent)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FeePayFunder_3_list)(nil)
+
+type _FeePayFunder_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeePayFunder_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeePayFunder_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeePayFunder_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeePayFunder_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeePayFunder_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayFunder_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeePayFunder_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayFunder_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FeePayFunder_4_list)(nil)
+
+type _FeePayFunder_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_FeePayFunder_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FeePayFunder_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_FeePayFunder_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FeePayFunder_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FeePayFunder_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayFunder_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FeePayFunder_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_FeePayFunder_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FeePayFunder                  protoreflect.MessageDescriptor
+	fd_FeePayFunder_contract_address protoreflect.FieldDescriptor
+	fd_FeePayFunder_funder_address   protoreflect.FieldDescriptor
+	fd_FeePayFunder_contributed      protoreflect.FieldDescriptor
+	fd_FeePayFunder_shares           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_juno_feepay_v1_feepay_proto_init()
+	md_FeePayFunder = File_juno_feepay_v1_feepay_proto.Messages().ByName("FeePayFunder")
+	fd_FeePayFunder_contract_address = md_FeePayFunder.Fields().ByName("contract_address")
+	fd_FeePayFunder_funder_address = md_FeePayFunder.Fields().ByName("funder_address")
+	fd_FeePayFunder_contributed = md_FeePayFunder.Fields().ByName("contributed")
+	fd_FeePayFunder_shares = md_FeePayFunder.Fields().ByName("shares")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeePayFunder)(nil)
+
+type fastReflection_FeePayFunder FeePayFunder
+
+func (x *FeePayFunder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeePayFunder)(x)
+}
+
+func (x *FeePayFunder) slowProtoReflect() protoreflect.Message {
+	mi := &file_juno_feepay_v1_feepay_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeePayFunder_messageType fastReflection_FeePayFunder_messageType
+var _ protoreflect.MessageType = fastReflection_FeePayFunder_messageType{}
+
+type fastReflection_FeePayFunder_messageType struct{}
+
+func (x fastReflection_FeePayFunder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeePayFunder)(nil)
+}
+func (x fastReflection_FeePayFunder_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeePayFunder)
+}
+func (x fastReflection_FeePayFunder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeePayFunder
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeePayFunder) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeePayFunder
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeePayFunder) Type() protoreflect.MessageType {
+	return _fastReflection_FeePayFunder_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeePayFunder) New() protoreflect.Message {
+	return new(fastReflection_FeePayFunder)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeePayFunder) Interface() protoreflect.ProtoMessage {
+	return (*FeePayFunder)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeePayFunder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_FeePayFunder_contract_address, value) {
+			return
+		}
+	}
+	if x.FunderAddress != "" {
+		value := protoreflect.ValueOfString(x.FunderAddress)
+		if !f(fd_FeePayFunder_funder_address, value) {
+			return
+		}
+	}
+	if len(x.Contributed) != 0 {
+		value := protoreflect.ValueOfList(&_FeePayFunder_3_list{list: &x.Contributed})
+		if !f(fd_FeePayFunder_contributed, value) {
+			return
+		}
+	}
+	if len(x.Shares) != 0 {
+		value := protoreflect.ValueOfList(&_FeePayFunder_4_list{list: &x.Shares})
+		if !f(fd_FeePayFunder_shares, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeePayFunder) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "juno.feepay.v1.FeePayFunder.contract_address":
+		return x.ContractAddress != ""
+	case "juno.feepay.v1.FeePayFunder.funder_address":
+		return x.FunderAddress != ""
+	case "juno.feepay.v1.FeePayFunder.contributed":
+		return len(x.Contributed) != 0
+	case "juno.feepay.v1.FeePayFunder.shares":
+		return len(x.Shares) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayWalletUsage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayFunder"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.FeePayWalletUsage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayFunder does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -662,19 +2835,21 @@ func (x *fastReflection_FeePayWalletUsage) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeePayWalletUsage) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_FeePayFunder) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "juno.feepay.v1.FeePayWalletUsage.contract_address":
+	case "juno.feepay.v1.FeePayFunder.contract_address":
 		x.ContractAddress = ""
-	case "juno.feepay.v1.FeePayWalletUsage.wallet_address":
-		x.WalletAddress = ""
-	case "juno.feepay.v1.FeePayWalletUsage.uses":
-		x.Uses = uint64(0)
+	case "juno.feepay.v1.FeePayFunder.funder_address":
+		x.FunderAddress = ""
+	case "juno.feepay.v1.FeePayFunder.contributed":
+		x.Contributed = nil
+	case "juno.feepay.v1.FeePayFunder.shares":
+		x.Shares = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayWalletUsage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayFunder"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.FeePayWalletUsage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayFunder does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -684,22 +2859,31 @@ func (x *fastReflection_FeePayWalletUsage) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_FeePayWalletUsage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeePayFunder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "juno.feepay.v1.FeePayWalletUsage.contract_address":
+	case "juno.feepay.v1.FeePayFunder.contract_address":
 		value := x.ContractAddress
 		return protoreflect.ValueOfString(value)
-	case "juno.feepay.v1.FeePayWalletUsage.wallet_address":
-		value := x.WalletAddress
+	case "juno.feepay.v1.FeePayFunder.funder_address":
+		value := x.FunderAddress
 		return protoreflect.ValueOfString(value)
-	case "juno.feepay.v1.FeePayWalletUsage.uses":
-		value := x.Uses
-		return protoreflect.ValueOfUint64(value)
+	case "juno.feepay.v1.FeePayFunder.contributed":
+		if len(x.Contributed) == 0 {
+			return protoreflect.ValueOfList(&_FeePayFunder_3_list{})
+		}
+		listValue := &_FeePayFunder_3_list{list: &x.Contributed}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.feepay.v1.FeePayFunder.shares":
+		if len(x.Shares) == 0 {
+			return protoreflect.ValueOfList(&_FeePayFunder_4_list{})
+		}
+		listValue := &_FeePayFunder_4_list{list: &x.Shares}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayWalletUsage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayFunder"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.FeePayWalletUsage does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayFunder does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -713,19 +2897,25 @@ func (x *fastReflection_FeePayWalletUsage) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeePayWalletUsage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_FeePayFunder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "juno.feepay.v1.FeePayWalletUsage.contract_address":
+	case "juno.feepay.v1.FeePayFunder.contract_address":
 		x.ContractAddress = value.Interface().(string)
-	case "juno.feepay.v1.FeePayWalletUsage.wallet_address":
-		x.WalletAddress = value.Interface().(string)
-	case "juno.feepay.v1.FeePayWalletUsage.uses":
-		x.Uses = value.Uint()
+	case "juno.feepay.v1.FeePayFunder.funder_address":
+		x.FunderAddress = value.Interface().(string)
+	case "juno.feepay.v1.FeePayFunder.contributed":
+		lv := value.List()
+		clv := lv.(*_FeePayFunder_3_list)
+		x.Contributed = *clv.list
+	case "juno.feepay.v1.FeePayFunder.shares":
+		lv := value.List()
+		clv := lv.(*_FeePayFunder_4_list)
+		x.Shares = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayWalletUsage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayFunder"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.FeePayWalletUsage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayFunder does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -739,48 +2929,62 @@ func (x *fastReflection_FeePayWalletUsage) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeePayWalletUsage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeePayFunder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "juno.feepay.v1.FeePayWalletUsage.contract_address":
-		panic(fmt.Errorf("field contract_address of message juno.feepay.v1.FeePayWalletUsage is not mutable"))
-	case "juno.feepay.v1.FeePayWalletUsage.wallet_address":
-		panic(fmt.Errorf("field wallet_address of message juno.feepay.v1.FeePayWalletUsage is not mutable"))
-	case "juno.feepay.v1.FeePayWalletUsage.uses":
-		panic(fmt.Errorf("field uses of message juno.feepay.v1.FeePayWalletUsage is not mutable"))
+	case "juno.feepay.v1.FeePayFunder.contributed":
+		if x.Contributed == nil {
+			x.Contributed = []*v1beta1.Coin{}
+		}
+		value := &_FeePayFunder_3_list{list: &x.Contributed}
+		return protoreflect.ValueOfList(value)
+	case "juno.feepay.v1.FeePayFunder.shares":
+		if x.Shares == nil {
+			x.Shares = []*v1beta1.Coin{}
+		}
+		value := &_FeePayFunder_4_list{list: &x.Shares}
+		return protoreflect.ValueOfList(value)
+	case "juno.feepay.v1.FeePayFunder.contract_address":
+		panic(fmt.Errorf("field contract_address of message juno.feepay.v1.FeePayFunder is not mutable"))
+	case "juno.feepay.v1.FeePayFunder.funder_address":
+		panic(fmt.Errorf("field funder_address of message juno.feepay.v1.FeePayFunder is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayWalletUsage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayFunder"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.FeePayWalletUsage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayFunder does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_FeePayWalletUsage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeePayFunder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "juno.feepay.v1.FeePayWalletUsage.contract_address":
+	case "juno.feepay.v1.FeePayFunder.contract_address":
 		return protoreflect.ValueOfString("")
-	case "juno.feepay.v1.FeePayWalletUsage.wallet_address":
+	case "juno.feepay.v1.FeePayFunder.funder_address":
 		return protoreflect.ValueOfString("")
-	case "juno.feepay.v1.FeePayWalletUsage.uses":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.feepay.v1.FeePayFunder.contributed":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeePayFunder_3_list{list: &list})
+	case "juno.feepay.v1.FeePayFunder.shares":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_FeePayFunder_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayWalletUsage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.FeePayFunder"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.FeePayWalletUsage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.FeePayFunder does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_FeePayWalletUsage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FeePayFunder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in juno.feepay.v1.FeePayWalletUsage", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in juno.feepay.v1.FeePayFunder", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -788,7 +2992,7 @@ func (x *fastReflection_FeePayWalletUsage) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_FeePayWalletUsage) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FeePayFunder) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -799,7 +3003,7 @@ func (x *fastReflection_FeePayWalletUsage) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_FeePayWalletUsage) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FeePayFunder) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -811,7 +3015,7 @@ func (x *fastReflection_FeePayWalletUsage) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_FeePayWalletUsage) IsValid() bool {
+func (x *fastReflection_FeePayFunder) IsValid() bool {
 	return x != nil
 }
 
@@ -821,9 +3025,9 @@ func (x *fastReflection_FeePayWalletUsage) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_FeePayWalletUsage) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FeePayFunder) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*FeePayWalletUsage)
+		x := input.Message.Interface().(*FeePayFunder)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -839,12 +3043,21 @@ func (x *fastReflection_FeePayWalletUsage) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.WalletAddress)
+		l = len(x.FunderAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Uses != 0 {
-			n += 1 + runtime.Sov(uint64(x.Uses))
+		if len(x.Contributed) > 0 {
+			for _, e := range x.Contributed {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Shares) > 0 {
+			for _, e := range x.Shares {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -856,7 +3069,7 @@ func (x *fastReflection_FeePayWalletUsage) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*FeePayWalletUsage)
+		x := input.Message.Interface().(*FeePayFunder)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -875,15 +3088,42 @@ func (x *fastReflection_FeePayWalletUsage) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Uses != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Uses))
-			i--
-			dAtA[i] = 0x18
+		if len(x.Shares) > 0 {
+			for iNdEx := len(x.Shares) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Shares[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
 		}
-		if len(x.WalletAddress) > 0 {
-			i -= len(x.WalletAddress)
-			copy(dAtA[i:], x.WalletAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+		if len(x.Contributed) > 0 {
+			for iNdEx := len(x.Contributed) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Contributed[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.FunderAddress) > 0 {
+			i -= len(x.FunderAddress)
+			copy(dAtA[i:], x.FunderAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FunderAddress)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -905,7 +3145,7 @@ func (x *fastReflection_FeePayWalletUsage) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*FeePayWalletUsage)
+		x := input.Message.Interface().(*FeePayFunder)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -937,10 +3177,10 @@ func (x *fastReflection_FeePayWalletUsage) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeePayWalletUsage: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeePayFunder: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeePayWalletUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeePayFunder: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -977,7 +3217,7 @@ func (x *fastReflection_FeePayWalletUsage) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1005,13 +3245,13 @@ func (x *fastReflection_FeePayWalletUsage) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				x.FunderAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contributed", wireType)
 				}
-				x.Uses = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1021,11 +3261,60 @@ func (x *fastReflection_FeePayWalletUsage) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Uses |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contributed = append(x.Contributed, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Contributed[len(x.Contributed)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Shares = append(x.Shares, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Shares[len(x.Shares)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1083,10 +3372,35 @@ type FeePayContract struct {
 
 	// The address of the contract.
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	// The ledger balance of the contract.
+	// Deprecated: the legacy ledger balance of the contract in the bond denom,
+	// moved to balances by the v2 migration.
 	Balance uint64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
-	// The number of times a wallet may interact with the contract.
+	// The number of times a wallet may interact with the contract, per limit
+	// window if the contract has one, or else over the lifetime of the contract.
 	WalletLimit uint64 `protobuf:"varint,3,opt,name=wallet_limit,json=walletLimit,proto3" json:"wallet_limit,omitempty"`
+	// The number of blocks in a wallet limit window. Zero means no block window.
+	LimitWindowBlocks uint64 `protobuf:"varint,4,opt,name=limit_window_blocks,json=limitWindowBlocks,proto3" json:"limit_window_blocks,omitempty"`
+	// The duration of a wallet limit window. Zero means no time window. Cannot
+	// be combined with limit_window_blocks.
+	LimitWindowDuration *durationpb.Duration `protobuf:"bytes,5,opt,name=limit_window_duration,json=limitWindowDuration,proto3" json:"limit_window_duration,omitempty"`
+	// Whether the contract decides which wallets are sponsored. The contract is
+	// sent a gas capped smart query with the sender and the messages of every
+	// FeePay transaction, and the query gas is paid with the transaction gas.
+	EligibilityQuery bool `protobuf:"varint,6,opt,name=eligibility_query,json=eligibilityQuery,proto3" json:"eligibility_query,omitempty"`
+	// The ledger balances of the contract, in the denoms with a global fee
+	// minimum gas price.
+	Balances []*v1beta1.Coin `protobuf:"bytes,7,rep,name=balances,proto3" json:"balances,omitempty"`
+	// The total fee the contract may sponsor for each wallet, per limit window if
+	// the contract has one, or else over the lifetime of the contract. Fees can
+	// only be sponsored in the listed denoms. Empty means no cap.
+	WalletSpendLimit []*v1beta1.Coin `protobuf:"bytes,8,rep,name=wallet_spend_limit,json=walletSpendLimit,proto3" json:"wallet_spend_limit,omitempty"`
+	// The total fee the contract may sponsor for all wallets, per limit window if
+	// the contract has one, or else over the lifetime of the contract. Fees can
+	// only be sponsored in the listed denoms. Empty means no cap.
+	ContractSpendLimit []*v1beta1.Coin `protobuf:"bytes,9,rep,name=contract_spend_limit,json=contractSpendLimit,proto3" json:"contract_spend_limit,omitempty"`
+	// The total pool shares of the funders of the contract in each denom of the
+	// balances.
+	TotalShares []*v1beta1.Coin `protobuf:"bytes,10,rep,name=total_shares,json=totalShares,proto3" json:"total_shares,omitempty"`
 }
 
 func (x *FeePayContract) Reset() {
@@ -1130,6 +3444,55 @@ func (x *FeePayContract) GetWalletLimit() uint64 {
 	return 0
 }
 
+func (x *FeePayContract) GetLimitWindowBlocks() uint64 {
+	if x != nil {
+		return x.LimitWindowBlocks
+	}
+	return 0
+}
+
+func (x *FeePayContract) GetLimitWindowDuration() *durationpb.Duration {
+	if x != nil {
+		return x.LimitWindowDuration
+	}
+	return nil
+}
+
+func (x *FeePayContract) GetEligibilityQuery() bool {
+	if x != nil {
+		return x.EligibilityQuery
+	}
+	return false
+}
+
+func (x *FeePayContract) GetBalances() []*v1beta1.Coin {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *FeePayContract) GetWalletSpendLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.WalletSpendLimit
+	}
+	return nil
+}
+
+func (x *FeePayContract) GetContractSpendLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.ContractSpendLimit
+	}
+	return nil
+}
+
+func (x *FeePayContract) GetTotalShares() []*v1beta1.Coin {
+	if x != nil {
+		return x.TotalShares
+	}
+	return nil
+}
+
 // This object is used to store the number of times a wallet has
 // interacted with a contract.
 type FeePayWalletUsage struct {
@@ -1143,6 +3506,11 @@ type FeePayWalletUsage struct {
 	WalletAddress string `protobuf:"bytes,2,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
 	// The number of uses corresponding to a wallet.
 	Uses uint64 `protobuf:"varint,3,opt,name=uses,proto3" json:"uses,omitempty"`
+	// The wallet limit window the uses were counted in. The uses reset when the
+	// window rolls over.
+	Window uint64 `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`
+	// The fees sponsored for the wallet in the window.
+	Spent []*v1beta1.Coin `protobuf:"bytes,5,rep,name=spent,proto3" json:"spent,omitempty"`
 }
 
 func (x *FeePayWalletUsage) Reset() {
@@ -1186,45 +3554,279 @@ func (x *FeePayWalletUsage) GetUses() uint64 {
 	return 0
 }
 
+func (x *FeePayWalletUsage) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *FeePayWalletUsage) GetSpent() []*v1beta1.Coin {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
+
+// This object is used to store the total fee a contract has sponsored
+// for all wallets.
+type FeePayContractSpend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The wallet limit window the fees were sponsored in. The spend resets when
+	// the window rolls over.
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// The fees sponsored for all wallets in the window.
+	Spent []*v1beta1.Coin `protobuf:"bytes,3,rep,name=spent,proto3" json:"spent,omitempty"`
+}
+
+func (x *FeePayContractSpend) Reset() {
+	*x = FeePayContractSpend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_juno_feepay_v1_feepay_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeePayContractSpend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeePayContractSpend) ProtoMessage() {}
+
+// Deprecated: Use FeePayContractSpend.ProtoReflect.Descriptor instead.
+func (*FeePayContractSpend) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_feepay_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FeePayContractSpend) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *FeePayContractSpend) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *FeePayContractSpend) GetSpent() []*v1beta1.Coin {
+	if x != nil {
+		return x.Spent
+	}
+	return nil
+}
+
+// This object is used to store the funds a funder has contributed to a
+// contract, and the pool shares of the contract balances they were minted.
+type FeePayFunder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The funder address.
+	FunderAddress string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// The funds contributed by the funder.
+	Contributed []*v1beta1.Coin `protobuf:"bytes,3,rep,name=contributed,proto3" json:"contributed,omitempty"`
+	// The pool shares of the funder in each denom, which entitle the funder to
+	// the same fraction of the total shares of the contract balances in the
+	// denom. Shares are minted at the current ratio of the total shares to the
+	// balances when funding, so fees spent before lower the value of earlier
+	// shares only.
+	Shares []*v1beta1.Coin `protobuf:"bytes,4,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *FeePayFunder) Reset() {
+	*x = FeePayFunder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_juno_feepay_v1_feepay_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeePayFunder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeePayFunder) ProtoMessage() {}
+
+// Deprecated: Use FeePayFunder.ProtoReflect.Descriptor instead.
+func (*FeePayFunder) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_feepay_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FeePayFunder) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *FeePayFunder) GetFunderAddress() string {
+	if x != nil {
+		return x.FunderAddress
+	}
+	return ""
+}
+
+func (x *FeePayFunder) GetContributed() []*v1beta1.Coin {
+	if x != nil {
+		return x.Contributed
+	}
+	return nil
+}
+
+func (x *FeePayFunder) GetShares() []*v1beta1.Coin {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
 var File_juno_feepay_v1_feepay_proto protoreflect.FileDescriptor
 
 var file_juno_feepay_v1_feepay_proto_rawDesc = []byte{
 	0x0a, 0x1b, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2f, 0x76, 0x31,
 	0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6a,
-	0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x46, 0x65, 0x65,
-	0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x43, 0x0a, 0x10, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xad, 0x01,
-	0x0a, 0x11, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x75, 0x73, 0x65, 0x73, 0x42, 0xa5, 0x01,
-	0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61,
-	0x79, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61,
-	0x79, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x4a, 0x46, 0x58, 0xaa, 0x02, 0x0e, 0x4a, 0x75, 0x6e, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x70, 0x61,
-	0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x46, 0x65, 0x65, 0x70,
-	0x61, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x46, 0x65, 0x65,
-	0x70, 0x61, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x10, 0x4a, 0x75, 0x6e, 0x6f, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x70, 0x61,
-	0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61,
+	0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xb0, 0x06, 0x0a, 0x0e, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x12, 0x43, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x13, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2b, 0x0a, 0x11, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x6c, 0x69, 0x67,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x6c, 0x0a, 0x08,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x7e, 0x0a, 0x12, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x82, 0x01, 0x0a, 0x14, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x73, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x22, 0xad, 0x02, 0x0a, 0x11, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x3f, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x66, 0x0a, 0x05,
+	0x73, 0x70, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x73,
+	0x70, 0x65, 0x6e, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x13, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x43, 0x0a, 0x10,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x66, 0x0a, 0x05, 0x73, 0x70, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e,
+	0x74, 0x22, 0xf2, 0x02, 0x0a, 0x0c, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x43, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x66, 0x75, 0x6e, 0x64, 0x65,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x66, 0x75, 0x6e, 0x64, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x72, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x12, 0x68, 0x0a, 0x06,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x42, 0xa5, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x6a,
+	0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x46,
+	0x65, 0x65, 0x70, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6a,
+	0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65,
+	0x65, 0x70, 0x61, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a, 0x46, 0x58, 0xaa, 0x02, 0x0e, 0x4a,
+	0x75, 0x6e, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e,
+	0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x1a, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x5c, 0x56, 0x31, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x4a, 0x75,
+	0x6e, 0x6f, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1239,17 +3841,30 @@ func file_juno_feepay_v1_feepay_proto_rawDescGZIP() []byte {
 	return file_juno_feepay_v1_feepay_proto_rawDescData
 }
 
-var file_juno_feepay_v1_feepay_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_juno_feepay_v1_feepay_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_juno_feepay_v1_feepay_proto_goTypes = []interface{}{
-	(*FeePayContract)(nil),    // 0: juno.feepay.v1.FeePayContract
-	(*FeePayWalletUsage)(nil), // 1: juno.feepay.v1.FeePayWalletUsage
+	(*FeePayContract)(nil),      // 0: juno.feepay.v1.FeePayContract
+	(*FeePayWalletUsage)(nil),   // 1: juno.feepay.v1.FeePayWalletUsage
+	(*FeePayContractSpend)(nil), // 2: juno.feepay.v1.FeePayContractSpend
+	(*FeePayFunder)(nil),        // 3: juno.feepay.v1.FeePayFunder
+	(*durationpb.Duration)(nil), // 4: google.protobuf.Duration
+	(*v1beta1.Coin)(nil),        // 5: cosmos.base.v1beta1.Coin
 }
 var file_juno_feepay_v1_feepay_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: juno.feepay.v1.FeePayContract.limit_window_duration:type_name -> google.protobuf.Duration
+	5, // 1: juno.feepay.v1.FeePayContract.balances:type_name -> cosmos.base.v1beta1.Coin
+	5, // 2: juno.feepay.v1.FeePayContract.wallet_spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	5, // 3: juno.feepay.v1.FeePayContract.contract_spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	5, // 4: juno.feepay.v1.FeePayContract.total_shares:type_name -> cosmos.base.v1beta1.Coin
+	5, // 5: juno.feepay.v1.FeePayWalletUsage.spent:type_name -> cosmos.base.v1beta1.Coin
+	5, // 6: juno.feepay.v1.FeePayContractSpend.spent:type_name -> cosmos.base.v1beta1.Coin
+	5, // 7: juno.feepay.v1.FeePayFunder.contributed:type_name -> cosmos.base.v1beta1.Coin
+	5, // 8: juno.feepay.v1.FeePayFunder.shares:type_name -> cosmos.base.v1beta1.Coin
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_juno_feepay_v1_feepay_proto_init() }
@@ -1282,6 +3897,30 @@ func file_juno_feepay_v1_feepay_proto_init() {
 				return nil
 			}
 		}
+		file_juno_feepay_v1_feepay_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeePayContractSpend); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_juno_feepay_v1_feepay_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeePayFunder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1289,7 +3928,7 @@ func file_juno_feepay_v1_feepay_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_juno_feepay_v1_feepay_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -65,10 +65,166 @@ func (x *_GenesisState_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+
+type _GenesisState_3_list struct {
+	list *[]*FeePayWalletUsage
+}
+
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeePayWalletUsage)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeePayWalletUsage)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(FeePayWalletUsage)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(FeePayWalletUsage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*FeePayContractSpend
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeePayContractSpend)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeePayContractSpend)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(FeePayContractSpend)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(FeePayContractSpend)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*FeePayFunder
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeePayFunder)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeePayFunder)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(FeePayFunder)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(FeePayFunder)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                   protoreflect.MessageDescriptor
 	fd_GenesisState_params            protoreflect.FieldDescriptor
 	fd_GenesisState_fee_pay_contracts protoreflect.FieldDescriptor
+	fd_GenesisState_wallet_usages     protoreflect.FieldDescriptor
+	fd_GenesisState_contract_spends   protoreflect.FieldDescriptor
+	fd_GenesisState_funders           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -76,6 +232,9 @@ func init() {
 	md_GenesisState = File_juno_feepay_v1_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_fee_pay_contracts = md_GenesisState.Fields().ByName("fee_pay_contracts")
+	fd_GenesisState_wallet_usages = md_GenesisState.Fields().ByName("wallet_usages")
+	fd_GenesisState_contract_spends = md_GenesisState.Fields().ByName("contract_spends")
+	fd_GenesisState_funders = md_GenesisState.Fields().ByName("funders")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -155,6 +314,24 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.WalletUsages) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.WalletUsages})
+		if !f(fd_GenesisState_wallet_usages, value) {
+			return
+		}
+	}
+	if len(x.ContractSpends) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.ContractSpends})
+		if !f(fd_GenesisState_contract_spends, value) {
+			return
+		}
+	}
+	if len(x.Funders) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Funders})
+		if !f(fd_GenesisState_funders, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -174,6 +351,12 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Params != nil
 	case "juno.feepay.v1.GenesisState.fee_pay_contracts":
 		return len(x.FeePayContracts) != 0
+	case "juno.feepay.v1.GenesisState.wallet_usages":
+		return len(x.WalletUsages) != 0
+	case "juno.feepay.v1.GenesisState.contract_spends":
+		return len(x.ContractSpends) != 0
+	case "juno.feepay.v1.GenesisState.funders":
+		return len(x.Funders) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.GenesisState"))
@@ -194,6 +377,12 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Params = nil
 	case "juno.feepay.v1.GenesisState.fee_pay_contracts":
 		x.FeePayContracts = nil
+	case "juno.feepay.v1.GenesisState.wallet_usages":
+		x.WalletUsages = nil
+	case "juno.feepay.v1.GenesisState.contract_spends":
+		x.ContractSpends = nil
+	case "juno.feepay.v1.GenesisState.funders":
+		x.Funders = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.GenesisState"))
@@ -219,6 +408,24 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_2_list{list: &x.FeePayContracts}
 		return protoreflect.ValueOfList(listValue)
+	case "juno.feepay.v1.GenesisState.wallet_usages":
+		if len(x.WalletUsages) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.WalletUsages}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.feepay.v1.GenesisState.contract_spends":
+		if len(x.ContractSpends) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.ContractSpends}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.feepay.v1.GenesisState.funders":
+		if len(x.Funders) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Funders}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.GenesisState"))
@@ -245,6 +452,18 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_2_list)
 		x.FeePayContracts = *clv.list
+	case "juno.feepay.v1.GenesisState.wallet_usages":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.WalletUsages = *clv.list
+	case "juno.feepay.v1.GenesisState.contract_spends":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.ContractSpends = *clv.list
+	case "juno.feepay.v1.GenesisState.funders":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Funders = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.GenesisState"))
@@ -276,6 +495,24 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_2_list{list: &x.FeePayContracts}
 		return protoreflect.ValueOfList(value)
+	case "juno.feepay.v1.GenesisState.wallet_usages":
+		if x.WalletUsages == nil {
+			x.WalletUsages = []*FeePayWalletUsage{}
+		}
+		value := &_GenesisState_3_list{list: &x.WalletUsages}
+		return protoreflect.ValueOfList(value)
+	case "juno.feepay.v1.GenesisState.contract_spends":
+		if x.ContractSpends == nil {
+			x.ContractSpends = []*FeePayContractSpend{}
+		}
+		value := &_GenesisState_4_list{list: &x.ContractSpends}
+		return protoreflect.ValueOfList(value)
+	case "juno.feepay.v1.GenesisState.funders":
+		if x.Funders == nil {
+			x.Funders = []*FeePayFunder{}
+		}
+		value := &_GenesisState_5_list{list: &x.Funders}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.GenesisState"))
@@ -295,6 +532,15 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "juno.feepay.v1.GenesisState.fee_pay_contracts":
 		list := []*FeePayContract{}
 		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "juno.feepay.v1.GenesisState.wallet_usages":
+		list := []*FeePayWalletUsage{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "juno.feepay.v1.GenesisState.contract_spends":
+		list := []*FeePayContractSpend{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "juno.feepay.v1.GenesisState.funders":
+		list := []*FeePayFunder{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.GenesisState"))
@@ -374,6 +620,24 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.WalletUsages) > 0 {
+			for _, e := range x.WalletUsages {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ContractSpends) > 0 {
+			for _, e := range x.ContractSpends {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Funders) > 0 {
+			for _, e := range x.Funders {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -403,6 +667,54 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Funders) > 0 {
+			for iNdEx := len(x.Funders) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Funders[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ContractSpends) > 0 {
+			for iNdEx := len(x.ContractSpends) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ContractSpends[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.WalletUsages) > 0 {
+			for iNdEx := len(x.WalletUsages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.WalletUsages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if len(x.FeePayContracts) > 0 {
 			for iNdEx := len(x.FeePayContracts) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.FeePayContracts[iNdEx])
@@ -552,6 +864,108 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletUsages", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletUsages = append(x.WalletUsages, &FeePayWalletUsage{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WalletUsages[len(x.WalletUsages)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractSpends", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractSpends = append(x.ContractSpends, &FeePayContractSpend{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ContractSpends[len(x.ContractSpends)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Funders", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Funders = append(x.Funders, &FeePayFunder{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Funders[len(x.Funders)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -588,14 +1002,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Params               protoreflect.MessageDescriptor
-	fd_Params_enable_feepay protoreflect.FieldDescriptor
+	md_Params                             protoreflect.MessageDescriptor
+	fd_Params_enable_feepay               protoreflect.FieldDescriptor
+	fd_Params_eligibility_query_gas_limit protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_juno_feepay_v1_genesis_proto_init()
 	md_Params = File_juno_feepay_v1_genesis_proto.Messages().ByName("Params")
 	fd_Params_enable_feepay = md_Params.Fields().ByName("enable_feepay")
+	fd_Params_eligibility_query_gas_limit = md_Params.Fields().ByName("eligibility_query_gas_limit")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -669,6 +1085,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.EligibilityQueryGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EligibilityQueryGasLimit)
+		if !f(fd_Params_eligibility_query_gas_limit, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -686,6 +1108,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "juno.feepay.v1.Params.enable_feepay":
 		return x.EnableFeepay != false
+	case "juno.feepay.v1.Params.eligibility_query_gas_limit":
+		return x.EligibilityQueryGasLimit != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.Params"))
@@ -704,6 +1128,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "juno.feepay.v1.Params.enable_feepay":
 		x.EnableFeepay = false
+	case "juno.feepay.v1.Params.eligibility_query_gas_limit":
+		x.EligibilityQueryGasLimit = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.Params"))
@@ -723,6 +1149,9 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "juno.feepay.v1.Params.enable_feepay":
 		value := x.EnableFeepay
 		return protoreflect.ValueOfBool(value)
+	case "juno.feepay.v1.Params.eligibility_query_gas_limit":
+		value := x.EligibilityQueryGasLimit
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.Params"))
@@ -745,6 +1174,8 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "juno.feepay.v1.Params.enable_feepay":
 		x.EnableFeepay = value.Bool()
+	case "juno.feepay.v1.Params.eligibility_query_gas_limit":
+		x.EligibilityQueryGasLimit = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.Params"))
@@ -767,6 +1198,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 	switch fd.FullName() {
 	case "juno.feepay.v1.Params.enable_feepay":
 		panic(fmt.Errorf("field enable_feepay of message juno.feepay.v1.Params is not mutable"))
+	case "juno.feepay.v1.Params.eligibility_query_gas_limit":
+		panic(fmt.Errorf("field eligibility_query_gas_limit of message juno.feepay.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.Params"))
@@ -782,6 +1215,8 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "juno.feepay.v1.Params.enable_feepay":
 		return protoreflect.ValueOfBool(false)
+	case "juno.feepay.v1.Params.eligibility_query_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.Params"))
@@ -854,6 +1289,9 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.EnableFeepay {
 			n += 2
 		}
+		if x.EligibilityQueryGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.EligibilityQueryGasLimit))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -883,6 +1321,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.EligibilityQueryGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EligibilityQueryGasLimit))
+			i--
+			dAtA[i] = 0x10
+		}
 		if x.EnableFeepay {
 			i--
 			if x.EnableFeepay {
@@ -962,6 +1405,25 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.EnableFeepay = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EligibilityQueryGasLimit", wireType)
+				}
+				x.EligibilityQueryGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EligibilityQueryGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1020,6 +1482,14 @@ type GenesisState struct {
 	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 	// fee_pay_contracts are the feepay module contracts
 	FeePayContracts []*FeePayContract `protobuf:"bytes,2,rep,name=fee_pay_contracts,json=feePayContracts,proto3" json:"fee_pay_contracts,omitempty"`
+	// wallet_usages are the uses and fees sponsored of the wallets on the
+	// feepay module contracts
+	WalletUsages []*FeePayWalletUsage `protobuf:"bytes,3,rep,name=wallet_usages,json=walletUsages,proto3" json:"wallet_usages,omitempty"`
+	// contract_spends are the fees sponsored by the feepay module contracts
+	ContractSpends []*FeePayContractSpend `protobuf:"bytes,4,rep,name=contract_spends,json=contractSpends,proto3" json:"contract_spends,omitempty"`
+	// funders are the contributions of the funders of the feepay module
+	// contracts
+	Funders []*FeePayFunder `protobuf:"bytes,5,rep,name=funders,proto3" json:"funders,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1056,6 +1526,27 @@ func (x *GenesisState) GetFeePayContracts() []*FeePayContract {
 	return nil
 }
 
+func (x *GenesisState) GetWalletUsages() []*FeePayWalletUsage {
+	if x != nil {
+		return x.WalletUsages
+	}
+	return nil
+}
+
+func (x *GenesisState) GetContractSpends() []*FeePayContractSpend {
+	if x != nil {
+		return x.ContractSpends
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFunders() []*FeePayFunder {
+	if x != nil {
+		return x.Funders
+	}
+	return nil
+}
+
 // Params defines the feepay module params
 type Params struct {
 	state         protoimpl.MessageState
@@ -1064,6 +1555,10 @@ type Params struct {
 
 	// enable_feepay defines a parameter to enable the feepay module
 	EnableFeepay bool `protobuf:"varint,1,opt,name=enable_feepay,json=enableFeepay,proto3" json:"enable_feepay,omitempty"`
+	// eligibility_query_gas_limit defines the maximum amount of gas the eligibility
+	// query of a contract can use. Zero disables the eligibility queries, so
+	// contracts which decide their eligible wallets are not sponsored.
+	EligibilityQueryGasLimit uint64 `protobuf:"varint,2,opt,name=eligibility_query_gas_limit,json=eligibilityQueryGasLimit,proto3" json:"eligibility_query_gas_limit,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1093,6 +1588,13 @@ func (x *Params) GetEnableFeepay() bool {
 	return false
 }
 
+func (x *Params) GetEligibilityQueryGasLimit() uint64 {
+	if x != nil {
+		return x.EligibilityQueryGasLimit
+	}
+	return 0
+}
+
 var File_juno_feepay_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_juno_feepay_v1_genesis_proto_rawDesc = []byte{
@@ -1103,7 +1605,7 @@ var file_juno_feepay_v1_genesis_proto_rawDesc = []byte{
 	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
 	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65,
 	0x65, 0x70, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa0, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
 	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65,
 	0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8,
@@ -1113,21 +1615,40 @@ var file_juno_feepay_v1_genesis_proto_rawDesc = []byte{
 	0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65,
 	0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f,
 	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x22, 0x33, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x70,
-	0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xa6, 0x01, 0x0a,
-	0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79,
-	0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61,
-	0x79, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x4a, 0x46, 0x58, 0xaa, 0x02, 0x0e, 0x4a, 0x75, 0x6e, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x70, 0x61,
-	0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x46, 0x65, 0x65, 0x70,
-	0x61, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x46, 0x65, 0x65,
-	0x70, 0x61, 0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x10, 0x4a, 0x75, 0x6e, 0x6f, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x70, 0x61,
-	0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x12, 0x51, 0x0a, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x46, 0x75, 0x6e, 0x64,
+	0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x66,
+	0x75, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x22, 0x72, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x70, 0x61,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x46,
+	0x65, 0x65, 0x70, 0x61, 0x79, 0x12, 0x3d, 0x0a, 0x1b, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x65, 0x6c, 0x69, 0x67,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x61, 0x73, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xa6, 0x01, 0x0a, 0x12, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2f,
+	0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a, 0x46,
+	0x58, 0xaa, 0x02, 0x0e, 0x4a, 0x75, 0x6e, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x0e, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x46, 0x65, 0x65, 0x70, 0x61,
+	0x79, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x10, 0x4a, 0x75, 0x6e, 0x6f, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1144,18 +1665,24 @@ func file_juno_feepay_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_juno_feepay_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_juno_feepay_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),   // 0: juno.feepay.v1.GenesisState
-	(*Params)(nil),         // 1: juno.feepay.v1.Params
-	(*FeePayContract)(nil), // 2: juno.feepay.v1.FeePayContract
+	(*GenesisState)(nil),        // 0: juno.feepay.v1.GenesisState
+	(*Params)(nil),              // 1: juno.feepay.v1.Params
+	(*FeePayContract)(nil),      // 2: juno.feepay.v1.FeePayContract
+	(*FeePayWalletUsage)(nil),   // 3: juno.feepay.v1.FeePayWalletUsage
+	(*FeePayContractSpend)(nil), // 4: juno.feepay.v1.FeePayContractSpend
+	(*FeePayFunder)(nil),        // 5: juno.feepay.v1.FeePayFunder
 }
 var file_juno_feepay_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: juno.feepay.v1.GenesisState.params:type_name -> juno.feepay.v1.Params
 	2, // 1: juno.feepay.v1.GenesisState.fee_pay_contracts:type_name -> juno.feepay.v1.FeePayContract
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: juno.feepay.v1.GenesisState.wallet_usages:type_name -> juno.feepay.v1.FeePayWalletUsage
+	4, // 3: juno.feepay.v1.GenesisState.contract_spends:type_name -> juno.feepay.v1.FeePayContractSpend
+	5, // 4: juno.feepay.v1.GenesisState.funders:type_name -> juno.feepay.v1.FeePayFunder
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_juno_feepay_v1_genesis_proto_init() }
@@ -22,7 +22,13 @@ const (
 	Query_FeePayContract_FullMethodName         = "/juno.feepay.v1.Query/FeePayContract"
 	Query_FeePayContracts_FullMethodName        = "/juno.feepay.v1.Query/FeePayContracts"
 	Query_FeePayContractUses_FullMethodName     = "/juno.feepay.v1.Query/FeePayContractUses"
+	Query_FeePayWalletUsages_FullMethodName     = "/juno.feepay.v1.Query/FeePayWalletUsages"
+	Query_FeePayFunders_FullMethodName          = "/juno.feepay.v1.Query/FeePayFunders"
+	Query_FeePayFunder_FullMethodName           = "/juno.feepay.v1.Query/FeePayFunder"
 	Query_FeePayWalletIsEligible_FullMethodName = "/juno.feepay.v1.Query/FeePayWalletIsEligible"
+	Query_FeePayWalletAllowance_FullMethodName  = "/juno.feepay.v1.Query/FeePayWalletAllowance"
+	Query_FeePaySpend_FullMethodName            = "/juno.feepay.v1.Query/FeePaySpend"
+	Query_FeePaySimulate_FullMethodName         = "/juno.feepay.v1.Query/FeePaySimulate"
 	Query_Params_FullMethodName                 = "/juno.feepay.v1.Query/Params"
 )
 
@@ -38,8 +44,23 @@ type QueryClient interface {
 	FeePayContracts(ctx context.Context, in *QueryFeePayContractsRequest, opts ...grpc.CallOption) (*QueryFeePayContractsResponse, error)
 	// Retrieve the number of uses on a fee pay contract by wallet
 	FeePayContractUses(ctx context.Context, in *QueryFeePayContractUsesRequest, opts ...grpc.CallOption) (*QueryFeePayContractUsesResponse, error)
+	// Retrieve the stored usage of every wallet on a fee pay contract
+	FeePayWalletUsages(ctx context.Context, in *QueryFeePayWalletUsagesRequest, opts ...grpc.CallOption) (*QueryFeePayWalletUsagesResponse, error)
+	// Retrieve the contributions of the funders of a fee pay contract
+	FeePayFunders(ctx context.Context, in *QueryFeePayFundersRequest, opts ...grpc.CallOption) (*QueryFeePayFundersResponse, error)
+	// Retrieve the contribution of a funder of a fee pay contract and its share
+	// of the unspent funds
+	FeePayFunder(ctx context.Context, in *QueryFeePayFunderRequest, opts ...grpc.CallOption) (*QueryFeePayFunderResponse, error)
 	// Query if sender is eligible for fee pay contract interaction
 	FeePayWalletIsEligible(ctx context.Context, in *QueryFeePayWalletIsEligibleRequest, opts ...grpc.CallOption) (*QueryFeePayWalletIsEligibleResponse, error)
+	// Retrieve the remaining allowance of a wallet on a fee pay contract and when it resets
+	FeePayWalletAllowance(ctx context.Context, in *QueryFeePayWalletAllowanceRequest, opts ...grpc.CallOption) (*QueryFeePayWalletAllowanceResponse, error)
+	// Retrieve the fees a fee pay contract has sponsored for a wallet and for all
+	// wallets in the current limit window
+	FeePaySpend(ctx context.Context, in *QueryFeePaySpendRequest, opts ...grpc.CallOption) (*QueryFeePaySpendResponse, error)
+	// Simulate whether FeePay would sponsor the fee of a transaction, and the fee
+	// it would deduct from the fee pay contracts
+	FeePaySimulate(ctx context.Context, in *QueryFeePaySimulateRequest, opts ...grpc.CallOption) (*QueryFeePaySimulateResponse, error)
 	// Params retrieves the FeePay module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -82,6 +103,36 @@ func (c *queryClient) FeePayContractUses(ctx context.Context, in *QueryFeePayCon
 	return out, nil
 }
 
+func (c *queryClient) FeePayWalletUsages(ctx context.Context, in *QueryFeePayWalletUsagesRequest, opts ...grpc.CallOption) (*QueryFeePayWalletUsagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeePayWalletUsagesResponse)
+	err := c.cc.Invoke(ctx, Query_FeePayWalletUsages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePayFunders(ctx context.Context, in *QueryFeePayFundersRequest, opts ...grpc.CallOption) (*QueryFeePayFundersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeePayFundersResponse)
+	err := c.cc.Invoke(ctx, Query_FeePayFunders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePayFunder(ctx context.Context, in *QueryFeePayFunderRequest, opts ...grpc.CallOption) (*QueryFeePayFunderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeePayFunderResponse)
+	err := c.cc.Invoke(ctx, Query_FeePayFunder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) FeePayWalletIsEligible(ctx context.Context, in *QueryFeePayWalletIsEligibleRequest, opts ...grpc.CallOption) (*QueryFeePayWalletIsEligibleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryFeePayWalletIsEligibleResponse)
@@ -92,6 +143,36 @@ func (c *queryClient) FeePayWalletIsEligible(ctx context.Context, in *QueryFeePa
 	return out, nil
 }
 
+func (c *queryClient) FeePayWalletAllowance(ctx context.Context, in *QueryFeePayWalletAllowanceRequest, opts ...grpc.CallOption) (*QueryFeePayWalletAllowanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeePayWalletAllowanceResponse)
+	err := c.cc.Invoke(ctx, Query_FeePayWalletAllowance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePaySpend(ctx context.Context, in *QueryFeePaySpendRequest, opts ...grpc.CallOption) (*QueryFeePaySpendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeePaySpendResponse)
+	err := c.cc.Invoke(ctx, Query_FeePaySpend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePaySimulate(ctx context.Context, in *QueryFeePaySimulateRequest, opts ...grpc.CallOption) (*QueryFeePaySimulateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeePaySimulateResponse)
+	err := c.cc.Invoke(ctx, Query_FeePaySimulate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryParamsResponse)
@@ -114,8 +195,23 @@ type QueryServer interface {
 	FeePayContracts(context.Context, *QueryFeePayContractsRequest) (*QueryFeePayContractsResponse, error)
 	// Retrieve the number of uses on a fee pay contract by wallet
 	FeePayContractUses(context.Context, *QueryFeePayContractUsesRequest) (*QueryFeePayContractUsesResponse, error)
+	// Retrieve the stored usage of every wallet on a fee pay contract
+	FeePayWalletUsages(context.Context, *QueryFeePayWalletUsagesRequest) (*QueryFeePayWalletUsagesResponse, error)
+	// Retrieve the contributions of the funders of a fee pay contract
+	FeePayFunders(context.Context, *QueryFeePayFundersRequest) (*QueryFeePayFundersResponse, error)
+	// Retrieve the contribution of a funder of a fee pay contract and its share
+	// of the unspent funds
+	FeePayFunder(context.Context, *QueryFeePayFunderRequest) (*QueryFeePayFunderResponse, error)
 	// Query if sender is eligible for fee pay contract interaction
 	FeePayWalletIsEligible(context.Context, *QueryFeePayWalletIsEligibleRequest) (*QueryFeePayWalletIsEligibleResponse, error)
+	// Retrieve the remaining allowance of a wallet on a fee pay contract and when it resets
+	FeePayWalletAllowance(context.Context, *QueryFeePayWalletAllowanceRequest) (*QueryFeePayWalletAllowanceResponse, error)
+	// Retrieve the fees a fee pay contract has sponsored for a wallet and for all
+	// wallets in the current limit window
+	FeePaySpend(context.Context, *QueryFeePaySpendRequest) (*QueryFeePaySpendResponse, error)
+	// Simulate whether FeePay would sponsor the fee of a transaction, and the fee
+	// it would deduct from the fee pay contracts
+	FeePaySimulate(context.Context, *QueryFeePaySimulateRequest) (*QueryFeePaySimulateResponse, error)
 	// Params retrieves the FeePay module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -137,9 +233,27 @@ func (UnimplementedQueryServer) FeePayContracts(context.Context, *QueryFeePayCon
 func (UnimplementedQueryServer) FeePayContractUses(context.Context, *QueryFeePayContractUsesRequest) (*QueryFeePayContractUsesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeePayContractUses not implemented")
 }
+func (UnimplementedQueryServer) FeePayWalletUsages(context.Context, *QueryFeePayWalletUsagesRequest) (*QueryFeePayWalletUsagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePayWalletUsages not implemented")
+}
+func (UnimplementedQueryServer) FeePayFunders(context.Context, *QueryFeePayFundersRequest) (*QueryFeePayFundersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePayFunders not implemented")
+}
+func (UnimplementedQueryServer) FeePayFunder(context.Context, *QueryFeePayFunderRequest) (*QueryFeePayFunderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePayFunder not implemented")
+}
 func (UnimplementedQueryServer) FeePayWalletIsEligible(context.Context, *QueryFeePayWalletIsEligibleRequest) (*QueryFeePayWalletIsEligibleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeePayWalletIsEligible not implemented")
 }
+func (UnimplementedQueryServer) FeePayWalletAllowance(context.Context, *QueryFeePayWalletAllowanceRequest) (*QueryFeePayWalletAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePayWalletAllowance not implemented")
+}
+func (UnimplementedQueryServer) FeePaySpend(context.Context, *QueryFeePaySpendRequest) (*QueryFeePaySpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePaySpend not implemented")
+}
+func (UnimplementedQueryServer) FeePaySimulate(context.Context, *QueryFeePaySimulateRequest) (*QueryFeePaySimulateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePaySimulate not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -218,6 +332,60 @@ func _Query_FeePayContractUses_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeePayWalletUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePayWalletUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePayWalletUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeePayWalletUsages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePayWalletUsages(ctx, req.(*QueryFeePayWalletUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePayFunders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePayFundersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePayFunders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeePayFunders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePayFunders(ctx, req.(*QueryFeePayFundersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePayFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePayFunderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePayFunder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeePayFunder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePayFunder(ctx, req.(*QueryFeePayFunderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_FeePayWalletIsEligible_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryFeePayWalletIsEligibleRequest)
 	if err := dec(in); err != nil {
@@ -236,6 +404,60 @@ func _Query_FeePayWalletIsEligible_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeePayWalletAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePayWalletAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePayWalletAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeePayWalletAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePayWalletAllowance(ctx, req.(*QueryFeePayWalletAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePaySpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePaySpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePaySpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeePaySpend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePaySpend(ctx, req.(*QueryFeePaySpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePaySimulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePaySimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePaySimulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeePaySimulate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePaySimulate(ctx, req.(*QueryFeePaySimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -273,10 +495,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FeePayContractUses",
 			Handler:    _Query_FeePayContractUses_Handler,
 		},
+		{
+			MethodName: "FeePayWalletUsages",
+			Handler:    _Query_FeePayWalletUsages_Handler,
+		},
+		{
+			MethodName: "FeePayFunders",
+			Handler:    _Query_FeePayFunders_Handler,
+		},
+		{
+			MethodName: "FeePayFunder",
+			Handler:    _Query_FeePayFunder_Handler,
+		},
 		{
 			MethodName: "FeePayWalletIsEligible",
 			Handler:    _Query_FeePayWalletIsEligible_Handler,
 		},
+		{
+			MethodName: "FeePayWalletAllowance",
+			Handler:    _Query_FeePayWalletAllowance_Handler,
+		},
+		{
+			MethodName: "FeePaySpend",
+			Handler:    _Query_FeePaySpend_Handler,
+		},
+		{
+			MethodName: "FeePaySimulate",
+			Handler:    _Query_FeePaySimulate_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -13,6 +13,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -2692,29 +2693,27 @@ func (x *fastReflection_MsgFundFeePayContractResponse) ProtoMethods() *protoifac
 }
 
 var (
-	md_MsgUpdateFeePayContractWalletLimit                  protoreflect.MessageDescriptor
-	fd_MsgUpdateFeePayContractWalletLimit_sender_address   protoreflect.FieldDescriptor
-	fd_MsgUpdateFeePayContractWalletLimit_contract_address protoreflect.FieldDescriptor
-	fd_MsgUpdateFeePayContractWalletLimit_wallet_limit     protoreflect.FieldDescriptor
+	md_MsgWithdrawFeePayContractFunds                  protoreflect.MessageDescriptor
+	fd_MsgWithdrawFeePayContractFunds_funder_address   protoreflect.FieldDescriptor
+	fd_MsgWithdrawFeePayContractFunds_contract_address protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_juno_feepay_v1_tx_proto_init()
-	md_MsgUpdateFeePayContractWalletLimit = File_juno_feepay_v1_tx_proto.Messages().ByName("MsgUpdateFeePayContractWalletLimit")
-	fd_MsgUpdateFeePayContractWalletLimit_sender_address = md_MsgUpdateFeePayContractWalletLimit.Fields().ByName("sender_address")
-	fd_MsgUpdateFeePayContractWalletLimit_contract_address = md_MsgUpdateFeePayContractWalletLimit.Fields().ByName("contract_address")
-	fd_MsgUpdateFeePayContractWalletLimit_wallet_limit = md_MsgUpdateFeePayContractWalletLimit.Fields().ByName("wallet_limit")
+	md_MsgWithdrawFeePayContractFunds = File_juno_feepay_v1_tx_proto.Messages().ByName("MsgWithdrawFeePayContractFunds")
+	fd_MsgWithdrawFeePayContractFunds_funder_address = md_MsgWithdrawFeePayContractFunds.Fields().ByName("funder_address")
+	fd_MsgWithdrawFeePayContractFunds_contract_address = md_MsgWithdrawFeePayContractFunds.Fields().ByName("contract_address")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateFeePayContractWalletLimit)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgWithdrawFeePayContractFunds)(nil)
 
-type fastReflection_MsgUpdateFeePayContractWalletLimit MsgUpdateFeePayContractWalletLimit
+type fastReflection_MsgWithdrawFeePayContractFunds MsgWithdrawFeePayContractFunds
 
-func (x *MsgUpdateFeePayContractWalletLimit) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateFeePayContractWalletLimit)(x)
+func (x *MsgWithdrawFeePayContractFunds) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawFeePayContractFunds)(x)
 }
 
-func (x *MsgUpdateFeePayContractWalletLimit) slowProtoReflect() protoreflect.Message {
+func (x *MsgWithdrawFeePayContractFunds) slowProtoReflect() protoreflect.Message {
 	mi := &file_juno_feepay_v1_tx_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2726,43 +2725,43 @@ func (x *MsgUpdateFeePayContractWalletLimit) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateFeePayContractWalletLimit_messageType fastReflection_MsgUpdateFeePayContractWalletLimit_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateFeePayContractWalletLimit_messageType{}
+var _fastReflection_MsgWithdrawFeePayContractFunds_messageType fastReflection_MsgWithdrawFeePayContractFunds_messageType
+var _ protoreflect.MessageType = fastReflection_MsgWithdrawFeePayContractFunds_messageType{}
 
-type fastReflection_MsgUpdateFeePayContractWalletLimit_messageType struct{}
+type fastReflection_MsgWithdrawFeePayContractFunds_messageType struct{}
 
-func (x fastReflection_MsgUpdateFeePayContractWalletLimit_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateFeePayContractWalletLimit)(nil)
+func (x fastReflection_MsgWithdrawFeePayContractFunds_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawFeePayContractFunds)(nil)
 }
-func (x fastReflection_MsgUpdateFeePayContractWalletLimit_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateFeePayContractWalletLimit)
+func (x fastReflection_MsgWithdrawFeePayContractFunds_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawFeePayContractFunds)
 }
-func (x fastReflection_MsgUpdateFeePayContractWalletLimit_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateFeePayContractWalletLimit
+func (x fastReflection_MsgWithdrawFeePayContractFunds_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawFeePayContractFunds
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateFeePayContractWalletLimit
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawFeePayContractFunds
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateFeePayContractWalletLimit_messageType
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) Type() protoreflect.MessageType {
+	return _fastReflection_MsgWithdrawFeePayContractFunds_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateFeePayContractWalletLimit)
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawFeePayContractFunds)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateFeePayContractWalletLimit)(x)
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) Interface() protoreflect.ProtoMessage {
+	return (*MsgWithdrawFeePayContractFunds)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2770,22 +2769,16 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.SenderAddress != "" {
-		value := protoreflect.ValueOfString(x.SenderAddress)
-		if !f(fd_MsgUpdateFeePayContractWalletLimit_sender_address, value) {
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FunderAddress != "" {
+		value := protoreflect.ValueOfString(x.FunderAddress)
+		if !f(fd_MsgWithdrawFeePayContractFunds_funder_address, value) {
 			return
 		}
 	}
 	if x.ContractAddress != "" {
 		value := protoreflect.ValueOfString(x.ContractAddress)
-		if !f(fd_MsgUpdateFeePayContractWalletLimit_contract_address, value) {
-			return
-		}
-	}
-	if x.WalletLimit != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.WalletLimit)
-		if !f(fd_MsgUpdateFeePayContractWalletLimit_wallet_limit, value) {
+		if !f(fd_MsgWithdrawFeePayContractFunds_contract_address, value) {
 			return
 		}
 	}
@@ -2802,19 +2795,17 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.sender_address":
-		return x.SenderAddress != ""
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.contract_address":
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFunds.funder_address":
+		return x.FunderAddress != ""
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFunds.contract_address":
 		return x.ContractAddress != ""
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.wallet_limit":
-		return x.WalletLimit != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgWithdrawFeePayContractFunds"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.MsgWithdrawFeePayContractFunds does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2824,19 +2815,17 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.sender_address":
-		x.SenderAddress = ""
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.contract_address":
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFunds.funder_address":
+		x.FunderAddress = ""
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFunds.contract_address":
 		x.ContractAddress = ""
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.wallet_limit":
-		x.WalletLimit = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgWithdrawFeePayContractFunds"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.MsgWithdrawFeePayContractFunds does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2846,22 +2835,19 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.sender_address":
-		value := x.SenderAddress
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFunds.funder_address":
+		value := x.FunderAddress
 		return protoreflect.ValueOfString(value)
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.contract_address":
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFunds.contract_address":
 		value := x.ContractAddress
 		return protoreflect.ValueOfString(value)
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.wallet_limit":
-		value := x.WalletLimit
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgWithdrawFeePayContractFunds"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.MsgWithdrawFeePayContractFunds does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2875,19 +2861,17 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.sender_address":
-		x.SenderAddress = value.Interface().(string)
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.contract_address":
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFunds.funder_address":
+		x.FunderAddress = value.Interface().(string)
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFunds.contract_address":
 		x.ContractAddress = value.Interface().(string)
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.wallet_limit":
-		x.WalletLimit = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgWithdrawFeePayContractFunds"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.MsgWithdrawFeePayContractFunds does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2901,48 +2885,44 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.sender_address":
-		panic(fmt.Errorf("field sender_address of message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit is not mutable"))
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.contract_address":
-		panic(fmt.Errorf("field contract_address of message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit is not mutable"))
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.wallet_limit":
-		panic(fmt.Errorf("field wallet_limit of message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit is not mutable"))
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFunds.funder_address":
+		panic(fmt.Errorf("field funder_address of message juno.feepay.v1.MsgWithdrawFeePayContractFunds is not mutable"))
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFunds.contract_address":
+		panic(fmt.Errorf("field contract_address of message juno.feepay.v1.MsgWithdrawFeePayContractFunds is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgWithdrawFeePayContractFunds"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.MsgWithdrawFeePayContractFunds does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.sender_address":
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFunds.funder_address":
 		return protoreflect.ValueOfString("")
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.contract_address":
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFunds.contract_address":
 		return protoreflect.ValueOfString("")
-	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.wallet_limit":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgWithdrawFeePayContractFunds"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.MsgWithdrawFeePayContractFunds does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in juno.feepay.v1.MsgUpdateFeePayContractWalletLimit", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in juno.feepay.v1.MsgWithdrawFeePayContractFunds", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2950,7 +2930,7 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2961,7 +2941,7 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2973,7 +2953,7 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) IsValid() bool {
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) IsValid() bool {
 	return x != nil
 }
 
@@ -2983,9 +2963,9 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgWithdrawFeePayContractFunds) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateFeePayContractWalletLimit)
+		x := input.Message.Interface().(*MsgWithdrawFeePayContractFunds)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2997,7 +2977,7 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		l = len(x.SenderAddress)
+		l = len(x.FunderAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -3005,9 +2985,6 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) ProtoMethods() *prot
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.WalletLimit != 0 {
-			n += 1 + runtime.Sov(uint64(x.WalletLimit))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3018,7 +2995,7 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateFeePayContractWalletLimit)
+		x := input.Message.Interface().(*MsgWithdrawFeePayContractFunds)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3037,11 +3014,6 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.WalletLimit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.WalletLimit))
-			i--
-			dAtA[i] = 0x18
-		}
 		if len(x.ContractAddress) > 0 {
 			i -= len(x.ContractAddress)
 			copy(dAtA[i:], x.ContractAddress)
@@ -3049,10 +3021,10 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) ProtoMethods() *prot
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.SenderAddress) > 0 {
-			i -= len(x.SenderAddress)
-			copy(dAtA[i:], x.SenderAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SenderAddress)))
+		if len(x.FunderAddress) > 0 {
+			i -= len(x.FunderAddress)
+			copy(dAtA[i:], x.FunderAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FunderAddress)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3067,7 +3039,7 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateFeePayContractWalletLimit)
+		x := input.Message.Interface().(*MsgWithdrawFeePayContractFunds)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3099,15 +3071,15 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimit: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawFeePayContractFunds: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawFeePayContractFunds: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3135,7 +3107,7 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) ProtoMethods() *prot
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.SenderAddress = string(dAtA[iNdEx:postIndex])
+				x.FunderAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
@@ -3169,25 +3141,6 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) ProtoMethods() *prot
 				}
 				x.ContractAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletLimit", wireType)
-				}
-				x.WalletLimit = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.WalletLimit |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3223,24 +3176,77 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) ProtoMethods() *prot
 	}
 }
 
+var _ protoreflect.List = (*_MsgWithdrawFeePayContractFundsResponse_1_list)(nil)
+
+type _MsgWithdrawFeePayContractFundsResponse_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgWithdrawFeePayContractFundsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgWithdrawFeePayContractFundsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgWithdrawFeePayContractFundsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgWithdrawFeePayContractFundsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgWithdrawFeePayContractFundsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgWithdrawFeePayContractFundsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgWithdrawFeePayContractFundsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgWithdrawFeePayContractFundsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgUpdateFeePayContractWalletLimitResponse protoreflect.MessageDescriptor
+	md_MsgWithdrawFeePayContractFundsResponse        protoreflect.MessageDescriptor
+	fd_MsgWithdrawFeePayContractFundsResponse_amount protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_juno_feepay_v1_tx_proto_init()
-	md_MsgUpdateFeePayContractWalletLimitResponse = File_juno_feepay_v1_tx_proto.Messages().ByName("MsgUpdateFeePayContractWalletLimitResponse")
+	md_MsgWithdrawFeePayContractFundsResponse = File_juno_feepay_v1_tx_proto.Messages().ByName("MsgWithdrawFeePayContractFundsResponse")
+	fd_MsgWithdrawFeePayContractFundsResponse_amount = md_MsgWithdrawFeePayContractFundsResponse.Fields().ByName("amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateFeePayContractWalletLimitResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgWithdrawFeePayContractFundsResponse)(nil)
 
-type fastReflection_MsgUpdateFeePayContractWalletLimitResponse MsgUpdateFeePayContractWalletLimitResponse
+type fastReflection_MsgWithdrawFeePayContractFundsResponse MsgWithdrawFeePayContractFundsResponse
 
-func (x *MsgUpdateFeePayContractWalletLimitResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateFeePayContractWalletLimitResponse)(x)
+func (x *MsgWithdrawFeePayContractFundsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawFeePayContractFundsResponse)(x)
 }
 
-func (x *MsgUpdateFeePayContractWalletLimitResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgWithdrawFeePayContractFundsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_juno_feepay_v1_tx_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3252,43 +3258,43 @@ func (x *MsgUpdateFeePayContractWalletLimitResponse) slowProtoReflect() protoref
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType{}
+var _fastReflection_MsgWithdrawFeePayContractFundsResponse_messageType fastReflection_MsgWithdrawFeePayContractFundsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgWithdrawFeePayContractFundsResponse_messageType{}
 
-type fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType struct{}
+type fastReflection_MsgWithdrawFeePayContractFundsResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateFeePayContractWalletLimitResponse)(nil)
+func (x fastReflection_MsgWithdrawFeePayContractFundsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawFeePayContractFundsResponse)(nil)
 }
-func (x fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateFeePayContractWalletLimitResponse)
+func (x fastReflection_MsgWithdrawFeePayContractFundsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawFeePayContractFundsResponse)
 }
-func (x fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateFeePayContractWalletLimitResponse
+func (x fastReflection_MsgWithdrawFeePayContractFundsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawFeePayContractFundsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateFeePayContractWalletLimitResponse
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawFeePayContractFundsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgWithdrawFeePayContractFundsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateFeePayContractWalletLimitResponse)
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawFeePayContractFundsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateFeePayContractWalletLimitResponse)(x)
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgWithdrawFeePayContractFundsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3296,7 +3302,13 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Interface()
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_MsgWithdrawFeePayContractFundsResponse_1_list{list: &x.Amount})
+		if !f(fd_MsgWithdrawFeePayContractFundsResponse_amount, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3310,13 +3322,15 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Range(f func
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse.amount":
+		return len(x.Amount) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3326,13 +3340,15 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Has(fd proto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse.amount":
+		x.Amount = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3342,13 +3358,19 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Clear(fd pro
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_MsgWithdrawFeePayContractFundsResponse_1_list{})
+		}
+		listValue := &_MsgWithdrawFeePayContractFundsResponse_1_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3362,13 +3384,17 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Get(descript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse.amount":
+		lv := value.List()
+		clv := lv.(*_MsgWithdrawFeePayContractFundsResponse_1_list)
+		x.Amount = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3382,36 +3408,45 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Set(fd proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_MsgWithdrawFeePayContractFundsResponse_1_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgWithdrawFeePayContractFundsResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse"))
 		}
-		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3419,7 +3454,7 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) WhichOneof(d
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3430,7 +3465,7 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) GetUnknown()
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3442,7 +3477,7 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) SetUnknown(f
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) IsValid() bool {
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3452,9 +3487,9 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) IsValid() bo
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgWithdrawFeePayContractFundsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateFeePayContractWalletLimitResponse)
+		x := input.Message.Interface().(*MsgWithdrawFeePayContractFundsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3466,6 +3501,12 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) ProtoMethods
 		var n int
 		var l int
 		_ = l
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3476,7 +3517,7 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) ProtoMethods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateFeePayContractWalletLimitResponse)
+		x := input.Message.Interface().(*MsgWithdrawFeePayContractFundsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3495,17 +3536,1082 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) ProtoMethods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgWithdrawFeePayContractFundsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawFeePayContractFundsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawFeePayContractFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateFeePayContractWalletLimit                       protoreflect.MessageDescriptor
+	fd_MsgUpdateFeePayContractWalletLimit_sender_address        protoreflect.FieldDescriptor
+	fd_MsgUpdateFeePayContractWalletLimit_contract_address      protoreflect.FieldDescriptor
+	fd_MsgUpdateFeePayContractWalletLimit_wallet_limit          protoreflect.FieldDescriptor
+	fd_MsgUpdateFeePayContractWalletLimit_limit_window_blocks   protoreflect.FieldDescriptor
+	fd_MsgUpdateFeePayContractWalletLimit_limit_window_duration protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_juno_feepay_v1_tx_proto_init()
+	md_MsgUpdateFeePayContractWalletLimit = File_juno_feepay_v1_tx_proto.Messages().ByName("MsgUpdateFeePayContractWalletLimit")
+	fd_MsgUpdateFeePayContractWalletLimit_sender_address = md_MsgUpdateFeePayContractWalletLimit.Fields().ByName("sender_address")
+	fd_MsgUpdateFeePayContractWalletLimit_contract_address = md_MsgUpdateFeePayContractWalletLimit.Fields().ByName("contract_address")
+	fd_MsgUpdateFeePayContractWalletLimit_wallet_limit = md_MsgUpdateFeePayContractWalletLimit.Fields().ByName("wallet_limit")
+	fd_MsgUpdateFeePayContractWalletLimit_limit_window_blocks = md_MsgUpdateFeePayContractWalletLimit.Fields().ByName("limit_window_blocks")
+	fd_MsgUpdateFeePayContractWalletLimit_limit_window_duration = md_MsgUpdateFeePayContractWalletLimit.Fields().ByName("limit_window_duration")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateFeePayContractWalletLimit)(nil)
+
+type fastReflection_MsgUpdateFeePayContractWalletLimit MsgUpdateFeePayContractWalletLimit
+
+func (x *MsgUpdateFeePayContractWalletLimit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateFeePayContractWalletLimit)(x)
+}
+
+func (x *MsgUpdateFeePayContractWalletLimit) slowProtoReflect() protoreflect.Message {
+	mi := &file_juno_feepay_v1_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateFeePayContractWalletLimit_messageType fastReflection_MsgUpdateFeePayContractWalletLimit_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateFeePayContractWalletLimit_messageType{}
+
+type fastReflection_MsgUpdateFeePayContractWalletLimit_messageType struct{}
+
+func (x fastReflection_MsgUpdateFeePayContractWalletLimit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateFeePayContractWalletLimit)(nil)
+}
+func (x fastReflection_MsgUpdateFeePayContractWalletLimit_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateFeePayContractWalletLimit)
+}
+func (x fastReflection_MsgUpdateFeePayContractWalletLimit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateFeePayContractWalletLimit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateFeePayContractWalletLimit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateFeePayContractWalletLimit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateFeePayContractWalletLimit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateFeePayContractWalletLimit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SenderAddress != "" {
+		value := protoreflect.ValueOfString(x.SenderAddress)
+		if !f(fd_MsgUpdateFeePayContractWalletLimit_sender_address, value) {
+			return
+		}
+	}
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_MsgUpdateFeePayContractWalletLimit_contract_address, value) {
+			return
+		}
+	}
+	if x.WalletLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WalletLimit)
+		if !f(fd_MsgUpdateFeePayContractWalletLimit_wallet_limit, value) {
+			return
+		}
+	}
+	if x.LimitWindowBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LimitWindowBlocks)
+		if !f(fd_MsgUpdateFeePayContractWalletLimit_limit_window_blocks, value) {
+			return
+		}
+	}
+	if x.LimitWindowDuration != nil {
+		value := protoreflect.ValueOfMessage(x.LimitWindowDuration.ProtoReflect())
+		if !f(fd_MsgUpdateFeePayContractWalletLimit_limit_window_duration, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.sender_address":
+		return x.SenderAddress != ""
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.contract_address":
+		return x.ContractAddress != ""
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.wallet_limit":
+		return x.WalletLimit != uint64(0)
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.limit_window_blocks":
+		return x.LimitWindowBlocks != uint64(0)
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.limit_window_duration":
+		return x.LimitWindowDuration != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimit"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.sender_address":
+		x.SenderAddress = ""
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.contract_address":
+		x.ContractAddress = ""
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.wallet_limit":
+		x.WalletLimit = uint64(0)
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.limit_window_blocks":
+		x.LimitWindowBlocks = uint64(0)
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.limit_window_duration":
+		x.LimitWindowDuration = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimit"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.sender_address":
+		value := x.SenderAddress
+		return protoreflect.ValueOfString(value)
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.contract_address":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.wallet_limit":
+		value := x.WalletLimit
+		return protoreflect.ValueOfUint64(value)
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.limit_window_blocks":
+		value := x.LimitWindowBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.limit_window_duration":
+		value := x.LimitWindowDuration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimit"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.sender_address":
+		x.SenderAddress = value.Interface().(string)
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.contract_address":
+		x.ContractAddress = value.Interface().(string)
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.wallet_limit":
+		x.WalletLimit = value.Uint()
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.limit_window_blocks":
+		x.LimitWindowBlocks = value.Uint()
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.limit_window_duration":
+		x.LimitWindowDuration = value.Message().Interface().(*durationpb.Duration)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimit"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.limit_window_duration":
+		if x.LimitWindowDuration == nil {
+			x.LimitWindowDuration = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.LimitWindowDuration.ProtoReflect())
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.sender_address":
+		panic(fmt.Errorf("field sender_address of message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit is not mutable"))
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.contract_address":
+		panic(fmt.Errorf("field contract_address of message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit is not mutable"))
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.wallet_limit":
+		panic(fmt.Errorf("field wallet_limit of message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit is not mutable"))
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.limit_window_blocks":
+		panic(fmt.Errorf("field limit_window_blocks of message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimit"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.sender_address":
+		return protoreflect.ValueOfString("")
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.contract_address":
+		return protoreflect.ValueOfString("")
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.wallet_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.limit_window_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.limit_window_duration":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimit"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in juno.feepay.v1.MsgUpdateFeePayContractWalletLimit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractWalletLimit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SenderAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.WalletLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.WalletLimit))
+		}
+		if x.LimitWindowBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.LimitWindowBlocks))
+		}
+		if x.LimitWindowDuration != nil {
+			l = options.Size(x.LimitWindowDuration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractWalletLimit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LimitWindowDuration != nil {
+			encoded, err := options.Marshal(x.LimitWindowDuration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.LimitWindowBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LimitWindowBlocks))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.WalletLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WalletLimit))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SenderAddress) > 0 {
+			i -= len(x.SenderAddress)
+			copy(dAtA[i:], x.SenderAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SenderAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractWalletLimit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SenderAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletLimit", wireType)
+				}
+				x.WalletLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WalletLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LimitWindowBlocks", wireType)
+				}
+				x.LimitWindowBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LimitWindowBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LimitWindowDuration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LimitWindowDuration == nil {
+					x.LimitWindowDuration = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LimitWindowDuration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateFeePayContractWalletLimitResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_juno_feepay_v1_tx_proto_init()
+	md_MsgUpdateFeePayContractWalletLimitResponse = File_juno_feepay_v1_tx_proto.Messages().ByName("MsgUpdateFeePayContractWalletLimitResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateFeePayContractWalletLimitResponse)(nil)
+
+type fastReflection_MsgUpdateFeePayContractWalletLimitResponse MsgUpdateFeePayContractWalletLimitResponse
+
+func (x *MsgUpdateFeePayContractWalletLimitResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateFeePayContractWalletLimitResponse)(x)
+}
+
+func (x *MsgUpdateFeePayContractWalletLimitResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_juno_feepay_v1_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType{}
+
+type fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateFeePayContractWalletLimitResponse)(nil)
+}
+func (x fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateFeePayContractWalletLimitResponse)
+}
+func (x fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateFeePayContractWalletLimitResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateFeePayContractWalletLimitResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateFeePayContractWalletLimitResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateFeePayContractWalletLimitResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateFeePayContractWalletLimitResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractWalletLimitResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractWalletLimitResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
 		x := input.Message.Interface().(*MsgUpdateFeePayContractWalletLimitResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
@@ -3538,10 +4644,2020 @@ func (x *fastReflection_MsgUpdateFeePayContractWalletLimitResponse) ProtoMethods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimitResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimitResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateFeePayContractEligibility                   protoreflect.MessageDescriptor
+	fd_MsgUpdateFeePayContractEligibility_sender_address    protoreflect.FieldDescriptor
+	fd_MsgUpdateFeePayContractEligibility_contract_address  protoreflect.FieldDescriptor
+	fd_MsgUpdateFeePayContractEligibility_eligibility_query protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_juno_feepay_v1_tx_proto_init()
+	md_MsgUpdateFeePayContractEligibility = File_juno_feepay_v1_tx_proto.Messages().ByName("MsgUpdateFeePayContractEligibility")
+	fd_MsgUpdateFeePayContractEligibility_sender_address = md_MsgUpdateFeePayContractEligibility.Fields().ByName("sender_address")
+	fd_MsgUpdateFeePayContractEligibility_contract_address = md_MsgUpdateFeePayContractEligibility.Fields().ByName("contract_address")
+	fd_MsgUpdateFeePayContractEligibility_eligibility_query = md_MsgUpdateFeePayContractEligibility.Fields().ByName("eligibility_query")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateFeePayContractEligibility)(nil)
+
+type fastReflection_MsgUpdateFeePayContractEligibility MsgUpdateFeePayContractEligibility
+
+func (x *MsgUpdateFeePayContractEligibility) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateFeePayContractEligibility)(x)
+}
+
+func (x *MsgUpdateFeePayContractEligibility) slowProtoReflect() protoreflect.Message {
+	mi := &file_juno_feepay_v1_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateFeePayContractEligibility_messageType fastReflection_MsgUpdateFeePayContractEligibility_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateFeePayContractEligibility_messageType{}
+
+type fastReflection_MsgUpdateFeePayContractEligibility_messageType struct{}
+
+func (x fastReflection_MsgUpdateFeePayContractEligibility_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateFeePayContractEligibility)(nil)
+}
+func (x fastReflection_MsgUpdateFeePayContractEligibility_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateFeePayContractEligibility)
+}
+func (x fastReflection_MsgUpdateFeePayContractEligibility_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateFeePayContractEligibility
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateFeePayContractEligibility
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateFeePayContractEligibility_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateFeePayContractEligibility)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateFeePayContractEligibility)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SenderAddress != "" {
+		value := protoreflect.ValueOfString(x.SenderAddress)
+		if !f(fd_MsgUpdateFeePayContractEligibility_sender_address, value) {
+			return
+		}
+	}
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_MsgUpdateFeePayContractEligibility_contract_address, value) {
+			return
+		}
+	}
+	if x.EligibilityQuery != false {
+		value := protoreflect.ValueOfBool(x.EligibilityQuery)
+		if !f(fd_MsgUpdateFeePayContractEligibility_eligibility_query, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.sender_address":
+		return x.SenderAddress != ""
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.contract_address":
+		return x.ContractAddress != ""
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.eligibility_query":
+		return x.EligibilityQuery != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractEligibility"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractEligibility does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.sender_address":
+		x.SenderAddress = ""
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.contract_address":
+		x.ContractAddress = ""
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.eligibility_query":
+		x.EligibilityQuery = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractEligibility"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractEligibility does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.sender_address":
+		value := x.SenderAddress
+		return protoreflect.ValueOfString(value)
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.contract_address":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.eligibility_query":
+		value := x.EligibilityQuery
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractEligibility"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractEligibility does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.sender_address":
+		x.SenderAddress = value.Interface().(string)
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.contract_address":
+		x.ContractAddress = value.Interface().(string)
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.eligibility_query":
+		x.EligibilityQuery = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractEligibility"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractEligibility does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.sender_address":
+		panic(fmt.Errorf("field sender_address of message juno.feepay.v1.MsgUpdateFeePayContractEligibility is not mutable"))
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.contract_address":
+		panic(fmt.Errorf("field contract_address of message juno.feepay.v1.MsgUpdateFeePayContractEligibility is not mutable"))
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.eligibility_query":
+		panic(fmt.Errorf("field eligibility_query of message juno.feepay.v1.MsgUpdateFeePayContractEligibility is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractEligibility"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractEligibility does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.sender_address":
+		return protoreflect.ValueOfString("")
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.contract_address":
+		return protoreflect.ValueOfString("")
+	case "juno.feepay.v1.MsgUpdateFeePayContractEligibility.eligibility_query":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractEligibility"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractEligibility does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in juno.feepay.v1.MsgUpdateFeePayContractEligibility", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateFeePayContractEligibility) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractEligibility)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SenderAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EligibilityQuery {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractEligibility)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EligibilityQuery {
+			i--
+			if x.EligibilityQuery {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SenderAddress) > 0 {
+			i -= len(x.SenderAddress)
+			copy(dAtA[i:], x.SenderAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SenderAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractEligibility)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractEligibility: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractEligibility: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SenderAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EligibilityQuery", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.EligibilityQuery = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateFeePayContractEligibilityResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_juno_feepay_v1_tx_proto_init()
+	md_MsgUpdateFeePayContractEligibilityResponse = File_juno_feepay_v1_tx_proto.Messages().ByName("MsgUpdateFeePayContractEligibilityResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateFeePayContractEligibilityResponse)(nil)
+
+type fastReflection_MsgUpdateFeePayContractEligibilityResponse MsgUpdateFeePayContractEligibilityResponse
+
+func (x *MsgUpdateFeePayContractEligibilityResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateFeePayContractEligibilityResponse)(x)
+}
+
+func (x *MsgUpdateFeePayContractEligibilityResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_juno_feepay_v1_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateFeePayContractEligibilityResponse_messageType fastReflection_MsgUpdateFeePayContractEligibilityResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateFeePayContractEligibilityResponse_messageType{}
+
+type fastReflection_MsgUpdateFeePayContractEligibilityResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateFeePayContractEligibilityResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateFeePayContractEligibilityResponse)(nil)
+}
+func (x fastReflection_MsgUpdateFeePayContractEligibilityResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateFeePayContractEligibilityResponse)
+}
+func (x fastReflection_MsgUpdateFeePayContractEligibilityResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateFeePayContractEligibilityResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateFeePayContractEligibilityResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateFeePayContractEligibilityResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateFeePayContractEligibilityResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateFeePayContractEligibilityResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateFeePayContractEligibilityResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractEligibilityResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractEligibilityResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractEligibilityResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractEligibilityResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractEligibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgUpdateFeePayContractSpendLimit_3_list)(nil)
+
+type _MsgUpdateFeePayContractSpendLimit_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgUpdateFeePayContractSpendLimit_4_list)(nil)
+
+type _MsgUpdateFeePayContractSpendLimit_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgUpdateFeePayContractSpendLimit_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgUpdateFeePayContractSpendLimit                      protoreflect.MessageDescriptor
+	fd_MsgUpdateFeePayContractSpendLimit_sender_address       protoreflect.FieldDescriptor
+	fd_MsgUpdateFeePayContractSpendLimit_contract_address     protoreflect.FieldDescriptor
+	fd_MsgUpdateFeePayContractSpendLimit_wallet_spend_limit   protoreflect.FieldDescriptor
+	fd_MsgUpdateFeePayContractSpendLimit_contract_spend_limit protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_juno_feepay_v1_tx_proto_init()
+	md_MsgUpdateFeePayContractSpendLimit = File_juno_feepay_v1_tx_proto.Messages().ByName("MsgUpdateFeePayContractSpendLimit")
+	fd_MsgUpdateFeePayContractSpendLimit_sender_address = md_MsgUpdateFeePayContractSpendLimit.Fields().ByName("sender_address")
+	fd_MsgUpdateFeePayContractSpendLimit_contract_address = md_MsgUpdateFeePayContractSpendLimit.Fields().ByName("contract_address")
+	fd_MsgUpdateFeePayContractSpendLimit_wallet_spend_limit = md_MsgUpdateFeePayContractSpendLimit.Fields().ByName("wallet_spend_limit")
+	fd_MsgUpdateFeePayContractSpendLimit_contract_spend_limit = md_MsgUpdateFeePayContractSpendLimit.Fields().ByName("contract_spend_limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateFeePayContractSpendLimit)(nil)
+
+type fastReflection_MsgUpdateFeePayContractSpendLimit MsgUpdateFeePayContractSpendLimit
+
+func (x *MsgUpdateFeePayContractSpendLimit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateFeePayContractSpendLimit)(x)
+}
+
+func (x *MsgUpdateFeePayContractSpendLimit) slowProtoReflect() protoreflect.Message {
+	mi := &file_juno_feepay_v1_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateFeePayContractSpendLimit_messageType fastReflection_MsgUpdateFeePayContractSpendLimit_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateFeePayContractSpendLimit_messageType{}
+
+type fastReflection_MsgUpdateFeePayContractSpendLimit_messageType struct{}
+
+func (x fastReflection_MsgUpdateFeePayContractSpendLimit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateFeePayContractSpendLimit)(nil)
+}
+func (x fastReflection_MsgUpdateFeePayContractSpendLimit_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateFeePayContractSpendLimit)
+}
+func (x fastReflection_MsgUpdateFeePayContractSpendLimit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateFeePayContractSpendLimit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateFeePayContractSpendLimit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateFeePayContractSpendLimit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateFeePayContractSpendLimit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateFeePayContractSpendLimit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.SenderAddress != "" {
+		value := protoreflect.ValueOfString(x.SenderAddress)
+		if !f(fd_MsgUpdateFeePayContractSpendLimit_sender_address, value) {
+			return
+		}
+	}
+	if x.ContractAddress != "" {
+		value := protoreflect.ValueOfString(x.ContractAddress)
+		if !f(fd_MsgUpdateFeePayContractSpendLimit_contract_address, value) {
+			return
+		}
+	}
+	if len(x.WalletSpendLimit) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateFeePayContractSpendLimit_3_list{list: &x.WalletSpendLimit})
+		if !f(fd_MsgUpdateFeePayContractSpendLimit_wallet_spend_limit, value) {
+			return
+		}
+	}
+	if len(x.ContractSpendLimit) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateFeePayContractSpendLimit_4_list{list: &x.ContractSpendLimit})
+		if !f(fd_MsgUpdateFeePayContractSpendLimit_contract_spend_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.sender_address":
+		return x.SenderAddress != ""
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.contract_address":
+		return x.ContractAddress != ""
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.wallet_spend_limit":
+		return len(x.WalletSpendLimit) != 0
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.contract_spend_limit":
+		return len(x.ContractSpendLimit) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractSpendLimit"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractSpendLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.sender_address":
+		x.SenderAddress = ""
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.contract_address":
+		x.ContractAddress = ""
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.wallet_spend_limit":
+		x.WalletSpendLimit = nil
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.contract_spend_limit":
+		x.ContractSpendLimit = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractSpendLimit"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractSpendLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.sender_address":
+		value := x.SenderAddress
+		return protoreflect.ValueOfString(value)
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.contract_address":
+		value := x.ContractAddress
+		return protoreflect.ValueOfString(value)
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.wallet_spend_limit":
+		if len(x.WalletSpendLimit) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateFeePayContractSpendLimit_3_list{})
+		}
+		listValue := &_MsgUpdateFeePayContractSpendLimit_3_list{list: &x.WalletSpendLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.contract_spend_limit":
+		if len(x.ContractSpendLimit) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateFeePayContractSpendLimit_4_list{})
+		}
+		listValue := &_MsgUpdateFeePayContractSpendLimit_4_list{list: &x.ContractSpendLimit}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractSpendLimit"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractSpendLimit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.sender_address":
+		x.SenderAddress = value.Interface().(string)
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.contract_address":
+		x.ContractAddress = value.Interface().(string)
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.wallet_spend_limit":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateFeePayContractSpendLimit_3_list)
+		x.WalletSpendLimit = *clv.list
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.contract_spend_limit":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateFeePayContractSpendLimit_4_list)
+		x.ContractSpendLimit = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractSpendLimit"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractSpendLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.wallet_spend_limit":
+		if x.WalletSpendLimit == nil {
+			x.WalletSpendLimit = []*v1beta1.Coin{}
+		}
+		value := &_MsgUpdateFeePayContractSpendLimit_3_list{list: &x.WalletSpendLimit}
+		return protoreflect.ValueOfList(value)
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.contract_spend_limit":
+		if x.ContractSpendLimit == nil {
+			x.ContractSpendLimit = []*v1beta1.Coin{}
+		}
+		value := &_MsgUpdateFeePayContractSpendLimit_4_list{list: &x.ContractSpendLimit}
+		return protoreflect.ValueOfList(value)
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.sender_address":
+		panic(fmt.Errorf("field sender_address of message juno.feepay.v1.MsgUpdateFeePayContractSpendLimit is not mutable"))
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.contract_address":
+		panic(fmt.Errorf("field contract_address of message juno.feepay.v1.MsgUpdateFeePayContractSpendLimit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractSpendLimit"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractSpendLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.sender_address":
+		return protoreflect.ValueOfString("")
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.contract_address":
+		return protoreflect.ValueOfString("")
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.wallet_spend_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgUpdateFeePayContractSpendLimit_3_list{list: &list})
+	case "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.contract_spend_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgUpdateFeePayContractSpendLimit_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractSpendLimit"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractSpendLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in juno.feepay.v1.MsgUpdateFeePayContractSpendLimit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractSpendLimit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.SenderAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ContractAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.WalletSpendLimit) > 0 {
+			for _, e := range x.WalletSpendLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ContractSpendLimit) > 0 {
+			for _, e := range x.ContractSpendLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractSpendLimit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ContractSpendLimit) > 0 {
+			for iNdEx := len(x.ContractSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ContractSpendLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.WalletSpendLimit) > 0 {
+			for iNdEx := len(x.WalletSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.WalletSpendLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.ContractAddress) > 0 {
+			i -= len(x.ContractAddress)
+			copy(dAtA[i:], x.ContractAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.SenderAddress) > 0 {
+			i -= len(x.SenderAddress)
+			copy(dAtA[i:], x.SenderAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SenderAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractSpendLimit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractSpendLimit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SenderAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletSpendLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletSpendLimit = append(x.WalletSpendLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WalletSpendLimit[len(x.WalletSpendLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractSpendLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractSpendLimit = append(x.ContractSpendLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ContractSpendLimit[len(x.ContractSpendLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateFeePayContractSpendLimitResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_juno_feepay_v1_tx_proto_init()
+	md_MsgUpdateFeePayContractSpendLimitResponse = File_juno_feepay_v1_tx_proto.Messages().ByName("MsgUpdateFeePayContractSpendLimitResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateFeePayContractSpendLimitResponse)(nil)
+
+type fastReflection_MsgUpdateFeePayContractSpendLimitResponse MsgUpdateFeePayContractSpendLimitResponse
+
+func (x *MsgUpdateFeePayContractSpendLimitResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateFeePayContractSpendLimitResponse)(x)
+}
+
+func (x *MsgUpdateFeePayContractSpendLimitResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_juno_feepay_v1_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateFeePayContractSpendLimitResponse_messageType fastReflection_MsgUpdateFeePayContractSpendLimitResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateFeePayContractSpendLimitResponse_messageType{}
+
+type fastReflection_MsgUpdateFeePayContractSpendLimitResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateFeePayContractSpendLimitResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateFeePayContractSpendLimitResponse)(nil)
+}
+func (x fastReflection_MsgUpdateFeePayContractSpendLimitResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateFeePayContractSpendLimitResponse)
+}
+func (x fastReflection_MsgUpdateFeePayContractSpendLimitResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateFeePayContractSpendLimitResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateFeePayContractSpendLimitResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateFeePayContractSpendLimitResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateFeePayContractSpendLimitResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateFeePayContractSpendLimitResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse"))
+		}
+		panic(fmt.Errorf("message juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateFeePayContractSpendLimitResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractSpendLimitResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractSpendLimitResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateFeePayContractSpendLimitResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractSpendLimitResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFeePayContractSpendLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -3601,7 +6717,7 @@ func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
-	mi := &file_juno_feepay_v1_tx_proto_msgTypes[8]
+	mi := &file_juno_feepay_v1_tx_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4096,7 +7212,7 @@ func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_juno_feepay_v1_tx_proto_msgTypes[9]
+	mi := &file_juno_feepay_v1_tx_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4453,308 +7569,586 @@ type MsgRegisterFeePayContract struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The wallet address of the sender.
-	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
-	// The fee pay contract to register.
-	FeePayContract *FeePayContract `protobuf:"bytes,2,opt,name=fee_pay_contract,json=feePayContract,proto3" json:"fee_pay_contract,omitempty"`
+	// The wallet address of the sender.
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// The fee pay contract to register.
+	FeePayContract *FeePayContract `protobuf:"bytes,2,opt,name=fee_pay_contract,json=feePayContract,proto3" json:"fee_pay_contract,omitempty"`
+}
+
+func (x *MsgRegisterFeePayContract) Reset() {
+	*x = MsgRegisterFeePayContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRegisterFeePayContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRegisterFeePayContract) ProtoMessage() {}
+
+// Deprecated: Use MsgRegisterFeePayContract.ProtoReflect.Descriptor instead.
+func (*MsgRegisterFeePayContract) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgRegisterFeePayContract) GetSenderAddress() string {
+	if x != nil {
+		return x.SenderAddress
+	}
+	return ""
+}
+
+func (x *MsgRegisterFeePayContract) GetFeePayContract() *FeePayContract {
+	if x != nil {
+		return x.FeePayContract
+	}
+	return nil
+}
+
+// The response message for registering a fee pay contract.
+type MsgRegisterFeePayContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRegisterFeePayContractResponse) Reset() {
+	*x = MsgRegisterFeePayContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRegisterFeePayContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRegisterFeePayContractResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRegisterFeePayContractResponse.ProtoReflect.Descriptor instead.
+func (*MsgRegisterFeePayContractResponse) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+// The message to unregister a fee pay contract.
+type MsgUnregisterFeePayContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The wallet address of the sender.
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// The fee pay contract address.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (x *MsgUnregisterFeePayContract) Reset() {
+	*x = MsgUnregisterFeePayContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUnregisterFeePayContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUnregisterFeePayContract) ProtoMessage() {}
+
+// Deprecated: Use MsgUnregisterFeePayContract.ProtoReflect.Descriptor instead.
+func (*MsgUnregisterFeePayContract) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgUnregisterFeePayContract) GetSenderAddress() string {
+	if x != nil {
+		return x.SenderAddress
+	}
+	return ""
+}
+
+func (x *MsgUnregisterFeePayContract) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+// The response message for unregistering a fee pay contract.
+type MsgUnregisterFeePayContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUnregisterFeePayContractResponse) Reset() {
+	*x = MsgUnregisterFeePayContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUnregisterFeePayContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUnregisterFeePayContractResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUnregisterFeePayContractResponse.ProtoReflect.Descriptor instead.
+func (*MsgUnregisterFeePayContractResponse) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+// The message to fund a fee pay contract
+type MsgFundFeePayContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The wallet address of the sender.
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// The fee pay contract to fund.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The coins to fund the contract with.
+	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MsgFundFeePayContract) Reset() {
+	*x = MsgFundFeePayContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgFundFeePayContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgFundFeePayContract) ProtoMessage() {}
+
+// Deprecated: Use MsgFundFeePayContract.ProtoReflect.Descriptor instead.
+func (*MsgFundFeePayContract) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgFundFeePayContract) GetSenderAddress() string {
+	if x != nil {
+		return x.SenderAddress
+	}
+	return ""
+}
+
+func (x *MsgFundFeePayContract) GetContractAddress() string {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return ""
+}
+
+func (x *MsgFundFeePayContract) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// The response message for funding a fee pay contract.
+type MsgFundFeePayContractResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgFundFeePayContractResponse) Reset() {
+	*x = MsgFundFeePayContractResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgFundFeePayContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgFundFeePayContractResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgFundFeePayContractResponse.ProtoReflect.Descriptor instead.
+func (*MsgFundFeePayContractResponse) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+// The message to withdraw the share of a funder of the unspent funds of a fee
+// pay contract.
+type MsgWithdrawFeePayContractFunds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The wallet address of the funder.
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// The fee pay contract to withdraw from.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func (x *MsgRegisterFeePayContract) Reset() {
-	*x = MsgRegisterFeePayContract{}
+func (x *MsgWithdrawFeePayContractFunds) Reset() {
+	*x = MsgWithdrawFeePayContractFunds{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_juno_feepay_v1_tx_proto_msgTypes[0]
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRegisterFeePayContract) String() string {
+func (x *MsgWithdrawFeePayContractFunds) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRegisterFeePayContract) ProtoMessage() {}
+func (*MsgWithdrawFeePayContractFunds) ProtoMessage() {}
 
-// Deprecated: Use MsgRegisterFeePayContract.ProtoReflect.Descriptor instead.
-func (*MsgRegisterFeePayContract) Descriptor() ([]byte, []int) {
-	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use MsgWithdrawFeePayContractFunds.ProtoReflect.Descriptor instead.
+func (*MsgWithdrawFeePayContractFunds) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *MsgRegisterFeePayContract) GetSenderAddress() string {
+func (x *MsgWithdrawFeePayContractFunds) GetFunderAddress() string {
 	if x != nil {
-		return x.SenderAddress
+		return x.FunderAddress
 	}
 	return ""
 }
 
-func (x *MsgRegisterFeePayContract) GetFeePayContract() *FeePayContract {
+func (x *MsgWithdrawFeePayContractFunds) GetContractAddress() string {
 	if x != nil {
-		return x.FeePayContract
+		return x.ContractAddress
 	}
-	return nil
+	return ""
 }
 
-// The response message for registering a fee pay contract.
-type MsgRegisterFeePayContractResponse struct {
+// The response message for withdrawing the funds of a funder.
+type MsgWithdrawFeePayContractFundsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The unspent funds refunded to the funder.
+	Amount []*v1beta1.Coin `protobuf:"bytes,1,rep,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *MsgRegisterFeePayContractResponse) Reset() {
-	*x = MsgRegisterFeePayContractResponse{}
+func (x *MsgWithdrawFeePayContractFundsResponse) Reset() {
+	*x = MsgWithdrawFeePayContractFundsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_juno_feepay_v1_tx_proto_msgTypes[1]
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRegisterFeePayContractResponse) String() string {
+func (x *MsgWithdrawFeePayContractFundsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRegisterFeePayContractResponse) ProtoMessage() {}
+func (*MsgWithdrawFeePayContractFundsResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgRegisterFeePayContractResponse.ProtoReflect.Descriptor instead.
-func (*MsgRegisterFeePayContractResponse) Descriptor() ([]byte, []int) {
-	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgWithdrawFeePayContractFundsResponse.ProtoReflect.Descriptor instead.
+func (*MsgWithdrawFeePayContractFundsResponse) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{7}
 }
 
-// The message to unregister a fee pay contract.
-type MsgUnregisterFeePayContract struct {
+func (x *MsgWithdrawFeePayContractFundsResponse) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// The message to update a fee pay contract wallet limit.
+type MsgUpdateFeePayContractWalletLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// The wallet address of the sender.
 	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
-	// The fee pay contract address.
+	// The fee pay contract to fund.
 	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The new wallet limit.
+	WalletLimit uint64 `protobuf:"varint,3,opt,name=wallet_limit,json=walletLimit,proto3" json:"wallet_limit,omitempty"`
+	// The new number of blocks in a wallet limit window. Zero means no block
+	// window.
+	LimitWindowBlocks uint64 `protobuf:"varint,4,opt,name=limit_window_blocks,json=limitWindowBlocks,proto3" json:"limit_window_blocks,omitempty"`
+	// The new duration of a wallet limit window. Zero means no time window.
+	LimitWindowDuration *durationpb.Duration `protobuf:"bytes,5,opt,name=limit_window_duration,json=limitWindowDuration,proto3" json:"limit_window_duration,omitempty"`
 }
 
-func (x *MsgUnregisterFeePayContract) Reset() {
-	*x = MsgUnregisterFeePayContract{}
+func (x *MsgUpdateFeePayContractWalletLimit) Reset() {
+	*x = MsgUpdateFeePayContractWalletLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_juno_feepay_v1_tx_proto_msgTypes[2]
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUnregisterFeePayContract) String() string {
+func (x *MsgUpdateFeePayContractWalletLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUnregisterFeePayContract) ProtoMessage() {}
+func (*MsgUpdateFeePayContractWalletLimit) ProtoMessage() {}
 
-// Deprecated: Use MsgUnregisterFeePayContract.ProtoReflect.Descriptor instead.
-func (*MsgUnregisterFeePayContract) Descriptor() ([]byte, []int) {
-	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgUpdateFeePayContractWalletLimit.ProtoReflect.Descriptor instead.
+func (*MsgUpdateFeePayContractWalletLimit) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MsgUnregisterFeePayContract) GetSenderAddress() string {
+func (x *MsgUpdateFeePayContractWalletLimit) GetSenderAddress() string {
 	if x != nil {
 		return x.SenderAddress
 	}
 	return ""
 }
 
-func (x *MsgUnregisterFeePayContract) GetContractAddress() string {
+func (x *MsgUpdateFeePayContractWalletLimit) GetContractAddress() string {
 	if x != nil {
 		return x.ContractAddress
 	}
 	return ""
 }
 
-// The response message for unregistering a fee pay contract.
-type MsgUnregisterFeePayContractResponse struct {
+func (x *MsgUpdateFeePayContractWalletLimit) GetWalletLimit() uint64 {
+	if x != nil {
+		return x.WalletLimit
+	}
+	return 0
+}
+
+func (x *MsgUpdateFeePayContractWalletLimit) GetLimitWindowBlocks() uint64 {
+	if x != nil {
+		return x.LimitWindowBlocks
+	}
+	return 0
+}
+
+func (x *MsgUpdateFeePayContractWalletLimit) GetLimitWindowDuration() *durationpb.Duration {
+	if x != nil {
+		return x.LimitWindowDuration
+	}
+	return nil
+}
+
+// The response message for updating a fee pay contract wallet limit.
+type MsgUpdateFeePayContractWalletLimitResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUnregisterFeePayContractResponse) Reset() {
-	*x = MsgUnregisterFeePayContractResponse{}
+func (x *MsgUpdateFeePayContractWalletLimitResponse) Reset() {
+	*x = MsgUpdateFeePayContractWalletLimitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_juno_feepay_v1_tx_proto_msgTypes[3]
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUnregisterFeePayContractResponse) String() string {
+func (x *MsgUpdateFeePayContractWalletLimitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUnregisterFeePayContractResponse) ProtoMessage() {}
+func (*MsgUpdateFeePayContractWalletLimitResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUnregisterFeePayContractResponse.ProtoReflect.Descriptor instead.
-func (*MsgUnregisterFeePayContractResponse) Descriptor() ([]byte, []int) {
-	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgUpdateFeePayContractWalletLimitResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateFeePayContractWalletLimitResponse) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{9}
 }
 
-// The message to fund a fee pay contract
-type MsgFundFeePayContract struct {
+// The message to update whether a fee pay contract decides which wallets are sponsored.
+type MsgUpdateFeePayContractEligibility struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// The wallet address of the sender.
 	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
-	// The fee pay contract to fund.
+	// The fee pay contract to update.
 	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	// The coins to fund the contract with.
-	Amount []*v1beta1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+	// Whether the contract is queried for the eligibility of wallets.
+	EligibilityQuery bool `protobuf:"varint,3,opt,name=eligibility_query,json=eligibilityQuery,proto3" json:"eligibility_query,omitempty"`
 }
 
-func (x *MsgFundFeePayContract) Reset() {
-	*x = MsgFundFeePayContract{}
+func (x *MsgUpdateFeePayContractEligibility) Reset() {
+	*x = MsgUpdateFeePayContractEligibility{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_juno_feepay_v1_tx_proto_msgTypes[4]
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgFundFeePayContract) String() string {
+func (x *MsgUpdateFeePayContractEligibility) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgFundFeePayContract) ProtoMessage() {}
+func (*MsgUpdateFeePayContractEligibility) ProtoMessage() {}
 
-// Deprecated: Use MsgFundFeePayContract.ProtoReflect.Descriptor instead.
-func (*MsgFundFeePayContract) Descriptor() ([]byte, []int) {
-	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgUpdateFeePayContractEligibility.ProtoReflect.Descriptor instead.
+func (*MsgUpdateFeePayContractEligibility) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MsgFundFeePayContract) GetSenderAddress() string {
+func (x *MsgUpdateFeePayContractEligibility) GetSenderAddress() string {
 	if x != nil {
 		return x.SenderAddress
 	}
 	return ""
 }
 
-func (x *MsgFundFeePayContract) GetContractAddress() string {
+func (x *MsgUpdateFeePayContractEligibility) GetContractAddress() string {
 	if x != nil {
 		return x.ContractAddress
 	}
 	return ""
 }
 
-func (x *MsgFundFeePayContract) GetAmount() []*v1beta1.Coin {
+func (x *MsgUpdateFeePayContractEligibility) GetEligibilityQuery() bool {
 	if x != nil {
-		return x.Amount
+		return x.EligibilityQuery
 	}
-	return nil
+	return false
 }
 
-// The response message for funding a fee pay contract.
-type MsgFundFeePayContractResponse struct {
+// The response message for updating the eligibility of a fee pay contract.
+type MsgUpdateFeePayContractEligibilityResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgFundFeePayContractResponse) Reset() {
-	*x = MsgFundFeePayContractResponse{}
+func (x *MsgUpdateFeePayContractEligibilityResponse) Reset() {
+	*x = MsgUpdateFeePayContractEligibilityResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_juno_feepay_v1_tx_proto_msgTypes[5]
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgFundFeePayContractResponse) String() string {
+func (x *MsgUpdateFeePayContractEligibilityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgFundFeePayContractResponse) ProtoMessage() {}
+func (*MsgUpdateFeePayContractEligibilityResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgFundFeePayContractResponse.ProtoReflect.Descriptor instead.
-func (*MsgFundFeePayContractResponse) Descriptor() ([]byte, []int) {
-	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgUpdateFeePayContractEligibilityResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateFeePayContractEligibilityResponse) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{11}
 }
 
-// The message to update a fee pay contract wallet limit.
-type MsgUpdateFeePayContractWalletLimit struct {
+// The message to update the fee spend limits of a fee pay contract.
+type MsgUpdateFeePayContractSpendLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// The wallet address of the sender.
 	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
-	// The fee pay contract to fund.
+	// The fee pay contract to update.
 	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	// The new wallet limit.
-	WalletLimit uint64 `protobuf:"varint,3,opt,name=wallet_limit,json=walletLimit,proto3" json:"wallet_limit,omitempty"`
+	// The new total fee the contract may sponsor for each wallet. Empty means no
+	// cap.
+	WalletSpendLimit []*v1beta1.Coin `protobuf:"bytes,3,rep,name=wallet_spend_limit,json=walletSpendLimit,proto3" json:"wallet_spend_limit,omitempty"`
+	// The new total fee the contract may sponsor for all wallets. Empty means no
+	// cap.
+	ContractSpendLimit []*v1beta1.Coin `protobuf:"bytes,4,rep,name=contract_spend_limit,json=contractSpendLimit,proto3" json:"contract_spend_limit,omitempty"`
 }
 
-func (x *MsgUpdateFeePayContractWalletLimit) Reset() {
-	*x = MsgUpdateFeePayContractWalletLimit{}
+func (x *MsgUpdateFeePayContractSpendLimit) Reset() {
+	*x = MsgUpdateFeePayContractSpendLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_juno_feepay_v1_tx_proto_msgTypes[6]
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateFeePayContractWalletLimit) String() string {
+func (x *MsgUpdateFeePayContractSpendLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateFeePayContractWalletLimit) ProtoMessage() {}
+func (*MsgUpdateFeePayContractSpendLimit) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateFeePayContractWalletLimit.ProtoReflect.Descriptor instead.
-func (*MsgUpdateFeePayContractWalletLimit) Descriptor() ([]byte, []int) {
-	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgUpdateFeePayContractSpendLimit.ProtoReflect.Descriptor instead.
+func (*MsgUpdateFeePayContractSpendLimit) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MsgUpdateFeePayContractWalletLimit) GetSenderAddress() string {
+func (x *MsgUpdateFeePayContractSpendLimit) GetSenderAddress() string {
 	if x != nil {
 		return x.SenderAddress
 	}
 	return ""
 }
 
-func (x *MsgUpdateFeePayContractWalletLimit) GetContractAddress() string {
+func (x *MsgUpdateFeePayContractSpendLimit) GetContractAddress() string {
 	if x != nil {
 		return x.ContractAddress
 	}
 	return ""
 }
 
-func (x *MsgUpdateFeePayContractWalletLimit) GetWalletLimit() uint64 {
+func (x *MsgUpdateFeePayContractSpendLimit) GetWalletSpendLimit() []*v1beta1.Coin {
 	if x != nil {
-		return x.WalletLimit
+		return x.WalletSpendLimit
 	}
-	return 0
+	return nil
 }
 
-// The response message for updating a fee pay contract wallet limit.
-type MsgUpdateFeePayContractWalletLimitResponse struct {
+func (x *MsgUpdateFeePayContractSpendLimit) GetContractSpendLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.ContractSpendLimit
+	}
+	return nil
+}
+
+// The response message for updating the fee spend limits of a fee pay contract.
+type MsgUpdateFeePayContractSpendLimitResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgUpdateFeePayContractWalletLimitResponse) Reset() {
-	*x = MsgUpdateFeePayContractWalletLimitResponse{}
+func (x *MsgUpdateFeePayContractSpendLimitResponse) Reset() {
+	*x = MsgUpdateFeePayContractSpendLimitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_juno_feepay_v1_tx_proto_msgTypes[7]
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateFeePayContractWalletLimitResponse) String() string {
+func (x *MsgUpdateFeePayContractSpendLimitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateFeePayContractWalletLimitResponse) ProtoMessage() {}
+func (*MsgUpdateFeePayContractSpendLimitResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateFeePayContractWalletLimitResponse.ProtoReflect.Descriptor instead.
-func (*MsgUpdateFeePayContractWalletLimitResponse) Descriptor() ([]byte, []int) {
-	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgUpdateFeePayContractSpendLimitResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateFeePayContractSpendLimitResponse) Descriptor() ([]byte, []int) {
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{13}
 }
 
 // MsgUpdateParams is the Msg/UpdateParams request type.
@@ -4774,7 +8168,7 @@ type MsgUpdateParams struct {
 func (x *MsgUpdateParams) Reset() {
 	*x = MsgUpdateParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_juno_feepay_v1_tx_proto_msgTypes[8]
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4788,7 +8182,7 @@ func (*MsgUpdateParams) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{8}
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *MsgUpdateParams) GetAuthority() string {
@@ -4816,7 +8210,7 @@ type MsgUpdateParamsResponse struct {
 func (x *MsgUpdateParamsResponse) Reset() {
 	*x = MsgUpdateParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_juno_feepay_v1_tx_proto_msgTypes[9]
+		mi := &file_juno_feepay_v1_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4830,7 +8224,7 @@ func (*MsgUpdateParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{9}
+	return file_juno_feepay_v1_tx_proto_rawDescGZIP(), []int{15}
 }
 
 var File_juno_feepay_v1_tx_proto protoreflect.FileDescriptor
@@ -4848,6 +8242,8 @@ var file_juno_feepay_v1_tx_proto_rawDesc = []byte{
 	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
 	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61,
 	0x79, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x1c, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2f, 0x76,
@@ -4911,100 +8307,228 @@ var file_juno_feepay_v1_tx_proto_rawDesc = []byte{
 	0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x1f,
 	0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43,
 	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x9f, 0x02, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65,
-	0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xf4, 0x01, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46,
+	0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x46, 0x75, 0x6e,
+	0x64, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x4c, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0,
+	0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x0e, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x2c, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x78,
+	0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2f, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x26, 0x4d, 0x73, 0x67, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x79, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7,
+	0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa8, 0x03, 0x0a,
+	0x22, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
 	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2e, 0x0a, 0x13,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x57, 0x0a, 0x15,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01,
+	0x52, 0x13, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x50, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82,
+	0xe7, 0xb0, 0x2a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x30, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x78, 0x2f, 0x66, 0x65,
+	0x65, 0x70, 0x61, 0x79, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65,
+	0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x2c, 0x0a, 0x2a, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa9, 0x02, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0e,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a,
+	0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65,
+	0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x3a,
 	0x50, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x0e, 0x73, 0x65,
 	0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a,
 	0x30, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x78, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2f, 0x4d,
 	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x22, 0x2c, 0x0a, 0x2a, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65,
-	0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x57, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xbe, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x06, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x75,
-	0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x38, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00,
-	0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7,
-	0xb0, 0x2a, 0x1d, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x78, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79,
-	0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xfc, 0x05, 0x0a, 0x03,
-	0x4d, 0x73, 0x67, 0x12, 0x9b, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x29,
-	0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x65, 0x65, 0x50, 0x61,
-	0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x31, 0x2e, 0x6a, 0x75, 0x6e, 0x6f,
-	0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1d, 0x22, 0x1b, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70,
-	0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x12, 0xa3, 0x01, 0x0a, 0x18, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x2b,
-	0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x65, 0x65,
-	0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x33, 0x2e, 0x6a, 0x75,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x22, 0x2c, 0x0a, 0x2a, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65,
+	0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6c, 0x69, 0x67,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xa2, 0x04, 0x0a, 0x21, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65,
+	0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x12,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x93, 0x01,
+	0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x12, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x3a, 0x4f, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x82, 0xe7, 0xb0,
+	0x2a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x78, 0x2f, 0x66, 0x65, 0x65, 0x70,
+	0x61, 0x79, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50,
+	0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x2b, 0x0a, 0x29, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xbe, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x38, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0,
+	0x1f, 0x00, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x1d, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x78, 0x2f, 0x66, 0x65, 0x65, 0x70,
+	0x61, 0x79, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xac, 0x0a,
+	0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x9b, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x12, 0x29, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x65, 0x65,
+	0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x31, 0x2e, 0x6a, 0x75,
 	0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1d, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f,
-	0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2f, 0x75, 0x6e, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x8b, 0x01, 0x0a, 0x12, 0x46, 0x75, 0x6e, 0x64,
-	0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x25,
-	0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x73, 0x67, 0x46, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x2d, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65,
-	0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6e, 0x64, 0x46, 0x65,
-	0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x17, 0x2f, 0x6a,
-	0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78,
-	0x2f, 0x66, 0x75, 0x6e, 0x64, 0x12, 0xc1, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x32, 0x2e, 0x6a, 0x75, 0x6e, 0x6f,
-	0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x3a, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x1b, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65,
+	0x65, 0x70, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0xa3, 0x01, 0x0a, 0x18, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x12, 0x2b, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46,
+	0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x33, 0x2e,
 	0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x28, 0x22, 0x26, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2f,
-	0x76, 0x31, 0x2f, 0x74, 0x78, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x58, 0x0a, 0x0c, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x6a, 0x75, 0x6e, 0x6f,
-	0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x27, 0x2e, 0x6a, 0x75, 0x6e,
-	0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xa1, 0x01, 0x0a, 0x12, 0x63,
-	0x6f, 0x6d, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76,
-	0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6a,
-	0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65,
-	0x65, 0x70, 0x61, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a, 0x46, 0x58, 0xaa, 0x02, 0x0e, 0x4a,
-	0x75, 0x6e, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e,
-	0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x1a, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x4a, 0x75,
-	0x6e, 0x6f, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x67, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x65, 0x65, 0x50,
+	0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1d, 0x2f, 0x6a, 0x75, 0x6e,
+	0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2f, 0x75,
+	0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x8b, 0x01, 0x0a, 0x12, 0x46, 0x75,
+	0x6e, 0x64, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x12, 0x25, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x1a, 0x2d, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66,
+	0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x75, 0x6e, 0x64,
+	0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x17,
+	0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f,
+	0x74, 0x78, 0x2f, 0x66, 0x75, 0x6e, 0x64, 0x12, 0xaa, 0x01, 0x0a, 0x1b, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66,
+	0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x1a, 0x36, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66,
+	0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x1b, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66,
+	0x65, 0x65, 0x70, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2f, 0x77, 0x69, 0x74, 0x68,
+	0x64, 0x72, 0x61, 0x77, 0x12, 0xc1, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x32, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e,
+	0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x3a, 0x2e, 0x6a,
+	0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28,
+	0x22, 0x26, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2f, 0x76,
+	0x31, 0x2f, 0x74, 0x78, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0xc0, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x32, 0x2e, 0x6a,
+	0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x1a, 0x3a, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61,
+	0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x27, 0x22, 0x25, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70,
+	0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0xbd, 0x01, 0x0a, 0x1e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x31,
+	0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x79, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x1a, 0x39, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x50,
+	0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x27, 0x22, 0x25, 0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70,
+	0x61, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x78, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x58, 0x0a, 0x0c, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x6a, 0x75,
+	0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x27, 0x2e, 0x6a,
+	0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xa1, 0x01, 0x0a,
+	0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x6a, 0x75, 0x6e, 0x6f, 0x2e, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6a, 0x75, 0x6e, 0x6f, 0x2f, 0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2f, 0x76, 0x31, 0x3b,
+	0x66, 0x65, 0x65, 0x70, 0x61, 0x79, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a, 0x46, 0x58, 0xaa, 0x02,
+	0x0e, 0x4a, 0x75, 0x6e, 0x6f, 0x2e, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x0e, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x1a, 0x4a, 0x75, 0x6e, 0x6f, 0x5c, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10,
+	0x4a, 0x75, 0x6e, 0x6f, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x70, 0x61, 0x79, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5019,7 +8543,7 @@ func file_juno_feepay_v1_tx_proto_rawDescGZIP() []byte {
 	return file_juno_feepay_v1_tx_proto_rawDescData
 }
 
-var file_juno_feepay_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_juno_feepay_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_juno_feepay_v1_tx_proto_goTypes = []interface{}{
 	(*MsgRegisterFeePayContract)(nil),                  // 0: juno.feepay.v1.MsgRegisterFeePayContract
 	(*MsgRegisterFeePayContractResponse)(nil),          // 1: juno.feepay.v1.MsgRegisterFeePayContractResponse
@@ -5027,33 +8551,50 @@ var file_juno_feepay_v1_tx_proto_goTypes = []interface{}{
 	(*MsgUnregisterFeePayContractResponse)(nil),        // 3: juno.feepay.v1.MsgUnregisterFeePayContractResponse
 	(*MsgFundFeePayContract)(nil),                      // 4: juno.feepay.v1.MsgFundFeePayContract
 	(*MsgFundFeePayContractResponse)(nil),              // 5: juno.feepay.v1.MsgFundFeePayContractResponse
-	(*MsgUpdateFeePayContractWalletLimit)(nil),         // 6: juno.feepay.v1.MsgUpdateFeePayContractWalletLimit
-	(*MsgUpdateFeePayContractWalletLimitResponse)(nil), // 7: juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse
-	(*MsgUpdateParams)(nil),                            // 8: juno.feepay.v1.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),                    // 9: juno.feepay.v1.MsgUpdateParamsResponse
-	(*FeePayContract)(nil),                             // 10: juno.feepay.v1.FeePayContract
-	(*v1beta1.Coin)(nil),                               // 11: cosmos.base.v1beta1.Coin
-	(*Params)(nil),                                     // 12: juno.feepay.v1.Params
+	(*MsgWithdrawFeePayContractFunds)(nil),             // 6: juno.feepay.v1.MsgWithdrawFeePayContractFunds
+	(*MsgWithdrawFeePayContractFundsResponse)(nil),     // 7: juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse
+	(*MsgUpdateFeePayContractWalletLimit)(nil),         // 8: juno.feepay.v1.MsgUpdateFeePayContractWalletLimit
+	(*MsgUpdateFeePayContractWalletLimitResponse)(nil), // 9: juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse
+	(*MsgUpdateFeePayContractEligibility)(nil),         // 10: juno.feepay.v1.MsgUpdateFeePayContractEligibility
+	(*MsgUpdateFeePayContractEligibilityResponse)(nil), // 11: juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse
+	(*MsgUpdateFeePayContractSpendLimit)(nil),          // 12: juno.feepay.v1.MsgUpdateFeePayContractSpendLimit
+	(*MsgUpdateFeePayContractSpendLimitResponse)(nil),  // 13: juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse
+	(*MsgUpdateParams)(nil),                            // 14: juno.feepay.v1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),                    // 15: juno.feepay.v1.MsgUpdateParamsResponse
+	(*FeePayContract)(nil),                             // 16: juno.feepay.v1.FeePayContract
+	(*v1beta1.Coin)(nil),                               // 17: cosmos.base.v1beta1.Coin
+	(*durationpb.Duration)(nil),                        // 18: google.protobuf.Duration
+	(*Params)(nil),                                     // 19: juno.feepay.v1.Params
 }
 var file_juno_feepay_v1_tx_proto_depIdxs = []int32{
-	10, // 0: juno.feepay.v1.MsgRegisterFeePayContract.fee_pay_contract:type_name -> juno.feepay.v1.FeePayContract
-	11, // 1: juno.feepay.v1.MsgFundFeePayContract.amount:type_name -> cosmos.base.v1beta1.Coin
-	12, // 2: juno.feepay.v1.MsgUpdateParams.params:type_name -> juno.feepay.v1.Params
-	0,  // 3: juno.feepay.v1.Msg.RegisterFeePayContract:input_type -> juno.feepay.v1.MsgRegisterFeePayContract
-	2,  // 4: juno.feepay.v1.Msg.UnregisterFeePayContract:input_type -> juno.feepay.v1.MsgUnregisterFeePayContract
-	4,  // 5: juno.feepay.v1.Msg.FundFeePayContract:input_type -> juno.feepay.v1.MsgFundFeePayContract
-	6,  // 6: juno.feepay.v1.Msg.UpdateFeePayContractWalletLimit:input_type -> juno.feepay.v1.MsgUpdateFeePayContractWalletLimit
-	8,  // 7: juno.feepay.v1.Msg.UpdateParams:input_type -> juno.feepay.v1.MsgUpdateParams
-	1,  // 8: juno.feepay.v1.Msg.RegisterFeePayContract:output_type -> juno.feepay.v1.MsgRegisterFeePayContractResponse
-	3,  // 9: juno.feepay.v1.Msg.UnregisterFeePayContract:output_type -> juno.feepay.v1.MsgUnregisterFeePayContractResponse
-	5,  // 10: juno.feepay.v1.Msg.FundFeePayContract:output_type -> juno.feepay.v1.MsgFundFeePayContractResponse
-	7,  // 11: juno.feepay.v1.Msg.UpdateFeePayContractWalletLimit:output_type -> juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse
-	9,  // 12: juno.feepay.v1.Msg.UpdateParams:output_type -> juno.feepay.v1.MsgUpdateParamsResponse
-	8,  // [8:13] is the sub-list for method output_type
-	3,  // [3:8] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	16, // 0: juno.feepay.v1.MsgRegisterFeePayContract.fee_pay_contract:type_name -> juno.feepay.v1.FeePayContract
+	17, // 1: juno.feepay.v1.MsgFundFeePayContract.amount:type_name -> cosmos.base.v1beta1.Coin
+	17, // 2: juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse.amount:type_name -> cosmos.base.v1beta1.Coin
+	18, // 3: juno.feepay.v1.MsgUpdateFeePayContractWalletLimit.limit_window_duration:type_name -> google.protobuf.Duration
+	17, // 4: juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.wallet_spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	17, // 5: juno.feepay.v1.MsgUpdateFeePayContractSpendLimit.contract_spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	19, // 6: juno.feepay.v1.MsgUpdateParams.params:type_name -> juno.feepay.v1.Params
+	0,  // 7: juno.feepay.v1.Msg.RegisterFeePayContract:input_type -> juno.feepay.v1.MsgRegisterFeePayContract
+	2,  // 8: juno.feepay.v1.Msg.UnregisterFeePayContract:input_type -> juno.feepay.v1.MsgUnregisterFeePayContract
+	4,  // 9: juno.feepay.v1.Msg.FundFeePayContract:input_type -> juno.feepay.v1.MsgFundFeePayContract
+	6,  // 10: juno.feepay.v1.Msg.WithdrawFeePayContractFunds:input_type -> juno.feepay.v1.MsgWithdrawFeePayContractFunds
+	8,  // 11: juno.feepay.v1.Msg.UpdateFeePayContractWalletLimit:input_type -> juno.feepay.v1.MsgUpdateFeePayContractWalletLimit
+	10, // 12: juno.feepay.v1.Msg.UpdateFeePayContractEligibility:input_type -> juno.feepay.v1.MsgUpdateFeePayContractEligibility
+	12, // 13: juno.feepay.v1.Msg.UpdateFeePayContractSpendLimit:input_type -> juno.feepay.v1.MsgUpdateFeePayContractSpendLimit
+	14, // 14: juno.feepay.v1.Msg.UpdateParams:input_type -> juno.feepay.v1.MsgUpdateParams
+	1,  // 15: juno.feepay.v1.Msg.RegisterFeePayContract:output_type -> juno.feepay.v1.MsgRegisterFeePayContractResponse
+	3,  // 16: juno.feepay.v1.Msg.UnregisterFeePayContract:output_type -> juno.feepay.v1.MsgUnregisterFeePayContractResponse
+	5,  // 17: juno.feepay.v1.Msg.FundFeePayContract:output_type -> juno.feepay.v1.MsgFundFeePayContractResponse
+	7,  // 18: juno.feepay.v1.Msg.WithdrawFeePayContractFunds:output_type -> juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse
+	9,  // 19: juno.feepay.v1.Msg.UpdateFeePayContractWalletLimit:output_type -> juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse
+	11, // 20: juno.feepay.v1.Msg.UpdateFeePayContractEligibility:output_type -> juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse
+	13, // 21: juno.feepay.v1.Msg.UpdateFeePayContractSpendLimit:output_type -> juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse
+	15, // 22: juno.feepay.v1.Msg.UpdateParams:output_type -> juno.feepay.v1.MsgUpdateParamsResponse
+	15, // [15:23] is the sub-list for method output_type
+	7,  // [7:15] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_juno_feepay_v1_tx_proto_init() }
@@ -5137,7 +8678,7 @@ func file_juno_feepay_v1_tx_proto_init() {
 			}
 		}
 		file_juno_feepay_v1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateFeePayContractWalletLimit); i {
+			switch v := v.(*MsgWithdrawFeePayContractFunds); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5149,7 +8690,7 @@ func file_juno_feepay_v1_tx_proto_init() {
 			}
 		}
 		file_juno_feepay_v1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateFeePayContractWalletLimitResponse); i {
+			switch v := v.(*MsgWithdrawFeePayContractFundsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5161,7 +8702,7 @@ func file_juno_feepay_v1_tx_proto_init() {
 			}
 		}
 		file_juno_feepay_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateParams); i {
+			switch v := v.(*MsgUpdateFeePayContractWalletLimit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5173,6 +8714,78 @@ func file_juno_feepay_v1_tx_proto_init() {
 			}
 		}
 		file_juno_feepay_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateFeePayContractWalletLimitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_juno_feepay_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateFeePayContractEligibility); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_juno_feepay_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateFeePayContractEligibilityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_juno_feepay_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateFeePayContractSpendLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_juno_feepay_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateFeePayContractSpendLimitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_juno_feepay_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_juno_feepay_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgUpdateParamsResponse); i {
 			case 0:
 				return &v.state
@@ -5191,7 +8804,7 @@ func file_juno_feepay_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_juno_feepay_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,7 +22,10 @@ const (
 	Msg_RegisterFeePayContract_FullMethodName          = "/juno.feepay.v1.Msg/RegisterFeePayContract"
 	Msg_UnregisterFeePayContract_FullMethodName        = "/juno.feepay.v1.Msg/UnregisterFeePayContract"
 	Msg_FundFeePayContract_FullMethodName              = "/juno.feepay.v1.Msg/FundFeePayContract"
+	Msg_WithdrawFeePayContractFunds_FullMethodName     = "/juno.feepay.v1.Msg/WithdrawFeePayContractFunds"
 	Msg_UpdateFeePayContractWalletLimit_FullMethodName = "/juno.feepay.v1.Msg/UpdateFeePayContractWalletLimit"
+	Msg_UpdateFeePayContractEligibility_FullMethodName = "/juno.feepay.v1.Msg/UpdateFeePayContractEligibility"
+	Msg_UpdateFeePayContractSpendLimit_FullMethodName  = "/juno.feepay.v1.Msg/UpdateFeePayContractSpendLimit"
 	Msg_UpdateParams_FullMethodName                    = "/juno.feepay.v1.Msg/UpdateParams"
 )
 
@@ -38,8 +41,14 @@ type MsgClient interface {
 	UnregisterFeePayContract(ctx context.Context, in *MsgUnregisterFeePayContract, opts ...grpc.CallOption) (*MsgUnregisterFeePayContractResponse, error)
 	// Fund a fee pay contract
 	FundFeePayContract(ctx context.Context, in *MsgFundFeePayContract, opts ...grpc.CallOption) (*MsgFundFeePayContractResponse, error)
+	// Withdraw the share of a funder of the unspent funds of a fee pay contract
+	WithdrawFeePayContractFunds(ctx context.Context, in *MsgWithdrawFeePayContractFunds, opts ...grpc.CallOption) (*MsgWithdrawFeePayContractFundsResponse, error)
 	// Update a fee pay contract wallet limit
 	UpdateFeePayContractWalletLimit(ctx context.Context, in *MsgUpdateFeePayContractWalletLimit, opts ...grpc.CallOption) (*MsgUpdateFeePayContractWalletLimitResponse, error)
+	// Update whether a fee pay contract decides which wallets are sponsored
+	UpdateFeePayContractEligibility(ctx context.Context, in *MsgUpdateFeePayContractEligibility, opts ...grpc.CallOption) (*MsgUpdateFeePayContractEligibilityResponse, error)
+	// Update the fee spend limits of a fee pay contract
+	UpdateFeePayContractSpendLimit(ctx context.Context, in *MsgUpdateFeePayContractSpendLimit, opts ...grpc.CallOption) (*MsgUpdateFeePayContractSpendLimitResponse, error)
 	// Update the params of the module through gov v1 type.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -82,6 +91,16 @@ func (c *msgClient) FundFeePayContract(ctx context.Context, in *MsgFundFeePayCon
 	return out, nil
 }
 
+func (c *msgClient) WithdrawFeePayContractFunds(ctx context.Context, in *MsgWithdrawFeePayContractFunds, opts ...grpc.CallOption) (*MsgWithdrawFeePayContractFundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgWithdrawFeePayContractFundsResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawFeePayContractFunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateFeePayContractWalletLimit(ctx context.Context, in *MsgUpdateFeePayContractWalletLimit, opts ...grpc.CallOption) (*MsgUpdateFeePayContractWalletLimitResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateFeePayContractWalletLimitResponse)
@@ -92,6 +111,26 @@ func (c *msgClient) UpdateFeePayContractWalletLimit(ctx context.Context, in *Msg
 	return out, nil
 }
 
+func (c *msgClient) UpdateFeePayContractEligibility(ctx context.Context, in *MsgUpdateFeePayContractEligibility, opts ...grpc.CallOption) (*MsgUpdateFeePayContractEligibilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateFeePayContractEligibilityResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateFeePayContractEligibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeePayContractSpendLimit(ctx context.Context, in *MsgUpdateFeePayContractSpendLimit, opts ...grpc.CallOption) (*MsgUpdateFeePayContractSpendLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateFeePayContractSpendLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateFeePayContractSpendLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateParamsResponse)
@@ -114,8 +153,14 @@ type MsgServer interface {
 	UnregisterFeePayContract(context.Context, *MsgUnregisterFeePayContract) (*MsgUnregisterFeePayContractResponse, error)
 	// Fund a fee pay contract
 	FundFeePayContract(context.Context, *MsgFundFeePayContract) (*MsgFundFeePayContractResponse, error)
+	// Withdraw the share of a funder of the unspent funds of a fee pay contract
+	WithdrawFeePayContractFunds(context.Context, *MsgWithdrawFeePayContractFunds) (*MsgWithdrawFeePayContractFundsResponse, error)
 	// Update a fee pay contract wallet limit
 	UpdateFeePayContractWalletLimit(context.Context, *MsgUpdateFeePayContractWalletLimit) (*MsgUpdateFeePayContractWalletLimitResponse, error)
+	// Update whether a fee pay contract decides which wallets are sponsored
+	UpdateFeePayContractEligibility(context.Context, *MsgUpdateFeePayContractEligibility) (*MsgUpdateFeePayContractEligibilityResponse, error)
+	// Update the fee spend limits of a fee pay contract
+	UpdateFeePayContractSpendLimit(context.Context, *MsgUpdateFeePayContractSpendLimit) (*MsgUpdateFeePayContractSpendLimitResponse, error)
 	// Update the params of the module through gov v1 type.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
@@ -137,9 +182,18 @@ func (UnimplementedMsgServer) UnregisterFeePayContract(context.Context, *MsgUnre
 func (UnimplementedMsgServer) FundFeePayContract(context.Context, *MsgFundFeePayContract) (*MsgFundFeePayContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundFeePayContract not implemented")
 }
+func (UnimplementedMsgServer) WithdrawFeePayContractFunds(context.Context, *MsgWithdrawFeePayContractFunds) (*MsgWithdrawFeePayContractFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFeePayContractFunds not implemented")
+}
 func (UnimplementedMsgServer) UpdateFeePayContractWalletLimit(context.Context, *MsgUpdateFeePayContractWalletLimit) (*MsgUpdateFeePayContractWalletLimitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeePayContractWalletLimit not implemented")
 }
+func (UnimplementedMsgServer) UpdateFeePayContractEligibility(context.Context, *MsgUpdateFeePayContractEligibility) (*MsgUpdateFeePayContractEligibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeePayContractEligibility not implemented")
+}
+func (UnimplementedMsgServer) UpdateFeePayContractSpendLimit(context.Context, *MsgUpdateFeePayContractSpendLimit) (*MsgUpdateFeePayContractSpendLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeePayContractSpendLimit not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -218,6 +272,24 @@ func _Msg_FundFeePayContract_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawFeePayContractFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFeePayContractFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFeePayContractFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawFeePayContractFunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFeePayContractFunds(ctx, req.(*MsgWithdrawFeePayContractFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateFeePayContractWalletLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateFeePayContractWalletLimit)
 	if err := dec(in); err != nil {
@@ -236,6 +308,42 @@ func _Msg_UpdateFeePayContractWalletLimit_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateFeePayContractEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeePayContractEligibility)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeePayContractEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFeePayContractEligibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeePayContractEligibility(ctx, req.(*MsgUpdateFeePayContractEligibility))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeePayContractSpendLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeePayContractSpendLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeePayContractSpendLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFeePayContractSpendLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeePayContractSpendLimit(ctx, req.(*MsgUpdateFeePayContractSpendLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -273,10 +381,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FundFeePayContract",
 			Handler:    _Msg_FundFeePayContract_Handler,
 		},
+		{
+			MethodName: "WithdrawFeePayContractFunds",
+			Handler:    _Msg_WithdrawFeePayContractFunds_Handler,
+		},
 		{
 			MethodName: "UpdateFeePayContractWalletLimit",
 			Handler:    _Msg_UpdateFeePayContractWalletLimit_Handler,
 		},
+		{
+			MethodName: "UpdateFeePayContractEligibility",
+			Handler:    _Msg_UpdateFeePayContractEligibility_Handler,
+		},
+		{
+			MethodName: "UpdateFeePayContractSpendLimit",
+			Handler:    _Msg_UpdateFeePayContractSpendLimit_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -37,7 +37,6 @@ type HandlerOptions struct {
 
 	// cosmos sdk
 	StakingKeeper stakingkeeper.Keeper
-	BondDenom     string
 	BankKeeper    bankkeeper.Keeper
 
 	// ibc
@@ -86,7 +85,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	// Define FeePay and Global Fee decorators. These decorators are called in different orders based on the type of
 	// transaction. The FeePay decorator is called first for FeePay transactions, and the GlobalFee decorator is called
 	// first for all other transactions. See the FeeRouteDecorator for more details.
-	fpd := feepayante.NewDeductFeeDecorator(options.FeePayKeeper, options.GlobalFeeKeeper, options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.BondDenom, &isFeePayTx)
+	fpd := feepayante.NewDeductFeeDecorator(options.FeePayKeeper, options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, &isFeePayTx)
 	gfd := globalfeeante.NewFeeDecorator(options.BypassMinFeeMsgTypes, options.GlobalFeeKeeper, options.StakingKeeper, maxBypassMinFeeMsgGasUsage, &isFeePayTx)
 
 	anteDecorators := []sdk.AnteDecorator{
@@ -268,7 +268,6 @@ func New(
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
 			StakingKeeper: *app.AppKeepers.StakingKeeper,
-			BondDenom:     app.GetChainBondDenom(),
 			BankKeeper:    app.AppKeepers.BankKeeper,
 
 			IBCKeeper: app.AppKeepers.IBCKeeper,
@@ -418,7 +417,10 @@ func (app *App) Name() string {
 }
 
 // PreBlocker application updates every pre block
-func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	// Record the number of transactions in the block for the clock contracts
+	app.AppKeepers.ClockKeeper.SetBlockTxCount(ctx, uint64(len(req.Txs)))
+
 	return app.ModuleManager.PreBlock(ctx)
 }
 
@@ -26,6 +26,24 @@ func ExecuteContract(k wasmtypes.ContractOpsKeeper, childCtx sdk.Context, contra
 	_, *err = k.Sudo(childCtx, contractAddr, msgBz)
 }
 
+// Query contract, recover from panic
+func QueryContract(k wasmtypes.ViewKeeper, childCtx sdk.Context, contractAddr sdk.AccAddress, reqBz []byte, res *[]byte, err *error) {
+	// Recover from panic, return error
+	defer func() {
+		if recoveryError := recover(); recoveryError != nil {
+			// Determine error associated with panic
+			if isOutofGas, msg := IsOutOfGasError(recoveryError); isOutofGas {
+				*err = ErrOutOfGas.Wrapf("%s", msg)
+			} else {
+				*err = ErrContractExecutionPanic.Wrapf("%s", recoveryError)
+			}
+		}
+	}()
+
+	// Query contract with a smart query
+	*res, *err = k.QuerySmart(childCtx, contractAddr, reqBz)
+}
+
 // Check if error is out of gas error
 func IsOutOfGasError(err any) (bool, string) {
 	switch e := err.(type) {
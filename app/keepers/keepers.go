@@ -129,6 +129,7 @@ var maccPerms = map[string][]string{
 	tokenfactorytypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
 	globalfeetypes.ModuleName:      nil,
 	feepaytypes.ModuleName:         nil,
+	clocktypes.ModuleName:          nil,
 	junoburn.ModuleName:            {authtypes.Burner},
 }
 
@@ -300,7 +301,8 @@ func NewAppKeepers(
 		appCodec,
 		cdc,
 		runtime.NewKVStoreService(appKeepers.keys[slashingtypes.StoreKey]),
-		stakingKeeper,
+		// x/cw-hooks is created later, so the wrapper holds a pointer to its keeper
+		cwhookskeeper.NewSlashingStakingKeeper(stakingKeeper, &appKeepers.CWHooksKeeper),
 		govModAddress,
 	)
 
@@ -483,7 +485,7 @@ func NewAppKeepers(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[evidencetypes.StoreKey]),
 		stakingKeeper,
-		appKeepers.SlashingKeeper,
+		cwhookskeeper.NewEvidenceSlashingKeeper(appKeepers.SlashingKeeper, &appKeepers.CWHooksKeeper),
 		ac,
 		runtime.ProvideCometInfoService(),
 	)
@@ -508,7 +510,7 @@ func NewAppKeepers(
 	}
 
 	// Move custom query of token factory to stargate, still use custom msg which is tfOpts[1]
-	tfOpts := bindings.RegisterCustomPlugins(appKeepers.BankKeeper, &appKeepers.TokenFactoryKeeper)
+	tfOpts := bindings.RegisterCustomPlugins(appKeepers.BankKeeper, &appKeepers.TokenFactoryKeeper, &appKeepers.ClockKeeper, &appKeepers.FeePayKeeper)
 	wasmOpts = append(wasmOpts, tfOpts...)
 
 	// Stargate Queries
@@ -560,12 +562,19 @@ func NewAppKeepers(
 		wasmOpts...,
 	)
 
+	appKeepers.GlobalFeeKeeper = globalfeekeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[globalfeetypes.StoreKey]),
+		govModAddress,
+	)
+
 	appKeepers.FeePayKeeper = feepaykeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[feepaytypes.StoreKey]),
 		appKeepers.BankKeeper,
 		appKeepers.WasmKeeper,
 		appKeepers.AccountKeeper,
+		appKeepers.GlobalFeeKeeper,
 		bondDenom,
 		govModAddress,
 	)
@@ -584,12 +593,6 @@ func NewAppKeepers(
 		govModAddress,
 	)
 
-	appKeepers.GlobalFeeKeeper = globalfeekeeper.NewKeeper(
-		appCodec,
-		runtime.NewKVStoreService(appKeepers.keys[globalfeetypes.StoreKey]),
-		govModAddress,
-	)
-
 	appKeepers.DripKeeper = dripkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[driptypes.StoreKey]),
@@ -601,8 +604,12 @@ func NewAppKeepers(
 	appKeepers.ClockKeeper = clockkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[clocktypes.StoreKey]),
+		runtime.NewTransientStoreService(appKeepers.tkeys[clocktypes.TStoreKey]),
+		appKeepers.BankKeeper,
 		appKeepers.WasmKeeper,
 		appKeepers.ContractKeeper,
+		bondDenom,
+		authtypes.FeeCollectorName,
 		govModAddress,
 	)
 
@@ -613,15 +620,19 @@ func NewAppKeepers(
 		*govKeeper.Keeper,
 		appKeepers.WasmKeeper,
 		appKeepers.ContractKeeper,
+		appKeepers.TokenFactoryKeeper,
 		govModAddress,
 	)
 
+	// calls the x/cw-hooks bank hooks of token factory denoms on every send
+	appKeepers.BankKeeper.AppendSendRestriction(appKeepers.CWHooksKeeper.BankSendRestriction)
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	// this must be at the end so CWHooksKeeper can use the contractKeeper
 	stakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(
-			appKeepers.DistrKeeper.Hooks(),
+			appKeepers.CWHooksKeeper.DistributionStakingHooks(appKeepers.DistrKeeper.Hooks(), appKeepers.DistrKeeper),
 			appKeepers.SlashingKeeper.Hooks(),
 			appKeepers.CWHooksKeeper.StakingHooks(),
 		),
@@ -83,7 +83,7 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 		cwhookstypes.StoreKey,
 	)
 
-	appKeepers.tkeys = storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
+	appKeepers.tkeys = storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, clocktypes.TStoreKey)
 	appKeepers.memKeys = storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 }
 
@@ -0,0 +1,134 @@
+package bindings
+
+import (
+	"context"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v29/wasmbindings/types"
+	clockkeeper "github.com/CosmosContracts/juno/v29/x/clock/keeper"
+	clocktypes "github.com/CosmosContracts/juno/v29/x/clock/types"
+)
+
+// clockPhases maps the phases accepted from contracts to the clock module phases.
+var clockPhases = map[string]clocktypes.ClockPhase{
+	"":                    clocktypes.ClockPhaseUnspecified,
+	"end_block":           clocktypes.ClockPhaseEndBlock,
+	"begin_block":         clocktypes.ClockPhaseBeginBlock,
+	"begin_and_end_block": clocktypes.ClockPhaseBeginAndEndBlock,
+}
+
+// registerClockContract registers the contract with the clock module.
+func (m *CustomMessenger) registerClockContract(ctx context.Context, contractAddr sdk.AccAddress, register *types.RegisterClockContract) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	err := PerformRegisterClockContract(ctx, m.clock, contractAddr, register)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform register clock contract")
+	}
+	return nil, nil, nil, nil
+}
+
+// PerformRegisterClockContract registers the contract with the clock module, with the
+// contract as both the sender and the registered contract.
+func PerformRegisterClockContract(ctx context.Context, c *clockkeeper.Keeper, contractAddr sdk.AccAddress, register *types.RegisterClockContract) error {
+	if register == nil {
+		return wasmvmtypes.InvalidRequest{Err: "register clock contract null"}
+	}
+
+	phase, ok := clockPhases[register.Phase]
+	if !ok {
+		return wasmvmtypes.InvalidRequest{Err: "unknown clock phase: " + register.Phase}
+	}
+
+	sdkMsg := &clocktypes.MsgRegisterClockContract{
+		SenderAddress:   contractAddr.String(),
+		ContractAddress: contractAddr.String(),
+		BlockInterval:   register.BlockInterval,
+		TimeInterval:    time.Duration(register.TimeIntervalSeconds) * time.Second,
+		Phase:           phase,
+		BlockContext:    register.BlockContext,
+	}
+
+	// Register through clock message server
+	msgServer := clockkeeper.NewMsgServerImpl(*c)
+	_, err := msgServer.RegisterClockContract(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "registering clock contract from message")
+	}
+	return nil
+}
+
+// unregisterClockContract unregisters the contract from the clock module.
+func (m *CustomMessenger) unregisterClockContract(ctx context.Context, contractAddr sdk.AccAddress, unregister *types.UnregisterClockContract) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	err := PerformUnregisterClockContract(ctx, m.clock, contractAddr, unregister)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform unregister clock contract")
+	}
+	return nil, nil, nil, nil
+}
+
+// PerformUnregisterClockContract unregisters the contract from the clock module.
+func PerformUnregisterClockContract(ctx context.Context, c *clockkeeper.Keeper, contractAddr sdk.AccAddress, unregister *types.UnregisterClockContract) error {
+	if unregister == nil {
+		return wasmvmtypes.InvalidRequest{Err: "unregister clock contract null"}
+	}
+
+	sdkMsg := &clocktypes.MsgUnregisterClockContract{
+		SenderAddress:   contractAddr.String(),
+		ContractAddress: contractAddr.String(),
+	}
+
+	// Unregister through clock message server
+	msgServer := clockkeeper.NewMsgServerImpl(*c)
+	_, err := msgServer.UnregisterClockContract(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "unregistering clock contract from message")
+	}
+	return nil
+}
+
+// unjailClockContract unjails the contract.
+func (m *CustomMessenger) unjailClockContract(ctx context.Context, contractAddr sdk.AccAddress, unjail *types.UnjailClockContract) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	err := PerformUnjailClockContract(ctx, m.clock, contractAddr, unjail)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform unjail clock contract")
+	}
+	return nil, nil, nil, nil
+}
+
+// PerformUnjailClockContract unjails the contract in the clock module.
+func PerformUnjailClockContract(ctx context.Context, c *clockkeeper.Keeper, contractAddr sdk.AccAddress, unjail *types.UnjailClockContract) error {
+	if unjail == nil {
+		return wasmvmtypes.InvalidRequest{Err: "unjail clock contract null"}
+	}
+
+	sdkMsg := &clocktypes.MsgUnjailClockContract{
+		SenderAddress:   contractAddr.String(),
+		ContractAddress: contractAddr.String(),
+	}
+
+	// Unjail through clock message server
+	msgServer := clockkeeper.NewMsgServerImpl(*c)
+	_, err := msgServer.UnjailClockContract(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "unjailing clock contract from message")
+	}
+	return nil
+}
+
+// clockPhaseName returns the name of the clock phase as accepted from contracts.
+func clockPhaseName(phase clocktypes.ClockPhase) string {
+	switch phase {
+	case clocktypes.ClockPhaseBeginBlock:
+		return "begin_block"
+	case clocktypes.ClockPhaseBeginAndEndBlock:
+		return "begin_and_end_block"
+	default:
+		return "end_block"
+	}
+}
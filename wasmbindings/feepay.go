@@ -0,0 +1,87 @@
+package bindings
+
+import (
+	"context"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v29/wasmbindings/types"
+	feepaykeeper "github.com/CosmosContracts/juno/v29/x/feepay/keeper"
+	feepaytypes "github.com/CosmosContracts/juno/v29/x/feepay/types"
+)
+
+// fundFeePayContract funds the FeePay balance of the contract.
+func (m *CustomMessenger) fundFeePayContract(ctx context.Context, contractAddr sdk.AccAddress, fund *types.FundFeePayContract) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	err := PerformFundFeePayContract(ctx, m.feepay, contractAddr, fund)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform fund fee pay contract")
+	}
+	return nil, nil, nil, nil
+}
+
+// PerformFundFeePayContract funds the FeePay balance of the contract from its own funds,
+// with the contract as both the funder and the funded contract.
+func PerformFundFeePayContract(ctx context.Context, f *feepaykeeper.Keeper, contractAddr sdk.AccAddress, fund *types.FundFeePayContract) error {
+	if fund == nil {
+		return wasmvmtypes.InvalidRequest{Err: "fund fee pay contract null"}
+	}
+
+	amount, err := wasmkeeper.ConvertWasmCoinsToSdkCoins(fund.Amount)
+	if err != nil {
+		return err
+	}
+
+	sdkMsg := &feepaytypes.MsgFundFeePayContract{
+		SenderAddress:   contractAddr.String(),
+		ContractAddress: contractAddr.String(),
+		Amount:          amount,
+	}
+
+	// Fund through feepay message server
+	msgServer := feepaykeeper.NewMsgServerImpl(*f)
+	_, err = msgServer.FundFeePayContract(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "funding fee pay contract from message")
+	}
+	return nil
+}
+
+// updateFeePayWalletLimit updates the FeePay wallet limit of the contract.
+func (m *CustomMessenger) updateFeePayWalletLimit(ctx context.Context, contractAddr sdk.AccAddress, update *types.UpdateFeePayWalletLimit) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	err := PerformUpdateFeePayWalletLimit(ctx, m.feepay, contractAddr, update)
+	if err != nil {
+		return nil, nil, nil, errorsmod.Wrap(err, "perform update fee pay wallet limit")
+	}
+	return nil, nil, nil, nil
+}
+
+// PerformUpdateFeePayWalletLimit updates the FeePay wallet limit and limit window of the
+// contract, with the contract as both the sender and the updated contract.
+func PerformUpdateFeePayWalletLimit(ctx context.Context, f *feepaykeeper.Keeper, contractAddr sdk.AccAddress, update *types.UpdateFeePayWalletLimit) error {
+	if update == nil {
+		return wasmvmtypes.InvalidRequest{Err: "update fee pay wallet limit null"}
+	}
+
+	sdkMsg := &feepaytypes.MsgUpdateFeePayContractWalletLimit{
+		SenderAddress:       contractAddr.String(),
+		ContractAddress:     contractAddr.String(),
+		WalletLimit:         update.WalletLimit,
+		LimitWindowBlocks:   update.LimitWindowBlocks,
+		LimitWindowDuration: time.Duration(update.LimitWindowSeconds) * time.Second,
+	}
+
+	// Update through feepay message server
+	msgServer := feepaykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.UpdateFeePayContractWalletLimit(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "updating fee pay wallet limit from message")
+	}
+	return nil
+}
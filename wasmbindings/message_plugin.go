@@ -15,17 +15,21 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/CosmosContracts/juno/v29/wasmbindings/types"
+	clockkeeper "github.com/CosmosContracts/juno/v29/x/clock/keeper"
+	feepaykeeper "github.com/CosmosContracts/juno/v29/x/feepay/keeper"
 	tokenfactorykeeper "github.com/CosmosContracts/juno/v29/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/CosmosContracts/juno/v29/x/tokenfactory/types"
 )
 
 // CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
-func CustomMessageDecorator(bank bankkeeper.Keeper, tokenFactory *tokenfactorykeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+func CustomMessageDecorator(bank bankkeeper.Keeper, tokenFactory *tokenfactorykeeper.Keeper, clock *clockkeeper.Keeper, feepay *feepaykeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
 			wrapped:      old,
 			bank:         bank,
 			tokenFactory: tokenFactory,
+			clock:        clock,
+			feepay:       feepay,
 		}
 	}
 }
@@ -34,6 +38,8 @@ type CustomMessenger struct {
 	wrapped      wasmkeeper.Messenger
 	bank         bankkeeper.Keeper
 	tokenFactory *tokenfactorykeeper.Keeper
+	clock        *clockkeeper.Keeper
+	feepay       *feepaykeeper.Keeper
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -71,6 +77,33 @@ func (m *CustomMessenger) DispatchMsg(
 		if contractMsg.ForceTransfer != nil {
 			return m.forceTransfer(ctx, contractAddr, contractMsg.ForceTransfer)
 		}
+
+		var clockMsg types.ClockMsg
+		if err := json.Unmarshal(msg.Custom, &clockMsg); err != nil {
+			return nil, nil, nil, errorsmod.Wrap(err, "clock msg")
+		}
+
+		if clockMsg.RegisterClockContract != nil {
+			return m.registerClockContract(ctx, contractAddr, clockMsg.RegisterClockContract)
+		}
+		if clockMsg.UnregisterClockContract != nil {
+			return m.unregisterClockContract(ctx, contractAddr, clockMsg.UnregisterClockContract)
+		}
+		if clockMsg.UnjailClockContract != nil {
+			return m.unjailClockContract(ctx, contractAddr, clockMsg.UnjailClockContract)
+		}
+
+		var feepayMsg types.FeePayMsg
+		if err := json.Unmarshal(msg.Custom, &feepayMsg); err != nil {
+			return nil, nil, nil, errorsmod.Wrap(err, "feepay msg")
+		}
+
+		if feepayMsg.FundFeePayContract != nil {
+			return m.fundFeePayContract(ctx, contractAddr, feepayMsg.FundFeePayContract)
+		}
+		if feepayMsg.UpdateFeePayWalletLimit != nil {
+			return m.updateFeePayWalletLimit(ctx, contractAddr, feepayMsg.UpdateFeePayWalletLimit)
+		}
 	}
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
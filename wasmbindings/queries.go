@@ -2,24 +2,31 @@ package bindings
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	types "github.com/CosmosContracts/juno/v29/wasmbindings/types"
+	clockkeeper "github.com/CosmosContracts/juno/v29/x/clock/keeper"
+	feepaykeeper "github.com/CosmosContracts/juno/v29/x/feepay/keeper"
 	tokenfactorykeeper "github.com/CosmosContracts/juno/v29/x/tokenfactory/keeper"
 )
 
 type QueryPlugin struct {
 	bankKeeper         bankkeeper.Keeper
 	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+	clockKeeper        *clockkeeper.Keeper
+	feepayKeeper       *feepaykeeper.Keeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
-func NewQueryPlugin(b bankkeeper.Keeper, tfk *tokenfactorykeeper.Keeper) *QueryPlugin {
+func NewQueryPlugin(b bankkeeper.Keeper, tfk *tokenfactorykeeper.Keeper, ck *clockkeeper.Keeper, fpk *feepaykeeper.Keeper) *QueryPlugin {
 	return &QueryPlugin{
 		bankKeeper:         b,
 		tokenFactoryKeeper: tfk,
+		clockKeeper:        ck,
+		feepayKeeper:       fpk,
 	}
 }
 
@@ -55,3 +62,87 @@ func (qp QueryPlugin) GetParams(ctx sdk.Context) (*types.ParamsResponse, error)
 		},
 	}, nil
 }
+
+// GetClockStatus is a query to get the clock registration of a contract.
+func (qp QueryPlugin) GetClockStatus(ctx sdk.Context, contractAddress string) (*types.ClockStatusResponse, error) {
+	if _, err := parseAddress(contractAddress); err != nil {
+		return nil, err
+	}
+
+	if !qp.clockKeeper.IsClockContract(ctx, contractAddress) {
+		return &types.ClockStatusResponse{IsRegistered: false}, nil
+	}
+
+	contract, err := qp.clockKeeper.GetClockContract(ctx, contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clock contract: %s", contractAddress)
+	}
+
+	return &types.ClockStatusResponse{
+		IsRegistered:        true,
+		IsJailed:            contract.IsJailed,
+		BlockInterval:       contract.BlockInterval,
+		TimeIntervalSeconds: uint64(contract.TimeInterval / time.Second),
+		Phase:               clockPhaseName(contract.Phase),
+		NextExecutionHeight: contract.NextExecutionHeight,
+		ExecutionCount:      contract.ExecutionCount,
+		FailureCount:        contract.FailureCount,
+		LastError:           contract.LastError,
+	}, nil
+}
+
+// GetFeePayContract is a query to get the FeePay registration of a contract.
+func (qp QueryPlugin) GetFeePayContract(ctx sdk.Context, contractAddress string) (*types.FeePayContractResponse, error) {
+	if _, err := parseAddress(contractAddress); err != nil {
+		return nil, err
+	}
+
+	if !qp.feepayKeeper.IsContractRegistered(ctx, contractAddress) {
+		return &types.FeePayContractResponse{IsRegistered: false}, nil
+	}
+
+	contract, err := qp.feepayKeeper.GetContract(ctx, contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fee pay contract: %s", contractAddress)
+	}
+
+	return &types.FeePayContractResponse{
+		IsRegistered:       true,
+		Balances:           ConvertSdkCoinsToWasmCoins(contract.Balances),
+		WalletLimit:        contract.WalletLimit,
+		LimitWindowBlocks:  contract.LimitWindowBlocks,
+		LimitWindowSeconds: uint64(contract.LimitWindowDuration / time.Second),
+	}, nil
+}
+
+// GetFeePayWalletUsage is a query to get the usage of a wallet on a FeePay contract.
+func (qp QueryPlugin) GetFeePayWalletUsage(ctx sdk.Context, contractAddress, walletAddress string) (*types.FeePayWalletUsageResponse, error) {
+	if _, err := parseAddress(contractAddress); err != nil {
+		return nil, err
+	}
+
+	if _, err := parseAddress(walletAddress); err != nil {
+		return nil, err
+	}
+
+	contract, err := qp.feepayKeeper.GetContract(ctx, contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fee pay contract: %s", contractAddress)
+	}
+
+	allowance, err := qp.feepayKeeper.GetWalletAllowance(ctx, contract, walletAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	spent, err := qp.feepayKeeper.GetWalletSpent(ctx, contract, walletAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.FeePayWalletUsageResponse{
+		Uses:      allowance.Uses,
+		Remaining: allowance.Remaining,
+		Spent:     ConvertSdkCoinsToWasmCoins(spent),
+	}, nil
+}
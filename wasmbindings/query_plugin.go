@@ -21,6 +21,16 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 			return nil, errorsmod.Wrap(err, "osmosis query")
 		}
 
+		var clockQuery types.ClockQuery
+		if err := json.Unmarshal(request, &clockQuery); err != nil {
+			return nil, errorsmod.Wrap(err, "clock query")
+		}
+
+		var feepayQuery types.FeePayQuery
+		if err := json.Unmarshal(request, &feepayQuery); err != nil {
+			return nil, errorsmod.Wrap(err, "feepay query")
+		}
+
 		switch {
 		case contractQuery.FullDenom != nil:
 			creator := contractQuery.FullDenom.CreatorAddr
@@ -94,6 +104,45 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case clockQuery.ClockStatus != nil:
+			res, err := qp.GetClockStatus(ctx, clockQuery.ClockStatus.ContractAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal ClockStatusResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case feepayQuery.FeePayContract != nil:
+			res, err := qp.GetFeePayContract(ctx, feepayQuery.FeePayContract.ContractAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal FeePayContractResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case feepayQuery.FeePayWalletUsage != nil:
+			res, err := qp.GetFeePayWalletUsage(ctx, feepayQuery.FeePayWalletUsage.ContractAddress, feepayQuery.FeePayWalletUsage.WalletAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal FeePayWalletUsageResponse: %w", err)
+			}
+
+			return bz, nil
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown token query variant"}
 		}
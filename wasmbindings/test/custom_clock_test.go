@@ -0,0 +1,86 @@
+package bindings_test
+
+import (
+	"encoding/json"
+
+	bindings "github.com/CosmosContracts/juno/v29/wasmbindings"
+	types "github.com/CosmosContracts/juno/v29/wasmbindings/types"
+	clocktypes "github.com/CosmosContracts/juno/v29/x/clock/types"
+)
+
+func (s *BindingsTestSuite) TestClockMsgs() {
+	s.SetupTest()
+	creator := s.RandomAccountAddress()
+	s.StoreReflectCode(creator)
+
+	lucky := s.RandomAccountAddress()
+	reflect := s.instantiateReflectContract(lucky)
+	s.Require().NotEmpty(reflect)
+
+	clockKeeper := &s.App.AppKeepers.ClockKeeper
+	querier := bindings.CustomQuerier(bindings.NewQueryPlugin(s.App.AppKeepers.BankKeeper, &s.App.AppKeepers.TokenFactoryKeeper, clockKeeper, &s.App.AppKeepers.FeePayKeeper))
+
+	queryStatus := func() types.ClockStatusResponse {
+		req, err := json.Marshal(types.ClockQuery{ClockStatus: &types.ClockStatus{ContractAddress: reflect.String()}})
+		s.Require().NoError(err)
+		bz, err := querier(s.Ctx, req)
+		s.Require().NoError(err)
+
+		var resp types.ClockStatusResponse
+		s.Require().NoError(json.Unmarshal(bz, &resp))
+		return resp
+	}
+
+	// Not registered yet
+	s.Require().False(queryStatus().IsRegistered)
+
+	// Invalid messages are rejected
+	err := bindings.PerformRegisterClockContract(s.Ctx, clockKeeper, reflect, nil)
+	s.Require().Error(err)
+	err = bindings.PerformRegisterClockContract(s.Ctx, clockKeeper, reflect, &types.RegisterClockContract{
+		Phase: "middle_block",
+	})
+	s.Require().Error(err)
+
+	// The contract registers itself, although it is not its own admin or creator
+	err = bindings.PerformRegisterClockContract(s.Ctx, clockKeeper, reflect, &types.RegisterClockContract{
+		BlockInterval: 5,
+		Phase:         "begin_block",
+		BlockContext:  true,
+	})
+	s.Require().NoError(err)
+
+	contract, err := clockKeeper.GetClockContract(s.Ctx, reflect.String())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), contract.BlockInterval)
+	s.Require().Equal(clocktypes.ClockPhaseBeginBlock, contract.Phase)
+	s.Require().True(contract.BlockContext)
+
+	status := queryStatus()
+	s.Require().True(status.IsRegistered)
+	s.Require().False(status.IsJailed)
+	s.Require().Equal(uint64(5), status.BlockInterval)
+	s.Require().Equal("begin_block", status.Phase)
+
+	// Unjailing a contract which is not jailed fails
+	err = bindings.PerformUnjailClockContract(s.Ctx, clockKeeper, reflect, &types.UnjailClockContract{})
+	s.Require().Error(err)
+
+	// The contract unjails itself
+	s.Require().NoError(clockKeeper.SetJailStatus(s.Ctx, reflect.String(), true))
+	s.Require().True(queryStatus().IsJailed)
+
+	err = bindings.PerformUnjailClockContract(s.Ctx, clockKeeper, reflect, &types.UnjailClockContract{})
+	s.Require().NoError(err)
+	s.Require().False(queryStatus().IsJailed)
+
+	// Accounts which are not contracts cannot register
+	err = bindings.PerformRegisterClockContract(s.Ctx, clockKeeper, lucky, &types.RegisterClockContract{})
+	s.Require().Error(err)
+
+	// The contract unregisters itself
+	err = bindings.PerformUnregisterClockContract(s.Ctx, clockKeeper, reflect, &types.UnregisterClockContract{})
+	s.Require().NoError(err)
+	s.Require().False(clockKeeper.IsClockContract(s.Ctx, reflect.String()))
+	s.Require().False(queryStatus().IsRegistered)
+}
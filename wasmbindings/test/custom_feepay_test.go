@@ -0,0 +1,117 @@
+package bindings_test
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindings "github.com/CosmosContracts/juno/v29/wasmbindings"
+	types "github.com/CosmosContracts/juno/v29/wasmbindings/types"
+	feepaytypes "github.com/CosmosContracts/juno/v29/x/feepay/types"
+	globalfeetypes "github.com/CosmosContracts/juno/v29/x/globalfee/types"
+)
+
+func (s *BindingsTestSuite) TestFeePayMsgs() {
+	s.SetupTest()
+	creator := s.RandomAccountAddress()
+	s.StoreReflectCode(creator)
+
+	lucky := s.RandomAccountAddress()
+	reflect := s.instantiateReflectContract(lucky)
+	s.Require().NotEmpty(reflect)
+
+	// Only denoms with a global fee minimum gas price can fund fee pay contracts
+	err := s.App.AppKeepers.GlobalFeeKeeper.SetParams(s.Ctx, globalfeetypes.Params{
+		MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ujuno", sdkmath.LegacyNewDecWithPrec(25, 4))),
+	})
+	s.Require().NoError(err)
+
+	feepayKeeper := &s.App.AppKeepers.FeePayKeeper
+	querier := bindings.CustomQuerier(bindings.NewQueryPlugin(s.App.AppKeepers.BankKeeper, &s.App.AppKeepers.TokenFactoryKeeper, &s.App.AppKeepers.ClockKeeper, feepayKeeper))
+
+	query := func(request types.FeePayQuery, response any) {
+		req, err := json.Marshal(request)
+		s.Require().NoError(err)
+		bz, err := querier(s.Ctx, req)
+		s.Require().NoError(err)
+		s.Require().NoError(json.Unmarshal(bz, response))
+	}
+
+	queryContract := func() types.FeePayContractResponse {
+		var resp types.FeePayContractResponse
+		query(types.FeePayQuery{FeePayContract: &types.FeePayContract{ContractAddress: reflect.String()}}, &resp)
+		return resp
+	}
+
+	// Not registered yet
+	s.Require().False(queryContract().IsRegistered)
+
+	fund := &types.FundFeePayContract{Amount: []wasmvmtypes.Coin{{Denom: "ujuno", Amount: "1000"}}}
+	err = bindings.PerformFundFeePayContract(s.Ctx, feepayKeeper, reflect, fund)
+	s.Require().Error(err)
+
+	feepayKeeper.SetFeePayContract(s.Ctx, feepaytypes.FeePayContract{
+		ContractAddress: reflect.String(),
+		WalletLimit:     1,
+	})
+
+	// Invalid messages are rejected
+	err = bindings.PerformFundFeePayContract(s.Ctx, feepayKeeper, reflect, nil)
+	s.Require().Error(err)
+	err = bindings.PerformUpdateFeePayWalletLimit(s.Ctx, feepayKeeper, reflect, nil)
+	s.Require().Error(err)
+	err = bindings.PerformUpdateFeePayWalletLimit(s.Ctx, feepayKeeper, reflect, &types.UpdateFeePayWalletLimit{WalletLimit: 1_000_001})
+	s.Require().Error(err)
+
+	// The contract cannot fund more than it holds, or in denoms without a gas price
+	err = bindings.PerformFundFeePayContract(s.Ctx, feepayKeeper, reflect, fund)
+	s.Require().Error(err)
+
+	s.FundAcc(reflect, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1000)), sdk.NewCoin("uatom", sdkmath.NewInt(1000))))
+	err = bindings.PerformFundFeePayContract(s.Ctx, feepayKeeper, reflect, &types.FundFeePayContract{Amount: []wasmvmtypes.Coin{{Denom: "uatom", Amount: "1000"}}})
+	s.Require().Error(err)
+
+	// The contract funds itself and is recorded as its own funder
+	err = bindings.PerformFundFeePayContract(s.Ctx, feepayKeeper, reflect, fund)
+	s.Require().NoError(err)
+
+	contract := queryContract()
+	s.Require().True(contract.IsRegistered)
+	s.Require().Equal([]wasmvmtypes.Coin{{Denom: "ujuno", Amount: "1000"}}, contract.Balances)
+	s.Require().True(s.App.AppKeepers.BankKeeper.GetBalance(s.Ctx, reflect, "ujuno").IsZero())
+
+	funder, err := feepayKeeper.GetFunder(s.Ctx, reflect.String(), reflect.String())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1000))), funder.Contributed)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1000))), funder.Shares)
+
+	// The contract updates its own wallet limit, although it is not its own admin or creator
+	err = bindings.PerformUpdateFeePayWalletLimit(s.Ctx, feepayKeeper, reflect, &types.UpdateFeePayWalletLimit{
+		WalletLimit:        5,
+		LimitWindowSeconds: 3600,
+	})
+	s.Require().NoError(err)
+
+	contract = queryContract()
+	s.Require().Equal(uint64(5), contract.WalletLimit)
+	s.Require().Equal(uint64(0), contract.LimitWindowBlocks)
+	s.Require().Equal(uint64(3600), contract.LimitWindowSeconds)
+
+	// The contract queries the usage of any wallet
+	fpc, err := feepayKeeper.GetContract(s.Ctx, reflect.String())
+	s.Require().NoError(err)
+	s.Require().NoError(feepayKeeper.IncrementContractUses(s.Ctx, fpc, lucky.String(), 2))
+
+	var usage types.FeePayWalletUsageResponse
+	query(types.FeePayQuery{FeePayWalletUsage: &types.FeePayWalletUsage{
+		ContractAddress: reflect.String(),
+		WalletAddress:   lucky.String(),
+	}}, &usage)
+	s.Require().Equal(uint64(2), usage.Uses)
+	s.Require().Equal(uint64(3), usage.Remaining)
+	s.Require().Empty(usage.Spent)
+}
@@ -76,7 +76,7 @@ func (s *BindingsTestSuite) TestDenomAdmin() {
 	s.Require().NoError(err)
 	s.Require().NotEmpty(tfDenom)
 
-	queryPlugin := bindings.NewQueryPlugin(s.App.AppKeepers.BankKeeper, &s.App.AppKeepers.TokenFactoryKeeper)
+	queryPlugin := bindings.NewQueryPlugin(s.App.AppKeepers.BankKeeper, &s.App.AppKeepers.TokenFactoryKeeper, &s.App.AppKeepers.ClockKeeper, &s.App.AppKeepers.FeePayKeeper)
 
 	testCases := []struct {
 		name        string
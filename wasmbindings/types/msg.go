@@ -1,6 +1,10 @@
 package types
 
-import "cosmossdk.io/math"
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	"cosmossdk.io/math"
+)
 
 type TokenFactoryMsg struct {
 	// Contracts can create denoms, namespaced under the contract's address.
@@ -62,3 +66,49 @@ type ForceTransfer struct {
 	FromAddress string   `json:"from_address"`
 	ToAddress   string   `json:"to_address"`
 }
+
+type ClockMsg struct {
+	// Contracts can register themselves with the clock module.
+	RegisterClockContract *RegisterClockContract `json:"register_clock_contract,omitempty"`
+	// Contracts can unregister themselves from the clock module.
+	UnregisterClockContract *UnregisterClockContract `json:"unregister_clock_contract,omitempty"`
+	// Contracts can unjail themselves once the cause of the failure is fixed.
+	UnjailClockContract *UnjailClockContract `json:"unjail_clock_contract,omitempty"`
+}
+
+// RegisterClockContract registers the sending contract with the clock module.
+// The contract is executed every block, unless a block or time interval is set.
+// Phase is one of "end_block" (default), "begin_block" or "begin_and_end_block".
+type RegisterClockContract struct {
+	BlockInterval       uint64 `json:"block_interval,omitempty"`
+	TimeIntervalSeconds uint64 `json:"time_interval_seconds,omitempty"`
+	Phase               string `json:"phase,omitempty"`
+	BlockContext        bool   `json:"block_context,omitempty"`
+}
+
+// UnregisterClockContract unregisters the sending contract from the clock module.
+type UnregisterClockContract struct{}
+
+// UnjailClockContract unjails the sending contract.
+type UnjailClockContract struct{}
+
+type FeePayMsg struct {
+	// Contracts registered with FeePay can fund their balance from their own funds.
+	FundFeePayContract *FundFeePayContract `json:"fund_fee_pay_contract,omitempty"`
+	// Contracts registered with FeePay can update their own wallet limit.
+	UpdateFeePayWalletLimit *UpdateFeePayWalletLimit `json:"update_fee_pay_wallet_limit,omitempty"`
+}
+
+// FundFeePayContract funds the FeePay balance of the sending contract from its own
+// funds. The contract is recorded as a funder of its FeePay balance.
+type FundFeePayContract struct {
+	Amount []wasmvmtypes.Coin `json:"amount"`
+}
+
+// UpdateFeePayWalletLimit replaces the wallet limit and limit window of the sending
+// contract. A zero window means the uses never reset.
+type UpdateFeePayWalletLimit struct {
+	WalletLimit        uint64 `json:"wallet_limit"`
+	LimitWindowBlocks  uint64 `json:"limit_window_blocks,omitempty"`
+	LimitWindowSeconds uint64 `json:"limit_window_seconds,omitempty"`
+}
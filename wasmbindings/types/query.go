@@ -1,5 +1,9 @@
 package types
 
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
 // See https://github.com/CosmWasm/token-bindings/blob/main/packages/bindings/src/query.rs
 type TokenFactoryQuery struct {
 	// Given a subdenom minted by a contract via `OsmosisMsg::MintTokens`,
@@ -53,3 +57,54 @@ type DenomsByCreatorResponse struct {
 type ParamsResponse struct {
 	Params Params `json:"params"`
 }
+
+type ClockQuery struct {
+	// Returns the clock registration of a contract, usually the querying contract.
+	ClockStatus *ClockStatus `json:"clock_status,omitempty"`
+}
+
+type ClockStatus struct {
+	ContractAddress string `json:"contract_address"`
+}
+
+type ClockStatusResponse struct {
+	IsRegistered        bool   `json:"is_registered"`
+	IsJailed            bool   `json:"is_jailed"`
+	BlockInterval       uint64 `json:"block_interval"`
+	TimeIntervalSeconds uint64 `json:"time_interval_seconds"`
+	Phase               string `json:"phase"`
+	NextExecutionHeight int64  `json:"next_execution_height"`
+	ExecutionCount      uint64 `json:"execution_count"`
+	FailureCount        uint64 `json:"failure_count"`
+	LastError           string `json:"last_error"`
+}
+
+type FeePayQuery struct {
+	// Returns the FeePay registration of a contract, usually the querying contract.
+	FeePayContract *FeePayContract `json:"fee_pay_contract,omitempty"`
+	// Returns the usage of a wallet on a FeePay contract in the current limit window.
+	FeePayWalletUsage *FeePayWalletUsage `json:"fee_pay_wallet_usage,omitempty"`
+}
+
+type FeePayContract struct {
+	ContractAddress string `json:"contract_address"`
+}
+
+type FeePayWalletUsage struct {
+	ContractAddress string `json:"contract_address"`
+	WalletAddress   string `json:"wallet_address"`
+}
+
+type FeePayContractResponse struct {
+	IsRegistered       bool               `json:"is_registered"`
+	Balances           []wasmvmtypes.Coin `json:"balances"`
+	WalletLimit        uint64             `json:"wallet_limit"`
+	LimitWindowBlocks  uint64             `json:"limit_window_blocks"`
+	LimitWindowSeconds uint64             `json:"limit_window_seconds"`
+}
+
+type FeePayWalletUsageResponse struct {
+	Uses      uint64             `json:"uses"`
+	Remaining uint64             `json:"remaining"`
+	Spent     []wasmvmtypes.Coin `json:"spent"`
+}
@@ -5,20 +5,24 @@ import (
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	clockkeeper "github.com/CosmosContracts/juno/v29/x/clock/keeper"
+	feepaykeeper "github.com/CosmosContracts/juno/v29/x/feepay/keeper"
 	tokenfactorykeeper "github.com/CosmosContracts/juno/v29/x/tokenfactory/keeper"
 )
 
 func RegisterCustomPlugins(
 	bank bankkeeper.Keeper,
 	tokenFactory *tokenfactorykeeper.Keeper,
+	clock *clockkeeper.Keeper,
+	feepay *feepaykeeper.Keeper,
 ) []wasmkeeper.Option {
-	wasmQueryPlugin := NewQueryPlugin(bank, tokenFactory)
+	wasmQueryPlugin := NewQueryPlugin(bank, tokenFactory, clock, feepay)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(bank, tokenFactory),
+		CustomMessageDecorator(bank, tokenFactory, clock, feepay),
 	)
 
 	return []wasmkeeper.Option{
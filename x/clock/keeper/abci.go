@@ -2,8 +2,8 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
-	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -13,13 +13,39 @@ import (
 	"github.com/CosmosContracts/juno/v29/x/clock/types"
 )
 
-var endBlockSudoMessage = []byte(types.EndBlockSudoMessage)
+var (
+	beginBlockSudoMessage = []byte(types.BeginBlockSudoMessage)
+	endBlockSudoMessage   = []byte(types.EndBlockSudoMessage)
+)
+
+// clockExecutionContextKey marks the context of a clock execution with the address of
+// the executed contract, so the contract cannot be unregistered during its own execution.
+type clockExecutionContextKey struct{}
+
+// isExecutingContract returns true if the context is of the clock execution of the contract.
+func isExecutingContract(ctx context.Context, contractAddress string) bool {
+	executing, ok := sdk.UnwrapSDKContext(ctx).Value(clockExecutionContextKey{}).(string)
+	return ok && executing == contractAddress
+}
+
+// BeginBlocker executes on contracts at the beginning of the block.
+func BeginBlocker(ctx context.Context, k Keeper) error {
+	start := telemetry.Now()
+	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyBeginBlocker)
+
+	return executeContracts(ctx, k, types.ClockPhaseBeginBlock, beginBlockSudoMessage)
+}
 
 // EndBlocker executes on contracts at the end of the block.
 func EndBlocker(ctx context.Context, k Keeper) error {
 	start := telemetry.Now()
 	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyEndBlocker)
 
+	return executeContracts(ctx, k, types.ClockPhaseEndBlock, endBlockSudoMessage)
+}
+
+// executeContracts executes all unjailed contracts which are due in the given block phase.
+func executeContracts(ctx context.Context, k Keeper, phase types.ClockPhase, msgBz []byte) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	logger := k.Logger(ctx)
@@ -36,59 +62,194 @@ func EndBlocker(ctx context.Context, k Keeper) error {
 	errorExecs := make([]string, len(contracts))
 	errorExists := false
 
-	// Execute all contracts that are not jailed
-	for idx, contract := range contracts {
+	// When the block gas limit is enabled, continue in round-robin order from the
+	// first contract skipped in this phase
+	hasBudget := p.BlockGasLimit > 0
+	budget := k.GetBudgetState(ctx)
+	budget.StartBlock(sdkCtx.BlockHeight())
+	start := 0
+	if hasBudget {
+		cursor := budget.GetCursor(phase)
+		start = sort.Search(len(contracts), func(i int) bool {
+			return contracts[i].ContractAddress >= cursor
+		})
+	}
+	skipped := false
+
+	// Execute all contracts that are not jailed and are due
+	for i := range contracts {
+		idx := (start + i) % len(contracts)
+		contract := contracts[idx]
+
+		// Skip contracts which are not executed in this phase
+		if !contract.ExecutesIn(phase) {
+			continue
+		}
+
+		// Automatically unjail contracts once their jail duration has passed
+		if contract.IsJailExpired(sdkCtx.BlockHeight(), p.JailDuration) {
+			contract.Unjail()
+			if err := k.SetClockContract(ctx, contract); err != nil {
+				logger.Error("Failed to unjail contract", "contract", contract.ContractAddress, "error", err)
+				continue
+			}
+		}
+
 		// Skip jailed contracts
 		if contract.IsJailed {
 			continue
 		}
 
+		// Skip contracts which are not yet due
+		if !contract.IsDue(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+			continue
+		}
+
+		// Contracts with a custom gas limit pay for their gas from their deposit. Jail
+		// the contract if the deposit cannot cover a full execution.
+		gasLimit := p.ContractGasLimit
+		gasPrice, paysForGas := p.ParseGasDepositPrice()
+		paysForGas = paysForGas && contract.HasCustomGasLimit()
+		if paysForGas {
+			gasLimit = contract.GasLimit
+
+			// Jail the contract if its gas limit cannot fit in an empty block, instead of
+			// skipping it for the block gas limit in every block
+			jailErr := p.ValidateCustomGasLimit(gasLimit)
+			if jailErr == nil && contract.GetGasDepositAmount().LT(types.GasDepositCost(gasLimit, gasPrice)) {
+				jailErr = types.ErrInsufficientDeposit
+			}
+
+			if jailErr != nil {
+				if err := k.jailContract(ctx, contract, jailErr); err != nil {
+					logger.Error("Failed to jail contract", "contract", contract.ContractAddress, "error", err)
+				}
+				continue
+			}
+		}
+
+		// Delay the contract to a following block if it could exceed the block gas limit.
+		// The next block starts from the first skipped contract, so contracts with a
+		// large gas limit are not starved by the contracts executed before them.
+		if hasBudget && budget.GasUsed+gasLimit > p.BlockGasLimit {
+			if !skipped {
+				budget.SetCursor(phase, contract.ContractAddress)
+				skipped = true
+			}
+			budget.SkippedContracts = append(budget.SkippedContracts, types.SkippedClockContract{
+				ContractAddress: contract.ContractAddress,
+				Phase:           phase,
+			})
+			continue
+		}
+
 		// Get sdk.AccAddress from contract address
 		contractAddr := sdk.MustAccAddressFromBech32(contract.ContractAddress)
-		if handleError(ctx, k, logger, errorExecs, &errorExists, err, idx, contract.ContractAddress) {
-			continue
+
+		// Track the number of executions of the contract
+		contract.ExecutionCount++
+
+		// Contracts which opted in receive the block context, others the empty message
+		contractMsgBz := msgBz
+		if contract.BlockContext {
+			bz, err := k.getBlockContextSudoMessage(ctx, phase, contract)
+			if err != nil {
+				logger.Error("Failed to build block context", "contract", contract.ContractAddress, "error", err)
+			} else {
+				contractMsgBz = bz
+			}
 		}
 
 		// Create context with gas limit
-		childCtx := sdkCtx.WithGasMeter(storetypes.NewGasMeter(p.ContractGasLimit))
+		childCtx := sdkCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit)).WithValue(clockExecutionContextKey{}, contract.ContractAddress)
 
 		// Execute contract
-		helpers.ExecuteContract(k.GetContractKeeper(), childCtx, contractAddr, endBlockSudoMessage, &err)
-		if handleError(ctx, k, logger, errorExecs, &errorExists, err, idx, contract.ContractAddress) {
+		var execErr error
+		helpers.ExecuteContract(k.GetContractKeeper(), childCtx, contractAddr, contractMsgBz, &execErr)
+
+		// Track the gas used in the block
+		gasUsed := childCtx.GasMeter().GasConsumedToLimit()
+		if hasBudget {
+			budget.GasUsed += gasUsed
+		}
+
+		// Reload the contract, which may have updated its registration during the execution
+		updated, err := k.GetClockContract(ctx, contract.ContractAddress)
+		if err != nil {
+			logger.Error("Failed to reload contract", "contract", contract.ContractAddress, "error", err)
 			continue
 		}
+		updated.ExecutionCount = contract.ExecutionCount
+		contract = *updated
+		contract.RecordGasUsed(gasUsed)
+
+		// Debit the gas used from the deposit
+		if paysForGas {
+			cost := sdk.NewCoin(k.bondDenom, types.GasDepositCost(gasUsed, gasPrice))
+			if err := k.chargeGasDeposit(ctx, &contract, cost); err != nil {
+				logger.Error("Failed to charge gas deposit", "contract", contract.ContractAddress, "error", err)
+			}
+		}
+
+		// Schedule the next execution once the last phase of the block has run,
+		// regardless of the outcome
+		if (contract.BlockInterval > 0 || contract.TimeInterval > 0) && contract.IsLastPhase(phase) {
+			contract.ScheduleNextExecution(sdkCtx.BlockHeight(), sdkCtx.BlockTime())
+		}
+
+		// Record failures and jail the contract if needed, reset strikes on success
+		if handleError(sdkCtx, p, errorExecs, &errorExists, execErr, idx, &contract) {
+			if contract.IsJailed {
+				k.emitJailEvent(ctx, contract, contract.LastError)
+			}
+		} else {
+			contract.RecordSuccess(sdkCtx.BlockHeight())
+		}
+		k.emitExecutionEvent(ctx, contract, phase, gasUsed, execErr)
+
+		if err := k.SetClockContract(ctx, contract); err != nil {
+			logger.Error("Failed to update contract", "contract", contract.ContractAddress, "error", err)
+		}
+	}
+
+	// Persist the gas usage, cursor and skipped contracts
+	if hasBudget {
+		if err := k.SetBudgetState(ctx, budget); err != nil {
+			logger.Error("Failed to set budget state", "error", err)
+		}
+		if len(budget.SkippedContracts) > 0 {
+			logger.Info("Block gas limit reached, delaying contracts", "phase", phase.String(), "skipped", len(budget.SkippedContracts))
+		}
 	}
 
 	// Log errors if present
 	if errorExists {
-		logger.Error("Failed to execute contracts", "contracts", errorExecs)
+		logger.Error("Failed to execute contracts", "phase", phase.String(), "contracts", errorExecs)
 	}
 
 	return nil
 }
 
-// Function to handle contract execution errors. Returns true if error is present, false otherwise.
+// Function to handle contract execution errors. The failure is recorded on the contract,
+// which is jailed once it exceeds the maximum number of strikes. Returns true if error is
+// present, false otherwise.
 func handleError(
-	ctx context.Context,
-	k Keeper,
-	logger log.Logger,
+	ctx sdk.Context,
+	p types.Params,
 	errorExecs []string,
 	errorExists *bool,
 	err error,
 	idx int,
-	contractAddress string,
+	contract *types.ClockContract,
 ) bool {
 	// Check if error is present
 	if err != nil {
 		// Flag error
 		*errorExists = true
-		errorExecs[idx] = contractAddress
+		errorExecs[idx] = contract.ContractAddress
 
-		// Attempt to jail contract, log error if present
-		err := k.SetJailStatus(ctx, contractAddress, true)
-		if err != nil {
-			logger.Error("Failed to jail contract", "contract", contractAddress, "error", err)
-		}
+		// Record the failure, jailing the contract if it has no strikes left
+		contract.RecordFailure(err, ctx.BlockHeight(), p.MaxStrikes)
 	}
 
 	return err != nil
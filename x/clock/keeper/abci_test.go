@@ -2,15 +2,20 @@ package keeper_test
 
 import (
 	"encoding/json"
+	"sort"
+	"time"
 
 	_ "embed"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/CosmosContracts/juno/v29/x/clock/keeper"
 	"github.com/CosmosContracts/juno/v29/x/clock/types"
 )
 
@@ -27,7 +32,7 @@ func (s *KeeperTestSuite) registerContract() string {
 
 	// Register contract
 	clockKeeper := s.App.AppKeepers.ClockKeeper
-	err := clockKeeper.RegisterContract(s.Ctx, admin.String(), contractAddress)
+	err := clockKeeper.RegisterContract(s.Ctx, admin.String(), contractAddress, 0, 0, types.ClockPhaseUnspecified, 0, false)
 	s.Require().NoError(err)
 
 	// Assert contract is registered
@@ -94,6 +99,90 @@ func (s *KeeperTestSuite) TestEndBlocker() {
 	s.Require().Equal(int64(2), val)
 }
 
+// Test contracts registered with a block or time interval are only executed
+// when they are due.
+func (s *KeeperTestSuite) TestEndBlockerInterval() {
+	// Setup test
+	clockKeeper := s.App.AppKeepers.ClockKeeper
+	s.StoreCode(clockContract)
+	contractAddress := s.registerContract()
+
+	// Execute every 3 blocks
+	contract, err := clockKeeper.GetClockContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	*contract = types.NewClockContract(contractAddress, 3, 0, types.ClockPhaseEndBlock, s.Ctx.BlockHeight(), s.Ctx.BlockTime())
+	s.Require().NoError(clockKeeper.SetClockContract(s.Ctx, *contract))
+
+	// Not yet due
+	s.EndBlock()
+	s.Require().Equal(int64(0), s.queryContract(contractAddress))
+
+	// Due after the interval, next execution is rescheduled
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 3)
+	s.EndBlock()
+	s.Require().Equal(int64(1), s.queryContract(contractAddress))
+
+	contract, err = clockKeeper.GetClockContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().Equal(s.Ctx.BlockHeight()+3, contract.NextExecutionHeight)
+
+	// Execute every minute
+	*contract = types.NewClockContract(contractAddress, 0, time.Minute, types.ClockPhaseEndBlock, s.Ctx.BlockHeight(), s.Ctx.BlockTime())
+	s.Require().NoError(clockKeeper.SetClockContract(s.Ctx, *contract))
+
+	// Not yet due
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithBlockTime(s.Ctx.BlockTime().Add(30 * time.Second))
+	s.EndBlock()
+	s.Require().Equal(int64(1), s.queryContract(contractAddress))
+
+	// Due after the interval
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithBlockTime(s.Ctx.BlockTime().Add(30 * time.Second))
+	s.EndBlock()
+	s.Require().Equal(int64(2), s.queryContract(contractAddress))
+
+	// Ensure the schedule is queryable
+	resp, err := s.queryClient.ClockSchedule(s.Ctx, &types.QueryClockScheduleRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Schedules, 1)
+	s.Require().Equal(contractAddress, resp.Schedules[0].ContractAddress)
+	s.Require().Equal(time.Minute, resp.Schedules[0].TimeInterval)
+	s.Require().Equal(s.Ctx.BlockTime().Add(time.Minute), resp.Schedules[0].NextExecutionTime)
+	s.Require().False(resp.Schedules[0].IsDue)
+}
+
+// Test contracts are only executed in the block phases they registered for, with
+// a distinct sudo message per phase.
+func (s *KeeperTestSuite) TestBeginBlocker() {
+	// Setup test
+	clockKeeper := s.App.AppKeepers.ClockKeeper
+	s.StoreCode(clockContract)
+	endBlockContract := s.registerContract()
+	beginBlockContract := s.registerContract()
+
+	contract, err := clockKeeper.GetClockContract(s.Ctx, beginBlockContract)
+	s.Require().NoError(err)
+	contract.Phase = types.ClockPhaseBeginBlock
+	s.Require().NoError(clockKeeper.SetClockContract(s.Ctx, *contract))
+
+	// Begin block only executes the begin block contract, which does not
+	// handle the begin block sudo message and is jailed
+	s.Require().NoError(keeper.BeginBlocker(s.Ctx, clockKeeper))
+	s.Require().Equal(int64(0), s.queryContract(endBlockContract))
+
+	contract, err = clockKeeper.GetClockContract(s.Ctx, beginBlockContract)
+	s.Require().NoError(err)
+	s.Require().True(contract.IsJailed)
+
+	contract, err = clockKeeper.GetClockContract(s.Ctx, endBlockContract)
+	s.Require().NoError(err)
+	s.Require().False(contract.IsJailed)
+
+	// End block only executes the end block contract
+	s.EndBlock()
+	s.Require().Equal(int64(1), s.queryContract(endBlockContract))
+	s.Require().Equal(int64(0), s.queryContract(beginBlockContract))
+}
+
 // Test a contract which does not handle the sudo EndBlock msg.
 func (s *KeeperTestSuite) TestInvalidContract() {
 	// Setup test
@@ -110,6 +199,335 @@ func (s *KeeperTestSuite) TestInvalidContract() {
 	s.Require().True(contract.IsJailed)
 }
 
+// Test contracts are only jailed once they run out of strikes, and are
+// automatically unjailed once the jail duration has passed.
+func (s *KeeperTestSuite) TestStrikesAndJailExpiry() {
+	// Setup test
+	clockKeeper := s.App.AppKeepers.ClockKeeper
+	s.StoreCode(burnContract)
+	contractAddress := s.registerContract()
+
+	// Allow a single strike, unjail after 5 blocks
+	err := clockKeeper.SetParams(s.Ctx, types.NewParams(types.DefaultParams().ContractGasLimit, 1, 5, "", 0))
+	s.Require().NoError(err)
+
+	// First failure is a strike
+	s.EndBlock()
+	contract, err := clockKeeper.GetClockContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().False(contract.IsJailed)
+	s.Require().Equal(uint64(1), contract.FailureCount)
+	s.Require().NotEmpty(contract.LastError)
+
+	// Second failure jails the contract
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	s.EndBlock()
+	contract, err = clockKeeper.GetClockContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().True(contract.IsJailed)
+	s.Require().Equal(uint64(2), contract.FailureCount)
+	s.Require().Equal(s.Ctx.BlockHeight(), contract.JailHeight)
+
+	// Contract remains jailed before the jail duration has passed
+	jailHeight := contract.JailHeight
+	s.Ctx = s.Ctx.WithBlockHeight(jailHeight + 4)
+	s.EndBlock()
+	contract, err = clockKeeper.GetClockContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().True(contract.IsJailed)
+
+	// Contract is unjailed and executed again once the jail duration has passed
+	s.Ctx = s.Ctx.WithBlockHeight(jailHeight + 5)
+	s.EndBlock()
+	contract, err = clockKeeper.GetClockContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().False(contract.IsJailed)
+	s.Require().Equal(int64(0), contract.JailHeight)
+	s.Require().Equal(uint64(1), contract.FailureCount)
+}
+
+// Test contracts with a custom gas limit pay for their gas from their deposit,
+// and are jailed once the deposit runs out.
+func (s *KeeperTestSuite) TestEndBlockerGasDeposit() {
+	// Setup test
+	clockKeeper := s.App.AppKeepers.ClockKeeper
+	s.StoreCode(clockContract)
+	contractAddress := s.registerContract()
+
+	params := types.DefaultParams()
+	params.GasDepositPrice = "0.01"
+	s.Require().NoError(clockKeeper.SetParams(s.Ctx, params))
+
+	// Deposit enough for a single execution at the custom gas limit
+	contract, err := clockKeeper.GetClockContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	contract.GasLimit = 200_000
+	s.Require().NoError(clockKeeper.SetClockContract(s.Ctx, *contract))
+
+	_, _, funder := testdata.KeyTestPubAddr()
+	s.FundAcc(funder, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(2_000))))
+	s.Require().NoError(clockKeeper.DepositGas(s.Ctx, funder.String(), contractAddress, sdk.NewCoin("ujuno", sdkmath.NewInt(2_000))))
+
+	// The gas used is debited from the deposit
+	s.EndBlock()
+	s.Require().Equal(int64(1), s.queryContract(contractAddress))
+
+	contract, err = clockKeeper.GetClockContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().False(contract.IsJailed)
+	s.Require().True(contract.GasDeposit.Amount.LT(sdkmath.NewInt(2_000)))
+	s.Require().True(contract.GasDeposit.Amount.IsPositive())
+
+	// The remaining deposit no longer covers an execution, jail the contract
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	s.EndBlock()
+	s.Require().Equal(int64(1), s.queryContract(contractAddress))
+
+	contract, err = clockKeeper.GetClockContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().True(contract.IsJailed)
+	s.Require().Equal(types.ErrInsufficientDeposit.Error(), contract.LastError)
+}
+
+// Test the block gas limit delays contracts to the following blocks in
+// round-robin order.
+func (s *KeeperTestSuite) TestEndBlockerBlockGasLimit() {
+	// Setup test
+	clockKeeper := s.App.AppKeepers.ClockKeeper
+	s.StoreCode(clockContract)
+	contracts := []string{s.registerContract(), s.registerContract(), s.registerContract()}
+	sort.Strings(contracts)
+
+	// Only allow two contracts per block
+	params := types.DefaultParams()
+	params.BlockGasLimit = 2 * params.ContractGasLimit
+	s.Require().NoError(clockKeeper.SetParams(s.Ctx, params))
+
+	// The last contract is delayed
+	s.EndBlock()
+	s.Require().Equal(int64(1), s.queryContract(contracts[0]))
+	s.Require().Equal(int64(1), s.queryContract(contracts[1]))
+	s.Require().Equal(int64(0), s.queryContract(contracts[2]))
+
+	resp, err := s.queryClient.SkippedClockContracts(s.Ctx, &types.QuerySkippedClockContractsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.Ctx.BlockHeight(), resp.Height)
+	s.Require().Positive(resp.GasUsed)
+	s.Require().Equal([]types.SkippedClockContract{
+		{ContractAddress: contracts[2], Phase: types.ClockPhaseEndBlock},
+	}, resp.SkippedContracts)
+
+	// The next block continues from the first skipped contract
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	s.EndBlock()
+	s.Require().Equal(int64(2), s.queryContract(contracts[0]))
+	s.Require().Equal(int64(1), s.queryContract(contracts[1]))
+	s.Require().Equal(int64(1), s.queryContract(contracts[2]))
+
+	resp, err = s.queryClient.SkippedClockContracts(s.Ctx, &types.QuerySkippedClockContractsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.SkippedClockContract{
+		{ContractAddress: contracts[1], Phase: types.ClockPhaseEndBlock},
+	}, resp.SkippedContracts)
+}
+
+// Test contracts with a gas limit which only fits in an empty block budget are not
+// starved by the contracts executed before them.
+func (s *KeeperTestSuite) TestEndBlockerBlockGasLimitLargeContract() {
+	// Setup test
+	clockKeeper := s.App.AppKeepers.ClockKeeper
+	s.StoreCode(clockContract)
+	contracts := []string{s.registerContract(), s.registerContract(), s.registerContract()}
+	sort.Strings(contracts)
+
+	params := types.DefaultParams()
+	params.GasDepositPrice = "0.01"
+	params.BlockGasLimit = 2 * params.ContractGasLimit
+	s.Require().NoError(clockKeeper.SetParams(s.Ctx, params))
+
+	// The middle contract needs the full block gas limit
+	contract, err := clockKeeper.GetClockContract(s.Ctx, contracts[1])
+	s.Require().NoError(err)
+	contract.GasLimit = params.BlockGasLimit
+	s.Require().NoError(clockKeeper.SetClockContract(s.Ctx, *contract))
+
+	_, _, funder := testdata.KeyTestPubAddr()
+	s.FundAcc(funder, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(100_000))))
+	s.Require().NoError(clockKeeper.DepositGas(s.Ctx, funder.String(), contracts[1], sdk.NewCoin("ujuno", sdkmath.NewInt(100_000))))
+
+	// The large contract does not fit after the first contract
+	s.EndBlock()
+	s.Require().Equal(int64(1), s.queryContract(contracts[0]))
+	s.Require().Equal(int64(0), s.queryContract(contracts[1]))
+	s.Require().Equal(int64(1), s.queryContract(contracts[2]))
+
+	// The next block starts from the large contract, and delays the contracts which no
+	// longer fit after it
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	s.EndBlock()
+	s.Require().Equal(int64(1), s.queryContract(contracts[0]))
+	s.Require().Equal(int64(1), s.queryContract(contracts[1]))
+	s.Require().Equal(int64(2), s.queryContract(contracts[2]))
+
+	resp, err := s.queryClient.SkippedClockContracts(s.Ctx, &types.QuerySkippedClockContractsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.SkippedClockContract{
+		{ContractAddress: contracts[0], Phase: types.ClockPhaseEndBlock},
+	}, resp.SkippedContracts)
+}
+
+// Test contracts with a gas limit which no longer fits in an empty block are jailed,
+// when the params change and before they are skipped for the block gas limit.
+func (s *KeeperTestSuite) TestEndBlockerBlockGasLimitOversizedContract() {
+	// Setup test
+	clockKeeper := s.App.AppKeepers.ClockKeeper
+	s.StoreCode(clockContract)
+	contracts := []string{s.registerContract(), s.registerContract()}
+	sort.Strings(contracts)
+
+	params := types.DefaultParams()
+	params.GasDepositPrice = "0.01"
+	s.Require().NoError(clockKeeper.SetParams(s.Ctx, params))
+
+	for _, contractAddress := range contracts {
+		contract, err := clockKeeper.GetClockContract(s.Ctx, contractAddress)
+		s.Require().NoError(err)
+		contract.GasLimit = 3 * params.ContractGasLimit
+		s.Require().NoError(clockKeeper.SetClockContract(s.Ctx, *contract))
+	}
+
+	// Lowering the block gas limit through governance jails the contracts which no
+	// longer fit
+	params.BlockGasLimit = 2 * params.ContractGasLimit
+	_, err := s.msgServer.UpdateParams(s.Ctx, &types.MsgUpdateParams{
+		Authority: clockKeeper.GetAuthority(),
+		Params:    params,
+	})
+	s.Require().NoError(err)
+
+	contract, err := clockKeeper.GetClockContract(s.Ctx, contracts[0])
+	s.Require().NoError(err)
+	s.Require().True(contract.IsJailed)
+	s.Require().Contains(contract.LastError, types.ErrInvalidGasLimit.Error())
+
+	// Contracts which exceed the block gas limit otherwise are jailed instead of
+	// being skipped and holding the cursor
+	contract, err = clockKeeper.GetClockContract(s.Ctx, contracts[1])
+	s.Require().NoError(err)
+	contract.Unjail()
+	s.Require().NoError(clockKeeper.SetClockContract(s.Ctx, *contract))
+
+	s.EndBlock()
+	s.Require().Equal(int64(0), s.queryContract(contracts[1]))
+
+	contract, err = clockKeeper.GetClockContract(s.Ctx, contracts[1])
+	s.Require().NoError(err)
+	s.Require().True(contract.IsJailed)
+	s.Require().Contains(contract.LastError, types.ErrInvalidGasLimit.Error())
+
+	resp, err := s.queryClient.SkippedClockContracts(s.Ctx, &types.QuerySkippedClockContractsRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(resp.SkippedContracts)
+	s.Require().Empty(clockKeeper.GetBudgetState(s.Ctx).GetCursor(types.ClockPhaseEndBlock))
+}
+
+// Test the execution count is tracked for every execution, and contracts which opt in
+// to the block context receive it instead of the empty sudo message.
+func (s *KeeperTestSuite) TestEndBlockerBlockContext() {
+	// Setup test
+	clockKeeper := s.App.AppKeepers.ClockKeeper
+	s.StoreCode(clockContract)
+	contractAddress := s.registerContract()
+	blockContextContract := s.registerContract()
+
+	contract, err := clockKeeper.GetClockContract(s.Ctx, blockContextContract)
+	s.Require().NoError(err)
+	contract.BlockContext = true
+	s.Require().NoError(clockKeeper.SetClockContract(s.Ctx, *contract))
+
+	// The transaction count is kept for the current block
+	clockKeeper.SetBlockTxCount(s.Ctx, 3)
+	s.Require().Equal(uint64(3), clockKeeper.GetBlockTxCount(s.Ctx))
+
+	// Contracts without the block context still receive the empty message
+	s.EndBlock()
+	s.Require().Equal(int64(1), s.queryContract(contractAddress))
+
+	contract, err = clockKeeper.GetClockContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), contract.ExecutionCount)
+
+	// The example contract does not accept the block context, so the execution fails
+	// but is still counted
+	s.Require().Equal(int64(0), s.queryContract(blockContextContract))
+
+	contract, err = clockKeeper.GetClockContract(s.Ctx, blockContextContract)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), contract.ExecutionCount)
+	s.Require().True(contract.IsJailed)
+	s.Require().Contains(contract.LastError, "unknown field")
+}
+
+// Test the execution stats are recorded, queryable and emitted as typed events.
+func (s *KeeperTestSuite) TestEndBlockerExecutionStats() {
+	// Setup test
+	clockKeeper := s.App.AppKeepers.ClockKeeper
+	s.StoreCode(clockContract)
+	contractAddress := s.registerContract()
+	failingContract := s.registerContract()
+
+	// The example contract does not accept the block context, so it fails
+	contract, err := clockKeeper.GetClockContract(s.Ctx, failingContract)
+	s.Require().NoError(err)
+	contract.BlockContext = true
+	s.Require().NoError(clockKeeper.SetClockContract(s.Ctx, *contract))
+
+	// Execute both contracts, the failing contract is jailed
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(keeper.EndBlocker(ctx, clockKeeper))
+
+	// Successful contract
+	resp, err := s.queryClient.ClockContractStats(s.Ctx, &types.QueryClockContractStatsRequest{
+		ContractAddress: contractAddress,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), resp.Stats.TotalExecutions)
+	s.Require().Equal(s.Ctx.BlockHeight(), resp.Stats.LastSuccessHeight)
+	s.Require().Zero(resp.Stats.LastFailureHeight)
+	s.Require().Empty(resp.Stats.LastError)
+	s.Require().NotZero(resp.Stats.LastGasUsed)
+	s.Require().Equal(resp.Stats.LastGasUsed, resp.Stats.TotalGasUsed)
+
+	// Failed contract
+	resp, err = s.queryClient.ClockContractStats(s.Ctx, &types.QueryClockContractStatsRequest{
+		ContractAddress: failingContract,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), resp.Stats.TotalExecutions)
+	s.Require().Zero(resp.Stats.LastSuccessHeight)
+	s.Require().Equal(s.Ctx.BlockHeight(), resp.Stats.LastFailureHeight)
+	s.Require().NotEmpty(resp.Stats.LastError)
+	s.Require().True(resp.Stats.IsJailed)
+
+	// All stats are listed
+	statsResp, err := s.queryClient.ClockStats(s.Ctx, &types.QueryClockStatsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(statsResp.Stats, 2)
+
+	// An execution event is emitted for each contract, and a jail event for the failed one
+	executions, jailings := 0, 0
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case proto.MessageName(&types.EventClockExecution{}):
+			executions++
+		case proto.MessageName(&types.EventClockContractJailed{}):
+			jailings++
+		}
+	}
+	s.Require().Equal(2, executions)
+	s.Require().Equal(1, jailings)
+}
+
 // Test the endblocker with numerous contracts that all panic
 func (s *KeeperTestSuite) TestPerformance() {
 	s.StoreCode(burnContract)
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v29/x/clock/types"
+)
+
+// Set the number of transactions in the current block. The value is kept in the
+// transient store and is therefore reset at the end of every block.
+func (k Keeper) SetBlockTxCount(ctx context.Context, numTxs uint64) {
+	store := k.transientStoreService.OpenTransientStore(ctx)
+	_ = store.Set(types.BlockTxCountKey, sdk.Uint64ToBigEndian(numTxs))
+}
+
+// Get the number of transactions in the current block.
+func (k Keeper) GetBlockTxCount(ctx context.Context) uint64 {
+	store := k.transientStoreService.OpenTransientStore(ctx)
+	bz, err := store.Get(types.BlockTxCountKey)
+	if err != nil || len(bz) != 8 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// Build the sudo message, including the block context, for a contract which opted in.
+func (k Keeper) getBlockContextSudoMessage(ctx context.Context, phase types.ClockPhase, contract types.ClockContract) ([]byte, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	blockCtx := types.NewBlockContext(
+		sdkCtx.BlockHeight(),
+		sdkCtx.BlockTime(),
+		k.GetBlockTxCount(ctx),
+		sdk.ConsAddress(sdkCtx.BlockHeader().ProposerAddress).String(),
+		contract.ExecutionCount,
+	)
+
+	return types.NewBlockContextSudoMessage(phase, blockCtx)
+}
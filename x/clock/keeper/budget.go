@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/CosmosContracts/juno/v29/x/clock/types"
+)
+
+// SetBudgetState sets the block gas usage and round-robin cursors of the module.
+func (k Keeper) SetBudgetState(ctx context.Context, state types.ClockBudgetState) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&state)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.BudgetStateKey, bz)
+}
+
+// GetBudgetState returns the block gas usage and round-robin cursors of the module.
+func (k Keeper) GetBudgetState(ctx context.Context) (state types.ClockBudgetState) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.BudgetStateKey)
+	if bz == nil || err != nil {
+		return state
+	}
+
+	k.cdc.MustUnmarshal(bz, &state)
+	return state
+}
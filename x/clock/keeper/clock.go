@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
@@ -126,6 +128,81 @@ func (k Keeper) GetPaginatedContracts(ctx context.Context, pag *query.PageReques
 	}, nil
 }
 
+// Get the execution schedule of all registered clock contracts
+func (k Keeper) GetPaginatedSchedules(ctx context.Context, pag *query.PageRequest) (*types.QueryClockScheduleResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := k.getStore(ctx)
+
+	// Filter and paginate all contracts
+	results, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		store,
+		pag,
+		func(_ []byte, value *types.ClockContract) (*types.ClockSchedule, error) {
+			return &types.ClockSchedule{
+				ContractAddress:     value.ContractAddress,
+				BlockInterval:       value.BlockInterval,
+				TimeInterval:        value.TimeInterval,
+				NextExecutionHeight: value.NextExecutionHeight,
+				NextExecutionTime:   value.NextExecutionTime,
+				IsDue:               !value.IsJailed && value.IsDue(sdkCtx.BlockHeight(), sdkCtx.BlockTime()),
+			}, nil
+		},
+		func() *types.ClockContract {
+			return &types.ClockContract{}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Dereference pointer array of schedules
+	var schedules []types.ClockSchedule
+	for _, schedule := range results {
+		schedules = append(schedules, *schedule)
+	}
+
+	// Return paginated schedules
+	return &types.QueryClockScheduleResponse{
+		Schedules:  schedules,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Get the execution stats of all registered clock contracts
+func (k Keeper) GetPaginatedStats(ctx context.Context, pag *query.PageRequest) (*types.QueryClockStatsResponse, error) {
+	store := k.getStore(ctx)
+
+	// Filter and paginate all contracts
+	results, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		store,
+		pag,
+		func(_ []byte, value *types.ClockContract) (*types.ClockExecutionStats, error) {
+			stats := value.ExecutionStats()
+			return &stats, nil
+		},
+		func() *types.ClockContract {
+			return &types.ClockContract{}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Dereference pointer array of stats
+	var stats []types.ClockExecutionStats
+	for _, stat := range results {
+		stats = append(stats, *stat)
+	}
+
+	// Return paginated stats
+	return &types.QueryClockStatsResponse{
+		Stats:      stats,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Remove a clock contract address from the KV store.
 func (k Keeper) RemoveContract(ctx context.Context, contractAddress string) {
 	store := k.getStore(ctx)
@@ -136,23 +213,66 @@ func (k Keeper) RemoveContract(ctx context.Context, contractAddress string) {
 	}
 }
 
-// Register a clock contract address in the KV store.
-func (k Keeper) RegisterContract(ctx context.Context, senderAddress string, contractAddress string) error {
+// Register a clock contract address in the KV store. The contract is executed every
+// block, unless a block or time interval is provided, in the given block phases. A gas
+// limit above the module's default requires the contract to pay for its gas from a deposit.
+// Contracts which opt in to the block context receive it in the sudo message.
+func (k Keeper) RegisterContract(
+	ctx context.Context,
+	senderAddress string,
+	contractAddress string,
+	blockInterval uint64,
+	timeInterval time.Duration,
+	phase types.ClockPhase,
+	gasLimit uint64,
+	blockContext bool,
+) error {
 	// Check if the contract is already registered
 	if k.IsClockContract(ctx, contractAddress) {
 		return globalerrors.ErrContractAlreadyRegistered
 	}
 
-	// Ensure the sender is the contract admin or creator
-	if ok, err := k.IsContractManager(ctx, senderAddress, contractAddress); !ok {
+	// Ensure the sender is the contract admin or creator, or the contract itself
+	if ok, err := k.isContractManagerOrSelf(ctx, senderAddress, contractAddress); !ok {
 		return err
 	}
 
+	// Ensure the interval and phase are valid
+	if err := types.ValidateInterval(blockInterval, timeInterval); err != nil {
+		return err
+	}
+	if err := types.ValidatePhase(phase); err != nil {
+		return err
+	}
+
+	// Ensure a custom gas limit is allowed
+	if gasLimit > 0 {
+		p := k.GetParams(ctx)
+		if _, ok := p.ParseGasDepositPrice(); !ok {
+			return errorsmod.Wrap(types.ErrInvalidGasLimit, "custom gas limits are disabled")
+		}
+		if gasLimit <= p.ContractGasLimit {
+			return errorsmod.Wrapf(types.ErrInvalidGasLimit, "custom gas limit must be above %d", p.ContractGasLimit)
+		}
+		if err := p.ValidateCustomGasLimit(gasLimit); err != nil {
+			return err
+		}
+	}
+
 	// Register contract
-	return k.SetClockContract(ctx, types.ClockContract{
-		ContractAddress: contractAddress,
-		IsJailed:        false,
-	})
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contract := types.NewClockContract(
+		contractAddress,
+		blockInterval,
+		timeInterval,
+		phase,
+		sdkCtx.BlockHeight(),
+		sdkCtx.BlockTime(),
+	)
+	contract.GasLimit = gasLimit
+	contract.BlockContext = blockContext
+
+	return k.SetClockContract(ctx, contract)
 }
 
 // Unregister a clock contract from either the jailed or unjailed KV store.
@@ -162,8 +282,23 @@ func (k Keeper) UnregisterContract(ctx context.Context, senderAddress string, co
 		return globalerrors.ErrContractNotRegistered
 	}
 
-	// Ensure the sender is the contract admin or creator
-	if ok, err := k.IsContractManager(ctx, senderAddress, contractAddress); !ok {
+	// Ensure the sender is the contract admin or creator, or the contract itself
+	if ok, err := k.isContractManagerOrSelf(ctx, senderAddress, contractAddress); !ok {
+		return err
+	}
+
+	// The gas of a clock execution is charged to the deposit after the execution, so the
+	// deposit cannot be refunded during it
+	if isExecutingContract(ctx, contractAddress) {
+		return types.ErrContractExecuting
+	}
+
+	// Refund the remaining gas deposit to the sender
+	contract, err := k.GetClockContract(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+	if err := k.refundGasDeposit(ctx, *contract, senderAddress); err != nil {
 		return err
 	}
 
@@ -190,23 +325,80 @@ func (k Keeper) SetJailStatus(ctx context.Context, contractAddress string, isJai
 	}
 
 	// Set the jail status
-	contract.IsJailed = isJailed
+	if isJailed {
+		contract.Jail(sdk.UnwrapSDKContext(ctx).BlockHeight())
+		k.emitJailEvent(ctx, *contract, "")
+	} else {
+		contract.Unjail()
+	}
 
 	// Set the contract
 	return k.SetClockContract(ctx, *contract)
 }
 
+// Jail a clock contract for the given reason, which is recorded as its last error.
+func (k Keeper) jailContract(ctx context.Context, contract types.ClockContract, reason error) error {
+	contract.LastError = reason.Error()
+	contract.Jail(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	k.emitJailEvent(ctx, contract, contract.LastError)
+
+	return k.SetClockContract(ctx, contract)
+}
+
+// Jail the unjailed contracts whose custom gas limit does not fit in an empty block, so
+// they are not skipped for the block gas limit in every block.
+func (k Keeper) jailContractsAboveBlockGasLimit(ctx context.Context, p types.Params) error {
+	if _, ok := p.ParseGasDepositPrice(); !ok {
+		return nil
+	}
+
+	contracts, err := k.GetAllContracts(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, contract := range contracts {
+		if contract.IsJailed || !contract.HasCustomGasLimit() {
+			continue
+		}
+
+		if err := p.ValidateCustomGasLimit(contract.GasLimit); err != nil {
+			if err := k.jailContract(ctx, contract, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Set the jail status of a clock contract by the sender address.
 func (k Keeper) SetJailStatusBySender(ctx context.Context, senderAddress string, contractAddress string, jailStatus bool) error {
-	// Ensure the sender is the contract admin or creator
-	if ok, err := k.IsContractManager(ctx, senderAddress, contractAddress); !ok {
+	// Ensure the sender is the contract admin or creator, or the contract itself
+	if ok, err := k.isContractManagerOrSelf(ctx, senderAddress, contractAddress); !ok {
 		return err
 	}
 
 	return k.SetJailStatus(ctx, contractAddress, jailStatus)
 }
 
-// Check if the sender is the designated contract manager for the FeePay contract. If
+// Check if the sender is the designated contract manager for the clock contract, or
+// the contract itself. Contracts can only manage their own registration and jail
+// status, not their gas deposit.
+func (k Keeper) isContractManagerOrSelf(ctx context.Context, senderAddress string, contractAddress string) (bool, error) {
+	if senderAddress == contractAddress {
+		// Ensure the contract is a cosm wasm contract
+		if ok := k.wasmKeeper.HasContractInfo(ctx, sdk.MustAccAddressFromBech32(contractAddress)); !ok {
+			return false, globalerrors.ErrInvalidCWContract
+		}
+
+		return true, nil
+	}
+
+	return k.IsContractManager(ctx, senderAddress, contractAddress)
+}
+
+// Check if the sender is the designated contract manager for the clock contract. If
 // an admin is present, they are considered the manager. If there is no admin, the
 // contract creator is considered the manager.
 func (k Keeper) IsContractManager(ctx context.Context, senderAddress string, contractAddress string) (bool, error) {
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmosContracts/juno/v29/x/clock/types"
+)
+
+// Deposit funds from the sender to pay for the gas of a clock contract with a custom gas limit.
+func (k Keeper) DepositGas(ctx context.Context, senderAddress string, contractAddress string, amount sdk.Coin) error {
+	// Get the contract
+	contract, err := k.GetClockContract(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	// Only contracts with a custom gas limit use a deposit
+	if !contract.HasCustomGasLimit() {
+		return types.ErrNoCustomGasLimit
+	}
+
+	// Ensure the deposit is valid
+	if err := k.validateGasDepositAmount(amount); err != nil {
+		return err
+	}
+
+	// Transfer funds from the sender to the module
+	senderAddr, err := sdk.AccAddressFromBech32(senderAddress)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	// Increase the deposit of the contract
+	contract.GasDeposit = sdk.NewCoin(k.bondDenom, contract.GetGasDepositAmount().Add(amount.Amount))
+	return k.SetClockContract(ctx, *contract)
+}
+
+// Withdraw funds from the gas deposit of a clock contract to the contract manager.
+func (k Keeper) WithdrawGas(ctx context.Context, senderAddress string, contractAddress string, amount sdk.Coin) error {
+	// Get the contract
+	contract, err := k.GetClockContract(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	// Ensure the sender is the contract admin or creator
+	if ok, err := k.IsContractManager(ctx, senderAddress, contractAddress); !ok {
+		return err
+	}
+
+	// Ensure the withdrawal is valid and covered by the deposit
+	if err := k.validateGasDepositAmount(amount); err != nil {
+		return err
+	}
+	if contract.GetGasDepositAmount().LT(amount.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientDeposit, "deposit %s is less than %s", contract.GetGasDepositAmount(), amount.Amount)
+	}
+
+	// Decrease the deposit of the contract
+	contract.GasDeposit = sdk.NewCoin(k.bondDenom, contract.GetGasDepositAmount().Sub(amount.Amount))
+	if err := k.SetClockContract(ctx, *contract); err != nil {
+		return err
+	}
+
+	// Transfer funds from the module to the sender
+	senderAddr, err := sdk.AccAddressFromBech32(senderAddress)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, senderAddr, sdk.NewCoins(amount))
+}
+
+// Refund the entire gas deposit of a clock contract to the recipient.
+func (k Keeper) refundGasDeposit(ctx context.Context, contract types.ClockContract, recipientAddress string) error {
+	deposit := contract.GetGasDepositAmount()
+	if !deposit.IsPositive() {
+		return nil
+	}
+
+	recipientAddr, err := sdk.AccAddressFromBech32(recipientAddress)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, sdk.NewCoins(sdk.NewCoin(k.bondDenom, deposit)))
+}
+
+// Charge the gas used by a clock contract to its deposit, sending the funds to the fee collector.
+func (k Keeper) chargeGasDeposit(ctx context.Context, contract *types.ClockContract, cost sdk.Coin) error {
+	if !cost.IsPositive() {
+		return nil
+	}
+
+	// Never charge more than the remaining deposit
+	if contract.GetGasDepositAmount().LT(cost.Amount) {
+		cost.Amount = contract.GetGasDepositAmount()
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, sdk.NewCoins(cost)); err != nil {
+		return err
+	}
+
+	contract.GasDeposit = sdk.NewCoin(k.bondDenom, contract.GetGasDepositAmount().Sub(cost.Amount))
+	return nil
+}
+
+// Ensure a gas deposit amount is positive and in the bond denom.
+func (k Keeper) validateGasDepositAmount(amount sdk.Coin) error {
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount: %s", amount)
+	}
+
+	if amount.Denom != k.bondDenom {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "deposit must be in %s, got %s", k.bondDenom, amount.Denom)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v29/x/clock/types"
+)
+
+// Emit an event for the execution of a clock contract.
+func (k Keeper) emitExecutionEvent(ctx context.Context, contract types.ClockContract, phase types.ClockPhase, gasUsed uint64, execErr error) {
+	event := &types.EventClockExecution{
+		ContractAddress: contract.ContractAddress,
+		Phase:           phase,
+		Success:         execErr == nil,
+		GasUsed:         gasUsed,
+		ExecutionCount:  contract.ExecutionCount,
+	}
+	if execErr != nil {
+		event.Error = contract.LastError
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("Failed to emit execution event", "contract", contract.ContractAddress, "error", err)
+	}
+}
+
+// Emit an event for the jailing of a clock contract.
+func (k Keeper) emitJailEvent(ctx context.Context, contract types.ClockContract, reason string) {
+	event := &types.EventClockContractJailed{
+		ContractAddress: contract.ContractAddress,
+		JailHeight:      contract.JailHeight,
+		Error:           reason,
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("Failed to emit jail event", "contract", contract.ContractAddress, "error", err)
+	}
+}
@@ -46,6 +46,54 @@ func (q queryServer) ClockContract(ctx context.Context, req *types.QueryClockCon
 	}, nil
 }
 
+// ClockSchedule returns the execution schedule of all clock contracts
+func (q queryServer) ClockSchedule(ctx context.Context, req *types.QueryClockScheduleRequest) (*types.QueryClockScheduleResponse, error) {
+	schedules, err := q.k.GetPaginatedSchedules(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// SkippedClockContracts returns the contracts delayed in the latest block because of the block gas limit
+func (q queryServer) SkippedClockContracts(ctx context.Context, _ *types.QuerySkippedClockContractsRequest) (*types.QuerySkippedClockContractsResponse, error) {
+	state := q.k.GetBudgetState(ctx)
+
+	return &types.QuerySkippedClockContractsResponse{
+		Height:           state.Height,
+		GasUsed:          state.GasUsed,
+		SkippedContracts: state.SkippedContracts,
+	}, nil
+}
+
+// ClockContractStats returns the execution stats of a clock contract
+func (q queryServer) ClockContractStats(ctx context.Context, req *types.QueryClockContractStatsRequest) (*types.QueryClockContractStatsResponse, error) {
+	// Ensure the contract address is valid
+	if _, err := sdk.AccAddressFromBech32(req.ContractAddress); err != nil {
+		return nil, globalerrors.ErrInvalidAddress
+	}
+
+	contract, err := q.k.GetClockContract(ctx, req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClockContractStatsResponse{
+		Stats: contract.ExecutionStats(),
+	}, nil
+}
+
+// ClockStats returns the execution stats of all clock contracts
+func (q queryServer) ClockStats(ctx context.Context, req *types.QueryClockStatsRequest) (*types.QueryClockStatsResponse, error) {
+	stats, err := q.k.GetPaginatedStats(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
 // Params returns the total set of clock parameters.
 func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	p := q.k.GetParams(ctx)
@@ -11,34 +11,48 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	"github.com/CosmosContracts/juno/v29/x/clock/types"
 )
 
 // Keeper of the clock store
 type Keeper struct {
-	cdc          codec.BinaryCodec
-	storeService storetypes.KVStoreService
+	cdc                   codec.BinaryCodec
+	storeService          storetypes.KVStoreService
+	transientStoreService storetypes.TransientStoreService
 
+	bankKeeper     bankkeeper.Keeper
 	wasmKeeper     wasmkeeper.Keeper
 	contractKeeper wasmtypes.ContractOpsKeeper
 
+	bondDenom        string
+	feeCollectorName string
+
 	authority string
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	ss storetypes.KVStoreService,
+	tss storetypes.TransientStoreService,
+	bankKeeper bankkeeper.Keeper,
 	wasmKeeper wasmkeeper.Keeper,
 	contractKeeper wasmtypes.ContractOpsKeeper,
+	bondDenom string,
+	feeCollectorName string,
 	authority string,
 ) Keeper {
 	return Keeper{
-		cdc:            cdc,
-		storeService:   ss,
-		wasmKeeper:     wasmKeeper,
-		contractKeeper: contractKeeper,
-		authority:      authority,
+		cdc:                   cdc,
+		storeService:          ss,
+		transientStoreService: tss,
+		bankKeeper:            bankKeeper,
+		wasmKeeper:            wasmKeeper,
+		contractKeeper:        contractKeeper,
+		bondDenom:             bondDenom,
+		feeCollectorName:      feeCollectorName,
+		authority:             authority,
 	}
 }
 
@@ -37,7 +37,7 @@ var burnContract []byte
 
 // Helper method for quickly registering a clock contract
 func (s *KeeperTestSuite) RegisterClockContract(senderAddress string, contractAddress string) {
-	err := s.App.AppKeepers.ClockKeeper.RegisterContract(s.Ctx, senderAddress, contractAddress)
+	err := s.App.AppKeepers.ClockKeeper.RegisterContract(s.Ctx, senderAddress, contractAddress, 0, 0, types.ClockPhaseUnspecified, 0, false)
 	s.Require().NoError(err)
 }
 
@@ -32,7 +32,27 @@ func (k msgServer) RegisterClockContract(ctx context.Context, req *types.MsgRegi
 		return nil, err
 	}
 
-	return &types.MsgRegisterClockContractResponse{}, k.RegisterContract(ctx, req.SenderAddress, req.ContractAddress)
+	if err := k.RegisterContract(
+		ctx,
+		req.SenderAddress,
+		req.ContractAddress,
+		req.BlockInterval,
+		req.TimeInterval,
+		req.Phase,
+		req.GasLimit,
+		req.BlockContext,
+	); err != nil {
+		return nil, err
+	}
+
+	// Lock the initial gas deposit of contracts with a custom gas limit
+	if req.GasLimit > 0 {
+		if err := k.DepositGas(ctx, req.SenderAddress, req.ContractAddress, req.GasDeposit); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgRegisterClockContractResponse{}, nil
 }
 
 // UnregisterClockContract handles incoming transactions to unregister clock contracts.
@@ -59,6 +79,26 @@ func (k msgServer) UnjailClockContract(goCtx context.Context, req *types.MsgUnja
 	return &types.MsgUnjailClockContractResponse{}, k.SetJailStatusBySender(ctx, req.SenderAddress, req.ContractAddress, false)
 }
 
+// DepositClockGas handles incoming transactions to top up the gas deposit of clock contracts.
+func (k msgServer) DepositClockGas(ctx context.Context, req *types.MsgDepositClockGas) (*types.MsgDepositClockGasResponse, error) {
+	// Validate request
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositClockGasResponse{}, k.DepositGas(ctx, req.SenderAddress, req.ContractAddress, req.Amount)
+}
+
+// WithdrawClockGas handles incoming transactions to withdraw from the gas deposit of clock contracts.
+func (k msgServer) WithdrawClockGas(ctx context.Context, req *types.MsgWithdrawClockGas) (*types.MsgWithdrawClockGasResponse, error) {
+	// Validate request
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawClockGasResponse{}, k.WithdrawGas(ctx, req.SenderAddress, req.ContractAddress, req.Amount)
+}
+
 func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.authority != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
@@ -69,5 +109,10 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 		return nil, err
 	}
 
+	// Contracts whose custom gas limit no longer fits in a block could never execute
+	if err := k.jailContractsAboveBlockGasLimit(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
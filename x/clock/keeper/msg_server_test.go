@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	_ "embed"
 
 	sdkmath "cosmossdk.io/math"
@@ -8,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	globalerrors "github.com/CosmosContracts/juno/v29/app/helpers"
 	"github.com/CosmosContracts/juno/v29/x/clock/types"
 )
 
@@ -23,11 +26,14 @@ func (s *KeeperTestSuite) TestRegisterClockContract() {
 	contractAddressWithAdmin := s.InstantiateContract(addr.String(), addr2.String(), clockContract)
 
 	for _, tc := range []struct {
-		desc     string
-		sender   string
-		contract string
-		isJailed bool
-		success  bool
+		desc          string
+		sender        string
+		contract      string
+		blockInterval uint64
+		timeInterval  time.Duration
+		phase         types.ClockPhase
+		isJailed      bool
+		success       bool
 	}{
 		{
 			desc:     "Success - Register Contract",
@@ -35,6 +41,49 @@ func (s *KeeperTestSuite) TestRegisterClockContract() {
 			contract: contractAddress,
 			success:  true,
 		},
+		{
+			desc:          "Success - Register Contract With Block Interval",
+			sender:        addr.String(),
+			contract:      contractAddress,
+			blockInterval: 10,
+			success:       true,
+		},
+		{
+			desc:         "Success - Register Contract With Time Interval",
+			sender:       addr.String(),
+			contract:     contractAddress,
+			timeInterval: time.Hour,
+			success:      true,
+		},
+		{
+			desc:     "Success - Register Contract In Begin And End Block",
+			sender:   addr.String(),
+			contract: contractAddress,
+			phase:    types.ClockPhaseBeginAndEndBlock,
+			success:  true,
+		},
+		{
+			desc:     "Fail - Register Contract With Unknown Phase",
+			sender:   addr.String(),
+			contract: contractAddress,
+			phase:    types.ClockPhase(10),
+			success:  false,
+		},
+		{
+			desc:          "Fail - Register Contract With Block And Time Interval",
+			sender:        addr.String(),
+			contract:      contractAddress,
+			blockInterval: 10,
+			timeInterval:  time.Hour,
+			success:       false,
+		},
+		{
+			desc:         "Fail - Register Contract With Negative Time Interval",
+			sender:       addr.String(),
+			contract:     contractAddress,
+			timeInterval: -time.Hour,
+			success:      false,
+		},
 		{
 			desc:     "Success - Register Contract With Admin",
 			sender:   addr2.String(),
@@ -91,6 +140,9 @@ func (s *KeeperTestSuite) TestRegisterClockContract() {
 			res, err := s.msgServer.RegisterClockContract(s.Ctx, &types.MsgRegisterClockContract{
 				SenderAddress:   tc.sender,
 				ContractAddress: tc.contract,
+				BlockInterval:   tc.blockInterval,
+				TimeInterval:    tc.timeInterval,
+				Phase:           tc.phase,
 			})
 
 			if !tc.success {
@@ -323,3 +375,130 @@ func (s *KeeperTestSuite) TestUnjailClockContract() {
 		})
 	}
 }
+
+// Test registering a clock contract with a custom gas limit, and depositing and
+// withdrawing gas.
+func (s *KeeperTestSuite) TestClockGasDeposit() {
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	s.FundAcc(addr, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000)), sdk.NewCoin("ujuno", sdkmath.NewInt(1_000_000))))
+	s.FundAcc(addr2, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_000_000))))
+
+	s.StoreCode(clockContract)
+	contractAddress := s.InstantiateContract(addr.String(), "", clockContract)
+	clockKeeper := s.App.AppKeepers.ClockKeeper
+
+	// Custom gas limits are disabled by default
+	_, err := s.msgServer.RegisterClockContract(s.Ctx, &types.MsgRegisterClockContract{
+		SenderAddress:   addr.String(),
+		ContractAddress: contractAddress,
+		GasLimit:        1_000_000,
+		GasDeposit:      sdk.NewCoin("ujuno", sdkmath.NewInt(1_000)),
+	})
+	s.Require().Error(err)
+
+	// Enable custom gas limits
+	params := types.DefaultParams()
+	params.GasDepositPrice = "0.001"
+	s.Require().NoError(clockKeeper.SetParams(s.Ctx, params))
+
+	// A custom gas limit must be above the default
+	_, err = s.msgServer.RegisterClockContract(s.Ctx, &types.MsgRegisterClockContract{
+		SenderAddress:   addr.String(),
+		ContractAddress: contractAddress,
+		GasLimit:        params.ContractGasLimit,
+		GasDeposit:      sdk.NewCoin("ujuno", sdkmath.NewInt(1_000)),
+	})
+	s.Require().Error(err)
+
+	// A custom gas limit must fit in the block gas limit
+	params.BlockGasLimit = 2 * params.ContractGasLimit
+	s.Require().NoError(clockKeeper.SetParams(s.Ctx, params))
+	_, err = s.msgServer.RegisterClockContract(s.Ctx, &types.MsgRegisterClockContract{
+		SenderAddress:   addr.String(),
+		ContractAddress: contractAddress,
+		GasLimit:        params.BlockGasLimit + 1,
+		GasDeposit:      sdk.NewCoin("ujuno", sdkmath.NewInt(1_000)),
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidGasLimit)
+
+	params.BlockGasLimit = 0
+	s.Require().NoError(clockKeeper.SetParams(s.Ctx, params))
+
+	// A custom gas limit requires a deposit
+	_, err = s.msgServer.RegisterClockContract(s.Ctx, &types.MsgRegisterClockContract{
+		SenderAddress:   addr.String(),
+		ContractAddress: contractAddress,
+		GasLimit:        1_000_000,
+	})
+	s.Require().Error(err)
+
+	// Register with a deposit
+	_, err = s.msgServer.RegisterClockContract(s.Ctx, &types.MsgRegisterClockContract{
+		SenderAddress:   addr.String(),
+		ContractAddress: contractAddress,
+		GasLimit:        1_000_000,
+		GasDeposit:      sdk.NewCoin("ujuno", sdkmath.NewInt(1_000)),
+	})
+	s.Require().NoError(err)
+
+	// Anyone can top up the deposit
+	_, err = s.msgServer.DepositClockGas(s.Ctx, &types.MsgDepositClockGas{
+		SenderAddress:   addr2.String(),
+		ContractAddress: contractAddress,
+		Amount:          sdk.NewCoin("ujuno", sdkmath.NewInt(500)),
+	})
+	s.Require().NoError(err)
+
+	// Deposits must be in the bond denom
+	_, err = s.msgServer.DepositClockGas(s.Ctx, &types.MsgDepositClockGas{
+		SenderAddress:   addr2.String(),
+		ContractAddress: contractAddress,
+		Amount:          sdk.NewCoin("other", sdkmath.NewInt(500)),
+	})
+	s.Require().Error(err)
+
+	contract, err := clockKeeper.GetClockContract(s.Ctx, contractAddress)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1_000_000), contract.GasLimit)
+	s.Require().Equal(sdkmath.NewInt(1_500), contract.GasDeposit.Amount)
+
+	// Only the contract manager can withdraw, not even the contract itself
+	_, err = s.msgServer.WithdrawClockGas(s.Ctx, &types.MsgWithdrawClockGas{
+		SenderAddress:   addr2.String(),
+		ContractAddress: contractAddress,
+		Amount:          sdk.NewCoin("ujuno", sdkmath.NewInt(500)),
+	})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.WithdrawClockGas(s.Ctx, &types.MsgWithdrawClockGas{
+		SenderAddress:   contractAddress,
+		ContractAddress: contractAddress,
+		Amount:          sdk.NewCoin("ujuno", sdkmath.NewInt(500)),
+	})
+	s.Require().ErrorIs(err, globalerrors.ErrContractNotCreator)
+
+	// Cannot withdraw more than the deposit
+	_, err = s.msgServer.WithdrawClockGas(s.Ctx, &types.MsgWithdrawClockGas{
+		SenderAddress:   addr.String(),
+		ContractAddress: contractAddress,
+		Amount:          sdk.NewCoin("ujuno", sdkmath.NewInt(2_000)),
+	})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.WithdrawClockGas(s.Ctx, &types.MsgWithdrawClockGas{
+		SenderAddress:   addr.String(),
+		ContractAddress: contractAddress,
+		Amount:          sdk.NewCoin("ujuno", sdkmath.NewInt(500)),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdkmath.NewInt(999_500), s.App.AppKeepers.BankKeeper.GetBalance(s.Ctx, addr, "ujuno").Amount)
+
+	// Unregistering refunds the remaining deposit
+	_, err = s.msgServer.UnregisterClockContract(s.Ctx, &types.MsgUnregisterClockContract{
+		SenderAddress:   addr.String(),
+		ContractAddress: contractAddress,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdkmath.NewInt(1_000_500), s.App.AppKeepers.BankKeeper.GetBalance(s.Ctx, addr, "ujuno").Amount)
+}
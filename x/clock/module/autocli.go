@@ -25,6 +25,29 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "contract_address"},
 					},
 				},
+				{
+					RpcMethod: "ClockSchedule",
+					Use:       "schedule",
+					Short:     "Show the execution schedule of all clock contracts",
+				},
+				{
+					RpcMethod: "SkippedClockContracts",
+					Use:       "skipped",
+					Short:     "Show contracts delayed in the latest block because of the block gas limit",
+				},
+				{
+					RpcMethod: "ClockContractStats",
+					Use:       "contract-stats [contract_address]",
+					Short:     "Get the execution stats of a contract by address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+					},
+				},
+				{
+					RpcMethod: "ClockStats",
+					Use:       "stats",
+					Short:     "Show the execution stats of all clock contracts",
+				},
 				{
 					RpcMethod: "Params",
 					Use:       "params",
@@ -40,6 +63,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "RegisterClockContract",
 					Use:       "register [sender_address] [contract_address]",
 					Short:     "Register a clock contract.",
+					Long:      "Register a clock contract. Use --block-interval or --time-interval to execute the contract less often than every block. Use --gas-limit with --gas-deposit to pay for a custom gas limit. Use --block-context to receive the block height, time, number of transactions, proposer and execution count in the sudo message.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "sender_address"},
 						{ProtoField: "contract_address"},
@@ -63,6 +87,26 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "contract_address"},
 					},
 				},
+				{
+					RpcMethod: "DepositClockGas",
+					Use:       "deposit-gas [sender_address] [contract_address] [amount]",
+					Short:     "Top up the gas deposit of a clock contract with a custom gas limit.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender_address"},
+						{ProtoField: "contract_address"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "WithdrawClockGas",
+					Use:       "withdraw-gas [sender_address] [contract_address] [amount]",
+					Short:     "Withdraw from the gas deposit of a clock contract.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender_address"},
+						{ProtoField: "contract_address"},
+						{ProtoField: "amount"},
+					},
+				},
 				{
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
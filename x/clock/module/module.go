@@ -29,8 +29,9 @@ var (
 	_ module.HasGenesis     = AppModule{}
 	_ module.HasServices    = AppModule{}
 
-	_ appmodule.AppModule     = AppModule{}
-	_ appmodule.HasEndBlocker = AppModule{}
+	_ appmodule.AppModule       = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
+	_ appmodule.HasEndBlocker   = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the wasm module.
@@ -117,6 +118,10 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, marshaler codec.JSONCodec) js
 	return marshaler.MustMarshalJSON(genState)
 }
 
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return keeper.BeginBlocker(ctx, am.keeper)
+}
+
 func (am AppModule) EndBlock(ctx context.Context) error {
 	return keeper.EndBlocker(ctx, am.keeper)
 }
@@ -0,0 +1,219 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// MaxErrorLength is the maximum length of an execution error stored on a contract.
+const MaxErrorLength = 256
+
+// NewClockContract creates a new clock contract which is first due one interval
+// after the given height and time. Without an interval, the contract is due every block.
+func NewClockContract(
+	contractAddress string,
+	blockInterval uint64,
+	timeInterval time.Duration,
+	phase ClockPhase,
+	height int64,
+	blockTime time.Time,
+) ClockContract {
+	contract := ClockContract{
+		ContractAddress: contractAddress,
+		IsJailed:        false,
+		BlockInterval:   blockInterval,
+		TimeInterval:    timeInterval,
+		Phase:           phase,
+	}
+	contract.ScheduleNextExecution(height, blockTime)
+
+	return contract
+}
+
+// IsDue returns true if the contract should be executed at the given height and time.
+func (c ClockContract) IsDue(height int64, blockTime time.Time) bool {
+	if c.BlockInterval > 0 && height < c.NextExecutionHeight {
+		return false
+	}
+
+	if c.TimeInterval > 0 && blockTime.Before(c.NextExecutionTime) {
+		return false
+	}
+
+	return true
+}
+
+// ExecutesIn returns true if the contract is executed in the given block phase,
+// which must be either ClockPhaseBeginBlock or ClockPhaseEndBlock.
+func (c ClockContract) ExecutesIn(phase ClockPhase) bool {
+	switch c.Phase {
+	case ClockPhaseBeginAndEndBlock:
+		return true
+	case ClockPhaseBeginBlock:
+		return phase == ClockPhaseBeginBlock
+	default:
+		return phase == ClockPhaseEndBlock
+	}
+}
+
+// IsLastPhase returns true if the given block phase is the last one in which the
+// contract is executed for a block. The schedule is only advanced after this phase.
+func (c ClockContract) IsLastPhase(phase ClockPhase) bool {
+	if c.Phase == ClockPhaseBeginBlock {
+		return phase == ClockPhaseBeginBlock
+	}
+
+	return phase == ClockPhaseEndBlock
+}
+
+// ScheduleNextExecution moves the next execution of the contract one interval
+// past the given height and time.
+func (c *ClockContract) ScheduleNextExecution(height int64, blockTime time.Time) {
+	if c.BlockInterval > 0 {
+		c.NextExecutionHeight = height + int64(c.BlockInterval)
+	}
+
+	if c.TimeInterval > 0 {
+		c.NextExecutionTime = blockTime.Add(c.TimeInterval)
+	}
+}
+
+// HasCustomGasLimit returns true if the contract pays for its own gas from its deposit.
+func (c ClockContract) HasCustomGasLimit() bool {
+	return c.GasLimit > 0
+}
+
+// GetGasDepositAmount returns the amount of the gas deposit of the contract.
+func (c ClockContract) GetGasDepositAmount() math.Int {
+	if c.GasDeposit.Amount.IsNil() {
+		return math.ZeroInt()
+	}
+
+	return c.GasDeposit.Amount
+}
+
+// GasDepositCost returns the cost of the given amount of gas at the given price, rounded up.
+func GasDepositCost(gas uint64, price math.LegacyDec) math.Int {
+	return price.MulInt(math.NewIntFromUint64(gas)).Ceil().TruncateInt()
+}
+
+// Jail jails the contract at the given height.
+func (c *ClockContract) Jail(height int64) {
+	c.IsJailed = true
+	c.JailHeight = height
+}
+
+// Unjail unjails the contract and resets its strikes.
+func (c *ClockContract) Unjail() {
+	c.IsJailed = false
+	c.JailHeight = 0
+	c.FailureCount = 0
+}
+
+// IsJailExpired returns true if the contract has been jailed for at least the
+// given number of blocks. A zero jail duration never expires.
+func (c ClockContract) IsJailExpired(height int64, jailDuration uint64) bool {
+	if !c.IsJailed || jailDuration == 0 {
+		return false
+	}
+
+	return height >= c.JailHeight+int64(jailDuration)
+}
+
+// RecordFailure records a failed execution. The contract is jailed once it has
+// failed more than maxStrikes times in a row. Returns true if the contract was jailed.
+func (c *ClockContract) RecordFailure(err error, height int64, maxStrikes uint64) bool {
+	c.LastError = err.Error()
+	if len(c.LastError) > MaxErrorLength {
+		c.LastError = c.LastError[:MaxErrorLength]
+	}
+
+	c.LastFailureHeight = height
+	c.FailureCount++
+	if c.FailureCount > maxStrikes {
+		c.Jail(height)
+		return true
+	}
+
+	return false
+}
+
+// RecordSuccess records a successful execution and resets the strikes of the contract.
+func (c *ClockContract) RecordSuccess(height int64) {
+	c.LastSuccessHeight = height
+	c.FailureCount = 0
+}
+
+// RecordGasUsed records the gas used by an execution.
+func (c *ClockContract) RecordGasUsed(gasUsed uint64) {
+	c.LastGasUsed = gasUsed
+	c.TotalGasUsed += gasUsed
+}
+
+// ExecutionStats returns the execution stats of the contract.
+func (c ClockContract) ExecutionStats() ClockExecutionStats {
+	return ClockExecutionStats{
+		ContractAddress:   c.ContractAddress,
+		TotalExecutions:   c.ExecutionCount,
+		LastSuccessHeight: c.LastSuccessHeight,
+		LastFailureHeight: c.LastFailureHeight,
+		LastError:         c.LastError,
+		LastGasUsed:       c.LastGasUsed,
+		TotalGasUsed:      c.TotalGasUsed,
+		FailureCount:      c.FailureCount,
+		IsJailed:          c.IsJailed,
+	}
+}
+
+// ValidateInterval ensures the execution interval of a contract is well formed.
+func ValidateInterval(blockInterval uint64, timeInterval time.Duration) error {
+	if timeInterval < 0 {
+		return ErrInvalidInterval.Wrapf("time interval cannot be negative: %s", timeInterval)
+	}
+
+	if blockInterval > 0 && timeInterval > 0 {
+		return ErrInvalidInterval.Wrap("cannot set both a block and a time interval")
+	}
+
+	return nil
+}
+
+// ValidatePhase ensures the block phase of a contract is known.
+func ValidatePhase(phase ClockPhase) error {
+	if _, ok := ClockPhase_name[int32(phase)]; !ok {
+		return ErrInvalidPhase.Wrapf("unknown phase: %d", phase)
+	}
+
+	return nil
+}
+
+// GetCursor returns the address of the first contract skipped in the given block phase,
+// which the next block starts executing from.
+func (s ClockBudgetState) GetCursor(phase ClockPhase) string {
+	if phase == ClockPhaseBeginBlock {
+		return s.BeginBlockCursor
+	}
+
+	return s.EndBlockCursor
+}
+
+// SetCursor sets the address of the first contract skipped in the given block phase.
+func (s *ClockBudgetState) SetCursor(phase ClockPhase, contractAddress string) {
+	if phase == ClockPhaseBeginBlock {
+		s.BeginBlockCursor = contractAddress
+	} else {
+		s.EndBlockCursor = contractAddress
+	}
+}
+
+// StartBlock resets the gas usage and skipped contracts when the given height is a new block.
+func (s *ClockBudgetState) StartBlock(height int64) {
+	if s.Height == height {
+		return
+	}
+
+	s.Height = height
+	s.GasUsed = 0
+	s.SkippedContracts = nil
+}
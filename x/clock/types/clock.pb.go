@@ -6,16 +6,23 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,13 +30,86 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// This object is used to store the contract address and the
-// jail status of the contract.
+// ClockPhase defines the block phases in which a clock contract is executed.
+type ClockPhase int32
+
+const (
+	// CLOCK_PHASE_UNSPECIFIED defaults to executing at the end of the block.
+	ClockPhaseUnspecified ClockPhase = 0
+	// CLOCK_PHASE_END_BLOCK executes the contract at the end of the block.
+	ClockPhaseEndBlock ClockPhase = 1
+	// CLOCK_PHASE_BEGIN_BLOCK executes the contract at the beginning of the block.
+	ClockPhaseBeginBlock ClockPhase = 2
+	// CLOCK_PHASE_BEGIN_AND_END_BLOCK executes the contract at both the
+	// beginning and the end of the block.
+	ClockPhaseBeginAndEndBlock ClockPhase = 3
+)
+
+var ClockPhase_name = map[int32]string{
+	0: "CLOCK_PHASE_UNSPECIFIED",
+	1: "CLOCK_PHASE_END_BLOCK",
+	2: "CLOCK_PHASE_BEGIN_BLOCK",
+	3: "CLOCK_PHASE_BEGIN_AND_END_BLOCK",
+}
+
+var ClockPhase_value = map[string]int32{
+	"CLOCK_PHASE_UNSPECIFIED":         0,
+	"CLOCK_PHASE_END_BLOCK":           1,
+	"CLOCK_PHASE_BEGIN_BLOCK":         2,
+	"CLOCK_PHASE_BEGIN_AND_END_BLOCK": 3,
+}
+
+func (x ClockPhase) String() string {
+	return proto.EnumName(ClockPhase_name, int32(x))
+}
+
+func (ClockPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae7dc6f78089f30c, []int{0}
+}
+
+// This object is used to store the contract address, the
+// jail status and the execution schedule of the contract.
 type ClockContract struct {
 	// The address of the contract.
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	// The jail status of the contract.
 	IsJailed bool `protobuf:"varint,2,opt,name=is_jailed,json=isJailed,proto3" json:"is_jailed,omitempty"`
+	// The number of blocks between executions. Zero means every block.
+	BlockInterval uint64 `protobuf:"varint,3,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// The minimum block time between executions. Zero means no time interval.
+	TimeInterval time.Duration `protobuf:"bytes,4,opt,name=time_interval,json=timeInterval,proto3,stdduration" json:"time_interval"`
+	// The height at which the contract is next due for execution.
+	NextExecutionHeight int64 `protobuf:"varint,5,opt,name=next_execution_height,json=nextExecutionHeight,proto3" json:"next_execution_height,omitempty"`
+	// The block time at which the contract is next due for execution.
+	NextExecutionTime time.Time `protobuf:"bytes,6,opt,name=next_execution_time,json=nextExecutionTime,proto3,stdtime" json:"next_execution_time"`
+	// The block phases in which the contract is executed.
+	Phase ClockPhase `protobuf:"varint,7,opt,name=phase,proto3,enum=juno.clock.v1.ClockPhase" json:"phase,omitempty"`
+	// The error of the last failed execution.
+	LastError string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// The number of consecutive failed executions. Reset on a successful
+	// execution or when the contract is unjailed.
+	FailureCount uint64 `protobuf:"varint,9,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	// The height at which the contract was jailed.
+	JailHeight int64 `protobuf:"varint,10,opt,name=jail_height,json=jailHeight,proto3" json:"jail_height,omitempty"`
+	// The custom gas limit of the contract. Zero uses the module's
+	// contract_gas_limit.
+	GasLimit uint64 `protobuf:"varint,11,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// The deposit which pays for the gas used by the contract when it has a
+	// custom gas limit.
+	GasDeposit types.Coin `protobuf:"bytes,12,opt,name=gas_deposit,json=gasDeposit,proto3" json:"gas_deposit"`
+	// Whether the sudo message includes the block context. Contracts which do
+	// not opt in receive the empty clock message.
+	BlockContext bool `protobuf:"varint,13,opt,name=block_context,json=blockContext,proto3" json:"block_context,omitempty"`
+	// The number of times the contract has been executed.
+	ExecutionCount uint64 `protobuf:"varint,14,opt,name=execution_count,json=executionCount,proto3" json:"execution_count,omitempty"`
+	// The height of the last successful execution.
+	LastSuccessHeight int64 `protobuf:"varint,15,opt,name=last_success_height,json=lastSuccessHeight,proto3" json:"last_success_height,omitempty"`
+	// The height of the last failed execution.
+	LastFailureHeight int64 `protobuf:"varint,16,opt,name=last_failure_height,json=lastFailureHeight,proto3" json:"last_failure_height,omitempty"`
+	// The gas used by the last execution.
+	LastGasUsed uint64 `protobuf:"varint,17,opt,name=last_gas_used,json=lastGasUsed,proto3" json:"last_gas_used,omitempty"`
+	// The total gas used by all executions.
+	TotalGasUsed uint64 `protobuf:"varint,18,opt,name=total_gas_used,json=totalGasUsed,proto3" json:"total_gas_used,omitempty"`
 }
 
 func (m *ClockContract) Reset()         { *m = ClockContract{} }
@@ -79,28 +159,327 @@ func (m *ClockContract) GetIsJailed() bool {
 	return false
 }
 
+func (m *ClockContract) GetBlockInterval() uint64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
+func (m *ClockContract) GetTimeInterval() time.Duration {
+	if m != nil {
+		return m.TimeInterval
+	}
+	return 0
+}
+
+func (m *ClockContract) GetNextExecutionHeight() int64 {
+	if m != nil {
+		return m.NextExecutionHeight
+	}
+	return 0
+}
+
+func (m *ClockContract) GetNextExecutionTime() time.Time {
+	if m != nil {
+		return m.NextExecutionTime
+	}
+	return time.Time{}
+}
+
+func (m *ClockContract) GetPhase() ClockPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return ClockPhaseUnspecified
+}
+
+func (m *ClockContract) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *ClockContract) GetFailureCount() uint64 {
+	if m != nil {
+		return m.FailureCount
+	}
+	return 0
+}
+
+func (m *ClockContract) GetJailHeight() int64 {
+	if m != nil {
+		return m.JailHeight
+	}
+	return 0
+}
+
+func (m *ClockContract) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *ClockContract) GetGasDeposit() types.Coin {
+	if m != nil {
+		return m.GasDeposit
+	}
+	return types.Coin{}
+}
+
+func (m *ClockContract) GetBlockContext() bool {
+	if m != nil {
+		return m.BlockContext
+	}
+	return false
+}
+
+func (m *ClockContract) GetExecutionCount() uint64 {
+	if m != nil {
+		return m.ExecutionCount
+	}
+	return 0
+}
+
+func (m *ClockContract) GetLastSuccessHeight() int64 {
+	if m != nil {
+		return m.LastSuccessHeight
+	}
+	return 0
+}
+
+func (m *ClockContract) GetLastFailureHeight() int64 {
+	if m != nil {
+		return m.LastFailureHeight
+	}
+	return 0
+}
+
+func (m *ClockContract) GetLastGasUsed() uint64 {
+	if m != nil {
+		return m.LastGasUsed
+	}
+	return 0
+}
+
+func (m *ClockContract) GetTotalGasUsed() uint64 {
+	if m != nil {
+		return m.TotalGasUsed
+	}
+	return 0
+}
+
+// ClockBudgetState tracks the gas used by clock contracts in the current block
+// and the round-robin position of each block phase.
+type ClockBudgetState struct {
+	// The height of the block the gas usage and skipped contracts belong to.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// The gas used by clock contracts in the block.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// The address of the first contract skipped at the beginning of a block.
+	BeginBlockCursor string `protobuf:"bytes,3,opt,name=begin_block_cursor,json=beginBlockCursor,proto3" json:"begin_block_cursor,omitempty"`
+	// The address of the first contract skipped at the end of a block.
+	EndBlockCursor string `protobuf:"bytes,4,opt,name=end_block_cursor,json=endBlockCursor,proto3" json:"end_block_cursor,omitempty"`
+	// The contracts which were due but skipped in the block because the block
+	// gas limit was reached.
+	SkippedContracts []SkippedClockContract `protobuf:"bytes,5,rep,name=skipped_contracts,json=skippedContracts,proto3" json:"skipped_contracts"`
+}
+
+func (m *ClockBudgetState) Reset()         { *m = ClockBudgetState{} }
+func (m *ClockBudgetState) String() string { return proto.CompactTextString(m) }
+func (*ClockBudgetState) ProtoMessage()    {}
+func (*ClockBudgetState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae7dc6f78089f30c, []int{1}
+}
+func (m *ClockBudgetState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClockBudgetState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClockBudgetState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClockBudgetState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClockBudgetState.Merge(m, src)
+}
+func (m *ClockBudgetState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClockBudgetState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClockBudgetState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClockBudgetState proto.InternalMessageInfo
+
+func (m *ClockBudgetState) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ClockBudgetState) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ClockBudgetState) GetBeginBlockCursor() string {
+	if m != nil {
+		return m.BeginBlockCursor
+	}
+	return ""
+}
+
+func (m *ClockBudgetState) GetEndBlockCursor() string {
+	if m != nil {
+		return m.EndBlockCursor
+	}
+	return ""
+}
+
+func (m *ClockBudgetState) GetSkippedContracts() []SkippedClockContract {
+	if m != nil {
+		return m.SkippedContracts
+	}
+	return nil
+}
+
+// SkippedClockContract is a contract which was due but not executed because
+// the block gas limit was reached. It is executed in a following block.
+type SkippedClockContract struct {
+	// The address of the contract.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The block phase in which the contract was skipped.
+	Phase ClockPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=juno.clock.v1.ClockPhase" json:"phase,omitempty"`
+}
+
+func (m *SkippedClockContract) Reset()         { *m = SkippedClockContract{} }
+func (m *SkippedClockContract) String() string { return proto.CompactTextString(m) }
+func (*SkippedClockContract) ProtoMessage()    {}
+func (*SkippedClockContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae7dc6f78089f30c, []int{2}
+}
+func (m *SkippedClockContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SkippedClockContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SkippedClockContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SkippedClockContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkippedClockContract.Merge(m, src)
+}
+func (m *SkippedClockContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *SkippedClockContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkippedClockContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SkippedClockContract proto.InternalMessageInfo
+
+func (m *SkippedClockContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *SkippedClockContract) GetPhase() ClockPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return ClockPhaseUnspecified
+}
+
 func init() {
+	proto.RegisterEnum("juno.clock.v1.ClockPhase", ClockPhase_name, ClockPhase_value)
 	proto.RegisterType((*ClockContract)(nil), "juno.clock.v1.ClockContract")
+	proto.RegisterType((*ClockBudgetState)(nil), "juno.clock.v1.ClockBudgetState")
+	proto.RegisterType((*SkippedClockContract)(nil), "juno.clock.v1.SkippedClockContract")
 }
 
 func init() { proto.RegisterFile("juno/clock/v1/clock.proto", fileDescriptor_ae7dc6f78089f30c) }
 
 var fileDescriptor_ae7dc6f78089f30c = []byte{
-	// 219 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcc, 0x2a, 0xcd, 0xcb,
-	0xd7, 0x4f, 0xce, 0xc9, 0x4f, 0xce, 0xd6, 0x2f, 0x33, 0x84, 0x30, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b,
-	0xf2, 0x85, 0x78, 0x41, 0x52, 0x7a, 0x10, 0x91, 0x32, 0x43, 0x29, 0xc9, 0xe4, 0xfc, 0xe2, 0xdc,
-	0xfc, 0xe2, 0x78, 0xb0, 0xa4, 0x3e, 0x84, 0x03, 0x51, 0xa9, 0x54, 0xc8, 0xc5, 0xeb, 0x0c, 0x52,
-	0xe6, 0x9c, 0x9f, 0x57, 0x52, 0x94, 0x98, 0x5c, 0x22, 0xe4, 0xcc, 0x25, 0x90, 0x0c, 0x65, 0xc7,
-	0x27, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49, 0x5c,
-	0xda, 0xa2, 0x2b, 0x02, 0xd5, 0xec, 0x08, 0x91, 0x09, 0x2e, 0x29, 0xca, 0xcc, 0x4b, 0x0f, 0xe2,
-	0x87, 0xe9, 0x80, 0x0a, 0x0b, 0x49, 0x73, 0x71, 0x66, 0x16, 0xc7, 0x67, 0x25, 0x66, 0xe6, 0xa4,
-	0xa6, 0x48, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x04, 0x71, 0x64, 0x16, 0x7b, 0x81, 0xf9, 0x4e, 0xee,
-	0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
-	0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9b, 0x9e, 0x59, 0x92, 0x51,
-	0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0xef, 0x0c, 0xb6, 0x08, 0xe6, 0xac, 0x62, 0x7d, 0xb0, 0x67,
-	0x2b, 0xa0, 0xde, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xc1, 0x18, 0x10, 0x00,
-	0x00, 0xff, 0xff, 0x11, 0x56, 0x0f, 0x60, 0x09, 0x01, 0x00, 0x00,
+	// 921 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xd3, 0xb4, 0x9b, 0x4c, 0x9a, 0x34, 0x9d, 0xb6, 0x8b, 0x93, 0x15, 0x8e, 0xd5, 0x05,
+	0x11, 0x21, 0xad, 0xad, 0x14, 0xc1, 0x99, 0xda, 0x49, 0xff, 0xc0, 0xaa, 0x54, 0xc9, 0x96, 0x03,
+	0x17, 0x6b, 0x62, 0x4f, 0xdd, 0xd9, 0x4d, 0x3c, 0x91, 0x67, 0x5c, 0x85, 0x6f, 0x80, 0x2a, 0x0e,
+	0x7b, 0xe4, 0xd2, 0x13, 0x27, 0xee, 0x7c, 0x88, 0x3d, 0xae, 0x38, 0xc1, 0x05, 0x50, 0xfb, 0x29,
+	0xb8, 0xa1, 0xf9, 0xe3, 0xa4, 0x69, 0x11, 0x70, 0xd8, 0x9b, 0xdf, 0xef, 0xfd, 0xde, 0x9b, 0xf7,
+	0x7b, 0xef, 0xcd, 0x18, 0x34, 0x5f, 0x66, 0x09, 0x75, 0xc3, 0x31, 0x0d, 0x5f, 0xb9, 0x97, 0x5d,
+	0xf5, 0xe1, 0x4c, 0x53, 0xca, 0x29, 0xac, 0x09, 0x97, 0xa3, 0x90, 0xcb, 0x6e, 0xcb, 0x0a, 0x29,
+	0x9b, 0x50, 0xe6, 0x8e, 0x10, 0xc3, 0xee, 0x65, 0x77, 0x84, 0x39, 0xea, 0xba, 0x21, 0x25, 0x89,
+	0xa2, 0xb7, 0x9a, 0xca, 0x1f, 0x48, 0xcb, 0x55, 0x86, 0x76, 0x6d, 0xc7, 0x34, 0xa6, 0x0a, 0x17,
+	0x5f, 0x1a, 0xb5, 0x62, 0x4a, 0xe3, 0x31, 0x76, 0xa5, 0x35, 0xca, 0xce, 0xdd, 0x28, 0x4b, 0x11,
+	0x27, 0x34, 0x4f, 0xd8, 0xbe, 0xef, 0xe7, 0x64, 0x82, 0x19, 0x47, 0x93, 0xa9, 0x22, 0xec, 0xfe,
+	0xb6, 0x06, 0x6a, 0xbe, 0x28, 0xcf, 0xa7, 0x09, 0x4f, 0x51, 0xc8, 0xa1, 0x0f, 0x1a, 0xa1, 0xfe,
+	0x0e, 0x50, 0x14, 0xa5, 0x98, 0x31, 0xd3, 0xb0, 0x8d, 0x4e, 0xc5, 0x33, 0x7f, 0xf9, 0xf9, 0xd9,
+	0xb6, 0x2e, 0x6a, 0x5f, 0x79, 0x86, 0x3c, 0x25, 0x49, 0x3c, 0xd8, 0xc8, 0x23, 0x34, 0x0c, 0x9f,
+	0x80, 0x0a, 0x61, 0xc1, 0x4b, 0x44, 0xc6, 0x38, 0x32, 0x8b, 0xb6, 0xd1, 0x29, 0x0f, 0xca, 0x84,
+	0x7d, 0x21, 0x6d, 0xf8, 0x21, 0xa8, 0x8f, 0xc4, 0x91, 0x01, 0x49, 0x38, 0x4e, 0x2f, 0xd1, 0xd8,
+	0x5c, 0xb1, 0x8d, 0x4e, 0x69, 0x50, 0x93, 0xe8, 0xb1, 0x06, 0xe1, 0x11, 0xa8, 0x89, 0x6a, 0x17,
+	0xac, 0x92, 0x6d, 0x74, 0xaa, 0x7b, 0x4d, 0x47, 0x69, 0x72, 0x72, 0x4d, 0x4e, 0x4f, 0x6b, 0xf6,
+	0xca, 0x6f, 0x7e, 0x6f, 0x17, 0x7e, 0xf8, 0xa3, 0x6d, 0x0c, 0xd6, 0x45, 0xe4, 0x3c, 0xd3, 0x1e,
+	0xd8, 0x49, 0xf0, 0x8c, 0x07, 0x78, 0x86, 0xc3, 0x4c, 0x30, 0x83, 0x0b, 0x4c, 0xe2, 0x0b, 0x6e,
+	0xae, 0xda, 0x46, 0x67, 0x65, 0xb0, 0x25, 0x9c, 0xfd, 0xdc, 0x77, 0x24, 0x5d, 0xf0, 0x05, 0xd8,
+	0xba, 0x17, 0x23, 0x52, 0x9a, 0x6b, 0xb2, 0x86, 0xd6, 0x83, 0x1a, 0x5e, 0xe4, 0x7d, 0x55, 0x45,
+	0xbc, 0x16, 0x45, 0x6c, 0x2e, 0xe5, 0x15, 0x0c, 0xe8, 0x82, 0xd5, 0xe9, 0x05, 0x62, 0xd8, 0x7c,
+	0x64, 0x1b, 0x9d, 0xfa, 0x5e, 0xd3, 0x59, 0xda, 0x0f, 0x47, 0x4e, 0xe2, 0x54, 0x10, 0x06, 0x8a,
+	0x07, 0xdf, 0x07, 0x60, 0x8c, 0x18, 0x0f, 0x70, 0x9a, 0xd2, 0xd4, 0x2c, 0x8b, 0x39, 0x0c, 0x2a,
+	0x02, 0xe9, 0x0b, 0x00, 0x3e, 0x05, 0xb5, 0x73, 0x44, 0xc6, 0x59, 0x8a, 0x83, 0x90, 0x66, 0x09,
+	0x37, 0x2b, 0xb2, 0x93, 0xeb, 0x1a, 0xf4, 0x05, 0x06, 0xdb, 0xa0, 0x2a, 0x26, 0x91, 0x8b, 0x06,
+	0x52, 0x34, 0x10, 0x90, 0xd6, 0xfa, 0x04, 0x54, 0x62, 0xc4, 0x82, 0x31, 0x99, 0x10, 0x6e, 0x56,
+	0x65, 0x86, 0x72, 0x8c, 0xd8, 0x73, 0x61, 0xc3, 0xcf, 0x41, 0x55, 0x38, 0x23, 0x3c, 0xa5, 0x8c,
+	0x70, 0x73, 0x5d, 0x0f, 0x41, 0xef, 0x81, 0xd8, 0x64, 0x47, 0x6f, 0xb2, 0xe3, 0x53, 0x92, 0x78,
+	0x25, 0xa1, 0x7f, 0x00, 0x62, 0xc4, 0x7a, 0x2a, 0x44, 0x14, 0xa9, 0xe6, 0x2d, 0xb6, 0x04, 0xcf,
+	0xb8, 0x59, 0x93, 0x0b, 0xb1, 0x3e, 0xca, 0xf7, 0x0e, 0xcf, 0x38, 0xfc, 0x08, 0x6c, 0x2c, 0x5a,
+	0xad, 0xb4, 0xd4, 0x65, 0x25, 0xf5, 0x39, 0xac, 0xd4, 0x38, 0x60, 0x4b, 0x76, 0x84, 0x65, 0x61,
+	0x88, 0x19, 0xcb, 0x55, 0x6d, 0x48, 0x55, 0x9b, 0xc2, 0x35, 0x54, 0x1e, 0x2d, 0x2e, 0xe7, 0xe7,
+	0x7d, 0xd2, 0xfc, 0xc6, 0x82, 0x7f, 0xa0, 0x3c, 0x9a, 0xbf, 0x0b, 0x6a, 0x92, 0x2f, 0x44, 0x67,
+	0x0c, 0x47, 0xe6, 0xa6, 0x2c, 0xa3, 0x2a, 0xc0, 0x43, 0xc4, 0xce, 0x18, 0x8e, 0xe0, 0x07, 0xa0,
+	0xce, 0x29, 0x47, 0xe3, 0x05, 0x09, 0xaa, 0xbe, 0x4b, 0x54, 0xb3, 0x76, 0x7f, 0x2a, 0x82, 0x86,
+	0x9c, 0xa8, 0x97, 0x45, 0x31, 0xe6, 0x43, 0x8e, 0x38, 0x86, 0x8f, 0xc1, 0x9a, 0xae, 0xc0, 0x90,
+	0x15, 0x68, 0x0b, 0x36, 0x41, 0x79, 0x9e, 0xac, 0x28, 0x93, 0x3d, 0x8a, 0xf5, 0x69, 0x07, 0x00,
+	0x8e, 0x70, 0x4c, 0x92, 0x40, 0x77, 0x31, 0x4b, 0x19, 0x4d, 0xe5, 0x9d, 0xf9, 0xb7, 0x3b, 0xd9,
+	0x90, 0x31, 0x9e, 0xec, 0xb1, 0x8c, 0x80, 0x1e, 0x68, 0xe0, 0x24, 0x5a, 0xce, 0x52, 0xfa, 0x8f,
+	0x2c, 0x75, 0x9c, 0x44, 0x77, 0x73, 0x7c, 0x0d, 0x36, 0xd9, 0x2b, 0x32, 0x9d, 0xe2, 0x28, 0xc8,
+	0xef, 0x3c, 0x33, 0x57, 0xed, 0x95, 0x4e, 0x75, 0xef, 0xe9, 0xbd, 0x65, 0x1e, 0x2a, 0xde, 0xd2,
+	0xeb, 0xa2, 0xb7, 0xa3, 0xa1, 0x73, 0xe4, 0x30, 0xdb, 0xfd, 0xde, 0x00, 0xdb, 0xff, 0x14, 0xf0,
+	0x6e, 0x9e, 0xa3, 0xf9, 0xb5, 0x2b, 0xfe, 0xbf, 0x6b, 0xf7, 0xf1, 0x5f, 0x06, 0x00, 0x0b, 0x14,
+	0x7e, 0x06, 0xde, 0xf3, 0x9f, 0x7f, 0xe5, 0x7f, 0x19, 0x9c, 0x1e, 0xed, 0x0f, 0xfb, 0xc1, 0xd9,
+	0xc9, 0xf0, 0xb4, 0xef, 0x1f, 0x1f, 0x1c, 0xf7, 0x7b, 0x8d, 0x42, 0xab, 0x79, 0x75, 0x6d, 0xef,
+	0x2c, 0xc8, 0x67, 0x09, 0x9b, 0xe2, 0x90, 0x9c, 0x13, 0x1c, 0xc1, 0x2e, 0xd8, 0xb9, 0x1b, 0xd7,
+	0x3f, 0xe9, 0x05, 0x9e, 0xb0, 0x1b, 0x46, 0xeb, 0xf1, 0xd5, 0xb5, 0x0d, 0x17, 0x51, 0x7d, 0xdd,
+	0x66, 0xf8, 0xe9, 0xf2, 0x51, 0x5e, 0xff, 0xf0, 0xf8, 0x44, 0x07, 0x15, 0x5b, 0xe6, 0xd5, 0xb5,
+	0xbd, 0xbd, 0x08, 0xf2, 0xe6, 0x13, 0x86, 0x3e, 0x68, 0x3f, 0x0c, 0xdb, 0x3f, 0xe9, 0xdd, 0x39,
+	0x73, 0xa5, 0x65, 0x5d, 0x5d, 0xdb, 0xad, 0x7b, 0xe1, 0xfb, 0x49, 0x94, 0x9f, 0xdd, 0x2a, 0x7d,
+	0xf7, 0xa3, 0x55, 0xf0, 0x0e, 0xdf, 0xdc, 0x58, 0xc6, 0xdb, 0x1b, 0xcb, 0xf8, 0xf3, 0xc6, 0x32,
+	0x5e, 0xdf, 0x5a, 0x85, 0xb7, 0xb7, 0x56, 0xe1, 0xd7, 0x5b, 0xab, 0xf0, 0xcd, 0xb3, 0x98, 0xf0,
+	0x8b, 0x6c, 0xe4, 0x84, 0x74, 0xe2, 0xfa, 0xb2, 0xf1, 0xf3, 0x01, 0xba, 0xf2, 0x1f, 0x38, 0xd3,
+	0x7f, 0x41, 0xfe, 0xed, 0x14, 0xb3, 0xd1, 0x9a, 0x7c, 0x1d, 0x3f, 0xf9, 0x3b, 0x00, 0x00, 0xff,
+	0xff, 0x85, 0x14, 0xad, 0x1d, 0x20, 0x07, 0x00, 0x00,
 }
 
 func (m *ClockContract) Marshal() (dAtA []byte, err error) {
@@ -123,6 +502,110 @@ func (m *ClockContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TotalGasUsed != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.TotalGasUsed))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.LastGasUsed != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.LastGasUsed))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.LastFailureHeight != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.LastFailureHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.LastSuccessHeight != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.LastSuccessHeight))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ExecutionCount != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.ExecutionCount))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BlockContext {
+		i--
+		if m.BlockContext {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.GasDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClock(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.GasLimit != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.JailHeight != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.JailHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FailureCount != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.FailureCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintClock(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Phase != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x38
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintClock(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.NextExecutionHeight != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.NextExecutionHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeInterval):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintClock(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.BlockInterval != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.BlockInterval))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.IsJailed {
 		i--
 		if m.IsJailed {
@@ -143,43 +626,230 @@ func (m *ClockContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintClock(dAtA []byte, offset int, v uint64) int {
-	offset -= sovClock(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ClockBudgetState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ClockContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ClockBudgetState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClockBudgetState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovClock(uint64(l))
+	if len(m.SkippedContracts) > 0 {
+		for iNdEx := len(m.SkippedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SkippedContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.IsJailed {
-		n += 2
+	if len(m.EndBlockCursor) > 0 {
+		i -= len(m.EndBlockCursor)
+		copy(dAtA[i:], m.EndBlockCursor)
+		i = encodeVarintClock(dAtA, i, uint64(len(m.EndBlockCursor)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.BeginBlockCursor) > 0 {
+		i -= len(m.BeginBlockCursor)
+		copy(dAtA[i:], m.BeginBlockCursor)
+		i = encodeVarintClock(dAtA, i, uint64(len(m.BeginBlockCursor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovClock(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SkippedClockContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozClock(x uint64) (n int) {
-	return sovClock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SkippedClockContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *ClockContract) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *SkippedClockContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		i = encodeVarintClock(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintClock(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClock(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClock(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClockContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovClock(uint64(l))
+	}
+	if m.IsJailed {
+		n += 2
+	}
+	if m.BlockInterval != 0 {
+		n += 1 + sovClock(uint64(m.BlockInterval))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeInterval)
+	n += 1 + l + sovClock(uint64(l))
+	if m.NextExecutionHeight != 0 {
+		n += 1 + sovClock(uint64(m.NextExecutionHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime)
+	n += 1 + l + sovClock(uint64(l))
+	if m.Phase != 0 {
+		n += 1 + sovClock(uint64(m.Phase))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovClock(uint64(l))
+	}
+	if m.FailureCount != 0 {
+		n += 1 + sovClock(uint64(m.FailureCount))
+	}
+	if m.JailHeight != 0 {
+		n += 1 + sovClock(uint64(m.JailHeight))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovClock(uint64(m.GasLimit))
+	}
+	l = m.GasDeposit.Size()
+	n += 1 + l + sovClock(uint64(l))
+	if m.BlockContext {
+		n += 2
+	}
+	if m.ExecutionCount != 0 {
+		n += 1 + sovClock(uint64(m.ExecutionCount))
+	}
+	if m.LastSuccessHeight != 0 {
+		n += 1 + sovClock(uint64(m.LastSuccessHeight))
+	}
+	if m.LastFailureHeight != 0 {
+		n += 2 + sovClock(uint64(m.LastFailureHeight))
+	}
+	if m.LastGasUsed != 0 {
+		n += 2 + sovClock(uint64(m.LastGasUsed))
+	}
+	if m.TotalGasUsed != 0 {
+		n += 2 + sovClock(uint64(m.TotalGasUsed))
+	}
+	return n
+}
+
+func (m *ClockBudgetState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovClock(uint64(m.Height))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovClock(uint64(m.GasUsed))
+	}
+	l = len(m.BeginBlockCursor)
+	if l > 0 {
+		n += 1 + l + sovClock(uint64(l))
+	}
+	l = len(m.EndBlockCursor)
+	if l > 0 {
+		n += 1 + l + sovClock(uint64(l))
+	}
+	if len(m.SkippedContracts) > 0 {
+		for _, e := range m.SkippedContracts {
+			l = e.Size()
+			n += 1 + l + sovClock(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SkippedClockContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovClock(uint64(l))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovClock(uint64(m.Phase))
+	}
+	return n
+}
+
+func sovClock(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClock(x uint64) (n int) {
+	return sovClock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClockContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -257,6 +927,653 @@ func (m *ClockContract) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsJailed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInterval", wireType)
+			}
+			m.BlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionHeight", wireType)
+			}
+			m.NextExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextExecutionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ClockPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
+			}
+			m.FailureCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailHeight", wireType)
+			}
+			m.JailHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockContext", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockContext = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionCount", wireType)
+			}
+			m.ExecutionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessHeight", wireType)
+			}
+			m.LastSuccessHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSuccessHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailureHeight", wireType)
+			}
+			m.LastFailureHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFailureHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastGasUsed", wireType)
+			}
+			m.LastGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGasUsed", wireType)
+			}
+			m.TotalGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClockBudgetState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClockBudgetState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClockBudgetState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlockCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeginBlockCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlockCursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndBlockCursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkippedContracts = append(m.SkippedContracts, SkippedClockContract{})
+			if err := m.SkippedContracts[len(m.SkippedContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SkippedClockContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SkippedClockContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SkippedClockContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ClockPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClock(dAtA[iNdEx:])
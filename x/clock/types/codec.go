@@ -12,6 +12,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterClockContract{}, "clock/MsgRegisterClockContract", nil)
 	cdc.RegisterConcrete(&MsgUnregisterClockContract{}, "clock/MsgUnregisterClockContract", nil)
 	cdc.RegisterConcrete(&MsgUnjailClockContract{}, "clock/MsgUnjailClockContract", nil)
+	cdc.RegisterConcrete(&MsgDepositClockGas{}, "clock/MsgDepositClockGas", nil)
+	cdc.RegisterConcrete(&MsgWithdrawClockGas{}, "clock/MsgWithdrawClockGas", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "clock/MsgUpdateParams", nil)
 }
 
@@ -21,6 +23,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgRegisterClockContract{},
 		&MsgUnregisterClockContract{},
 		&MsgUnjailClockContract{},
+		&MsgDepositClockGas{},
+		&MsgWithdrawClockGas{},
 		&MsgUpdateParams{},
 	)
 
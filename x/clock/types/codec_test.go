@@ -23,11 +23,13 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(4, len(impls))
+	suite.Require().Equal(6, len(impls))
 	suite.Require().ElementsMatch([]string{
 		"/juno.clock.v1.MsgUpdateParams",
 		"/juno.clock.v1.MsgRegisterClockContract",
 		"/juno.clock.v1.MsgUnregisterClockContract",
 		"/juno.clock.v1.MsgUnjailClockContract",
+		"/juno.clock.v1.MsgDepositClockGas",
+		"/juno.clock.v1.MsgWithdrawClockGas",
 	}, impls)
 }
@@ -8,4 +8,10 @@ var (
 	ErrContractJailed        = errorsmod.Register(ModuleName, 1, "contract is jailed")
 	ErrContractNotJailed     = errorsmod.Register(ModuleName, 2, "contract is not jailed")
 	ErrContractAlreadyJailed = errorsmod.Register(ModuleName, 3, "contract is already jailed")
+	ErrInvalidInterval       = errorsmod.Register(ModuleName, 4, "invalid execution interval")
+	ErrInvalidPhase          = errorsmod.Register(ModuleName, 5, "invalid execution phase")
+	ErrInvalidGasLimit       = errorsmod.Register(ModuleName, 6, "invalid gas limit")
+	ErrNoCustomGasLimit      = errorsmod.Register(ModuleName, 7, "contract does not have a custom gas limit")
+	ErrInsufficientDeposit   = errorsmod.Register(ModuleName, 8, "insufficient gas deposit")
+	ErrContractExecuting     = errorsmod.Register(ModuleName, 9, "contract cannot be unregistered during its own clock execution")
 )
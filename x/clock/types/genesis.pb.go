@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -74,6 +75,20 @@ func (m *GenesisState) GetParams() Params {
 type Params struct {
 	// contract_gas_limit defines the maximum amount of gas that can be used by a contract.
 	ContractGasLimit uint64 `protobuf:"varint,1,opt,name=contract_gas_limit,json=contractGasLimit,proto3" json:"contract_gas_limit,omitempty"`
+	// max_strikes defines the number of consecutive failed executions a contract
+	// is allowed before it is jailed. Zero jails a contract on its first failure.
+	MaxStrikes uint64 `protobuf:"varint,2,opt,name=max_strikes,json=maxStrikes,proto3" json:"max_strikes,omitempty"`
+	// jail_duration defines the number of blocks after which a jailed contract is
+	// automatically unjailed. Zero disables automatic unjailing.
+	JailDuration uint64 `protobuf:"varint,3,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty"`
+	// gas_deposit_price defines the price per unit of gas, in the bond denom,
+	// which is debited from the gas deposit of contracts with a custom gas
+	// limit. Empty disables custom gas limits.
+	GasDepositPrice string `protobuf:"bytes,4,opt,name=gas_deposit_price,json=gasDepositPrice,proto3" json:"gas_deposit_price,omitempty"`
+	// block_gas_limit defines the total amount of gas that can be used by all
+	// contracts in a block. Contracts beyond the limit are executed in the
+	// following blocks in round-robin order. Zero disables the limit.
+	BlockGasLimit uint64 `protobuf:"varint,5,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -116,6 +131,34 @@ func (m *Params) GetContractGasLimit() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxStrikes() uint64 {
+	if m != nil {
+		return m.MaxStrikes
+	}
+	return 0
+}
+
+func (m *Params) GetJailDuration() uint64 {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetGasDepositPrice() string {
+	if m != nil {
+		return m.GasDepositPrice
+	}
+	return ""
+}
+
+func (m *Params) GetBlockGasLimit() uint64 {
+	if m != nil {
+		return m.BlockGasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "juno.clock.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "juno.clock.v1.Params")
@@ -124,23 +167,31 @@ func init() {
 func init() { proto.RegisterFile("juno/clock/v1/genesis.proto", fileDescriptor_c31a7855fe794abe) }
 
 var fileDescriptor_c31a7855fe794abe = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x2a, 0xcd, 0xcb,
-	0xd7, 0x4f, 0xce, 0xc9, 0x4f, 0xce, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
-	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x05, 0x49, 0xea, 0x81, 0x25, 0xf5, 0xca,
-	0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x85, 0x94, 0x48, 0x7a,
-	0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0x3c, 0xb8, 0x78, 0xdc, 0x21, 0x06,
-	0x05, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x70, 0xb1, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x4b,
-	0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0xea, 0xa1, 0x18, 0xac, 0x17, 0x00, 0x96, 0x74, 0xe2,
-	0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0xf5, 0x4a, 0x36, 0x5c,
-	0x6c, 0x10, 0x49, 0x21, 0x1d, 0x2e, 0xa1, 0xe4, 0xfc, 0xbc, 0x92, 0xa2, 0xc4, 0xe4, 0x92, 0xf8,
-	0xf4, 0xc4, 0xe2, 0xf8, 0x9c, 0xcc, 0xdc, 0xcc, 0x12, 0xb0, 0x79, 0x2c, 0x41, 0x02, 0x30, 0x19,
-	0xf7, 0xc4, 0x62, 0x1f, 0x90, 0xb8, 0x15, 0xcb, 0x8b, 0x05, 0xf2, 0x8c, 0x4e, 0xee, 0x27, 0x1e,
-	0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17,
-	0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9b, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4,
-	0x97, 0x9c, 0x9f, 0xab, 0xef, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0xec, 0x0c, 0x35, 0xa2, 0x58, 0x1f,
-	0x1c, 0x22, 0x15, 0xd0, 0x30, 0x29, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xfb, 0xcb, 0x18,
-	0x10, 0x00, 0x00, 0xff, 0xff, 0xd6, 0xf0, 0x4e, 0x58, 0x2e, 0x01, 0x00, 0x00,
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xc1, 0x4a, 0x02, 0x41,
+	0x18, 0xc7, 0x77, 0xca, 0x04, 0x47, 0xcd, 0x1c, 0x0a, 0x36, 0x83, 0x55, 0x0c, 0x42, 0x22, 0x77,
+	0xb1, 0x2e, 0xe1, 0x51, 0x05, 0x3b, 0x74, 0x10, 0xbd, 0x75, 0x59, 0xc6, 0x71, 0xd8, 0x46, 0xdd,
+	0x9d, 0x65, 0x67, 0x14, 0x7b, 0x8b, 0x1e, 0xa1, 0x63, 0xc7, 0x0e, 0x3d, 0x84, 0x47, 0xe9, 0xd4,
+	0x29, 0x42, 0xa1, 0x7a, 0x8c, 0xd8, 0x99, 0x15, 0xea, 0x32, 0xcc, 0xf7, 0xfb, 0xff, 0x99, 0xff,
+	0xf7, 0xcd, 0x07, 0x4f, 0xc6, 0xb3, 0x80, 0x3b, 0x64, 0xca, 0xc9, 0xc4, 0x99, 0x37, 0x1c, 0x8f,
+	0x06, 0x54, 0x30, 0x61, 0x87, 0x11, 0x97, 0x1c, 0xe5, 0x63, 0xd1, 0x56, 0xa2, 0x3d, 0x6f, 0x94,
+	0x8a, 0xd8, 0x67, 0x01, 0x77, 0xd4, 0xa9, 0x1d, 0xa5, 0x63, 0xc2, 0x85, 0xcf, 0x85, 0xab, 0x2a,
+	0x47, 0x17, 0x89, 0x74, 0xe8, 0x71, 0x8f, 0x6b, 0x1e, 0xdf, 0x34, 0xad, 0xde, 0xc0, 0x5c, 0x57,
+	0x67, 0x0c, 0x24, 0x96, 0x14, 0x5d, 0xc3, 0x74, 0x88, 0x23, 0xec, 0x0b, 0x13, 0x54, 0x40, 0x2d,
+	0x7b, 0x79, 0x64, 0xff, 0xcb, 0xb4, 0x7b, 0x4a, 0x6c, 0x65, 0x96, 0x1f, 0x65, 0xe3, 0xf9, 0xfb,
+	0xe5, 0x1c, 0xf4, 0x13, 0x7f, 0xf5, 0x0b, 0xc0, 0xb4, 0x56, 0xd1, 0x05, 0x44, 0x84, 0x07, 0x32,
+	0xc2, 0x44, 0xba, 0x1e, 0x16, 0xee, 0x94, 0xf9, 0x4c, 0xaa, 0x07, 0x53, 0xfd, 0x83, 0xad, 0xd2,
+	0xc5, 0xe2, 0x36, 0xe6, 0xa8, 0x0c, 0xb3, 0x3e, 0x5e, 0xb8, 0x42, 0x46, 0x6c, 0x42, 0x85, 0xb9,
+	0xa3, 0x6c, 0xd0, 0xc7, 0x8b, 0x81, 0x26, 0xe8, 0x14, 0xe6, 0xc7, 0x98, 0x4d, 0xdd, 0xd1, 0x2c,
+	0xc2, 0x92, 0xf1, 0xc0, 0xdc, 0x55, 0x96, 0x5c, 0x0c, 0x3b, 0x09, 0x43, 0x4d, 0x58, 0x8c, 0xa3,
+	0x46, 0x34, 0xe4, 0x82, 0x49, 0x37, 0x8c, 0x18, 0xa1, 0x66, 0xaa, 0x02, 0x6a, 0x99, 0xd6, 0xfe,
+	0xdb, 0x6b, 0x1d, 0x26, 0x7f, 0xd1, 0xa1, 0xa4, 0x5f, 0xf0, 0xb0, 0xe8, 0x68, 0x5f, 0x2f, 0xb6,
+	0xa1, 0x33, 0x58, 0x18, 0xc6, 0x03, 0xfe, 0x69, 0x76, 0x4f, 0x45, 0xe4, 0x15, 0xde, 0x76, 0xda,
+	0x4c, 0xfd, 0x3c, 0x95, 0x41, 0xab, 0xbb, 0x5c, 0x5b, 0x60, 0xb5, 0xb6, 0xc0, 0xe7, 0xda, 0x02,
+	0x8f, 0x1b, 0xcb, 0x58, 0x6d, 0x2c, 0xe3, 0x7d, 0x63, 0x19, 0x77, 0x75, 0x8f, 0xc9, 0xfb, 0xd9,
+	0xd0, 0x26, 0xdc, 0x77, 0xda, 0x2a, 0xaf, 0x9d, 0x0c, 0x2b, 0x1c, 0xb5, 0xd7, 0x45, 0xb2, 0x59,
+	0xf9, 0x10, 0x52, 0x31, 0x4c, 0xab, 0x15, 0x5c, 0xfd, 0x06, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xa2,
+	0x6a, 0xa7, 0xf4, 0x01, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -165,6 +216,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ContractGasLimit != that1.ContractGasLimit {
 		return false
 	}
+	if this.MaxStrikes != that1.MaxStrikes {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	if this.GasDepositPrice != that1.GasDepositPrice {
+		return false
+	}
+	if this.BlockGasLimit != that1.BlockGasLimit {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -220,6 +283,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlockGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.GasDepositPrice) > 0 {
+		i -= len(m.GasDepositPrice)
+		copy(dAtA[i:], m.GasDepositPrice)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GasDepositPrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.JailDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.JailDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxStrikes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxStrikes))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ContractGasLimit != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ContractGasLimit))
 		i--
@@ -259,6 +344,19 @@ func (m *Params) Size() (n int) {
 	if m.ContractGasLimit != 0 {
 		n += 1 + sovGenesis(uint64(m.ContractGasLimit))
 	}
+	if m.MaxStrikes != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxStrikes))
+	}
+	if m.JailDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.JailDuration))
+	}
+	l = len(m.GasDepositPrice)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BlockGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockGasLimit))
+	}
 	return n
 }
 
@@ -399,6 +497,95 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStrikes", wireType)
+			}
+			m.MaxStrikes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStrikes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			m.JailDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasDepositPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasDepositPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasLimit", wireType)
+			}
+			m.BlockGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
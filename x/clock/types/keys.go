@@ -1,8 +1,15 @@
 package types
 
-var ParamsKey = []byte{0x00}
+var (
+	ParamsKey      = []byte{0x00}
+	BudgetStateKey = []byte{0x01}
+
+	// Transient store keys
+	BlockTxCountKey = []byte{0x00}
+)
 
 const (
 	ModuleName = "clock"
 	StoreKey   = ModuleName
+	TStoreKey  = "transient_" + ModuleName
 )
@@ -4,12 +4,15 @@ import (
 	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	globalerrors "github.com/CosmosContracts/juno/v29/app/helpers"
 )
 
 const (
-	// Sudo Message called on the contracts
+	// Sudo Message called on the contracts at the beginning of the block
+	BeginBlockSudoMessage = `{"clock_begin_block":{}}`
+	// Sudo Message called on the contracts at the end of the block
 	EndBlockSudoMessage = `{"clock_end_block":{}}`
 )
 
@@ -18,6 +21,8 @@ const (
 	TypeMsgRegisterFeePayContract   = "register_clock_contract"
 	TypeMsgUnregisterFeePayContract = "unregister_clock_contract"
 	TypeMsgUnjailFeePayContract     = "unjail_clock_contract"
+	TypeMsgDepositClockGas          = "deposit_clock_gas"
+	TypeMsgWithdrawClockGas         = "withdraw_clock_gas"
 	TypeMsgUpdateParams             = "update_clock_params"
 )
 
@@ -25,12 +30,31 @@ var (
 	_ sdk.Msg = &MsgRegisterClockContract{}
 	_ sdk.Msg = &MsgUnregisterClockContract{}
 	_ sdk.Msg = &MsgUnjailClockContract{}
+	_ sdk.Msg = &MsgDepositClockGas{}
+	_ sdk.Msg = &MsgWithdrawClockGas{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
 // ValidateBasic runs stateless checks on the message
 func (msg MsgRegisterClockContract) ValidateBasic() error {
-	return validateAddresses(msg.SenderAddress, msg.ContractAddress)
+	if err := validateAddresses(msg.SenderAddress, msg.ContractAddress); err != nil {
+		return err
+	}
+
+	if err := ValidateInterval(msg.BlockInterval, msg.TimeInterval); err != nil {
+		return err
+	}
+
+	if err := ValidatePhase(msg.Phase); err != nil {
+		return err
+	}
+
+	// A custom gas limit requires a deposit
+	if msg.GasLimit > 0 {
+		return validateAmount(msg.GasDeposit)
+	}
+
+	return nil
 }
 
 // ValidateBasic runs stateless checks on the message
@@ -43,6 +67,24 @@ func (msg MsgUnjailClockContract) ValidateBasic() error {
 	return validateAddresses(msg.SenderAddress, msg.ContractAddress)
 }
 
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDepositClockGas) ValidateBasic() error {
+	if err := validateAddresses(msg.SenderAddress, msg.ContractAddress); err != nil {
+		return err
+	}
+
+	return validateAmount(msg.Amount)
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWithdrawClockGas) ValidateBasic() error {
+	if err := validateAddresses(msg.SenderAddress, msg.ContractAddress); err != nil {
+		return err
+	}
+
+	return validateAmount(msg.Amount)
+}
+
 // ValidateBasic does a sanity check on the provided data.
 func (msg MsgUpdateParams) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
@@ -62,3 +104,12 @@ func validateAddresses(addresses ...string) error {
 
 	return nil
 }
+
+// validateAmount ensures the provided amount is valid and positive
+func validateAmount(amount sdk.Coin) error {
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount: %s", amount)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -10,15 +11,27 @@ import (
 func DefaultParams() Params {
 	return Params{
 		ContractGasLimit: 100_000,
+		MaxStrikes:       0,
+		JailDuration:     0,
+		GasDepositPrice:  "",
+		BlockGasLimit:    0,
 	}
 }
 
 // NewParams creates a new Params object
 func NewParams(
 	contractGasLimit uint64,
+	maxStrikes uint64,
+	jailDuration uint64,
+	gasDepositPrice string,
+	blockGasLimit uint64,
 ) Params {
 	return Params{
 		ContractGasLimit: contractGasLimit,
+		MaxStrikes:       maxStrikes,
+		JailDuration:     jailDuration,
+		GasDepositPrice:  gasDepositPrice,
+		BlockGasLimit:    blockGasLimit,
 	}
 }
 
@@ -32,5 +45,47 @@ func (p Params) Validate() error {
 		)
 	}
 
+	if p.BlockGasLimit > 0 && p.BlockGasLimit < p.ContractGasLimit {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"invalid block gas limit: %d. Must be zero or above the contract gas limit %d", p.BlockGasLimit, p.ContractGasLimit,
+		)
+	}
+
+	if p.GasDepositPrice != "" {
+		price, err := math.LegacyNewDecFromStr(p.GasDepositPrice)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid gas deposit price: %s", err)
+		}
+		if !price.IsPositive() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "gas deposit price must be positive: %s", price)
+		}
+	}
+
+	return nil
+}
+
+// ValidateCustomGasLimit ensures a custom contract gas limit fits in an empty block
+// when the block gas limit is enabled.
+func (p Params) ValidateCustomGasLimit(gasLimit uint64) error {
+	if p.BlockGasLimit > 0 && gasLimit > p.BlockGasLimit {
+		return errorsmod.Wrapf(ErrInvalidGasLimit, "custom gas limit %d exceeds the block gas limit %d", gasLimit, p.BlockGasLimit)
+	}
+
 	return nil
 }
+
+// ParseGasDepositPrice returns the gas deposit price, and false if custom gas limits
+// are disabled.
+func (p Params) ParseGasDepositPrice() (math.LegacyDec, bool) {
+	if p.GasDepositPrice == "" {
+		return math.LegacyZeroDec(), false
+	}
+
+	price, err := math.LegacyNewDecFromStr(p.GasDepositPrice)
+	if err != nil || !price.IsPositive() {
+		return math.LegacyZeroDec(), false
+	}
+
+	return price, true
+}
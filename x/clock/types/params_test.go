@@ -21,32 +21,62 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			"Success - Meets min Gas",
-			types.NewParams(100_000),
+			types.NewParams(100_000, 0, 0, "", 0),
 			true,
 		},
 		{
 			"Success - Meets min Gas",
-			types.NewParams(500_000),
+			types.NewParams(500_000, 0, 0, "", 0),
 			true,
 		},
 		{
 			"Fail - Not Enough Gas",
-			types.NewParams(1),
+			types.NewParams(1, 0, 0, "", 0),
 			false,
 		},
 		{
 			"Fail - Not Enough Gas",
-			types.NewParams(100),
+			types.NewParams(100, 0, 0, "", 0),
+			false,
+		},
+		{
+			"Success - Strikes & Jail Duration",
+			types.NewParams(100_000, 3, 100, "", 0),
+			true,
+		},
+		{
+			"Success - Gas Deposit Price",
+			types.NewParams(100_000, 0, 0, "0.0025", 0),
+			true,
+		},
+		{
+			"Fail - Invalid Gas Deposit Price",
+			types.NewParams(100_000, 0, 0, "abc", 0),
+			false,
+		},
+		{
+			"Fail - Zero Gas Deposit Price",
+			types.NewParams(100_000, 0, 0, "0", 0),
+			false,
+		},
+		{
+			"Success - Block Gas Limit",
+			types.NewParams(100_000, 0, 0, "", 1_000_000),
+			true,
+		},
+		{
+			"Fail - Block Gas Limit Below Contract Gas Limit",
+			types.NewParams(100_000, 0, 0, "", 50_000),
 			false,
 		},
 		{
 			"Fail - Not Enough Gas",
-			types.NewParams(1_000),
+			types.NewParams(1_000, 0, 0, "", 0),
 			false,
 		},
 		{
 			"Fail - Not Enough Gas",
-			types.NewParams(10_000),
+			types.NewParams(10_000, 0, 0, "", 0),
 			false,
 		},
 	}
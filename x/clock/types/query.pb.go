@@ -12,19 +12,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -225,22 +230,25 @@ func (m *QueryClockContractResponse) GetClockContract() ClockContract {
 	return ClockContract{}
 }
 
-// QueryParams is the request type to get all module params.
-type QueryParamsRequest struct {
+// QueryClockScheduleRequest is the request type to get the execution
+// schedule of all contracts.
+type QueryClockScheduleRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryClockScheduleRequest) Reset()         { *m = QueryClockScheduleRequest{} }
+func (m *QueryClockScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClockScheduleRequest) ProtoMessage()    {}
+func (*QueryClockScheduleRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7da208f579d775c8, []int{4}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryClockScheduleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryClockScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryClockScheduleRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -250,35 +258,45 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryClockScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClockScheduleRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryClockScheduleRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryClockScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClockScheduleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryClockScheduleRequest proto.InternalMessageInfo
 
-// QueryClockContractsResponse is the response type for the Query/ClockContracts RPC method.
-type QueryParamsResponse struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryClockScheduleRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+// QueryClockScheduleResponse is the response type for the Query/ClockSchedule RPC method.
+type QueryClockScheduleResponse struct {
+	// schedules are the execution schedules of the clock contracts.
+	Schedules []ClockSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryClockScheduleResponse) Reset()         { *m = QueryClockScheduleResponse{} }
+func (m *QueryClockScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClockScheduleResponse) ProtoMessage()    {}
+func (*QueryClockScheduleResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7da208f579d775c8, []int{5}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryClockScheduleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryClockScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryClockScheduleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -288,532 +306,3075 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryClockScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClockScheduleResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryClockScheduleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryClockScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClockScheduleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryClockScheduleResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryClockScheduleResponse) GetSchedules() []ClockSchedule {
 	if m != nil {
-		return m.Params
+		return m.Schedules
 	}
-	return Params{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryClockContractsRequest)(nil), "juno.clock.v1.QueryClockContractsRequest")
-	proto.RegisterType((*QueryClockContractsResponse)(nil), "juno.clock.v1.QueryClockContractsResponse")
-	proto.RegisterType((*QueryClockContractRequest)(nil), "juno.clock.v1.QueryClockContractRequest")
-	proto.RegisterType((*QueryClockContractResponse)(nil), "juno.clock.v1.QueryClockContractResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "juno.clock.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "juno.clock.v1.QueryParamsResponse")
+func (m *QueryClockScheduleResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("juno/clock/v1/query.proto", fileDescriptor_7da208f579d775c8) }
-
-var fileDescriptor_7da208f579d775c8 = []byte{
-	// 572 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6f, 0x12, 0x4d,
-	0x1c, 0xc7, 0xd9, 0x36, 0x4f, 0x93, 0x4e, 0x03, 0x7d, 0x1c, 0x69, 0x84, 0x6d, 0xb3, 0xd6, 0x4d,
-	0x54, 0x8a, 0x61, 0x27, 0xe0, 0xa5, 0x57, 0x21, 0xb1, 0x37, 0x45, 0xbc, 0x79, 0xc1, 0x61, 0x99,
-	0xac, 0xab, 0x30, 0xb3, 0xdd, 0x19, 0x88, 0x8d, 0xf1, 0xe2, 0xc9, 0x93, 0x31, 0xf1, 0xee, 0xd9,
-	0x93, 0xf1, 0xe0, 0xc1, 0x97, 0xd0, 0x63, 0xa3, 0x17, 0x4f, 0xc6, 0x80, 0x89, 0x6f, 0xc3, 0xec,
-	0xcc, 0x2c, 0x30, 0x2b, 0xb5, 0x5c, 0x08, 0xf3, 0xfb, 0xf7, 0xfd, 0xcc, 0xf7, 0x37, 0x00, 0xca,
-	0x4f, 0x47, 0x94, 0x21, 0x7f, 0xc0, 0xfc, 0x67, 0x68, 0x5c, 0x47, 0xc7, 0x23, 0x12, 0x9f, 0x78,
-	0x51, 0xcc, 0x04, 0x83, 0xf9, 0x24, 0xe5, 0xc9, 0x94, 0x37, 0xae, 0xdb, 0x97, 0xf0, 0x30, 0xa4,
-	0x0c, 0xc9, 0x4f, 0x55, 0x61, 0x57, 0x7d, 0xc6, 0x87, 0x8c, 0xa3, 0x1e, 0xe6, 0x44, 0xb5, 0xa2,
-	0x71, 0xbd, 0x47, 0x04, 0xae, 0xa3, 0x08, 0x07, 0x21, 0xc5, 0x22, 0x64, 0x54, 0xd7, 0xee, 0xea,
-	0xda, 0xb4, 0x6c, 0x51, 0xca, 0x2e, 0xab, 0x64, 0x57, 0x9e, 0x90, 0x3a, 0xe8, 0x54, 0x31, 0x60,
-	0x01, 0x53, 0xf1, 0xe4, 0x9b, 0x8e, 0xee, 0x05, 0x8c, 0x05, 0x03, 0x82, 0x70, 0x14, 0x22, 0x4c,
-	0x29, 0x13, 0x52, 0x2a, 0xed, 0xc9, 0x5c, 0x4a, 0x5d, 0x41, 0x63, 0x98, 0xa9, 0x80, 0x50, 0xc2,
-	0x43, 0xdd, 0xe7, 0xf6, 0x81, 0xfd, 0x20, 0xa1, 0x6a, 0x25, 0xe9, 0x16, 0xa3, 0x22, 0xc6, 0xbe,
-	0xe0, 0x1d, 0x72, 0x3c, 0x22, 0x5c, 0xc0, 0xbb, 0x00, 0xcc, 0x6f, 0x55, 0xb2, 0xf6, 0xad, 0xca,
-	0x56, 0xe3, 0x86, 0xa7, 0x61, 0x13, 0x0b, 0x3c, 0x75, 0x25, 0x6d, 0x81, 0xd7, 0xc6, 0x01, 0xd1,
-	0xbd, 0x9d, 0x85, 0x4e, 0xf7, 0x8b, 0x05, 0x76, 0x97, 0xca, 0xf0, 0x88, 0x51, 0x4e, 0x60, 0x1b,
-	0x6c, 0x4b, 0xbe, 0xae, 0x9f, 0xa6, 0x4a, 0xd6, 0xfe, 0x7a, 0x65, 0xab, 0xb1, 0xe7, 0x19, 0x1b,
-	0xf1, 0x8c, 0xfe, 0xe6, 0xe6, 0xe9, 0x8f, 0xab, 0xb9, 0x0f, 0xbf, 0x3f, 0x55, 0xad, 0x4e, 0xc1,
-	0x37, 0x26, 0xc3, 0x23, 0x83, 0x7c, 0x4d, 0x92, 0xdf, 0xbc, 0x90, 0x5c, 0xe1, 0x18, 0xe8, 0x8f,
-	0x41, 0xf9, 0x6f, 0xf2, 0xd4, 0x9f, 0x16, 0xf8, 0x3f, 0x25, 0xee, 0xe2, 0x7e, 0x3f, 0x26, 0x9c,
-	0x4b, 0x97, 0x36, 0x9b, 0xa5, 0xaf, 0x9f, 0x6b, 0x45, 0x2d, 0x77, 0x47, 0x65, 0x1e, 0x8a, 0x38,
-	0xa4, 0x41, 0x67, 0x3b, 0xed, 0xd0, 0x61, 0x77, 0xb0, 0x6c, 0x05, 0x33, 0x6b, 0xee, 0x81, 0x82,
-	0x69, 0x8d, 0x5e, 0xc3, 0xca, 0xce, 0xe4, 0x0d, 0x67, 0xdc, 0x22, 0x80, 0x52, 0xad, 0x8d, 0x63,
-	0x3c, 0x4c, 0x17, 0xed, 0xde, 0x07, 0x97, 0x8d, 0xa8, 0x16, 0x3f, 0x04, 0x1b, 0x91, 0x8c, 0x68,
-	0xd1, 0x9d, 0x8c, 0xa8, 0x2a, 0x5f, 0x54, 0xd3, 0xf5, 0x8d, 0x8f, 0xeb, 0xe0, 0x3f, 0x39, 0x11,
-	0xbe, 0xb1, 0x40, 0xc1, 0x5c, 0x3b, 0x3c, 0xc8, 0x8c, 0x39, 0xff, 0x05, 0xda, 0xd5, 0x55, 0x4a,
-	0x15, 0xad, 0x7b, 0xfd, 0x75, 0x82, 0xf0, 0xea, 0xdb, 0xaf, 0x77, 0x6b, 0x36, 0x2c, 0xa1, 0xcc,
-	0x2f, 0x62, 0xa6, 0xfe, 0xde, 0x02, 0x79, 0x63, 0x02, 0xac, 0x5c, 0x28, 0x92, 0xe2, 0x1c, 0xac,
-	0x50, 0xa9, 0x69, 0x0e, 0xe7, 0x34, 0x35, 0x78, 0xeb, 0x3c, 0x1a, 0xf4, 0x22, 0xfb, 0x80, 0x5e,
-	0x42, 0x0e, 0x36, 0x94, 0xb1, 0xf0, 0xda, 0x32, 0x39, 0x63, 0x73, 0xb6, 0xfb, 0xaf, 0x12, 0x8d,
-	0xe2, 0xce, 0x51, 0xae, 0xc0, 0x9d, 0x0c, 0x8a, 0x5a, 0x58, 0xf3, 0xe8, 0x74, 0xe2, 0x58, 0x67,
-	0x13, 0xc7, 0xfa, 0x39, 0x71, 0xac, 0xb7, 0x53, 0x27, 0x77, 0x36, 0x75, 0x72, 0xdf, 0xa7, 0x4e,
-	0xee, 0x51, 0x2d, 0x08, 0xc5, 0x93, 0x51, 0xcf, 0xf3, 0xd9, 0x10, 0xb5, 0xe4, 0x8b, 0x9e, 0x59,
-	0xaf, 0x46, 0x3d, 0xd7, 0xc3, 0xc4, 0x49, 0x44, 0x78, 0x6f, 0x43, 0xfe, 0xb1, 0xdc, 0xfe, 0x13,
-	0x00, 0x00, 0xff, 0xff, 0x60, 0x41, 0xb3, 0xa7, 0x67, 0x05, 0x00, 0x00,
+// ClockSchedule is the execution schedule of a single clock contract.
+type ClockSchedule struct {
+	// contract_address is the address of the contract.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// block_interval is the number of blocks between executions.
+	BlockInterval uint64 `protobuf:"varint,2,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// time_interval is the minimum block time between executions.
+	TimeInterval time.Duration `protobuf:"bytes,3,opt,name=time_interval,json=timeInterval,proto3,stdduration" json:"time_interval"`
+	// next_execution_height is the height at which the contract is next due.
+	NextExecutionHeight int64 `protobuf:"varint,4,opt,name=next_execution_height,json=nextExecutionHeight,proto3" json:"next_execution_height,omitempty"`
+	// next_execution_time is the block time at which the contract is next due.
+	NextExecutionTime time.Time `protobuf:"bytes,5,opt,name=next_execution_time,json=nextExecutionTime,proto3,stdtime" json:"next_execution_time"`
+	// is_due is true if the contract will be executed in the current block.
+	IsDue bool `protobuf:"varint,6,opt,name=is_due,json=isDue,proto3" json:"is_due,omitempty"`
+}
+
+func (m *ClockSchedule) Reset()         { *m = ClockSchedule{} }
+func (m *ClockSchedule) String() string { return proto.CompactTextString(m) }
+func (*ClockSchedule) ProtoMessage()    {}
+func (*ClockSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da208f579d775c8, []int{6}
+}
+func (m *ClockSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClockSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClockSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClockSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClockSchedule.Merge(m, src)
+}
+func (m *ClockSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClockSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClockSchedule.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ClockSchedule proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ClockContracts
-	ClockContracts(ctx context.Context, in *QueryClockContractsRequest, opts ...grpc.CallOption) (*QueryClockContractsResponse, error)
-	// ClockContract
-	ClockContract(ctx context.Context, in *QueryClockContractRequest, opts ...grpc.CallOption) (*QueryClockContractResponse, error)
-	// Params
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *ClockSchedule) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *ClockSchedule) GetBlockInterval() uint64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *ClockSchedule) GetTimeInterval() time.Duration {
+	if m != nil {
+		return m.TimeInterval
+	}
+	return 0
 }
 
-func (c *queryClient) ClockContracts(ctx context.Context, in *QueryClockContractsRequest, opts ...grpc.CallOption) (*QueryClockContractsResponse, error) {
-	out := new(QueryClockContractsResponse)
-	err := c.cc.Invoke(ctx, "/juno.clock.v1.Query/ClockContracts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ClockSchedule) GetNextExecutionHeight() int64 {
+	if m != nil {
+		return m.NextExecutionHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ClockContract(ctx context.Context, in *QueryClockContractRequest, opts ...grpc.CallOption) (*QueryClockContractResponse, error) {
-	out := new(QueryClockContractResponse)
-	err := c.cc.Invoke(ctx, "/juno.clock.v1.Query/ClockContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ClockSchedule) GetNextExecutionTime() time.Time {
+	if m != nil {
+		return m.NextExecutionTime
 	}
-	return out, nil
+	return time.Time{}
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/juno.clock.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ClockSchedule) GetIsDue() bool {
+	if m != nil {
+		return m.IsDue
 	}
-	return out, nil
+	return false
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ClockContracts
-	ClockContracts(context.Context, *QueryClockContractsRequest) (*QueryClockContractsResponse, error)
-	// ClockContract
-	ClockContract(context.Context, *QueryClockContractRequest) (*QueryClockContractResponse, error)
-	// Params
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QuerySkippedClockContractsRequest is the request type to get the contracts
+// which were skipped in the latest block because of the block gas limit.
+type QuerySkippedClockContractsRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QuerySkippedClockContractsRequest) Reset()         { *m = QuerySkippedClockContractsRequest{} }
+func (m *QuerySkippedClockContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySkippedClockContractsRequest) ProtoMessage()    {}
+func (*QuerySkippedClockContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da208f579d775c8, []int{7}
 }
-
-func (*UnimplementedQueryServer) ClockContracts(ctx context.Context, req *QueryClockContractsRequest) (*QueryClockContractsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClockContracts not implemented")
+func (m *QuerySkippedClockContractsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ClockContract(ctx context.Context, req *QueryClockContractRequest) (*QueryClockContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClockContract not implemented")
+func (m *QuerySkippedClockContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySkippedClockContractsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySkippedClockContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySkippedClockContractsRequest.Merge(m, src)
+}
+func (m *QuerySkippedClockContractsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySkippedClockContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySkippedClockContractsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QuerySkippedClockContractsRequest proto.InternalMessageInfo
+
+// QuerySkippedClockContractsResponse is the response type for the Query/SkippedClockContracts RPC method.
+type QuerySkippedClockContractsResponse struct {
+	// height is the height of the latest block in which contracts were executed.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// gas_used is the gas used by clock contracts in that block.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// skipped_contracts are the contracts which were due but delayed to a
+	// following block.
+	SkippedContracts []SkippedClockContract `protobuf:"bytes,3,rep,name=skipped_contracts,json=skippedContracts,proto3" json:"skipped_contracts"`
 }
 
-func _Query_ClockContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClockContractsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClockContracts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.clock.v1.Query/ClockContracts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClockContracts(ctx, req.(*QueryClockContractsRequest))
+func (m *QuerySkippedClockContractsResponse) Reset()         { *m = QuerySkippedClockContractsResponse{} }
+func (m *QuerySkippedClockContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySkippedClockContractsResponse) ProtoMessage()    {}
+func (*QuerySkippedClockContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da208f579d775c8, []int{8}
+}
+func (m *QuerySkippedClockContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySkippedClockContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySkippedClockContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySkippedClockContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySkippedClockContractsResponse.Merge(m, src)
+}
+func (m *QuerySkippedClockContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySkippedClockContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySkippedClockContractsResponse.DiscardUnknown(m)
 }
 
-func _Query_ClockContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClockContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClockContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.clock.v1.Query/ClockContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClockContract(ctx, req.(*QueryClockContractRequest))
+var xxx_messageInfo_QuerySkippedClockContractsResponse proto.InternalMessageInfo
+
+func (m *QuerySkippedClockContractsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.clock.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QuerySkippedClockContractsResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "juno.clock.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ClockContracts",
-			Handler:    _Query_ClockContracts_Handler,
-		},
-		{
-			MethodName: "ClockContract",
-			Handler:    _Query_ClockContract_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "juno/clock/v1/query.proto",
+func (m *QuerySkippedClockContractsResponse) GetSkippedContracts() []SkippedClockContract {
+	if m != nil {
+		return m.SkippedContracts
+	}
+	return nil
 }
 
-func (m *QueryClockContractsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryClockContractStatsRequest is the request type to get the execution
+// stats of a single contract.
+type QueryClockContractStatsRequest struct {
+	// contract_address is the address of the contract to query.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func (m *QueryClockContractsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryClockContractStatsRequest) Reset()         { *m = QueryClockContractStatsRequest{} }
+func (m *QueryClockContractStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClockContractStatsRequest) ProtoMessage()    {}
+func (*QueryClockContractStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da208f579d775c8, []int{9}
 }
-
-func (m *QueryClockContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryClockContractStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClockContractStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClockContractStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryClockContractStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClockContractStatsRequest.Merge(m, src)
+}
+func (m *QueryClockContractStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClockContractStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClockContractStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryClockContractsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClockContractStatsRequest proto.InternalMessageInfo
+
+func (m *QueryClockContractStatsRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryClockContractsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryClockContractStatsResponse is the response type for the Query/ClockContractStats RPC method.
+type QueryClockContractStatsResponse struct {
+	// stats are the execution stats of the clock contract.
+	Stats ClockExecutionStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
 }
 
-func (m *QueryClockContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClockContracts) > 0 {
-		for iNdEx := len(m.ClockContracts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClockContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryClockContractStatsResponse) Reset()         { *m = QueryClockContractStatsResponse{} }
+func (m *QueryClockContractStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClockContractStatsResponse) ProtoMessage()    {}
+func (*QueryClockContractStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da208f579d775c8, []int{10}
+}
+func (m *QueryClockContractStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClockContractStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClockContractStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryClockContractStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClockContractStatsResponse.Merge(m, src)
+}
+func (m *QueryClockContractStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClockContractStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClockContractStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryClockContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClockContractStatsResponse proto.InternalMessageInfo
+
+func (m *QueryClockContractStatsResponse) GetStats() ClockExecutionStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return ClockExecutionStats{}
 }
 
-func (m *QueryClockContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryClockStatsRequest is the request type to get the execution stats of
+// all contracts.
+type QueryClockStatsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryClockContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryClockStatsRequest) Reset()         { *m = QueryClockStatsRequest{} }
+func (m *QueryClockStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClockStatsRequest) ProtoMessage()    {}
+func (*QueryClockStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da208f579d775c8, []int{11}
+}
+func (m *QueryClockStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClockStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClockStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryClockStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClockStatsRequest.Merge(m, src)
+}
+func (m *QueryClockStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClockStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClockStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryClockContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClockStatsRequest proto.InternalMessageInfo
+
+func (m *QueryClockStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryClockContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryClockStatsResponse is the response type for the Query/ClockStats RPC method.
+type QueryClockStatsResponse struct {
+	// stats are the execution stats of the clock contracts.
+	Stats []ClockExecutionStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryClockContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ClockContract.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryClockStatsResponse) Reset()         { *m = QueryClockStatsResponse{} }
+func (m *QueryClockStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClockStatsResponse) ProtoMessage()    {}
+func (*QueryClockStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da208f579d775c8, []int{12}
+}
+func (m *QueryClockStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClockStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClockStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryClockStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClockStatsResponse.Merge(m, src)
+}
+func (m *QueryClockStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClockStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClockStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClockStatsResponse proto.InternalMessageInfo
+
+func (m *QueryClockStatsResponse) GetStats() []ClockExecutionStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryClockStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// ClockExecutionStats are the execution stats of a single clock contract.
+type ClockExecutionStats struct {
+	// contract_address is the address of the contract.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// total_executions is the number of times the contract has been executed.
+	TotalExecutions uint64 `protobuf:"varint,2,opt,name=total_executions,json=totalExecutions,proto3" json:"total_executions,omitempty"`
+	// last_success_height is the height of the last successful execution.
+	LastSuccessHeight int64 `protobuf:"varint,3,opt,name=last_success_height,json=lastSuccessHeight,proto3" json:"last_success_height,omitempty"`
+	// last_failure_height is the height of the last failed execution.
+	LastFailureHeight int64 `protobuf:"varint,4,opt,name=last_failure_height,json=lastFailureHeight,proto3" json:"last_failure_height,omitempty"`
+	// last_error is the error of the last failed execution.
+	LastError string `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// last_gas_used is the gas used by the last execution.
+	LastGasUsed uint64 `protobuf:"varint,6,opt,name=last_gas_used,json=lastGasUsed,proto3" json:"last_gas_used,omitempty"`
+	// total_gas_used is the total gas used by all executions.
+	TotalGasUsed uint64 `protobuf:"varint,7,opt,name=total_gas_used,json=totalGasUsed,proto3" json:"total_gas_used,omitempty"`
+	// failure_count is the number of consecutive failed executions.
+	FailureCount uint64 `protobuf:"varint,8,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	// is_jailed is the jail status of the contract.
+	IsJailed bool `protobuf:"varint,9,opt,name=is_jailed,json=isJailed,proto3" json:"is_jailed,omitempty"`
+}
+
+func (m *ClockExecutionStats) Reset()         { *m = ClockExecutionStats{} }
+func (m *ClockExecutionStats) String() string { return proto.CompactTextString(m) }
+func (*ClockExecutionStats) ProtoMessage()    {}
+func (*ClockExecutionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da208f579d775c8, []int{13}
+}
+func (m *ClockExecutionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ClockExecutionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClockExecutionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ClockExecutionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClockExecutionStats.Merge(m, src)
+}
+func (m *ClockExecutionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClockExecutionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClockExecutionStats.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ClockExecutionStats proto.InternalMessageInfo
+
+func (m *ClockExecutionStats) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ClockExecutionStats) GetTotalExecutions() uint64 {
+	if m != nil {
+		return m.TotalExecutions
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ClockExecutionStats) GetLastSuccessHeight() int64 {
+	if m != nil {
+		return m.LastSuccessHeight
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryClockContractsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ClockExecutionStats) GetLastFailureHeight() int64 {
+	if m != nil {
+		return m.LastFailureHeight
+	}
+	return 0
+}
+
+func (m *ClockExecutionStats) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *ClockExecutionStats) GetLastGasUsed() uint64 {
+	if m != nil {
+		return m.LastGasUsed
+	}
+	return 0
+}
+
+func (m *ClockExecutionStats) GetTotalGasUsed() uint64 {
+	if m != nil {
+		return m.TotalGasUsed
+	}
+	return 0
+}
+
+func (m *ClockExecutionStats) GetFailureCount() uint64 {
+	if m != nil {
+		return m.FailureCount
+	}
+	return 0
+}
+
+func (m *ClockExecutionStats) GetIsJailed() bool {
+	if m != nil {
+		return m.IsJailed
+	}
+	return false
+}
+
+// QueryParams is the request type to get all module params.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da208f579d775c8, []int{14}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryClockContractsResponse is the response type for the Query/ClockContracts RPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da208f579d775c8, []int{15}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QueryClockContractsRequest)(nil), "juno.clock.v1.QueryClockContractsRequest")
+	proto.RegisterType((*QueryClockContractsResponse)(nil), "juno.clock.v1.QueryClockContractsResponse")
+	proto.RegisterType((*QueryClockContractRequest)(nil), "juno.clock.v1.QueryClockContractRequest")
+	proto.RegisterType((*QueryClockContractResponse)(nil), "juno.clock.v1.QueryClockContractResponse")
+	proto.RegisterType((*QueryClockScheduleRequest)(nil), "juno.clock.v1.QueryClockScheduleRequest")
+	proto.RegisterType((*QueryClockScheduleResponse)(nil), "juno.clock.v1.QueryClockScheduleResponse")
+	proto.RegisterType((*ClockSchedule)(nil), "juno.clock.v1.ClockSchedule")
+	proto.RegisterType((*QuerySkippedClockContractsRequest)(nil), "juno.clock.v1.QuerySkippedClockContractsRequest")
+	proto.RegisterType((*QuerySkippedClockContractsResponse)(nil), "juno.clock.v1.QuerySkippedClockContractsResponse")
+	proto.RegisterType((*QueryClockContractStatsRequest)(nil), "juno.clock.v1.QueryClockContractStatsRequest")
+	proto.RegisterType((*QueryClockContractStatsResponse)(nil), "juno.clock.v1.QueryClockContractStatsResponse")
+	proto.RegisterType((*QueryClockStatsRequest)(nil), "juno.clock.v1.QueryClockStatsRequest")
+	proto.RegisterType((*QueryClockStatsResponse)(nil), "juno.clock.v1.QueryClockStatsResponse")
+	proto.RegisterType((*ClockExecutionStats)(nil), "juno.clock.v1.ClockExecutionStats")
+	proto.RegisterType((*QueryParamsRequest)(nil), "juno.clock.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "juno.clock.v1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("juno/clock/v1/query.proto", fileDescriptor_7da208f579d775c8) }
+
+var fileDescriptor_7da208f579d775c8 = []byte{
+	// 1193 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x6f, 0x1b, 0xd5,
+	0x17, 0xcf, 0xc4, 0x8d, 0x6b, 0x9f, 0xd4, 0x79, 0xdc, 0xbc, 0x6c, 0xa7, 0x7f, 0x27, 0x99, 0x34,
+	0xfd, 0x27, 0x41, 0x99, 0xc1, 0xe9, 0xa6, 0x2b, 0x24, 0xe2, 0xa6, 0x29, 0x2c, 0x20, 0x38, 0x65,
+	0x03, 0x0b, 0xf7, 0x7a, 0x7c, 0x33, 0x99, 0xd6, 0x9e, 0x71, 0xe7, 0xce, 0x44, 0xa9, 0x10, 0x2c,
+	0x58, 0x21, 0x16, 0x28, 0x12, 0x42, 0x62, 0x85, 0xc4, 0x0a, 0x16, 0x2c, 0x90, 0xe8, 0x82, 0x8f,
+	0xd0, 0x65, 0x05, 0x1b, 0x56, 0x80, 0x12, 0x04, 0x5f, 0x03, 0xdd, 0xc7, 0x3c, 0xed, 0x38, 0x29,
+	0xf2, 0xc6, 0xf2, 0x9c, 0xf3, 0x3b, 0xe7, 0xfc, 0xce, 0x73, 0x06, 0x4a, 0x8f, 0x7d, 0xdb, 0xd1,
+	0x8d, 0xb6, 0x63, 0x3c, 0xd1, 0x8f, 0xab, 0xfa, 0x53, 0x9f, 0xb8, 0xcf, 0xb4, 0xae, 0xeb, 0x78,
+	0x0e, 0x2a, 0x30, 0x95, 0xc6, 0x55, 0xda, 0x71, 0xb5, 0x3c, 0x8d, 0x3b, 0x96, 0xed, 0xe8, 0xfc,
+	0x57, 0x20, 0xca, 0x9b, 0x86, 0x43, 0x3b, 0x0e, 0xd5, 0x9b, 0x98, 0x12, 0x61, 0xaa, 0x1f, 0x57,
+	0x9b, 0xc4, 0xc3, 0x55, 0xbd, 0x8b, 0x4d, 0xcb, 0xc6, 0x9e, 0xe5, 0xd8, 0x12, 0xbb, 0x28, 0xb1,
+	0x01, 0x2c, 0x1e, 0xaa, 0x5c, 0x12, 0xca, 0x06, 0x7f, 0xd2, 0xc5, 0x83, 0x54, 0xcd, 0x9a, 0x8e,
+	0xe9, 0x08, 0x39, 0xfb, 0x27, 0xa5, 0x37, 0x4d, 0xc7, 0x31, 0xdb, 0x44, 0xc7, 0x5d, 0x4b, 0xc7,
+	0xb6, 0xed, 0x78, 0x3c, 0x54, 0x60, 0x53, 0x91, 0x5a, 0xfe, 0xd4, 0xf4, 0x0f, 0xf5, 0x96, 0xef,
+	0xc6, 0xb9, 0x2c, 0xa5, 0xf5, 0x9e, 0xd5, 0x21, 0xd4, 0xc3, 0x9d, 0x6e, 0xc0, 0x27, 0x59, 0x15,
+	0x51, 0x03, 0x99, 0x47, 0x52, 0x65, 0x12, 0x9b, 0x50, 0x4b, 0x06, 0x56, 0x5b, 0x50, 0x7e, 0x8f,
+	0xa5, 0x55, 0x63, 0xea, 0x9a, 0x63, 0x7b, 0x2e, 0x36, 0x3c, 0x5a, 0x27, 0x4f, 0x7d, 0x42, 0x3d,
+	0x74, 0x1f, 0x20, 0x2a, 0x4b, 0x51, 0x59, 0x56, 0xd6, 0xc7, 0xb7, 0x6f, 0x6b, 0x32, 0x5b, 0x56,
+	0x43, 0x4d, 0xd4, 0x44, 0xd6, 0x50, 0xdb, 0xc7, 0x26, 0x91, 0xb6, 0xf5, 0x98, 0xa5, 0xfa, 0xb3,
+	0x02, 0x8b, 0x7d, 0xc3, 0xd0, 0xae, 0x63, 0x53, 0x82, 0xf6, 0x61, 0x92, 0xf3, 0x6b, 0x18, 0x81,
+	0xaa, 0xa8, 0x2c, 0x67, 0xd6, 0xc7, 0xb7, 0x6f, 0x6a, 0x89, 0x96, 0x6a, 0x09, 0xfb, 0x9d, 0xfc,
+	0x8b, 0xdf, 0x97, 0x46, 0xbe, 0xff, 0xe7, 0xc7, 0x4d, 0xa5, 0x3e, 0x61, 0x24, 0x3c, 0xa3, 0xbd,
+	0x04, 0xf3, 0x51, 0xce, 0xfc, 0xff, 0x97, 0x32, 0x17, 0x74, 0x12, 0xd4, 0x1f, 0x41, 0xa9, 0x97,
+	0x79, 0x50, 0x9f, 0x1a, 0x4c, 0x05, 0x8c, 0x1b, 0xb8, 0xd5, 0x72, 0x09, 0xa5, 0xbc, 0x4a, 0xf9,
+	0x9d, 0xe2, 0x2f, 0xcf, 0xb7, 0x66, 0x65, 0xb8, 0x37, 0x85, 0xe6, 0xc0, 0x73, 0x2d, 0xdb, 0xac,
+	0x4f, 0x06, 0x16, 0x52, 0xac, 0xb6, 0xfb, 0xb5, 0x20, 0x2c, 0xcd, 0x3b, 0x30, 0x91, 0x2c, 0x8d,
+	0x6c, 0xc3, 0x95, 0x2b, 0x53, 0x48, 0x54, 0x46, 0x35, 0xe2, 0xf9, 0x1c, 0x18, 0x47, 0xa4, 0xe5,
+	0xb7, 0xc9, 0xb0, 0xfb, 0xfd, 0x83, 0x12, 0xcf, 0x29, 0x8a, 0x22, 0x73, 0xda, 0x85, 0x3c, 0x95,
+	0xb2, 0x81, 0x8d, 0x0e, 0x0c, 0xe3, 0xe9, 0x44, 0x96, 0xc3, 0xeb, 0xf1, 0xdf, 0xa3, 0x50, 0x48,
+	0x04, 0x1c, 0x4a, 0x63, 0xd1, 0x1a, 0x4c, 0x34, 0x79, 0xeb, 0x2c, 0xdb, 0x23, 0xee, 0x31, 0x6e,
+	0x73, 0x8e, 0xd7, 0xea, 0x05, 0x2e, 0x7d, 0x4b, 0x0a, 0xd1, 0x03, 0x28, 0xb0, 0x6d, 0x8e, 0x50,
+	0x19, 0x9e, 0x49, 0x49, 0x13, 0x3b, 0xaf, 0x05, 0x3b, 0xaf, 0xdd, 0x93, 0x37, 0x61, 0x27, 0xc7,
+	0xca, 0xf1, 0xf5, 0x1f, 0x4b, 0x4a, 0xfd, 0x06, 0xb3, 0x0c, 0x3d, 0x6d, 0xc3, 0x9c, 0x4d, 0x4e,
+	0xbc, 0x06, 0x39, 0x21, 0x86, 0xcf, 0x90, 0x8d, 0x23, 0x62, 0x99, 0x47, 0x5e, 0xf1, 0xda, 0xb2,
+	0xb2, 0x9e, 0xa9, 0xcf, 0x30, 0xe5, 0x6e, 0xa0, 0x7b, 0xc0, 0x55, 0xe8, 0x21, 0xcc, 0xa4, 0x6c,
+	0x98, 0xcb, 0xe2, 0x18, 0xe7, 0x50, 0xee, 0xe1, 0xf0, 0x30, 0xb8, 0x3b, 0x82, 0xc4, 0x29, 0x23,
+	0x31, 0x9d, 0xf0, 0xcb, 0x10, 0x68, 0x0e, 0xb2, 0x16, 0x6d, 0xb4, 0x7c, 0x52, 0xcc, 0x2e, 0x2b,
+	0xeb, 0xb9, 0xfa, 0x98, 0x45, 0xef, 0xf9, 0x44, 0x5d, 0x85, 0x15, 0x3e, 0x16, 0x07, 0x4f, 0xac,
+	0x6e, 0x97, 0xb4, 0xfa, 0x1e, 0x1d, 0xf5, 0xb9, 0x02, 0xea, 0x20, 0x94, 0x1c, 0xa2, 0x79, 0xc8,
+	0xca, 0xec, 0x14, 0x9e, 0x9d, 0x7c, 0x42, 0x25, 0xc8, 0x99, 0x98, 0x36, 0x7c, 0x4a, 0x5a, 0xb2,
+	0xde, 0xd7, 0x4d, 0x4c, 0xdf, 0xa7, 0xa4, 0x85, 0x3e, 0x84, 0x69, 0x2a, 0x7c, 0xc6, 0x0e, 0x4d,
+	0x86, 0xcf, 0xdf, 0x6a, 0x6a, 0xfe, 0xfa, 0xc5, 0x8e, 0x8f, 0xe1, 0x94, 0x74, 0x14, 0xf2, 0x52,
+	0x09, 0x54, 0x7a, 0xd7, 0xf8, 0xc0, 0xc3, 0xd1, 0x35, 0x1d, 0xca, 0xb5, 0x38, 0x84, 0xa5, 0x0b,
+	0xc3, 0xc8, 0xca, 0xd4, 0x60, 0x8c, 0x32, 0x81, 0x5c, 0x60, 0xb5, 0xdf, 0x6a, 0x85, 0xed, 0xe2,
+	0xa6, 0xf1, 0xcc, 0x84, 0xad, 0xfa, 0x08, 0xe6, 0x63, 0x1b, 0x1c, 0x4f, 0x63, 0x58, 0x47, 0xe2,
+	0x3b, 0x05, 0x16, 0x7a, 0x42, 0xf4, 0xa6, 0x90, 0xf9, 0xaf, 0x29, 0x0c, 0xef, 0x3e, 0x9c, 0x66,
+	0x60, 0xa6, 0x4f, 0xc8, 0xe1, 0x5c, 0x89, 0x0d, 0x98, 0xf2, 0x1c, 0x0f, 0xb7, 0xa3, 0x0d, 0xa4,
+	0x72, 0x6e, 0x27, 0xb9, 0x3c, 0x8c, 0x49, 0x91, 0x06, 0x33, 0x6d, 0x4c, 0xbd, 0x06, 0xf5, 0x0d,
+	0x83, 0x50, 0x1a, 0x6c, 0x77, 0x86, 0xcf, 0xff, 0x34, 0x53, 0x1d, 0x08, 0x8d, 0xdc, 0xed, 0x00,
+	0x7f, 0x88, 0xad, 0xb6, 0xef, 0x92, 0xe4, 0x35, 0xe0, 0xf8, 0xfb, 0x42, 0x23, 0xf1, 0xff, 0x03,
+	0xe0, 0x78, 0xe2, 0xba, 0x8e, 0xcb, 0x4f, 0x40, 0xbe, 0x9e, 0x67, 0x92, 0x5d, 0x26, 0x40, 0x2a,
+	0x14, 0xb8, 0x3a, 0x5c, 0xaf, 0x2c, 0xa7, 0x39, 0xce, 0x84, 0x7b, 0x72, 0xc5, 0x6e, 0xc1, 0x84,
+	0xc8, 0x26, 0x04, 0x5d, 0xe7, 0xa0, 0x1b, 0x5c, 0x1a, 0xa0, 0x56, 0xa1, 0x10, 0x70, 0x32, 0x1c,
+	0xdf, 0xf6, 0x8a, 0x39, 0x01, 0x92, 0xc2, 0x1a, 0x93, 0xa1, 0x45, 0xc8, 0x5b, 0xb4, 0xf1, 0x18,
+	0x5b, 0x6d, 0xd2, 0x2a, 0xe6, 0xf9, 0x19, 0xc9, 0x59, 0xf4, 0x6d, 0xfe, 0xac, 0xce, 0x02, 0xe2,
+	0xb3, 0xb3, 0x8f, 0x5d, 0xdc, 0x09, 0x4f, 0xc7, 0xbb, 0x30, 0x93, 0x90, 0xca, 0x69, 0xba, 0x0b,
+	0xd9, 0x2e, 0x97, 0xc8, 0x69, 0x9d, 0x4b, 0x8d, 0x93, 0x80, 0xc7, 0x27, 0x48, 0xe2, 0xb7, 0xbf,
+	0xca, 0xc1, 0x18, 0xf7, 0x88, 0xbe, 0x50, 0x60, 0x22, 0x79, 0x89, 0xd0, 0x46, 0xca, 0xcd, 0xc5,
+	0x1f, 0x52, 0xe5, 0xcd, 0xab, 0x40, 0x05, 0x5b, 0x75, 0xed, 0x33, 0x46, 0xe1, 0xd3, 0x5f, 0xff,
+	0xfa, 0x72, 0xb4, 0x8c, 0x8a, 0x7a, 0xea, 0xc3, 0x2e, 0x8c, 0xfe, 0x8d, 0x22, 0x5f, 0x5a, 0x81,
+	0x07, 0xb4, 0x7e, 0x69, 0x90, 0x80, 0xce, 0xc6, 0x15, 0x90, 0x92, 0xcd, 0xdd, 0x88, 0xcd, 0x16,
+	0x7a, 0xed, 0x22, 0x36, 0xfa, 0x47, 0xe9, 0x45, 0xf8, 0x18, 0x7d, 0xae, 0xa4, 0xdf, 0xaa, 0x17,
+	0x13, 0x4c, 0x7d, 0x88, 0x0c, 0x20, 0x98, 0xfe, 0x98, 0x50, 0x6f, 0x45, 0x04, 0x4b, 0x68, 0x21,
+	0x45, 0x30, 0xf8, 0x58, 0x40, 0xdf, 0x2a, 0x30, 0xd7, 0xf7, 0x7d, 0x82, 0x5e, 0xef, 0x17, 0x6a,
+	0xd0, 0x0b, 0xaa, 0x5c, 0x7d, 0x05, 0x0b, 0x49, 0x72, 0x35, 0x22, 0x59, 0x44, 0xf3, 0x69, 0x92,
+	0xc2, 0x14, 0xfd, 0xa4, 0x00, 0xea, 0x3d, 0xeb, 0x68, 0xeb, 0xd2, 0x66, 0xc5, 0xcf, 0x73, 0x59,
+	0xbb, 0x2a, 0x5c, 0x52, 0x7b, 0x23, 0xa2, 0x76, 0x07, 0x55, 0x5f, 0xa1, 0xc1, 0xba, 0xb8, 0xb2,
+	0x9f, 0x00, 0x44, 0x07, 0x1c, 0xad, 0x5d, 0xdc, 0xb8, 0x38, 0xc9, 0xdb, 0x97, 0xc1, 0x24, 0xb9,
+	0x95, 0x88, 0xdc, 0x3c, 0x9a, 0x4d, 0xd7, 0x8d, 0x47, 0xa4, 0x90, 0x15, 0xfb, 0x8b, 0x56, 0xfa,
+	0x39, 0x4d, 0x1c, 0x88, 0xb2, 0x3a, 0x08, 0x22, 0x63, 0xaa, 0x51, 0xcc, 0x05, 0x34, 0x97, 0x8a,
+	0x29, 0xee, 0xc2, 0xce, 0xde, 0x8b, 0xb3, 0x8a, 0xf2, 0xf2, 0xac, 0xa2, 0xfc, 0x79, 0x56, 0x51,
+	0x4e, 0xcf, 0x2b, 0x23, 0x2f, 0xcf, 0x2b, 0x23, 0xbf, 0x9d, 0x57, 0x46, 0x3e, 0xd8, 0x32, 0x2d,
+	0xef, 0xc8, 0x6f, 0x6a, 0x86, 0xd3, 0xd1, 0x6b, 0xfc, 0x05, 0x10, 0x4e, 0x83, 0x70, 0x75, 0x22,
+	0x9d, 0x79, 0xcf, 0xba, 0x84, 0x36, 0xb3, 0xfc, 0xcb, 0xea, 0xce, 0xbf, 0x01, 0x00, 0x00, 0xff,
+	0xff, 0x6e, 0x54, 0x71, 0x57, 0xd6, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ClockContracts
+	ClockContracts(ctx context.Context, in *QueryClockContractsRequest, opts ...grpc.CallOption) (*QueryClockContractsResponse, error)
+	// ClockContract
+	ClockContract(ctx context.Context, in *QueryClockContractRequest, opts ...grpc.CallOption) (*QueryClockContractResponse, error)
+	// ClockSchedule
+	ClockSchedule(ctx context.Context, in *QueryClockScheduleRequest, opts ...grpc.CallOption) (*QueryClockScheduleResponse, error)
+	// SkippedClockContracts
+	SkippedClockContracts(ctx context.Context, in *QuerySkippedClockContractsRequest, opts ...grpc.CallOption) (*QuerySkippedClockContractsResponse, error)
+	// ClockContractStats
+	ClockContractStats(ctx context.Context, in *QueryClockContractStatsRequest, opts ...grpc.CallOption) (*QueryClockContractStatsResponse, error)
+	// ClockStats
+	ClockStats(ctx context.Context, in *QueryClockStatsRequest, opts ...grpc.CallOption) (*QueryClockStatsResponse, error)
+	// Params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ClockContracts(ctx context.Context, in *QueryClockContractsRequest, opts ...grpc.CallOption) (*QueryClockContractsResponse, error) {
+	out := new(QueryClockContractsResponse)
+	err := c.cc.Invoke(ctx, "/juno.clock.v1.Query/ClockContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClockContract(ctx context.Context, in *QueryClockContractRequest, opts ...grpc.CallOption) (*QueryClockContractResponse, error) {
+	out := new(QueryClockContractResponse)
+	err := c.cc.Invoke(ctx, "/juno.clock.v1.Query/ClockContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClockSchedule(ctx context.Context, in *QueryClockScheduleRequest, opts ...grpc.CallOption) (*QueryClockScheduleResponse, error) {
+	out := new(QueryClockScheduleResponse)
+	err := c.cc.Invoke(ctx, "/juno.clock.v1.Query/ClockSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SkippedClockContracts(ctx context.Context, in *QuerySkippedClockContractsRequest, opts ...grpc.CallOption) (*QuerySkippedClockContractsResponse, error) {
+	out := new(QuerySkippedClockContractsResponse)
+	err := c.cc.Invoke(ctx, "/juno.clock.v1.Query/SkippedClockContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClockContractStats(ctx context.Context, in *QueryClockContractStatsRequest, opts ...grpc.CallOption) (*QueryClockContractStatsResponse, error) {
+	out := new(QueryClockContractStatsResponse)
+	err := c.cc.Invoke(ctx, "/juno.clock.v1.Query/ClockContractStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClockStats(ctx context.Context, in *QueryClockStatsRequest, opts ...grpc.CallOption) (*QueryClockStatsResponse, error) {
+	out := new(QueryClockStatsResponse)
+	err := c.cc.Invoke(ctx, "/juno.clock.v1.Query/ClockStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/juno.clock.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ClockContracts
+	ClockContracts(context.Context, *QueryClockContractsRequest) (*QueryClockContractsResponse, error)
+	// ClockContract
+	ClockContract(context.Context, *QueryClockContractRequest) (*QueryClockContractResponse, error)
+	// ClockSchedule
+	ClockSchedule(context.Context, *QueryClockScheduleRequest) (*QueryClockScheduleResponse, error)
+	// SkippedClockContracts
+	SkippedClockContracts(context.Context, *QuerySkippedClockContractsRequest) (*QuerySkippedClockContractsResponse, error)
+	// ClockContractStats
+	ClockContractStats(context.Context, *QueryClockContractStatsRequest) (*QueryClockContractStatsResponse, error)
+	// ClockStats
+	ClockStats(context.Context, *QueryClockStatsRequest) (*QueryClockStatsResponse, error)
+	// Params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ClockContracts(ctx context.Context, req *QueryClockContractsRequest) (*QueryClockContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClockContracts not implemented")
+}
+func (*UnimplementedQueryServer) ClockContract(ctx context.Context, req *QueryClockContractRequest) (*QueryClockContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClockContract not implemented")
+}
+func (*UnimplementedQueryServer) ClockSchedule(ctx context.Context, req *QueryClockScheduleRequest) (*QueryClockScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClockSchedule not implemented")
+}
+func (*UnimplementedQueryServer) SkippedClockContracts(ctx context.Context, req *QuerySkippedClockContractsRequest) (*QuerySkippedClockContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkippedClockContracts not implemented")
+}
+func (*UnimplementedQueryServer) ClockContractStats(ctx context.Context, req *QueryClockContractStatsRequest) (*QueryClockContractStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClockContractStats not implemented")
+}
+func (*UnimplementedQueryServer) ClockStats(ctx context.Context, req *QueryClockStatsRequest) (*QueryClockStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClockStats not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ClockContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClockContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClockContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.clock.v1.Query/ClockContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClockContracts(ctx, req.(*QueryClockContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClockContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClockContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClockContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.clock.v1.Query/ClockContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClockContract(ctx, req.(*QueryClockContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClockSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClockScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClockSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.clock.v1.Query/ClockSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClockSchedule(ctx, req.(*QueryClockScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SkippedClockContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySkippedClockContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SkippedClockContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.clock.v1.Query/SkippedClockContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SkippedClockContracts(ctx, req.(*QuerySkippedClockContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClockContractStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClockContractStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClockContractStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.clock.v1.Query/ClockContractStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClockContractStats(ctx, req.(*QueryClockContractStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClockStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClockStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClockStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.clock.v1.Query/ClockStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClockStats(ctx, req.(*QueryClockStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.clock.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "juno.clock.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClockContracts",
+			Handler:    _Query_ClockContracts_Handler,
+		},
+		{
+			MethodName: "ClockContract",
+			Handler:    _Query_ClockContract_Handler,
+		},
+		{
+			MethodName: "ClockSchedule",
+			Handler:    _Query_ClockSchedule_Handler,
+		},
+		{
+			MethodName: "SkippedClockContracts",
+			Handler:    _Query_SkippedClockContracts_Handler,
+		},
+		{
+			MethodName: "ClockContractStats",
+			Handler:    _Query_ClockContractStats_Handler,
+		},
+		{
+			MethodName: "ClockStats",
+			Handler:    _Query_ClockStats_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "juno/clock/v1/query.proto",
+}
+
+func (m *QueryClockContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClockContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClockContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClockContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClockContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClockContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClockContracts) > 0 {
+		for iNdEx := len(m.ClockContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClockContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClockContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClockContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClockContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClockContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClockContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClockContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClockContract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClockScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClockScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClockScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClockScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClockScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClockScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClockSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClockSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClockSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsDue {
+		i--
+		if m.IsDue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	if m.NextExecutionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextExecutionHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeInterval):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockInterval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySkippedClockContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySkippedClockContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySkippedClockContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySkippedClockContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySkippedClockContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySkippedClockContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SkippedContracts) > 0 {
+		for iNdEx := len(m.SkippedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SkippedContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClockContractStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClockContractStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClockContractStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClockContractStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClockContractStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClockContractStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClockStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClockStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClockStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClockStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClockStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClockStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClockExecutionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClockExecutionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClockExecutionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsJailed {
+		i--
+		if m.IsJailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FailureCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FailureCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TotalGasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalGasUsed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastGasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastGasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastFailureHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastFailureHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastSuccessHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastSuccessHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalExecutions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalExecutions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryClockContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClockContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClockContracts) > 0 {
+		for _, e := range m.ClockContracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClockContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClockContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClockContract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClockScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClockScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ClockSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockInterval != 0 {
+		n += 1 + sovQuery(uint64(m.BlockInterval))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeInterval)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NextExecutionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextExecutionHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsDue {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySkippedClockContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySkippedClockContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if len(m.SkippedContracts) > 0 {
+		for _, e := range m.SkippedContracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClockContractStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClockContractStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClockStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClockStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
+	return n
+}
+
+func (m *ClockExecutionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TotalExecutions != 0 {
+		n += 1 + sovQuery(uint64(m.TotalExecutions))
+	}
+	if m.LastSuccessHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastSuccessHeight))
+	}
+	if m.LastFailureHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastFailureHeight))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastGasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.LastGasUsed))
+	}
+	if m.TotalGasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.TotalGasUsed))
+	}
+	if m.FailureCount != 0 {
+		n += 1 + sovQuery(uint64(m.FailureCount))
+	}
+	if m.IsJailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryClockContractsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClockContractsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClockContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClockContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClockContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClockContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClockContracts = append(m.ClockContracts, ClockContract{})
+			if err := m.ClockContracts[len(m.ClockContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClockContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClockContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClockContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClockContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClockContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClockContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClockContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClockScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClockScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClockScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClockScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClockScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClockScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, ClockSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClockSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClockSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClockSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInterval", wireType)
+			}
+			m.BlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionHeight", wireType)
+			}
+			m.NextExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextExecutionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDue = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySkippedClockContractsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySkippedClockContractsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySkippedClockContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *QuerySkippedClockContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySkippedClockContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySkippedClockContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkippedContracts = append(m.SkippedContracts, SkippedClockContract{})
+			if err := m.SkippedContracts[len(m.SkippedContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryClockContractsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.ClockContracts) > 0 {
-		for _, e := range m.ClockContracts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryClockContractStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClockContractStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClockContractStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClockContractStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClockContractStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClockContractStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryClockContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryClockContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ClockContract.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryClockContractsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClockStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -836,10 +3397,10 @@ func (m *QueryClockContractsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClockContractsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClockStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClockContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClockStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -899,7 +3460,7 @@ func (m *QueryClockContractsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClockContractsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClockStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -922,15 +3483,15 @@ func (m *QueryClockContractsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClockContractsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClockStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClockContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClockStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClockContracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -957,8 +3518,8 @@ func (m *QueryClockContractsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClockContracts = append(m.ClockContracts, ClockContract{})
-			if err := m.ClockContracts[len(m.ClockContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stats = append(m.Stats, ClockExecutionStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1019,7 +3580,7 @@ func (m *QueryClockContractsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClockContractRequest) Unmarshal(dAtA []byte) error {
+func (m *ClockExecutionStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1042,10 +3603,10 @@ func (m *QueryClockContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClockContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClockExecutionStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClockContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClockExecutionStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1080,61 +3641,68 @@ func (m *QueryClockContractRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalExecutions", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.TotalExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessHeight", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryClockContractResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.LastSuccessHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSuccessHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFailureHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LastFailureHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFailureHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClockContractResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClockContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClockContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1144,25 +3712,101 @@ func (m *QueryClockContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClockContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastGasUsed", wireType)
+			}
+			m.LastGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGasUsed", wireType)
+			}
+			m.TotalGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
+			}
+			m.FailureCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsJailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsJailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// BlockContext is the block information passed to contracts which opted in to
+// receive it with the clock sudo message.
+type BlockContext struct {
+	// The height of the block.
+	Height uint64 `json:"height"`
+	// The time of the block in nanoseconds since the unix epoch, encoded as a
+	// string to match cosmwasm_std::Timestamp.
+	Time string `json:"time"`
+	// The number of transactions in the block.
+	NumTxs uint64 `json:"num_txs"`
+	// The consensus address of the block proposer.
+	Proposer string `json:"proposer"`
+	// The number of times the contract has been executed, including this execution.
+	ExecutionCount uint64 `json:"execution_count"`
+}
+
+// NewBlockContext creates a new block context.
+func NewBlockContext(height int64, blockTime time.Time, numTxs uint64, proposer string, executionCount uint64) BlockContext {
+	return BlockContext{
+		Height:         uint64(height),
+		Time:           strconv.FormatInt(blockTime.UnixNano(), 10),
+		NumTxs:         numTxs,
+		Proposer:       proposer,
+		ExecutionCount: executionCount,
+	}
+}
+
+// blockContextSudoMsg is the sudo message which carries the block context.
+type blockContextSudoMsg struct {
+	ClockBeginBlock *BlockContext `json:"clock_begin_block,omitempty"`
+	ClockEndBlock   *BlockContext `json:"clock_end_block,omitempty"`
+}
+
+// NewBlockContextSudoMessage returns the sudo message for the given block phase,
+// which must be either ClockPhaseBeginBlock or ClockPhaseEndBlock, with the block
+// context as its payload.
+func NewBlockContextSudoMessage(phase ClockPhase, blockCtx BlockContext) ([]byte, error) {
+	msg := blockContextSudoMsg{}
+	if phase == ClockPhaseBeginBlock {
+		msg.ClockBeginBlock = &blockCtx
+	} else {
+		msg.ClockEndBlock = &blockCtx
+	}
+
+	return json.Marshal(msg)
+}
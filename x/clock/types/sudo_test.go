@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmosContracts/juno/v29/x/clock/types"
+)
+
+func TestNewBlockContextSudoMessage(t *testing.T) {
+	blockCtx := types.NewBlockContext(10, time.Unix(1_700_000_000, 5), 3, "junovalcons1abc", 7)
+
+	for _, tc := range []struct {
+		name     string
+		phase    types.ClockPhase
+		expected string
+	}{
+		{
+			name:     "begin block",
+			phase:    types.ClockPhaseBeginBlock,
+			expected: `{"clock_begin_block":{"height":10,"time":"1700000000000000005","num_txs":3,"proposer":"junovalcons1abc","execution_count":7}}`,
+		},
+		{
+			name:     "end block",
+			phase:    types.ClockPhaseEndBlock,
+			expected: `{"clock_end_block":{"height":10,"time":"1700000000000000005","num_txs":3,"proposer":"junovalcons1abc","execution_count":7}}`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := types.NewBlockContextSudoMessage(tc.phase, blockCtx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(bz))
+		})
+	}
+}
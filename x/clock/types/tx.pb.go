@@ -7,24 +7,29 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,6 +43,23 @@ type MsgRegisterClockContract struct {
 	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
 	// The address of the contract to register.
 	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The number of blocks between executions. Zero means every block.
+	BlockInterval uint64 `protobuf:"varint,3,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// The minimum block time between executions. Cannot be combined with
+	// block_interval.
+	TimeInterval time.Duration `protobuf:"bytes,4,opt,name=time_interval,json=timeInterval,proto3,stdduration" json:"time_interval"`
+	// The block phases in which the contract is executed. Defaults to the end
+	// of the block.
+	Phase ClockPhase `protobuf:"varint,5,opt,name=phase,proto3,enum=juno.clock.v1.ClockPhase" json:"phase,omitempty"`
+	// A custom gas limit above the module's contract_gas_limit. Requires a gas
+	// deposit. Zero uses the module's contract_gas_limit.
+	GasLimit uint64 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// The initial gas deposit, in the bond denom, which pays for the gas used
+	// by the contract when it has a custom gas limit.
+	GasDeposit types.Coin `protobuf:"bytes,7,opt,name=gas_deposit,json=gasDeposit,proto3" json:"gas_deposit"`
+	// Whether the sudo message includes the block height and time, the number
+	// of transactions, the proposer and the contract's execution count.
+	BlockContext bool `protobuf:"varint,8,opt,name=block_context,json=blockContext,proto3" json:"block_context,omitempty"`
 }
 
 func (m *MsgRegisterClockContract) Reset()         { *m = MsgRegisterClockContract{} }
@@ -269,6 +291,168 @@ func (m *MsgUnjailClockContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnjailClockContractResponse proto.InternalMessageInfo
 
+// MsgDepositClockGas is the Msg/DepositClockGas request type.
+type MsgDepositClockGas struct {
+	// The address of the sender.
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// The address of the contract to deposit gas for.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The amount to deposit, in the bond denom.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgDepositClockGas) Reset()         { *m = MsgDepositClockGas{} }
+func (m *MsgDepositClockGas) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositClockGas) ProtoMessage()    {}
+func (*MsgDepositClockGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76642a1e9a85f94b, []int{6}
+}
+func (m *MsgDepositClockGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositClockGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositClockGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositClockGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositClockGas.Merge(m, src)
+}
+func (m *MsgDepositClockGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositClockGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositClockGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositClockGas proto.InternalMessageInfo
+
+// MsgDepositClockGasResponse defines the response structure for executing a
+// MsgDepositClockGas message.
+type MsgDepositClockGasResponse struct {
+}
+
+func (m *MsgDepositClockGasResponse) Reset()         { *m = MsgDepositClockGasResponse{} }
+func (m *MsgDepositClockGasResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositClockGasResponse) ProtoMessage()    {}
+func (*MsgDepositClockGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76642a1e9a85f94b, []int{7}
+}
+func (m *MsgDepositClockGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositClockGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositClockGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositClockGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositClockGasResponse.Merge(m, src)
+}
+func (m *MsgDepositClockGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositClockGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositClockGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositClockGasResponse proto.InternalMessageInfo
+
+// MsgWithdrawClockGas is the Msg/WithdrawClockGas request type.
+type MsgWithdrawClockGas struct {
+	// The address of the sender.
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// The address of the contract to withdraw gas from.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The amount to withdraw, in the bond denom.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgWithdrawClockGas) Reset()         { *m = MsgWithdrawClockGas{} }
+func (m *MsgWithdrawClockGas) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawClockGas) ProtoMessage()    {}
+func (*MsgWithdrawClockGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76642a1e9a85f94b, []int{8}
+}
+func (m *MsgWithdrawClockGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawClockGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawClockGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawClockGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawClockGas.Merge(m, src)
+}
+func (m *MsgWithdrawClockGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawClockGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawClockGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawClockGas proto.InternalMessageInfo
+
+// MsgWithdrawClockGasResponse defines the response structure for executing a
+// MsgWithdrawClockGas message.
+type MsgWithdrawClockGasResponse struct {
+}
+
+func (m *MsgWithdrawClockGasResponse) Reset()         { *m = MsgWithdrawClockGasResponse{} }
+func (m *MsgWithdrawClockGasResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawClockGasResponse) ProtoMessage()    {}
+func (*MsgWithdrawClockGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76642a1e9a85f94b, []int{9}
+}
+func (m *MsgWithdrawClockGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawClockGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawClockGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawClockGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawClockGasResponse.Merge(m, src)
+}
+func (m *MsgWithdrawClockGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawClockGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawClockGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawClockGasResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -283,7 +467,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76642a1e9a85f94b, []int{6}
+	return fileDescriptor_76642a1e9a85f94b, []int{10}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -321,7 +505,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76642a1e9a85f94b, []int{7}
+	return fileDescriptor_76642a1e9a85f94b, []int{11}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,6 +541,10 @@ func init() {
 	proto.RegisterType((*MsgUnregisterClockContractResponse)(nil), "juno.clock.v1.MsgUnregisterClockContractResponse")
 	proto.RegisterType((*MsgUnjailClockContract)(nil), "juno.clock.v1.MsgUnjailClockContract")
 	proto.RegisterType((*MsgUnjailClockContractResponse)(nil), "juno.clock.v1.MsgUnjailClockContractResponse")
+	proto.RegisterType((*MsgDepositClockGas)(nil), "juno.clock.v1.MsgDepositClockGas")
+	proto.RegisterType((*MsgDepositClockGasResponse)(nil), "juno.clock.v1.MsgDepositClockGasResponse")
+	proto.RegisterType((*MsgWithdrawClockGas)(nil), "juno.clock.v1.MsgWithdrawClockGas")
+	proto.RegisterType((*MsgWithdrawClockGasResponse)(nil), "juno.clock.v1.MsgWithdrawClockGasResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "juno.clock.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "juno.clock.v1.MsgUpdateParamsResponse")
 }
@@ -364,46 +552,68 @@ func init() {
 func init() { proto.RegisterFile("juno/clock/v1/tx.proto", fileDescriptor_76642a1e9a85f94b) }
 
 var fileDescriptor_76642a1e9a85f94b = []byte{
-	// 618 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcf, 0x6b, 0x13, 0x4f,
-	0x1c, 0xdd, 0xe9, 0xf7, 0x6b, 0xa1, 0xa3, 0xb5, 0xba, 0xb6, 0x4d, 0xba, 0x86, 0x4d, 0x18, 0x5b,
-	0xab, 0x81, 0xec, 0x90, 0x0a, 0x2a, 0xbd, 0x88, 0x09, 0xd2, 0x53, 0x40, 0x22, 0x7a, 0xf0, 0x52,
-	0xb6, 0x9b, 0x61, 0xba, 0x35, 0xbb, 0xb3, 0xec, 0x4c, 0x4a, 0x7b, 0x93, 0x9e, 0x44, 0x10, 0x04,
-	0xf1, 0x28, 0xf4, 0xe8, 0xc1, 0x43, 0x0e, 0x1e, 0xfd, 0x03, 0x7a, 0x2c, 0x7a, 0xf1, 0x24, 0x92,
-	0x08, 0x11, 0xfc, 0x27, 0x64, 0x7f, 0x36, 0xc9, 0xee, 0x9a, 0x1c, 0x7b, 0x09, 0x99, 0xcf, 0x7b,
-	0x9f, 0xcf, 0xbc, 0xf7, 0x98, 0x99, 0x85, 0xcb, 0x7b, 0x1d, 0x9b, 0x61, 0xa3, 0xcd, 0x8c, 0x17,
-	0x78, 0xbf, 0x8a, 0xc5, 0x81, 0xe6, 0xb8, 0x4c, 0x30, 0x79, 0xde, 0xab, 0x6b, 0x7e, 0x5d, 0xdb,
-	0xaf, 0x2a, 0x57, 0x75, 0xcb, 0xb4, 0x19, 0xf6, 0x7f, 0x03, 0x86, 0x92, 0x33, 0x18, 0xb7, 0x18,
-	0xc7, 0x16, 0xa7, 0x5e, 0xa7, 0xc5, 0x69, 0x08, 0xac, 0x04, 0xc0, 0xb6, 0xbf, 0xc2, 0xc1, 0x22,
-	0x84, 0x16, 0x29, 0xa3, 0x2c, 0xa8, 0x7b, 0xff, 0xc2, 0x6a, 0x81, 0x32, 0x46, 0xdb, 0x04, 0xeb,
-	0x8e, 0x89, 0x75, 0xdb, 0x66, 0x42, 0x17, 0x26, 0xb3, 0xa3, 0x9e, 0xeb, 0xa3, 0x0a, 0x29, 0xb1,
-	0x09, 0x37, 0x43, 0x10, 0x0d, 0x00, 0xcc, 0x37, 0x38, 0x6d, 0x12, 0x6a, 0x72, 0x41, 0xdc, 0xba,
-	0xc7, 0xaa, 0x33, 0x5b, 0xb8, 0xba, 0x21, 0xe4, 0x07, 0xf0, 0x32, 0x27, 0x76, 0x8b, 0xb8, 0xdb,
-	0x7a, 0xab, 0xe5, 0x12, 0xce, 0xf3, 0xa0, 0x04, 0x6e, 0xcd, 0xd5, 0xf2, 0x5f, 0x3f, 0x57, 0x16,
-	0x43, 0x5d, 0x0f, 0x03, 0xe4, 0x89, 0x70, 0x4d, 0x9b, 0x36, 0xe7, 0x03, 0x7e, 0x58, 0x94, 0xeb,
-	0xf0, 0x8a, 0x11, 0x0e, 0x8b, 0x47, 0xcc, 0x4c, 0x18, 0xb1, 0x10, 0x75, 0x84, 0xe5, 0xcd, 0x47,
-	0xaf, 0x8e, 0x8b, 0xd2, 0xef, 0xe3, 0xa2, 0x74, 0x34, 0xe8, 0x96, 0xc7, 0x04, 0xbd, 0x1e, 0x74,
-	0xcb, 0x6b, 0xbe, 0xbf, 0x83, 0xd0, 0x61, 0x96, 0x19, 0x84, 0x60, 0x29, 0x0b, 0x6b, 0x12, 0xee,
-	0x30, 0x9b, 0x13, 0xf4, 0x07, 0x40, 0xa5, 0xc1, 0xe9, 0x53, 0xdb, 0x3d, 0xc7, 0x79, 0x6c, 0x4d,
-	0xc8, 0x63, 0x7d, 0x3c, 0x8f, 0x0c, 0x3b, 0x68, 0x15, 0xa2, 0x6c, 0x34, 0xce, 0xa4, 0x0f, 0xe0,
-	0xb2, 0x4f, 0xdb, 0xd3, 0xcd, 0xf6, 0x79, 0xcc, 0xa3, 0x3e, 0x21, 0x8f, 0x1b, 0xc9, 0x3c, 0x12,
-	0x56, 0x50, 0x09, 0xaa, 0xe9, 0x48, 0x9c, 0xc3, 0x17, 0x00, 0x17, 0x3c, 0x8a, 0xd3, 0xd2, 0x05,
-	0x79, 0xac, 0xbb, 0xba, 0xc5, 0xe5, 0xbb, 0x70, 0x4e, 0xef, 0x88, 0x5d, 0xe6, 0x9a, 0xe2, 0x70,
-	0xa2, 0xf7, 0x33, 0xaa, 0x7c, 0x1f, 0xce, 0x3a, 0xfe, 0x04, 0xdf, 0xed, 0xc5, 0x8d, 0x25, 0x6d,
-	0xe4, 0xb5, 0xd0, 0x82, 0xf1, 0xb5, 0xb9, 0x93, 0x1f, 0x45, 0xe9, 0xe3, 0xa0, 0x5b, 0x06, 0xcd,
-	0x90, 0xbf, 0x79, 0x6f, 0xd8, 0xec, 0xd9, 0x44, 0xcf, 0x67, 0x21, 0xe1, 0x73, 0x48, 0x2a, 0x5a,
-	0x81, 0xb9, 0xb1, 0x52, 0xe4, 0x6c, 0xe3, 0xd3, 0xff, 0xf0, 0xbf, 0x06, 0xa7, 0xf2, 0x7b, 0x00,
-	0x97, 0xd2, 0x1f, 0x82, 0xf5, 0x31, 0x7d, 0x59, 0x17, 0x49, 0xc1, 0x53, 0x12, 0xe3, 0x54, 0xd1,
-	0xd1, 0xb7, 0x5f, 0xef, 0x66, 0x0a, 0x48, 0xc1, 0xe3, 0xef, 0x28, 0x8e, 0x8e, 0xa5, 0xfc, 0x01,
-	0xc0, 0x5c, 0xd6, 0x95, 0xbc, 0x9d, 0xdc, 0x30, 0x83, 0xaa, 0x54, 0xa7, 0xa6, 0xc6, 0xea, 0x56,
-	0x7d, 0x75, 0x2a, 0x2a, 0x24, 0xd5, 0x75, 0xe2, 0x56, 0xf9, 0x0d, 0x80, 0xd7, 0xd2, 0xae, 0xc7,
-	0x5a, 0xda, 0x86, 0x09, 0x9a, 0x52, 0x99, 0x8a, 0x16, 0x6b, 0x2a, 0xf9, 0x9a, 0x14, 0x94, 0x4f,
-	0xd3, 0xe4, 0xb5, 0xc9, 0xcf, 0xe0, 0xa5, 0x91, 0x53, 0xaa, 0xa6, 0x6c, 0x30, 0x84, 0x2b, 0x37,
-	0xff, 0x8d, 0x47, 0x3b, 0x2b, 0x17, 0x5e, 0x7a, 0x47, 0xb1, 0xb6, 0x75, 0xd2, 0x53, 0xc1, 0x69,
-	0x4f, 0x05, 0x3f, 0x7b, 0x2a, 0x78, 0xdb, 0x57, 0xa5, 0xd3, 0xbe, 0x2a, 0x7d, 0xef, 0xab, 0xd2,
-	0xf3, 0x0a, 0x35, 0xc5, 0x6e, 0x67, 0x47, 0x33, 0x98, 0x85, 0xeb, 0xfe, 0x15, 0x88, 0xd4, 0x73,
-	0x3c, 0x72, 0x38, 0xc5, 0xa1, 0x43, 0xf8, 0xce, 0xac, 0xff, 0x09, 0xba, 0xf3, 0x37, 0x00, 0x00,
-	0xff, 0xff, 0x05, 0x59, 0x5c, 0xa5, 0x43, 0x07, 0x00, 0x00,
+	// 975 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x6b, 0x24, 0x45,
+	0x14, 0x9e, 0xca, 0x26, 0x63, 0x52, 0xf9, 0xb5, 0xf6, 0xfe, 0xc8, 0xa4, 0x33, 0xdb, 0xd3, 0xf6,
+	0x6e, 0x76, 0x67, 0x03, 0xe9, 0x66, 0x22, 0xa8, 0x04, 0x61, 0xd7, 0xcc, 0x4a, 0x14, 0x1c, 0x58,
+	0x5a, 0x54, 0xf0, 0x32, 0xd4, 0xcc, 0x94, 0x95, 0x5a, 0xa7, 0xbb, 0x86, 0xae, 0x9a, 0x6c, 0xf6,
+	0x26, 0x7b, 0x10, 0x59, 0x58, 0x10, 0x44, 0x50, 0x44, 0xd8, 0xa3, 0xc7, 0x1c, 0x3c, 0x7a, 0xf2,
+	0xb4, 0xc7, 0x45, 0x2f, 0x9e, 0x54, 0x12, 0x21, 0x82, 0xff, 0x84, 0x54, 0x75, 0x75, 0x27, 0xd3,
+	0x3d, 0x93, 0xc9, 0x31, 0xec, 0x25, 0xa4, 0xdf, 0xf7, 0xbd, 0x57, 0xdf, 0xfb, 0xfa, 0xd5, 0x9b,
+	0x86, 0x57, 0x1f, 0xf4, 0x43, 0xe6, 0xb5, 0xbb, 0xac, 0xfd, 0xb9, 0xb7, 0x5b, 0xf3, 0xc4, 0x9e,
+	0xdb, 0x8b, 0x98, 0x60, 0xc6, 0xbc, 0x8c, 0xbb, 0x2a, 0xee, 0xee, 0xd6, 0xcc, 0x57, 0x51, 0x40,
+	0x43, 0xe6, 0xa9, 0xbf, 0x31, 0xc3, 0xb4, 0xda, 0x8c, 0x07, 0x8c, 0x7b, 0x2d, 0xc4, 0xb1, 0xb7,
+	0x5b, 0x6b, 0x61, 0x81, 0x6a, 0x5e, 0x9b, 0xd1, 0x50, 0xe3, 0x4b, 0x1a, 0x0f, 0x38, 0x91, 0x95,
+	0x03, 0x4e, 0x34, 0xb0, 0x1c, 0x03, 0x4d, 0xf5, 0xe4, 0xc5, 0x0f, 0x1a, 0xba, 0x4c, 0x18, 0x61,
+	0x71, 0x5c, 0xfe, 0xa7, 0xa3, 0x65, 0xc2, 0x18, 0xe9, 0x62, 0x0f, 0xf5, 0xa8, 0x87, 0xc2, 0x90,
+	0x09, 0x24, 0x28, 0x0b, 0x93, 0x1c, 0x4b, 0xa3, 0xea, 0xa9, 0xd5, 0xff, 0xcc, 0xeb, 0xf4, 0x23,
+	0x45, 0x48, 0x8e, 0x1b, 0xec, 0x30, 0x6e, 0x29, 0x86, 0x56, 0x06, 0x21, 0x82, 0x43, 0xcc, 0xa9,
+	0xae, 0xeb, 0x3c, 0x9d, 0x84, 0xa5, 0x06, 0x27, 0x3e, 0x26, 0x94, 0x0b, 0x1c, 0xd5, 0x25, 0xab,
+	0xce, 0x42, 0x11, 0xa1, 0xb6, 0x30, 0xee, 0xc0, 0x05, 0x8e, 0xc3, 0x0e, 0x8e, 0x9a, 0xa8, 0xd3,
+	0x89, 0x30, 0xe7, 0x25, 0x60, 0x83, 0xea, 0xcc, 0x56, 0xe9, 0xb7, 0x9f, 0xd7, 0x2f, 0xeb, 0x96,
+	0xde, 0x89, 0x91, 0x0f, 0x45, 0x44, 0x43, 0xe2, 0xcf, 0xc7, 0x7c, 0x1d, 0x34, 0xea, 0xf0, 0x62,
+	0x5b, 0x17, 0x4b, 0x4b, 0x4c, 0x8c, 0x29, 0xb1, 0x98, 0x64, 0x24, 0x45, 0x56, 0xe1, 0x42, 0x4b,
+	0xca, 0x6a, 0xd2, 0x50, 0xe0, 0x68, 0x17, 0x75, 0x4b, 0x17, 0x6c, 0x50, 0x9d, 0xf4, 0xe7, 0x55,
+	0xf4, 0x7d, 0x1d, 0x34, 0xde, 0x83, 0xf3, 0x82, 0x06, 0xf8, 0x98, 0x35, 0x69, 0x83, 0xea, 0xec,
+	0xc6, 0xb2, 0x1b, 0x3b, 0xe7, 0x26, 0xce, 0xb9, 0xf7, 0xb4, 0x73, 0x5b, 0xd3, 0xcf, 0xff, 0xac,
+	0x14, 0xbe, 0xfb, 0xab, 0x02, 0xfc, 0x39, 0x99, 0x99, 0x56, 0xf2, 0xe0, 0x54, 0x6f, 0x07, 0x71,
+	0x5c, 0x9a, 0xb2, 0x41, 0x75, 0x61, 0x63, 0xd9, 0x1d, 0x98, 0x12, 0x57, 0x79, 0x74, 0x5f, 0x12,
+	0xfc, 0x98, 0x67, 0xac, 0xc0, 0x19, 0x82, 0x78, 0xb3, 0x4b, 0x03, 0x2a, 0x4a, 0x45, 0x25, 0x6e,
+	0x9a, 0x20, 0xfe, 0x81, 0x7c, 0x36, 0xee, 0xc2, 0x59, 0x09, 0x76, 0x70, 0x8f, 0x71, 0x2a, 0x4a,
+	0xaf, 0x68, 0x55, 0xba, 0x77, 0x39, 0x57, 0xae, 0x9e, 0x2b, 0xb7, 0xce, 0x68, 0xb8, 0x35, 0x29,
+	0x55, 0xf9, 0x90, 0x20, 0x7e, 0x2f, 0x4e, 0x31, 0xae, 0xc3, 0xb8, 0xd5, 0xa6, 0x74, 0x06, 0xef,
+	0x89, 0xd2, 0xb4, 0x0d, 0xaa, 0xd3, 0xfe, 0x5c, 0x2b, 0x79, 0x59, 0x78, 0x4f, 0x6c, 0xbe, 0xfb,
+	0xd5, 0xb3, 0x4a, 0xe1, 0xdf, 0x67, 0x95, 0xc2, 0xe3, 0xa3, 0xfd, 0xb5, 0xcc, 0x6b, 0x7b, 0x72,
+	0xb4, 0xbf, 0xb6, 0xaa, 0xa6, 0x60, 0x4f, 0xcf, 0xc1, 0xa8, 0x57, 0xee, 0x38, 0xd0, 0x1e, 0x85,
+	0xf9, 0x98, 0xf7, 0x58, 0xc8, 0xb1, 0xf3, 0x1f, 0x80, 0x66, 0x83, 0x93, 0x8f, 0xc2, 0xe8, 0xfc,
+	0x4e, 0xcd, 0xe6, 0xf6, 0x18, 0x3f, 0x6e, 0x65, 0xfd, 0x18, 0xd1, 0x8e, 0x73, 0x03, 0x3a, 0xa3,
+	0xd1, 0xd4, 0x93, 0x43, 0x00, 0xaf, 0x2a, 0xda, 0x03, 0x44, 0xbb, 0xe7, 0xd1, 0x8f, 0xfa, 0x18,
+	0x3f, 0xae, 0xe7, 0xfd, 0xc8, 0xb5, 0xe2, 0xd8, 0xd0, 0x1a, 0x8e, 0xa4, 0x3e, 0x7c, 0x3f, 0x01,
+	0x8d, 0x06, 0x27, 0x7a, 0x74, 0x15, 0x67, 0x1b, 0xf1, 0x73, 0xb2, 0x49, 0xde, 0x86, 0x45, 0x14,
+	0xb0, 0x7e, 0x28, 0xd4, 0x06, 0x39, 0xf5, 0x16, 0xce, 0xc8, 0x5b, 0xf8, 0xd3, 0xd1, 0xfe, 0x1a,
+	0xf0, 0x75, 0xce, 0xe6, 0x9d, 0x31, 0x0e, 0x56, 0xb2, 0x0e, 0x66, 0x4c, 0x70, 0xca, 0xea, 0xda,
+	0x64, 0xa2, 0xa9, 0x73, 0x3f, 0x4c, 0xc0, 0x4b, 0x0d, 0x4e, 0x3e, 0xa1, 0x62, 0xa7, 0x13, 0xa1,
+	0x87, 0x2f, 0x95, 0x75, 0x77, 0xc7, 0x58, 0x67, 0x67, 0xad, 0xcb, 0xba, 0xe0, 0x5c, 0x83, 0x2b,
+	0x43, 0xc2, 0xa9, 0x79, 0xbf, 0x00, 0xb8, 0x28, 0x27, 0xb3, 0xd7, 0x41, 0x02, 0xdf, 0x47, 0x11,
+	0x0a, 0xb8, 0xf1, 0x06, 0x9c, 0x41, 0x7d, 0xb1, 0xc3, 0x22, 0x2a, 0x1e, 0x8d, 0xf5, 0xec, 0x98,
+	0x6a, 0xbc, 0x05, 0x8b, 0x3d, 0x55, 0x41, 0xb9, 0x34, 0xbb, 0x71, 0x25, 0xb3, 0xff, 0xe3, 0xf2,
+	0x03, 0x6d, 0xc6, 0xfc, 0xcd, 0x37, 0x4f, 0xb6, 0x79, 0x5c, 0x51, 0x76, 0x58, 0xce, 0x5d, 0xaf,
+	0x13, 0x52, 0x9d, 0x65, 0xb8, 0x94, 0x09, 0x25, 0x9d, 0x6d, 0xfc, 0x5a, 0x84, 0x17, 0x1a, 0x9c,
+	0x18, 0xdf, 0x02, 0x78, 0x65, 0xf8, 0xaf, 0xf4, 0xad, 0x8c, 0xbe, 0x51, 0xfb, 0xdb, 0xf4, 0xce,
+	0x48, 0x4c, 0x5d, 0x75, 0x1e, 0xff, 0xfe, 0xcf, 0x37, 0x13, 0x65, 0xc7, 0xf4, 0xb2, 0xdf, 0x4f,
+	0x5e, 0xb2, 0x0d, 0x8d, 0x1f, 0x01, 0x5c, 0x1a, 0xf5, 0x4b, 0x70, 0x3b, 0x7f, 0xe0, 0x08, 0xaa,
+	0x59, 0x3b, 0x33, 0x35, 0x55, 0x77, 0x43, 0xa9, 0xb3, 0x9c, 0x72, 0x5e, 0x5d, 0x3f, 0x4d, 0x35,
+	0x9e, 0x02, 0x78, 0x69, 0xd8, 0x56, 0x5e, 0x1d, 0x76, 0x60, 0x8e, 0x66, 0xae, 0x9f, 0x89, 0x96,
+	0x6a, 0xb2, 0x95, 0x26, 0xd3, 0x29, 0x0d, 0xd3, 0x24, 0xd3, 0x8c, 0x2f, 0x01, 0x5c, 0xcc, 0x6e,
+	0xc7, 0xd7, 0xf2, 0x87, 0x64, 0x28, 0xe6, 0xed, 0xb1, 0x94, 0x54, 0xc3, 0xaa, 0xd2, 0x50, 0x71,
+	0xae, 0xe5, 0x35, 0xe8, 0x8f, 0x90, 0x26, 0x41, 0xdc, 0x78, 0x02, 0xe0, 0xc5, 0xdc, 0xb2, 0x71,
+	0xf2, 0xc7, 0x64, 0x39, 0xe6, 0xda, 0x78, 0x4e, 0xaa, 0xe5, 0xa6, 0xd2, 0x62, 0x3b, 0x56, 0x5e,
+	0xcb, 0x43, 0x9d, 0xa3, 0xc4, 0x7c, 0x0c, 0xe7, 0x06, 0xee, 0xae, 0x35, 0xc4, 0xf6, 0x13, 0xb8,
+	0x79, 0xf3, 0x74, 0x3c, 0x39, 0xdf, 0x9c, 0xfa, 0x42, 0x5e, 0xd0, 0xad, 0xed, 0xe7, 0x07, 0x16,
+	0x78, 0x71, 0x60, 0x81, 0xbf, 0x0f, 0x2c, 0xf0, 0xf5, 0xa1, 0x55, 0x78, 0x71, 0x68, 0x15, 0xfe,
+	0x38, 0xb4, 0x0a, 0x9f, 0xae, 0x13, 0x2a, 0x76, 0xfa, 0x2d, 0xb7, 0xcd, 0x02, 0xaf, 0xae, 0x16,
+	0x43, 0xf2, 0x4e, 0xb9, 0x37, 0x70, 0x65, 0xc5, 0xa3, 0x1e, 0xe6, 0xad, 0xa2, 0xfa, 0x8a, 0x7c,
+	0xfd, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe0, 0xc2, 0x1d, 0x8a, 0x51, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -427,6 +637,12 @@ type MsgClient interface {
 	// UnjailClockContract defines the endpoint for
 	// unjailing a clock contract.
 	UnjailClockContract(ctx context.Context, in *MsgUnjailClockContract, opts ...grpc.CallOption) (*MsgUnjailClockContractResponse, error)
+	// DepositClockGas defines the endpoint for
+	// topping up the gas deposit of a clock contract.
+	DepositClockGas(ctx context.Context, in *MsgDepositClockGas, opts ...grpc.CallOption) (*MsgDepositClockGasResponse, error)
+	// WithdrawClockGas defines the endpoint for
+	// withdrawing from the gas deposit of a clock contract.
+	WithdrawClockGas(ctx context.Context, in *MsgWithdrawClockGas, opts ...grpc.CallOption) (*MsgWithdrawClockGasResponse, error)
 	// UpdateParams defines a governance operation for updating the x/clock module
 	// parameters. The authority is hard-coded to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -467,6 +683,24 @@ func (c *msgClient) UnjailClockContract(ctx context.Context, in *MsgUnjailClockC
 	return out, nil
 }
 
+func (c *msgClient) DepositClockGas(ctx context.Context, in *MsgDepositClockGas, opts ...grpc.CallOption) (*MsgDepositClockGasResponse, error) {
+	out := new(MsgDepositClockGasResponse)
+	err := c.cc.Invoke(ctx, "/juno.clock.v1.Msg/DepositClockGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawClockGas(ctx context.Context, in *MsgWithdrawClockGas, opts ...grpc.CallOption) (*MsgWithdrawClockGasResponse, error) {
+	out := new(MsgWithdrawClockGasResponse)
+	err := c.cc.Invoke(ctx, "/juno.clock.v1.Msg/WithdrawClockGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/juno.clock.v1.Msg/UpdateParams", in, out, opts...)
@@ -487,6 +721,12 @@ type MsgServer interface {
 	// UnjailClockContract defines the endpoint for
 	// unjailing a clock contract.
 	UnjailClockContract(context.Context, *MsgUnjailClockContract) (*MsgUnjailClockContractResponse, error)
+	// DepositClockGas defines the endpoint for
+	// topping up the gas deposit of a clock contract.
+	DepositClockGas(context.Context, *MsgDepositClockGas) (*MsgDepositClockGasResponse, error)
+	// WithdrawClockGas defines the endpoint for
+	// withdrawing from the gas deposit of a clock contract.
+	WithdrawClockGas(context.Context, *MsgWithdrawClockGas) (*MsgWithdrawClockGasResponse, error)
 	// UpdateParams defines a governance operation for updating the x/clock module
 	// parameters. The authority is hard-coded to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -505,6 +745,12 @@ func (*UnimplementedMsgServer) UnregisterClockContract(ctx context.Context, req
 func (*UnimplementedMsgServer) UnjailClockContract(ctx context.Context, req *MsgUnjailClockContract) (*MsgUnjailClockContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnjailClockContract not implemented")
 }
+func (*UnimplementedMsgServer) DepositClockGas(ctx context.Context, req *MsgDepositClockGas) (*MsgDepositClockGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositClockGas not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawClockGas(ctx context.Context, req *MsgWithdrawClockGas) (*MsgWithdrawClockGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawClockGas not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -567,6 +813,42 @@ func _Msg_UnjailClockContract_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DepositClockGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositClockGas)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositClockGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.clock.v1.Msg/DepositClockGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositClockGas(ctx, req.(*MsgDepositClockGas))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawClockGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawClockGas)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawClockGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.clock.v1.Msg/WithdrawClockGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawClockGas(ctx, req.(*MsgWithdrawClockGas))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -602,6 +884,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnjailClockContract",
 			Handler:    _Msg_UnjailClockContract_Handler,
 		},
+		{
+			MethodName: "DepositClockGas",
+			Handler:    _Msg_DepositClockGas_Handler,
+		},
+		{
+			MethodName: "WithdrawClockGas",
+			Handler:    _Msg_WithdrawClockGas_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -631,6 +921,49 @@ func (m *MsgRegisterClockContract) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.BlockContext {
+		i--
+		if m.BlockContext {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.GasDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Phase != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x28
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeInterval):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.BlockInterval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockInterval))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.ContractAddress) > 0 {
 		i -= len(m.ContractAddress)
 		copy(dAtA[i:], m.ContractAddress)
@@ -791,7 +1124,7 @@ func (m *MsgUnjailClockContractResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgDepositClockGas) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -801,18 +1134,18 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDepositClockGas) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDepositClockGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -820,18 +1153,25 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	dAtA[i] = 0x1a
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDepositClockGasResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -841,12 +1181,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDepositClockGasResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDepositClockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -854,45 +1194,194 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgWithdrawClockGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterClockContract) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgWithdrawClockGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawClockGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SenderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterClockContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawClockGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawClockGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawClockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnregisterClockContract) Size() (n int) {
-	if m == nil {
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterClockContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockInterval != 0 {
+		n += 1 + sovTx(uint64(m.BlockInterval))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeInterval)
+	n += 1 + l + sovTx(uint64(l))
+	if m.Phase != 0 {
+		n += 1 + sovTx(uint64(m.Phase))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = m.GasDeposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.BlockContext {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRegisterClockContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnregisterClockContract) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -931,49 +1420,576 @@ func (m *MsgUnjailClockContract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgUnjailClockContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositClockGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositClockGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawClockGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawClockGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterClockContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterClockContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterClockContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInterval", wireType)
+			}
+			m.BlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ClockPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockContext", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockContext = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterClockContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterClockContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterClockContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterClockContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterClockContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterClockContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUnjailClockContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUnregisterClockContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterClockContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterClockContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRegisterClockContract) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailClockContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -996,10 +2012,10 @@ func (m *MsgRegisterClockContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterClockContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailClockContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterClockContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailClockContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1087,7 +2103,7 @@ func (m *MsgRegisterClockContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterClockContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailClockContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1110,10 +2126,10 @@ func (m *MsgRegisterClockContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterClockContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailClockContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterClockContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailClockContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1137,7 +2153,7 @@ func (m *MsgRegisterClockContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterClockContract) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositClockGas) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1160,10 +2176,10 @@ func (m *MsgUnregisterClockContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterClockContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositClockGas: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterClockContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositClockGas: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1230,6 +2246,39 @@ func (m *MsgUnregisterClockContract) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1251,7 +2300,7 @@ func (m *MsgUnregisterClockContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterClockContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositClockGasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1274,10 +2323,10 @@ func (m *MsgUnregisterClockContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterClockContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositClockGasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterClockContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositClockGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1301,7 +2350,7 @@ func (m *MsgUnregisterClockContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnjailClockContract) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawClockGas) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1324,10 +2373,10 @@ func (m *MsgUnjailClockContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnjailClockContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawClockGas: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnjailClockContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawClockGas: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1394,6 +2443,39 @@ func (m *MsgUnjailClockContract) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1415,7 +2497,7 @@ func (m *MsgUnjailClockContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnjailClockContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawClockGasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1438,10 +2520,10 @@ func (m *MsgUnjailClockContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnjailClockContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawClockGasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnjailClockContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawClockGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
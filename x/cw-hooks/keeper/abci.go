@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
+)
+
+// EndBlocker delivers the queued hooks to deferred contracts.
+func EndBlocker(ctx context.Context, k Keeper) error {
+	start := telemetry.Now()
+	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyEndBlocker)
+
+	k.DeliverQueuedHooks(ctx)
+	return nil
+}
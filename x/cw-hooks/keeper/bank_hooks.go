@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	helpers "github.com/CosmosContracts/juno/v29/app/helpers"
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
+	tokenfactorytypes "github.com/CosmosContracts/juno/v29/x/tokenfactory/types"
+)
+
+// bankHookContextKey marks the context of a before_send execution with the denoms whose
+// before_send is executing, so sends of these denoms made by the contract do not call
+// their bank hooks again.
+type bankHookContextKey struct{}
+
+// executingBankHookDenoms returns the denoms whose before_send is executing in the context.
+func executingBankHookDenoms(ctx sdk.Context) []string {
+	denoms, _ := ctx.Value(bankHookContextKey{}).([]string)
+	return denoms
+}
+
+type BankSend struct {
+	From   string   `json:"from"`
+	To     string   `json:"to"`
+	Amount sdk.Coin `json:"amount"`
+}
+
+type SudoMsgBeforeSend struct {
+	BeforeSend *BankSend `json:"before_send"`
+}
+
+type SudoMsgAfterSend struct {
+	AfterSend *BankSend `json:"after_send"`
+}
+
+// SetBankHook attaches the contract of the bank hook to its denom.
+func (k Keeper) SetBankHook(ctx context.Context, hook types.BankHook) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	loadedPrefix := prefix.NewStore(store, types.KeyPrefixBank)
+	loadedPrefix.Set([]byte(hook.Denom), k.cdc.MustMarshal(&hook))
+}
+
+// GetBankHook returns the bank hook of a denom.
+func (k Keeper) GetBankHook(ctx context.Context, denom string) (types.BankHook, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	loadedPrefix := prefix.NewStore(store, types.KeyPrefixBank)
+
+	bz := loadedPrefix.Get([]byte(denom))
+	if bz == nil {
+		return types.BankHook{}, false
+	}
+
+	var hook types.BankHook
+	k.cdc.MustUnmarshal(bz, &hook)
+	return hook, true
+}
+
+// DeleteBankHook removes the bank hook of a denom and the registration of its contract.
+func (k Keeper) DeleteBankHook(ctx context.Context, denom string) {
+	hook, found := k.GetBankHook(ctx, denom)
+	if !found {
+		return
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	loadedPrefix := prefix.NewStore(store, types.KeyPrefixBank)
+	loadedPrefix.Delete([]byte(denom))
+
+	k.DeleteRegistration(ctx, types.CategoryBank, sdk.MustAccAddressFromBech32(hook.ContractAddress), denom)
+}
+
+// GetAllBankHooks returns the bank hooks of all denoms.
+func (k Keeper) GetAllBankHooks(ctx context.Context) []types.BankHook {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixBank)
+	defer iterator.Close() //nolint:errcheck
+
+	list := []types.BankHook{}
+	for ; iterator.Valid(); iterator.Next() {
+		var hook types.BankHook
+		k.cdc.MustUnmarshal(iterator.Value(), &hook)
+		list = append(list, hook)
+	}
+	return list
+}
+
+// GetPaginatedBankHooks returns the bank hooks ordered by denom.
+func (k Keeper) GetPaginatedBankHooks(ctx context.Context, pag *query.PageRequest) (*types.QueryBankHooksResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyPrefixBank)
+
+	results, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		store,
+		pag,
+		func(_ []byte, value *types.BankHook) (*types.BankHook, error) {
+			return value, nil
+		},
+		func() *types.BankHook {
+			return &types.BankHook{}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks := make([]types.BankHook, 0, len(results))
+	for _, hook := range results {
+		hooks = append(hooks, *hook)
+	}
+
+	return &types.QueryBankHooksResponse{
+		BankHooks:  hooks,
+		Pagination: pageRes,
+	}, nil
+}
+
+// BankSendRestriction calls the bank hooks of the token factory denoms being sent. It
+// is appended to the send restrictions of x/bank. A contract receiving before_send can
+// reject the send by returning an error, after_send is queued for the end of the block.
+// Sends fail while the after_send queue quota of the denom is full.
+func (k Keeper) BankSendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	executing := executingBankHookDenoms(sdkCtx)
+
+	for _, coin := range amt {
+		if !strings.HasPrefix(coin.Denom, tokenfactorytypes.ModuleDenomPrefix+"/") {
+			continue
+		}
+
+		if slices.Contains(executing, coin.Denom) {
+			continue
+		}
+
+		hook, found := k.GetBankHook(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+
+		contract := sdk.MustAccAddressFromBech32(hook.ContractAddress)
+		send := &BankSend{
+			From:   fromAddr.String(),
+			To:     toAddr.String(),
+			Amount: coin,
+		}
+
+		if hook.BeforeSend {
+			msgBz, err := json.Marshal(SudoMsgBeforeSend{BeforeSend: send})
+			if err != nil {
+				return toAddr, err
+			}
+
+			if err := k.executeBeforeSend(sdkCtx, contract, coin.Denom, msgBz); err != nil {
+				return toAddr, errorsmod.Wrapf(types.ErrSendRejected, "%s: %s", coin.Denom, err)
+			}
+		}
+
+		if hook.AfterSend {
+			msgBz, err := json.Marshal(SudoMsgAfterSend{AfterSend: send})
+			if err != nil {
+				return toAddr, err
+			}
+
+			// The sender pays the fixed delivery gas of the queued hook, and the send fails
+			// while the queue quota of the denom is full
+			sdkCtx.GasMeter().ConsumeGas(types.QueuedHookGas, "cw-hooks after send")
+			if err := k.queueBankHook(ctx, contract, coin.Denom, msgBz); err != nil {
+				return toAddr, errorsmod.Wrapf(err, "after send of %s", coin.Denom)
+			}
+		}
+	}
+
+	return toAddr, nil
+}
+
+// executeBeforeSend sends the before_send sudo message of the denom to the contract with
+// the contract gas limit. The gas used is consumed on the gas meter of the send, so it is
+// paid by the sender. Errors reject the send and are not counted as strikes.
+func (k Keeper) executeBeforeSend(ctx sdk.Context, contract sdk.AccAddress, denom string, msgBz []byte) error {
+	p := k.GetParams(ctx)
+
+	executing := append(slices.Clone(executingBankHookDenoms(ctx)), denom)
+	gasLimitCtx := ctx.WithGasMeter(storetypes.NewGasMeter(p.ContractGasLimit)).WithValue(bankHookContextKey{}, executing)
+	cacheCtx, write := gasLimitCtx.CacheContext()
+
+	var err error
+	helpers.ExecuteContract(k.GetContractKeeper(), cacheCtx, contract, msgBz, &err)
+	ctx.GasMeter().ConsumeGas(gasLimitCtx.GasMeter().GasConsumedToLimit(), "cw-hooks before send")
+	if err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
+
+// queueBankHook queues the after_send sudo message for the contract of the denom. Returns
+// an error if the queue quota of the denom is full.
+func (k Keeper) queueBankHook(ctx context.Context, contract sdk.AccAddress, denom string, msgBz []byte) error {
+	return k.queueHook(ctx, types.QueuedHook{
+		ContractAddress: contract.String(),
+		Category:        types.CategoryBank,
+		EventType:       types.EventAfterSend,
+		Msg:             msgBz,
+		Denom:           denom,
+	})
+}
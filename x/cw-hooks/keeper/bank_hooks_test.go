@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
+	tokenfactorykeeper "github.com/CosmosContracts/juno/v29/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/CosmosContracts/juno/v29/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestBankHooks() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, receiver := testdata.KeyTestPubAddr()
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))))
+
+	contractAddress := s.InstantiateContract(sender.String(), "", wasmContract)
+	contract := sdk.MustAccAddressFromBech32(contractAddress)
+
+	// Create and mint a token factory denom
+	err := s.App.AppKeepers.TokenFactoryKeeper.SetParams(s.Ctx, tokenfactorytypes.Params{})
+	s.Require().NoError(err)
+
+	tfMsgServer := tokenfactorykeeper.NewMsgServerImpl(s.App.AppKeepers.TokenFactoryKeeper)
+	res, err := tfMsgServer.CreateDenom(s.Ctx, &tokenfactorytypes.MsgCreateDenom{Sender: sender.String(), Subdenom: "hooked"})
+	s.Require().NoError(err)
+	denom := res.NewTokenDenom
+
+	_, err = tfMsgServer.Mint(s.Ctx, &tokenfactorytypes.MsgMint{Sender: sender.String(), Amount: sdk.NewCoin(denom, sdkmath.NewInt(10_000))})
+	s.Require().NoError(err)
+
+	// Only the denom admin can attach a contract to token factory denoms
+	_, err = s.msgServer.RegisterBank(s.Ctx, &types.MsgRegisterBank{
+		ContractAddress: contractAddress,
+		RegisterAddress: receiver.String(),
+		Denom:           denom,
+		BeforeSend:      true,
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.msgServer.RegisterBank(s.Ctx, &types.MsgRegisterBank{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+		Denom:           "stake",
+		BeforeSend:      true,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidBankHook)
+
+	_, err = s.msgServer.RegisterBank(s.Ctx, &types.MsgRegisterBank{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+		Denom:           denom,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidBankHook)
+
+	_, err = s.msgServer.RegisterBank(s.Ctx, &types.MsgRegisterBank{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+		Denom:           denom,
+		BeforeSend:      true,
+	})
+	s.Require().NoError(err)
+
+	resp, err := s.queryClient.BankHook(s.Ctx, &types.QueryBankHookRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewBankHook(denom, contractAddress, true, false), resp.BankHook)
+
+	// The example contract does not handle before_send, so it rejects every send of the denom
+	bankKeeper := s.App.AppKeepers.BankKeeper
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	err = bankKeeper.SendCoins(s.Ctx, sender, receiver, coins)
+	s.Require().ErrorIs(err, types.ErrSendRejected)
+
+	// Other denoms are not affected, and rejections are not strikes
+	err = bankKeeper.SendCoins(s.Ctx, sender, receiver, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))))
+	s.Require().NoError(err)
+	s.Require().Zero(s.App.AppKeepers.CWHooksKeeper.GetContractStatus(s.Ctx, contract).FailureCount)
+
+	// The gas used by before_send is charged to the send
+	hookedCtx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(10_000_000))
+	err = bankKeeper.SendCoins(hookedCtx, sender, receiver, coins)
+	s.Require().ErrorIs(err, types.ErrSendRejected)
+
+	plainCtx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(10_000_000))
+	err = bankKeeper.SendCoins(plainCtx, sender, receiver, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))))
+	s.Require().NoError(err)
+	s.Require().Greater(hookedCtx.GasMeter().GasConsumed(), plainCtx.GasMeter().GasConsumed())
+
+	_, err = s.msgServer.UnregisterBank(s.Ctx, &types.MsgUnregisterBank{
+		RegisterAddress: sender.String(),
+		Denom:           denom,
+	})
+	s.Require().NoError(err)
+
+	err = bankKeeper.SendCoins(s.Ctx, sender, receiver, coins)
+	s.Require().NoError(err)
+
+	// after_send is queued for the end of the block
+	_, err = s.msgServer.RegisterBank(s.Ctx, &types.MsgRegisterBank{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+		Denom:           denom,
+		AfterSend:       true,
+	})
+	s.Require().NoError(err)
+
+	err = bankKeeper.SendCoins(s.Ctx, sender, receiver, coins)
+	s.Require().NoError(err)
+	s.Require().Equal(sdkmath.NewInt(20), bankKeeper.GetBalance(s.Ctx, receiver, denom).Amount)
+
+	k := s.App.AppKeepers.CWHooksKeeper
+	queued := k.GetAllQueuedHooks(s.Ctx)
+	s.Require().Len(queued, 1)
+	s.Require().Equal(types.CategoryBank, queued[0].Category)
+	s.Require().Equal(types.EventAfterSend, queued[0].EventType)
+	s.Require().Equal(denom, queued[0].Denom)
+
+	regs, err := s.queryClient.ContractRegistrations(s.Ctx, &types.QueryContractRegistrationsRequest{ContractAddress: contractAddress})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Registration{
+		types.NewRegistration(types.CategoryBank, contractAddress, sender.String(), s.Ctx.BlockHeight(), denom),
+	}, regs.Registrations)
+
+	genesis := k.ExportGenesis(s.Ctx)
+	s.Require().Len(genesis.BankHooks, 1)
+	s.Require().Len(genesis.Registrations, 1)
+	s.Require().NoError(types.ValidateGenesis(*genesis))
+
+	k.DeliverQueuedHooks(s.Ctx)
+	s.Require().Empty(k.GetAllQueuedHooks(s.Ctx))
+	s.Require().Equal(uint64(1), k.GetContractStatus(s.Ctx, contract).FailureCount)
+
+	// The sender pays the fixed gas of the queued after_send hook
+	hookedCtx = s.Ctx.WithGasMeter(storetypes.NewGasMeter(10_000_000))
+	err = bankKeeper.SendCoins(hookedCtx, sender, receiver, coins)
+	s.Require().NoError(err)
+	s.Require().GreaterOrEqual(hookedCtx.GasMeter().GasConsumed(), uint64(types.QueuedHookGas))
+
+	// Sends fail while the queue quota of the denom is full
+	one := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.OneInt()))
+	for i := 1; i < types.MaxQueuedHooksPerDenom; i++ {
+		err = bankKeeper.SendCoins(s.Ctx, sender, receiver, one)
+		s.Require().NoError(err)
+	}
+	s.Require().Len(k.GetAllQueuedHooks(s.Ctx), types.MaxQueuedHooksPerDenom)
+
+	err = bankKeeper.SendCoins(s.Ctx, sender, receiver, one)
+	s.Require().ErrorIs(err, types.ErrQueueFull)
+	s.Require().Len(k.GetAllQueuedHooks(s.Ctx), types.MaxQueuedHooksPerDenom)
+	s.Require().Zero(k.GetContractStatus(s.Ctx, contract).DroppedHooks)
+}
@@ -8,14 +8,83 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	helpers "github.com/CosmosContracts/juno/v29/app/helpers"
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
 )
 
 func (k Keeper) SetContract(ctx context.Context, keyPrefix []byte, contractAddr sdk.AccAddress) {
+	k.SetSubscription(ctx, keyPrefix, contractAddr, types.Subscription{ContractAddress: contractAddr.String()})
+}
+
+// SetSubscription registers the contract under the key prefix, receiving the events
+// matching the subscription.
+func (k Keeper) SetSubscription(ctx context.Context, keyPrefix []byte, contractAddr sdk.AccAddress, sub types.Subscription) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	loadedPrefix := prefix.NewStore(store, keyPrefix)
+
+	// Contracts receiving every event are stored without a value
+	if sub.IsDefault() {
+		loadedPrefix.Set(contractAddr.Bytes(), []byte{})
+		return
+	}
+
+	sub.ContractAddress = ""
+	loadedPrefix.Set(contractAddr.Bytes(), k.cdc.MustMarshal(&sub))
+}
+
+// GetSubscription returns the subscription of a contract registered under the key prefix.
+func (k Keeper) GetSubscription(ctx context.Context, keyPrefix []byte, contractAddr sdk.AccAddress) (types.Subscription, bool) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	loadedPrefix := prefix.NewStore(store, keyPrefix)
-	loadedPrefix.Set(contractAddr.Bytes(), []byte{})
+
+	bz := loadedPrefix.Get(contractAddr.Bytes())
+	if bz == nil {
+		return types.Subscription{}, false
+	}
+
+	return k.unmarshalSubscription(contractAddr, bz), true
+}
+
+// IterateSubscriptions iterates over the subscriptions of all contracts registered
+// under the key prefix.
+func (k Keeper) IterateSubscriptions(
+	ctx context.Context,
+	keyPrefix []byte,
+	handlerFn func(contractAddr sdk.AccAddress, sub types.Subscription) (stop bool),
+) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close() //nolint:errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr := sdk.AccAddress(iterator.Key()[len(keyPrefix):])
+
+		if handlerFn(addr, k.unmarshalSubscription(addr, iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetCustomSubscriptions returns the subscriptions under the key prefix which do not
+// receive every event during the transaction which triggered it.
+func (k Keeper) GetCustomSubscriptions(ctx context.Context, keyPrefix []byte) []types.Subscription {
+	list := []types.Subscription{}
+	k.IterateSubscriptions(ctx, keyPrefix, func(_ sdk.AccAddress, sub types.Subscription) bool {
+		if !sub.IsDefault() {
+			list = append(list, sub)
+		}
+		return false
+	})
+	return list
+}
+
+func (k Keeper) unmarshalSubscription(contractAddr sdk.AccAddress, bz []byte) types.Subscription {
+	var sub types.Subscription
+	k.cdc.MustUnmarshal(bz, &sub)
+	sub.ContractAddress = contractAddr.String()
+	return sub
 }
 
 func (k Keeper) IsContractRegistered(ctx context.Context, keyPrefix []byte, contractAddr sdk.AccAddress) bool {
@@ -61,27 +130,117 @@ func (k Keeper) GetAllContractsBech32(ctx context.Context, keyPrefix []byte) []s
 	return list
 }
 
+// GetPaginatedContractsBech32 returns the contracts registered under the key prefix
+// ordered by address.
+func (k Keeper) GetPaginatedContractsBech32(ctx context.Context, keyPrefix []byte, pag *query.PageRequest) ([]string, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+
+	list := []string{}
+	pageRes, err := query.Paginate(store, pag, func(key, _ []byte) error {
+		list = append(list, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return list, pageRes, nil
+}
+
 func (k Keeper) DeleteContract(ctx context.Context, keyPrefix []byte, contractAddr sdk.AccAddress) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	loadedPrefix := prefix.NewStore(store, keyPrefix)
 	loadedPrefix.Delete(contractAddr)
+
+	k.DeleteRegistration(ctx, types.GetKeyPrefixCategory(keyPrefix), contractAddr, "")
 }
 
-func (k Keeper) ExecuteMessageOnContracts(ctx context.Context, keyPrefix []byte, msgBz []byte) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
+// ExecuteMessageOnContracts sends the sudo message to all contracts registered under
+// the key prefix which are subscribed to the event, in the payload version each contract
+// registered with. Deferred contracts receive the message at the end of the block instead.
+func (k Keeper) ExecuteMessageOnContracts(ctx context.Context, keyPrefix []byte, event types.HookEvent, payload Payload) {
+	k.executeOnSubscribers(ctx, keyPrefix, k.getSubscribers(ctx, keyPrefix, event), event, payload)
+}
+
+// hookSubscribers are the contracts registered under a key prefix which are subscribed
+// to a hook event.
+type hookSubscribers struct {
+	addrs []sdk.AccAddress
+	subs  []types.Subscription
+}
+
+// empty returns true if no contract is subscribed to the event.
+func (s hookSubscribers) empty() bool {
+	return len(s.addrs) == 0
+}
+
+// receivesVersion returns true if a subscribed contract receives the payload version.
+func (s hookSubscribers) receivesVersion(version uint32) bool {
+	for _, sub := range s.subs {
+		if sub.GetPayloadVersionOrDefault() == version {
+			return true
+		}
+	}
+	return false
+}
+
+// getSubscribers returns the contracts registered under the key prefix which are
+// subscribed to the event, so hooks can skip building the payload without subscribers.
+func (k Keeper) getSubscribers(ctx context.Context, keyPrefix []byte, event types.HookEvent) hookSubscribers {
+	var subscribers hookSubscribers
+	k.IterateSubscriptions(ctx, keyPrefix, func(addr sdk.AccAddress, sub types.Subscription) bool {
+		if sub.Matches(event) {
+			subscribers.addrs = append(subscribers.addrs, addr)
+			subscribers.subs = append(subscribers.subs, sub)
+		}
+		return false
+	})
+	return subscribers
+}
+
+// executeOnSubscribers sends the sudo message to the subscribed contracts, see
+// ExecuteMessageOnContracts.
+func (k Keeper) executeOnSubscribers(ctx context.Context, keyPrefix []byte, subscribers hookSubscribers, event types.HookEvent, payload Payload) {
+	if subscribers.empty() {
+		return
+	}
+
 	p := k.GetParams(ctx)
+	for i, addr := range subscribers.addrs {
+		if k.isContractJailed(ctx, addr, p) {
+			continue
+		}
 
-	for _, c := range k.GetAllContracts(ctx, keyPrefix) {
-		gasLimitCtx := sdkCtx.WithGasMeter(storetypes.NewGasMeter(p.ContractGasLimit))
-		addr := sdk.AccAddress(c.Bytes())
+		msgBz := payload.Version(subscribers.subs[i].GetPayloadVersionOrDefault())
 
-		var err error
-		helpers.ExecuteContract(k.GetContractKeeper(), gasLimitCtx, addr, msgBz, &err)
-		if err != nil {
-			k.Logger(ctx).Error("ExecuteMessageOnContracts err", err, "contract", addr.String())
-			return err
+		if subscribers.subs[i].Deferred {
+			k.QueueHook(ctx, types.GetKeyPrefixCategory(keyPrefix), addr, event.Type, msgBz)
+			continue
 		}
+
+		k.executeOnContract(ctx, addr, p, msgBz)
+	}
+}
+
+// executeOnContract sends the sudo message to the contract in its own cached context
+// with the contract gas limit, so a failing contract neither reverts its own writes nor
+// fails the calling operation. Failures count as strikes and jail the contract once it
+// runs out of strikes. Returns the gas used by the contract.
+func (k Keeper) executeOnContract(ctx context.Context, addr sdk.AccAddress, p types.Params, msgBz []byte) uint64 {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	gasLimitCtx := sdkCtx.WithGasMeter(storetypes.NewGasMeter(p.ContractGasLimit))
+	cacheCtx, write := gasLimitCtx.CacheContext()
+
+	var err error
+	helpers.ExecuteContract(k.GetContractKeeper(), cacheCtx, addr, msgBz, &err)
+	if err != nil {
+		k.Logger(ctx).Error("ExecuteMessageOnContracts err", "error", err, "contract", addr.String())
+	} else {
+		write()
 	}
 
-	return nil
+	k.recordContractResult(ctx, addr, p, err)
+
+	return gasLimitCtx.GasMeter().GasConsumed()
 }
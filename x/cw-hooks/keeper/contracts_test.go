@@ -0,0 +1,283 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/keeper"
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
+)
+
+func (s *KeeperTestSuite) TestExecuteMessageOnContractsJailing() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))))
+
+	contractAddress := s.InstantiateContract(sender.String(), "", wasmContract)
+	contract := sdk.MustAccAddressFromBech32(contractAddress)
+
+	_, err := s.msgServer.RegisterStaking(s.Ctx, &types.MsgRegisterStaking{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+	})
+	s.Require().NoError(err)
+
+	k := s.App.AppKeepers.CWHooksKeeper
+	err = k.SetParams(s.Ctx, types.NewParams(250_000, 1, 0, 0))
+	s.Require().NoError(err)
+
+	// Unjailing a contract which is not jailed fails
+	_, err = s.msgServer.UnjailContract(s.Ctx, &types.MsgUnjailContract{
+		ContractAddress: contractAddress,
+		SenderAddress:   sender.String(),
+	})
+	s.Require().ErrorIs(err, types.ErrContractNotJailed)
+
+	// The contract does not understand the message, the failure is recorded as a strike
+	invalidMsg := keeper.Payload{V1: []byte(`{"unknown_hook":{}}`)}
+	event := types.HookEvent{Type: types.EventAfterDelegationModified}
+	k.ExecuteMessageOnContracts(s.Ctx, types.KeyPrefixStaking, event, invalidMsg)
+
+	resp, err := s.queryClient.ContractStatus(s.Ctx, &types.QueryContractStatusRequest{ContractAddress: contractAddress})
+	s.Require().NoError(err)
+	s.Require().False(resp.Status.IsJailed)
+	s.Require().Equal(uint64(1), resp.Status.FailureCount)
+	s.Require().NotEmpty(resp.Status.LastError)
+
+	// Running out of strikes jails the contract
+	k.ExecuteMessageOnContracts(s.Ctx, types.KeyPrefixStaking, event, invalidMsg)
+
+	status := k.GetContractStatus(s.Ctx, contract)
+	s.Require().True(status.IsJailed)
+	s.Require().Equal(uint64(2), status.FailureCount)
+	s.Require().Equal(s.Ctx.BlockHeight(), status.JailHeight)
+
+	// Jailed contracts are skipped
+	k.ExecuteMessageOnContracts(s.Ctx, types.KeyPrefixStaking, event, invalidMsg)
+	s.Require().Equal(uint64(2), k.GetContractStatus(s.Ctx, contract).FailureCount)
+
+	// Only the contract creator can unjail the contract
+	_, _, notAuthorizedAcc := testdata.KeyTestPubAddr()
+	_, err = s.msgServer.UnjailContract(s.Ctx, &types.MsgUnjailContract{
+		ContractAddress: contractAddress,
+		SenderAddress:   notAuthorizedAcc.String(),
+	})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UnjailContract(s.Ctx, &types.MsgUnjailContract{
+		ContractAddress: contractAddress,
+		SenderAddress:   sender.String(),
+	})
+	s.Require().NoError(err)
+
+	status = k.GetContractStatus(s.Ctx, contract)
+	s.Require().False(status.IsJailed)
+	s.Require().Zero(status.FailureCount)
+	s.Require().Empty(k.GetAllContractStatuses(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestJailDuration() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))))
+
+	contractAddress := s.InstantiateContract(sender.String(), "", wasmContract)
+	contract := sdk.MustAccAddressFromBech32(contractAddress)
+
+	_, err := s.msgServer.RegisterGovernance(s.Ctx, &types.MsgRegisterGovernance{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+	})
+	s.Require().NoError(err)
+
+	k := s.App.AppKeepers.CWHooksKeeper
+	err = k.SetParams(s.Ctx, types.NewParams(250_000, 0, 10, 0))
+	s.Require().NoError(err)
+
+	// Without strikes the contract is jailed on its first failure
+	k.ExecuteMessageOnContracts(s.Ctx, types.KeyPrefixGov, types.NewGovEvent(types.EventAfterProposalVote, 1), keeper.Payload{V1: []byte(`{"unknown_hook":{}}`)})
+	s.Require().True(k.GetContractStatus(s.Ctx, contract).IsJailed)
+
+	// The contract is unjailed once the jail duration has passed
+	ctx := s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 10)
+	k.ExecuteMessageOnContracts(ctx, types.KeyPrefixGov, types.NewGovEvent(types.EventAfterProposalVote, 1), keeper.Payload{V1: []byte(`{"unknown_hook":{}}`)})
+
+	status := k.GetContractStatus(ctx, contract)
+	s.Require().True(status.IsJailed)
+	s.Require().Equal(ctx.BlockHeight(), status.JailHeight)
+}
+
+func (s *KeeperTestSuite) TestSubscriptions() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))))
+
+	contractAddress := s.InstantiateContract(sender.String(), "", wasmContract)
+	contract := sdk.MustAccAddressFromBech32(contractAddress)
+
+	valAddr := sdk.ValAddress(sender)
+	otherValAddr := sdk.ValAddress(contract)
+
+	// Invalid subscriptions are rejected
+	_, err := s.msgServer.RegisterStaking(s.Ctx, &types.MsgRegisterStaking{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+		EventTypes:      []string{types.EventAfterProposalVote},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidSubscription)
+
+	_, err = s.msgServer.RegisterStaking(s.Ctx, &types.MsgRegisterStaking{
+		ContractAddress:    contractAddress,
+		RegisterAddress:    sender.String(),
+		ValidatorAddresses: []string{sender.String()},
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidSubscription)
+
+	_, err = s.msgServer.RegisterStaking(s.Ctx, &types.MsgRegisterStaking{
+		ContractAddress:    contractAddress,
+		RegisterAddress:    sender.String(),
+		EventTypes:         []string{types.EventAfterDelegationModified},
+		ValidatorAddresses: []string{valAddr.String()},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.RegisterGovernance(s.Ctx, &types.MsgRegisterGovernance{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+		ProposalIds:     []uint64{7},
+	})
+	s.Require().NoError(err)
+
+	resp, err := s.queryClient.Subscriptions(s.Ctx, &types.QuerySubscriptionsRequest{ContractAddress: contractAddress})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{types.EventAfterDelegationModified}, resp.Staking.EventTypes)
+	s.Require().Equal([]string{valAddr.String()}, resp.Staking.ValidatorAddresses)
+	s.Require().Empty(resp.Governance.EventTypes)
+	s.Require().Equal([]uint64{7}, resp.Governance.ProposalIds)
+
+	// Events the contract is not subscribed to are not sent, the failing message is never executed
+	k := s.App.AppKeepers.CWHooksKeeper
+	invalidMsg := keeper.Payload{V1: []byte(`{"unknown_hook":{}}`)}
+	k.ExecuteMessageOnContracts(s.Ctx, types.KeyPrefixStaking, types.NewValidatorEvent(types.EventBeforeDelegationCreated, valAddr), invalidMsg)
+	k.ExecuteMessageOnContracts(s.Ctx, types.KeyPrefixStaking, types.NewValidatorEvent(types.EventAfterDelegationModified, otherValAddr), invalidMsg)
+	k.ExecuteMessageOnContracts(s.Ctx, types.KeyPrefixGov, types.NewGovEvent(types.EventAfterProposalVote, 1), invalidMsg)
+	s.Require().Zero(k.GetContractStatus(s.Ctx, contract).FailureCount)
+
+	// Subscribed events are sent
+	k.ExecuteMessageOnContracts(s.Ctx, types.KeyPrefixStaking, types.NewValidatorEvent(types.EventAfterDelegationModified, valAddr), invalidMsg)
+	k.ExecuteMessageOnContracts(s.Ctx, types.KeyPrefixGov, types.NewGovEvent(types.EventAfterProposalDeposit, 7), invalidMsg)
+	s.Require().Equal(uint64(2), k.GetContractStatus(s.Ctx, contract).FailureCount)
+
+	// Filtered subscriptions are exported in genesis
+	genesis := k.ExportGenesis(s.Ctx)
+	s.Require().Len(genesis.StakingSubscriptions, 1)
+	s.Require().Len(genesis.GovSubscriptions, 1)
+	s.Require().NoError(types.ValidateGenesis(*genesis))
+}
+
+func (s *KeeperTestSuite) TestDistributionAndSlashingHooks() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))))
+
+	contractAddress := s.InstantiateContract(sender.String(), "", wasmContract)
+	contract := sdk.MustAccAddressFromBech32(contractAddress)
+
+	validators, err := s.stakingKeeper.GetAllValidators(s.Ctx)
+	s.Require().NoError(err)
+	val := validators[0]
+	valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+	s.Require().NoError(err)
+	consAddr, err := val.GetConsAddr()
+	s.Require().NoError(err)
+
+	delegations, err := s.stakingKeeper.GetValidatorDelegations(s.Ctx, valAddr)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(delegations)
+
+	_, err = s.msgServer.RegisterDistribution(s.Ctx, &types.MsgRegisterDistribution{
+		ContractAddress:    contractAddress,
+		RegisterAddress:    sender.String(),
+		EventTypes:         []string{types.EventAfterDelegatorRewardsWithdrawn},
+		ValidatorAddresses: []string{valAddr.String()},
+	})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.RegisterSlashing(s.Ctx, &types.MsgRegisterSlashing{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+		EventTypes:      []string{types.EventAfterValidatorJailed},
+	})
+	s.Require().NoError(err)
+
+	distrResp, err := s.queryClient.DistributionContracts(s.Ctx, &types.QueryDistributionContractsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{contractAddress}, distrResp.Contracts)
+
+	slashingResp, err := s.queryClient.SlashingContracts(s.Ctx, &types.QuerySlashingContractsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{contractAddress}, slashingResp.Contracts)
+
+	// The example contract does not handle these sudo messages, so every delivered
+	// hook is recorded as a failure.
+	k := s.App.AppKeepers.CWHooksKeeper
+	ctx := s.Ctx.WithBlockHeight(10)
+
+	// Events the contract is not subscribed to are not sent
+	err = k.DistributionHooks().AfterValidatorCommissionWithdrawn(ctx, valAddr, sdk.NewCoins())
+	s.Require().NoError(err)
+	err = k.DistributionHooks().AfterDelegatorRewardsWithdrawn(ctx, sender, sdk.ValAddress(contract), sdk.NewCoins())
+	s.Require().NoError(err)
+	err = k.SlashingHooks().AfterValidatorUnjailed(ctx, consAddr)
+	s.Require().NoError(err)
+	s.Require().Zero(k.GetContractStatus(ctx, contract).FailureCount)
+
+	// Withdrawing rewards through the distribution msg server calls the hook
+	handler := s.App.MsgServiceRouter().Handler(&distrtypes.MsgWithdrawDelegatorReward{})
+	_, err = handler(ctx, &distrtypes.MsgWithdrawDelegatorReward{
+		DelegatorAddress: delegations[0].DelegatorAddress,
+		ValidatorAddress: valAddr.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), k.GetContractStatus(ctx, contract).FailureCount)
+
+	// Modifying the delegation in a later block withdraws its rewards, which calls the hook
+	ctx = ctx.WithBlockHeight(11)
+	delAddr := sdk.MustAccAddressFromBech32(delegations[0].DelegatorAddress)
+	rewards := sdk.NewCoins(sdk.NewCoin("ujuno", val.GetTokens()))
+	s.FundModuleAcc(distrtypes.ModuleName, rewards)
+	err = s.App.AppKeepers.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoinsFromCoins(rewards...))
+	s.Require().NoError(err)
+
+	amount := sdk.NewCoin("ujuno", sdkmath.NewInt(1_000))
+	s.FundAcc(delAddr, sdk.NewCoins(amount))
+	_, err = stakingkeeper.NewMsgServerImpl(&s.stakingKeeper).Delegate(ctx, &stakingtypes.MsgDelegate{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), k.GetContractStatus(ctx, contract).FailureCount)
+
+	// Jailing the validator through x/slashing calls the hook
+	err = s.App.AppKeepers.SlashingKeeper.Jail(ctx, consAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), k.GetContractStatus(ctx, contract).FailureCount)
+
+	_, err = s.msgServer.UnregisterSlashing(ctx, &types.MsgUnregisterSlashing{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+	})
+	s.Require().NoError(err)
+
+	genesis := k.ExportGenesis(ctx)
+	s.Require().Equal([]string{contractAddress}, genesis.DistributionContractAddresses)
+	s.Require().Len(genesis.DistributionSubscriptions, 1)
+	s.Require().Empty(genesis.SlashingContractAddresses)
+	s.Require().NoError(types.ValidateGenesis(*genesis))
+}
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
+)
+
+// DistributionHooks sends the reward and commission withdrawals of x/distribution to
+// the registered distribution contracts. The SDK does not provide distribution hooks,
+// so they are called by the msg server returned from NewDistributionMsgServer for
+// explicit withdrawals, and by the staking hooks returned from
+// DistributionStakingHooks for the withdrawals x/distribution makes implicitly.
+type DistributionHooks struct {
+	k Keeper
+}
+
+func (k Keeper) DistributionHooks() DistributionHooks {
+	return DistributionHooks{k: k}
+}
+
+type RewardsWithdrawn struct {
+	DelegatorAddress string    `json:"delegator_address"`
+	ValidatorAddress string    `json:"validator_address"`
+	Amount           sdk.Coins `json:"amount"`
+}
+
+type CommissionWithdrawn struct {
+	ValidatorAddress string    `json:"validator_address"`
+	Amount           sdk.Coins `json:"amount"`
+}
+
+type SudoMsgAfterDelegatorRewardsWithdrawn struct {
+	AfterDelegatorRewardsWithdrawn *RewardsWithdrawn `json:"after_delegator_rewards_withdrawn"`
+}
+
+type SudoMsgAfterValidatorCommissionWithdrawn struct {
+	AfterValidatorCommissionWithdrawn *CommissionWithdrawn `json:"after_validator_commission_withdrawn"`
+}
+
+func (h DistributionHooks) AfterDelegatorRewardsWithdrawn(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coins) error {
+	withdrawn := &RewardsWithdrawn{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+	}
+
+	// Both payload versions are the same
+	payload, err := NewPayload(types.EventAfterDelegatorRewardsWithdrawn, SudoMsgAfterDelegatorRewardsWithdrawn{
+		AfterDelegatorRewardsWithdrawn: withdrawn,
+	}, withdrawn)
+	if err != nil {
+		return err
+	}
+
+	h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixDistribution, types.NewValidatorEvent(types.EventAfterDelegatorRewardsWithdrawn, valAddr), payload)
+	return nil
+}
+
+func (h DistributionHooks) AfterValidatorCommissionWithdrawn(ctx context.Context, valAddr sdk.ValAddress, amount sdk.Coins) error {
+	withdrawn := &CommissionWithdrawn{
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+	}
+
+	// Both payload versions are the same
+	payload, err := NewPayload(types.EventAfterValidatorCommissionWithdrawn, SudoMsgAfterValidatorCommissionWithdrawn{
+		AfterValidatorCommissionWithdrawn: withdrawn,
+	}, withdrawn)
+	if err != nil {
+		return err
+	}
+
+	h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixDistribution, types.NewValidatorEvent(types.EventAfterValidatorCommissionWithdrawn, valAddr), payload)
+	return nil
+}
+
+// DistributionKeeper defines the x/distribution keeper methods used by the wrapped
+// x/distribution staking hooks.
+type DistributionKeeper interface {
+	GetValidatorAccumulatedCommission(ctx context.Context, val sdk.ValAddress) (distrtypes.ValidatorAccumulatedCommission, error)
+}
+
+// distributionStakingHooks wraps the x/distribution staking hooks to call the
+// distribution hooks for the rewards withdrawn when a delegation is modified by a
+// delegation, undelegation or redelegation, and for the commission withdrawn when a
+// validator is removed.
+type distributionStakingHooks struct {
+	stakingtypes.StakingHooks
+	k           Keeper
+	distrKeeper DistributionKeeper
+}
+
+// DistributionStakingHooks wraps the x/distribution staking hooks.
+func (k Keeper) DistributionStakingHooks(hooks stakingtypes.StakingHooks, dk DistributionKeeper) stakingtypes.StakingHooks {
+	return distributionStakingHooks{
+		StakingHooks: hooks,
+		k:            k,
+		distrKeeper:  dk,
+	}
+}
+
+func (h distributionStakingHooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	emitted := len(sdkCtx.EventManager().Events())
+
+	if err := h.StakingHooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr); err != nil {
+		return err
+	}
+
+	if sdkCtx.BlockHeight() <= skipUntilHeight {
+		return nil
+	}
+
+	// x/distribution only reports the withdrawn rewards in its event
+	amount := withdrawnRewards(sdkCtx.EventManager().Events()[emitted:], delAddr, valAddr)
+	if amount.IsZero() {
+		return nil
+	}
+
+	return h.k.DistributionHooks().AfterDelegatorRewardsWithdrawn(ctx, delAddr, valAddr, amount)
+}
+
+func (h distributionStakingHooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	// The commission record is deleted when the commission is withdrawn
+	commission, err := h.distrKeeper.GetValidatorAccumulatedCommission(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := h.StakingHooks.AfterValidatorRemoved(ctx, consAddr, valAddr); err != nil {
+		return err
+	}
+
+	if sdk.UnwrapSDKContext(ctx).BlockHeight() <= skipUntilHeight {
+		return nil
+	}
+
+	// The decimal remainder goes to the community pool
+	amount, _ := commission.Commission.TruncateDecimal()
+	if amount.IsZero() {
+		return nil
+	}
+
+	return h.k.DistributionHooks().AfterValidatorCommissionWithdrawn(ctx, valAddr, amount)
+}
+
+// withdrawnRewards returns the rewards of the x/distribution withdraw rewards events of
+// the delegation.
+func withdrawnRewards(events sdk.Events, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Coins {
+	amount := sdk.NewCoins()
+	for _, event := range events {
+		if event.Type != distrtypes.EventTypeWithdrawRewards {
+			continue
+		}
+
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+
+		if attrs[distrtypes.AttributeKeyDelegator] != delAddr.String() || attrs[distrtypes.AttributeKeyValidator] != valAddr.String() {
+			continue
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(attrs[sdk.AttributeKeyAmount])
+		if err != nil {
+			continue
+		}
+
+		amount = amount.Add(coins...)
+	}
+
+	return amount
+}
+
+// distributionMsgServer wraps the x/distribution msg server to call the distribution
+// hooks after successful withdrawals.
+type distributionMsgServer struct {
+	distrtypes.MsgServer
+	k Keeper
+}
+
+// NewDistributionMsgServer wraps the x/distribution msg server.
+func NewDistributionMsgServer(ms distrtypes.MsgServer, k Keeper) distrtypes.MsgServer {
+	return distributionMsgServer{
+		MsgServer: ms,
+		k:         k,
+	}
+}
+
+func (s distributionMsgServer) WithdrawDelegatorReward(ctx context.Context, msg *distrtypes.MsgWithdrawDelegatorReward) (*distrtypes.MsgWithdrawDelegatorRewardResponse, error) {
+	resp, err := s.MsgServer.WithdrawDelegatorReward(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.k.DistributionHooks().AfterDelegatorRewardsWithdrawn(ctx, delAddr, valAddr, resp.Amount); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s distributionMsgServer) WithdrawValidatorCommission(ctx context.Context, msg *distrtypes.MsgWithdrawValidatorCommission) (*distrtypes.MsgWithdrawValidatorCommissionResponse, error) {
+	resp, err := s.MsgServer.WithdrawValidatorCommission(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.k.DistributionHooks().AfterValidatorCommissionWithdrawn(ctx, valAddr, resp.Amount); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
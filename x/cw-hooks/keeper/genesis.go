@@ -19,30 +19,55 @@ func (k Keeper) InitGenesis(
 		panic(err)
 	}
 
-	for _, v := range data.StakingContractAddresses {
-		accAddr, err := sdk.AccAddressFromBech32(v)
-		if err != nil {
-			panic(err)
+	for _, c := range data.GetCategoryContracts() {
+		for _, v := range c.ContractAddresses {
+			accAddr, err := sdk.AccAddressFromBech32(v)
+			if err != nil {
+				panic(err)
+			}
+
+			k.SetContract(ctx, c.KeyPrefix, accAddr)
 		}
 
-		k.SetContract(ctx, types.KeyPrefixStaking, accAddr)
+		for _, sub := range c.Subscriptions {
+			k.SetSubscription(ctx, c.KeyPrefix, sdk.MustAccAddressFromBech32(sub.ContractAddress), sub)
+		}
 	}
 
-	for _, v := range data.GovContractAddresses {
-		accAddr, err := sdk.AccAddressFromBech32(v)
-		if err != nil {
+	for _, hook := range data.QueuedHooks {
+		if err := k.queueHook(ctx, hook); err != nil {
 			panic(err)
 		}
+	}
+
+	for _, hook := range data.BankHooks {
+		k.SetBankHook(ctx, hook)
+	}
+
+	for _, reg := range data.Registrations {
+		k.SetRegistration(ctx, reg)
+	}
 
-		k.SetContract(ctx, types.KeyPrefixGov, accAddr)
+	for _, status := range data.ContractStatuses {
+		k.SetContractStatus(ctx, sdk.MustAccAddressFromBech32(status.ContractAddress), status)
 	}
 }
 
 // ExportGenesis export module state
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params:                   k.GetParams(ctx),
-		StakingContractAddresses: k.GetAllContractsBech32(ctx, types.KeyPrefixStaking),
-		GovContractAddresses:     k.GetAllContractsBech32(ctx, types.KeyPrefixGov),
+		Params:                        k.GetParams(ctx),
+		StakingContractAddresses:      k.GetAllContractsBech32(ctx, types.KeyPrefixStaking),
+		GovContractAddresses:          k.GetAllContractsBech32(ctx, types.KeyPrefixGov),
+		ContractStatuses:              k.GetAllContractStatuses(ctx),
+		StakingSubscriptions:          k.GetCustomSubscriptions(ctx, types.KeyPrefixStaking),
+		GovSubscriptions:              k.GetCustomSubscriptions(ctx, types.KeyPrefixGov),
+		QueuedHooks:                   k.GetAllQueuedHooks(ctx),
+		DistributionContractAddresses: k.GetAllContractsBech32(ctx, types.KeyPrefixDistribution),
+		SlashingContractAddresses:     k.GetAllContractsBech32(ctx, types.KeyPrefixSlashing),
+		DistributionSubscriptions:     k.GetCustomSubscriptions(ctx, types.KeyPrefixDistribution),
+		SlashingSubscriptions:         k.GetCustomSubscriptions(ctx, types.KeyPrefixSlashing),
+		BankHooks:                     k.GetAllBankHooks(ctx),
+		Registrations:                 k.GetAllRegistrations(ctx),
 	}
 }
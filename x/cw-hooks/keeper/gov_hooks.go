@@ -2,9 +2,8 @@ package keeper
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"strconv"
+	"time"
 
 	"cosmossdk.io/collections"
 
@@ -25,6 +24,9 @@ func (k Keeper) GovHooks() GovHooks {
 	return GovHooks{k: k}
 }
 
+// Proposal is the proposal of payload version 1. SubmitTime is only the seconds of the
+// submit time, kept for compatibility with existing contracts. Payload version 2 has the
+// full timestamps.
 type Proposal struct {
 	ProposalID uint64 `json:"proposal_id"`
 	Proposer   string `json:"proposer"`
@@ -61,6 +63,127 @@ func NewVote(vote v1.Vote) Vote {
 	}
 }
 
+// ProposalV2 is the proposal of payload version 2. Timestamps are RFC3339, the submit
+// time is also given as unix timestamp in seconds, and the final tally result is only
+// set once the voting period ended.
+type ProposalV2 struct {
+	ProposalID       uint64            `json:"proposal_id"`
+	Proposer         string            `json:"proposer"`
+	Status           string            `json:"status"`
+	SubmitTime       string            `json:"submit_time"`
+	SubmitTimeUnix   string            `json:"submit_time_unix"`
+	DepositEndTime   string            `json:"deposit_end_time"`
+	VotingStartTime  string            `json:"voting_start_time,omitempty"`
+	VotingEndTime    string            `json:"voting_end_time,omitempty"`
+	TotalDeposit     sdk.Coins         `json:"total_deposit"`
+	Messages         []ProposalMessage `json:"messages"`
+	FinalTallyResult *TallyResult      `json:"final_tally_result,omitempty"`
+	Expedited        bool              `json:"expedited"`
+	Metadata         string            `json:"metadata"`
+	Title            string            `json:"title"`
+	Summary          string            `json:"summary"`
+}
+
+// ProposalMessage is a proto encoded message of a proposal. Value is base64 encoded.
+type ProposalMessage struct {
+	TypeURL string `json:"type_url"`
+	Value   []byte `json:"value"`
+}
+
+type TallyResult struct {
+	YesCount        string `json:"yes_count"`
+	AbstainCount    string `json:"abstain_count"`
+	NoCount         string `json:"no_count"`
+	NoWithVetoCount string `json:"no_with_veto_count"`
+}
+
+func NewProposalV2(prop v1.Proposal) ProposalV2 {
+	messages := make([]ProposalMessage, 0, len(prop.Messages))
+	for _, msg := range prop.Messages {
+		messages = append(messages, ProposalMessage{
+			TypeURL: msg.TypeUrl,
+			Value:   msg.Value,
+		})
+	}
+
+	p := ProposalV2{
+		ProposalID:      prop.Id,
+		Proposer:        prop.Proposer,
+		Status:          prop.Status.String(),
+		SubmitTime:      formatTime(prop.SubmitTime),
+		SubmitTimeUnix:  formatUnixTime(prop.SubmitTime),
+		DepositEndTime:  formatTime(prop.DepositEndTime),
+		VotingStartTime: formatTime(prop.VotingStartTime),
+		VotingEndTime:   formatTime(prop.VotingEndTime),
+		TotalDeposit:    prop.TotalDeposit,
+		Messages:        messages,
+		Expedited:       prop.Expedited,
+		Metadata:        prop.GetMetadata(),
+		Title:           prop.GetTitle(),
+		Summary:         prop.GetSummary(),
+	}
+
+	switch prop.Status {
+	case v1.StatusPassed, v1.StatusRejected, v1.StatusFailed:
+		if tally := prop.FinalTallyResult; tally != nil {
+			p.FinalTallyResult = &TallyResult{
+				YesCount:        tally.YesCount,
+				AbstainCount:    tally.AbstainCount,
+				NoCount:         tally.NoCount,
+				NoWithVetoCount: tally.NoWithVetoCount,
+			}
+		}
+	}
+
+	return p
+}
+
+// VoteV2 is the vote of payload version 2, with the vote options by name.
+type VoteV2 struct {
+	ProposalID   uint64         `json:"proposal_id"`
+	VoterAddress string         `json:"voter_address"`
+	Options      []VoteOptionV2 `json:"options"`
+	Metadata     string         `json:"metadata"`
+}
+
+type VoteOptionV2 struct {
+	Option string `json:"option"`
+	Weight string `json:"weight"`
+}
+
+func NewVoteV2(vote v1.Vote) VoteV2 {
+	options := make([]VoteOptionV2, 0, len(vote.Options))
+	for _, o := range vote.Options {
+		options = append(options, VoteOptionV2{
+			Option: o.Option.String(),
+			Weight: o.Weight,
+		})
+	}
+
+	return VoteV2{
+		ProposalID:   vote.ProposalId,
+		VoterAddress: vote.Voter,
+		Options:      options,
+		Metadata:     vote.Metadata,
+	}
+}
+
+// formatTime formats a timestamp as RFC3339, unset timestamps are empty.
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// formatUnixTime returns the unix timestamp in seconds of the time, or an empty string if unset.
+func formatUnixTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
 type SudoMsgAfterProposalSubmission struct {
 	AfterProposalSubmission Proposal `json:"after_proposal_submission"`
 }
@@ -83,18 +206,14 @@ func (h GovHooks) AfterProposalSubmission(ctx context.Context, proposalID uint64
 		return err
 	}
 
-	msgBz, err := json.Marshal(SudoMsgAfterProposalSubmission{
+	payload, err := NewPayload(types.EventAfterProposalSubmission, SudoMsgAfterProposalSubmission{
 		AfterProposalSubmission: NewProposal(prop),
-	})
+	}, NewProposalV2(prop))
 	if err != nil {
 		return err
 	}
 
-	if err := h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixGov, msgBz); err != nil {
-		_, err = fmt.Println("AfterProposalSubmission: ", err)
-		return err
-	}
-
+	h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixGov, types.NewGovEvent(types.EventAfterProposalSubmission, proposalID), payload)
 	return nil
 }
 
@@ -104,18 +223,14 @@ func (h GovHooks) AfterProposalDeposit(ctx context.Context, proposalID uint64, _
 		return err
 	}
 
-	msgBz, err := json.Marshal(SudoMsgAfterProposalDeposit{
+	payload, err := NewPayload(types.EventAfterProposalDeposit, SudoMsgAfterProposalDeposit{
 		AfterProposalDeposit: NewProposal(prop),
-	})
+	}, NewProposalV2(prop))
 	if err != nil {
 		return err
 	}
 
-	if err := h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixGov, msgBz); err != nil {
-		_, err = fmt.Println("AfterProposalDeposit: ", err)
-		return err
-	}
-
+	h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixGov, types.NewGovEvent(types.EventAfterProposalDeposit, proposalID), payload)
 	return nil
 }
 
@@ -125,18 +240,14 @@ func (h GovHooks) AfterProposalVote(ctx context.Context, proposalID uint64, vote
 		return err
 	}
 
-	msgBz, err := json.Marshal(SudoMsgAfterProposalVote{
+	payload, err := NewPayload(types.EventAfterProposalVote, SudoMsgAfterProposalVote{
 		AfterProposalVote: NewVote(vote),
-	})
+	}, NewVoteV2(vote))
 	if err != nil {
 		return err
 	}
 
-	if err := h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixGov, msgBz); err != nil {
-		_, err = fmt.Println("AfterProposalVote: ", err)
-		return err
-	}
-
+	h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixGov, types.NewGovEvent(types.EventAfterProposalVote, proposalID), payload)
 	return nil
 }
 
@@ -144,18 +255,21 @@ func (GovHooks) AfterProposalFailedMinDeposit(_ context.Context, _ uint64) error
 	return nil
 }
 
+// AfterProposalVotingPeriodEnded is called after the proposal was tallied, payload
+// version 2 includes the final tally result.
 func (h GovHooks) AfterProposalVotingPeriodEnded(ctx context.Context, proposalID uint64) error {
-	msgBz, err := json.Marshal(SudoAfterProposalVotingPeriodEnded{
-		AfterProposalVotingPeriodEnded: strconv.Itoa(int(proposalID)),
-	})
+	prop, err := h.k.govKeeper.Proposals.Get(ctx, proposalID)
 	if err != nil {
 		return err
 	}
 
-	if err := h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixGov, msgBz); err != nil {
-		_, err = fmt.Println("AfterProposalVotingPeriodEnded: ", err)
+	payload, err := NewPayload(types.EventAfterProposalVotingPeriodEnded, SudoAfterProposalVotingPeriodEnded{
+		AfterProposalVotingPeriodEnded: strconv.Itoa(int(proposalID)),
+	}, NewProposalV2(prop))
+	if err != nil {
 		return err
 	}
 
+	h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixGov, types.NewGovEvent(types.EventAfterProposalVotingPeriodEnded, proposalID), payload)
 	return nil
 }
@@ -3,6 +3,11 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
 	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
 )
 
@@ -25,14 +30,123 @@ func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*
 	}, nil
 }
 
-func (q queryServer) StakingContracts(ctx context.Context, _ *types.QueryStakingContractsRequest) (*types.QueryStakingContractsResponse, error) {
+func (q queryServer) StakingContracts(ctx context.Context, req *types.QueryStakingContractsRequest) (*types.QueryStakingContractsResponse, error) {
+	contracts, pageRes, err := q.k.GetPaginatedContractsBech32(ctx, types.KeyPrefixStaking, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.QueryStakingContractsResponse{
-		Contracts: q.k.GetAllContractsBech32(ctx, types.KeyPrefixStaking),
+		Contracts:  contracts,
+		Pagination: pageRes,
 	}, nil
 }
 
-func (q queryServer) GovernanceContracts(ctx context.Context, _ *types.QueryGovernanceContractsRequest) (*types.QueryGovernanceContractsResponse, error) {
+func (q queryServer) GovernanceContracts(ctx context.Context, req *types.QueryGovernanceContractsRequest) (*types.QueryGovernanceContractsResponse, error) {
+	contracts, pageRes, err := q.k.GetPaginatedContractsBech32(ctx, types.KeyPrefixGov, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.QueryGovernanceContractsResponse{
-		Contracts: q.k.GetAllContractsBech32(ctx, types.KeyPrefixGov),
+		Contracts:  contracts,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q queryServer) DistributionContracts(ctx context.Context, req *types.QueryDistributionContractsRequest) (*types.QueryDistributionContractsResponse, error) {
+	contracts, pageRes, err := q.k.GetPaginatedContractsBech32(ctx, types.KeyPrefixDistribution, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDistributionContractsResponse{
+		Contracts:  contracts,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q queryServer) SlashingContracts(ctx context.Context, req *types.QuerySlashingContractsRequest) (*types.QuerySlashingContractsResponse, error) {
+	contracts, pageRes, err := q.k.GetPaginatedContractsBech32(ctx, types.KeyPrefixSlashing, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySlashingContractsResponse{
+		Contracts:  contracts,
+		Pagination: pageRes,
 	}, nil
 }
+
+func (q queryServer) ContractStatus(ctx context.Context, req *types.QueryContractStatusRequest) (*types.QueryContractStatusResponse, error) {
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractStatusResponse{
+		Status: q.k.GetContractStatus(ctx, contract),
+	}, nil
+}
+
+func (q queryServer) QueuedHooks(ctx context.Context, req *types.QueryQueuedHooksRequest) (*types.QueryQueuedHooksResponse, error) {
+	return q.k.GetPaginatedQueuedHooks(ctx, req.Pagination)
+}
+
+func (q queryServer) Subscriptions(ctx context.Context, req *types.QuerySubscriptionsRequest) (*types.QuerySubscriptionsResponse, error) {
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.QuerySubscriptionsResponse{}
+	if sub, ok := q.k.GetSubscription(ctx, types.KeyPrefixStaking, contract); ok {
+		resp.Staking = &sub
+	}
+	if sub, ok := q.k.GetSubscription(ctx, types.KeyPrefixGov, contract); ok {
+		resp.Governance = &sub
+	}
+	if sub, ok := q.k.GetSubscription(ctx, types.KeyPrefixDistribution, contract); ok {
+		resp.Distribution = &sub
+	}
+	if sub, ok := q.k.GetSubscription(ctx, types.KeyPrefixSlashing, contract); ok {
+		resp.Slashing = &sub
+	}
+
+	return resp, nil
+}
+
+func (q queryServer) BankHooks(ctx context.Context, req *types.QueryBankHooksRequest) (*types.QueryBankHooksResponse, error) {
+	return q.k.GetPaginatedBankHooks(ctx, req.Pagination)
+}
+
+func (q queryServer) BankHook(ctx context.Context, req *types.QueryBankHookRequest) (*types.QueryBankHookResponse, error) {
+	hook, found := q.k.GetBankHook(ctx, req.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "denom %s has no bank hook", req.Denom)
+	}
+
+	return &types.QueryBankHookResponse{
+		BankHook: hook,
+	}, nil
+}
+
+func (q queryServer) ContractRegistrations(ctx context.Context, req *types.QueryContractRegistrationsRequest) (*types.QueryContractRegistrationsResponse, error) {
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractRegistrationsResponse{
+		Registrations: q.k.GetContractRegistrations(ctx, contract),
+	}, nil
+}
+
+func (q queryServer) Registrations(ctx context.Context, req *types.QueryRegistrationsRequest) (*types.QueryRegistrationsResponse, error) {
+	keyPrefix, ok := types.GetRegistrationKeyPrefix(req.Category)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown category %s", req.Category)
+	}
+
+	return q.k.GetPaginatedRegistrations(ctx, keyPrefix, req.Pagination)
+}
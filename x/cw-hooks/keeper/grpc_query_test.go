@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
 )
@@ -55,3 +56,82 @@ func (s *KeeperTestSuite) TestContracts() {
 	s.Require().NoError(err)
 	s.Require().LessOrEqual(len(resp2.Contracts), len(governance))
 }
+
+func (s *KeeperTestSuite) TestRegistrations() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))))
+
+	var contractAddressList []string
+	for i := 0; i < 3; i++ {
+		contractAddress := s.InstantiateContract(sender.String(), "", wasmContract)
+		contractAddressList = append(contractAddressList, contractAddress)
+
+		_, err := s.msgServer.RegisterStaking(s.Ctx, &types.MsgRegisterStaking{
+			ContractAddress: contractAddress,
+			RegisterAddress: sender.String(),
+		})
+		s.Require().NoError(err)
+	}
+
+	contractAddress := contractAddressList[0]
+	ctx := s.Ctx.WithBlockHeight(10)
+	_, err := s.msgServer.RegisterGovernance(ctx, &types.MsgRegisterGovernance{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+	})
+	s.Require().NoError(err)
+
+	// Contract lists are paginated
+	resp, err := s.queryClient.StakingContracts(s.Ctx, &types.QueryStakingContractsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Contracts, 2)
+	s.Require().Equal(uint64(3), resp.Pagination.Total)
+
+	resp, err = s.queryClient.StakingContracts(s.Ctx, &types.QueryStakingContractsRequest{
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Contracts, 1)
+	s.Require().Nil(resp.Pagination.NextKey)
+
+	// Reverse lookup of the categories of a contract
+	regs, err := s.queryClient.ContractRegistrations(s.Ctx, &types.QueryContractRegistrationsRequest{ContractAddress: contractAddress})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Registration{
+		types.NewRegistration(types.CategoryStaking, contractAddress, sender.String(), s.Ctx.BlockHeight(), ""),
+		types.NewRegistration(types.CategoryGovernance, contractAddress, sender.String(), 10, ""),
+	}, regs.Registrations)
+
+	categoryRegs, err := s.queryClient.Registrations(s.Ctx, &types.QueryRegistrationsRequest{Category: types.CategoryStaking})
+	s.Require().NoError(err)
+	s.Require().Len(categoryRegs.Registrations, 3)
+
+	_, err = s.queryClient.Registrations(s.Ctx, &types.QueryRegistrationsRequest{Category: "unknown"})
+	s.Require().Error(err)
+
+	// Contracts registered without a recorded registration are still listed
+	k := s.App.AppKeepers.CWHooksKeeper
+	contract := sdk.MustAccAddressFromBech32(contractAddress)
+	k.DeleteRegistration(s.Ctx, types.CategoryStaking, contract, "")
+
+	regs, err = s.queryClient.ContractRegistrations(s.Ctx, &types.QueryContractRegistrationsRequest{ContractAddress: contractAddress})
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewRegistration(types.CategoryStaking, contractAddress, "", 0, ""), regs.Registrations[0])
+
+	// Unregistering removes the registration
+	_, err = s.msgServer.UnregisterGovernance(s.Ctx, &types.MsgUnregisterGovernance{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+	})
+	s.Require().NoError(err)
+
+	_, found := k.GetRegistration(s.Ctx, types.CategoryGovernance, contract, "")
+	s.Require().False(found)
+
+	genesis := k.ExportGenesis(s.Ctx)
+	s.Require().Len(genesis.Registrations, 2)
+	s.Require().NoError(types.ValidateGenesis(*genesis))
+}
@@ -15,16 +15,18 @@ import (
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
 	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
+	tokenfactorykeeper "github.com/CosmosContracts/juno/v29/x/tokenfactory/keeper"
 )
 
 type Keeper struct {
 	cdc          codec.BinaryCodec
 	storeService storetypes.KVStoreService
 
-	stakingKeeper  stakingkeeper.Keeper
-	govKeeper      govkeeper.Keeper
-	wk             wasmkeeper.Keeper
-	contractKeeper wasmtypes.ContractOpsKeeper
+	stakingKeeper      stakingkeeper.Keeper
+	govKeeper          govkeeper.Keeper
+	wk                 wasmkeeper.Keeper
+	contractKeeper     wasmtypes.ContractOpsKeeper
+	tokenFactoryKeeper tokenfactorykeeper.Keeper
 
 	authority string
 }
@@ -36,16 +38,18 @@ func NewKeeper(
 	gk govkeeper.Keeper,
 	wk wasmkeeper.Keeper,
 	ck wasmtypes.ContractOpsKeeper,
+	tfk tokenfactorykeeper.Keeper,
 	authority string,
 ) Keeper {
 	return Keeper{
-		cdc:            cdc,
-		storeService:   ss,
-		stakingKeeper:  sk,
-		govKeeper:      gk,
-		contractKeeper: ck,
-		authority:      authority,
-		wk:             wk,
+		cdc:                cdc,
+		storeService:       ss,
+		stakingKeeper:      sk,
+		govKeeper:          gk,
+		contractKeeper:     ck,
+		tokenFactoryKeeper: tfk,
+		authority:          authority,
+		wk:                 wk,
 	}
 }
 
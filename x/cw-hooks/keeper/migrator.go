@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/CosmosContracts/juno/v29/x/cw-hooks/migrations/v2"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 migrates the x/cw-hooks module state from the consensus version 1 to
+// version 2. Specifically, it sets the params added since version 1 to their default
+// values, so contracts are not jailed on their first failure and deferred contracts
+// receive their hooks.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	return v2.Migrate(store, m.keeper.cdc)
+}
@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/keeper"
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	s.SetupTest()
+	k := s.App.AppKeepers.CWHooksKeeper
+
+	// Params at version 1 only have the contract gas limit
+	s.Require().NoError(k.SetParams(s.Ctx, types.Params{ContractGasLimit: 300_000}))
+
+	s.Require().NoError(keeper.NewMigrator(k).Migrate1to2(s.Ctx))
+
+	defaults := types.DefaultParams()
+	params := k.GetParams(s.Ctx)
+	s.Require().Equal(uint64(300_000), params.ContractGasLimit)
+	s.Require().Equal(defaults.MaxStrikes, params.MaxStrikes)
+	s.Require().Equal(defaults.JailDuration, params.JailDuration)
+	s.Require().Equal(defaults.EndBlockGasLimit, params.EndBlockGasLimit)
+
+	// The end block gas limit fits at least one queued hook
+	s.Require().NoError(k.SetParams(s.Ctx, types.Params{ContractGasLimit: 20_000_000}))
+	s.Require().NoError(keeper.NewMigrator(k).Migrate1to2(s.Ctx))
+	s.Require().Equal(uint64(20_000_000+types.QueuedHookGas), k.GetParams(s.Ctx).EndBlockGasLimit)
+}
@@ -54,7 +54,12 @@ func (k msgServer) RegisterStaking(goCtx context.Context, req *types.MsgRegister
 		return nil, errorsmod.Wrap(err, "invalid contract address")
 	}
 
-	if err := k.handleContractRegister(ctx, req.RegisterAddress, req.ContractAddress, types.KeyPrefixStaking, "staking"); err != nil {
+	sub := types.NewValidatorSubscription(req.ContractAddress, req.EventTypes, req.ValidatorAddresses, req.Deferred, req.PayloadVersion)
+	if err := sub.Validate(types.CategoryStaking); err != nil {
+		return nil, err
+	}
+
+	if err := k.handleContractRegister(ctx, req.RegisterAddress, sub, types.KeyPrefixStaking, types.CategoryStaking); err != nil {
 		return nil, err
 	}
 
@@ -72,7 +77,12 @@ func (k msgServer) RegisterGovernance(goCtx context.Context, req *types.MsgRegis
 		return nil, errorsmod.Wrap(err, "invalid contract address")
 	}
 
-	if err := k.handleContractRegister(ctx, req.RegisterAddress, req.ContractAddress, types.KeyPrefixGov, "governance"); err != nil {
+	sub := types.NewGovSubscription(req.ContractAddress, req.EventTypes, req.ProposalIds, req.Deferred, req.PayloadVersion)
+	if err := sub.Validate(types.CategoryGovernance); err != nil {
+		return nil, err
+	}
+
+	if err := k.handleContractRegister(ctx, req.RegisterAddress, sub, types.KeyPrefixGov, types.CategoryGovernance); err != nil {
 		return nil, err
 	}
 
@@ -90,7 +100,7 @@ func (k msgServer) UnregisterGovernance(goCtx context.Context, req *types.MsgUnr
 		return nil, errorsmod.Wrap(err, "invalid contract address")
 	}
 
-	if err := k.handleContractRemoval(ctx, req.RegisterAddress, req.ContractAddress, types.KeyPrefixGov, "governance"); err != nil {
+	if err := k.handleContractRemoval(ctx, req.RegisterAddress, req.ContractAddress, types.KeyPrefixGov, types.CategoryGovernance); err != nil {
 		return nil, err
 	}
 
@@ -108,13 +118,168 @@ func (k msgServer) UnregisterStaking(goCtx context.Context, req *types.MsgUnregi
 		return nil, errorsmod.Wrap(err, "invalid contract address")
 	}
 
-	if err := k.handleContractRemoval(ctx, req.RegisterAddress, req.ContractAddress, types.KeyPrefixStaking, "staking"); err != nil {
+	if err := k.handleContractRemoval(ctx, req.RegisterAddress, req.ContractAddress, types.KeyPrefixStaking, types.CategoryStaking); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgUnregisterStakingResponse{}, nil
 }
 
+func (k msgServer) RegisterDistribution(goCtx context.Context, req *types.MsgRegisterDistribution) (*types.MsgRegisterDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.RegisterAddress); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid register address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.ContractAddress); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	sub := types.NewValidatorSubscription(req.ContractAddress, req.EventTypes, req.ValidatorAddresses, req.Deferred, req.PayloadVersion)
+	if err := sub.Validate(types.CategoryDistribution); err != nil {
+		return nil, err
+	}
+
+	if err := k.handleContractRegister(ctx, req.RegisterAddress, sub, types.KeyPrefixDistribution, types.CategoryDistribution); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterDistributionResponse{}, nil
+}
+
+func (k msgServer) UnregisterDistribution(goCtx context.Context, req *types.MsgUnregisterDistribution) (*types.MsgUnregisterDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.RegisterAddress); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid register address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.ContractAddress); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	if err := k.handleContractRemoval(ctx, req.RegisterAddress, req.ContractAddress, types.KeyPrefixDistribution, types.CategoryDistribution); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnregisterDistributionResponse{}, nil
+}
+
+func (k msgServer) RegisterSlashing(goCtx context.Context, req *types.MsgRegisterSlashing) (*types.MsgRegisterSlashingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.RegisterAddress); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid register address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.ContractAddress); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	sub := types.NewValidatorSubscription(req.ContractAddress, req.EventTypes, req.ValidatorAddresses, req.Deferred, req.PayloadVersion)
+	if err := sub.Validate(types.CategorySlashing); err != nil {
+		return nil, err
+	}
+
+	if err := k.handleContractRegister(ctx, req.RegisterAddress, sub, types.KeyPrefixSlashing, types.CategorySlashing); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterSlashingResponse{}, nil
+}
+
+func (k msgServer) UnregisterSlashing(goCtx context.Context, req *types.MsgUnregisterSlashing) (*types.MsgUnregisterSlashingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.RegisterAddress); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid register address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.ContractAddress); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	if err := k.handleContractRemoval(ctx, req.RegisterAddress, req.ContractAddress, types.KeyPrefixSlashing, types.CategorySlashing); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnregisterSlashingResponse{}, nil
+}
+
+func (k msgServer) RegisterBank(goCtx context.Context, req *types.MsgRegisterBank) (*types.MsgRegisterBankResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.RegisterAddress); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid register address")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	hook := types.NewBankHook(req.Denom, req.ContractAddress, req.BeforeSend, req.AfterSend)
+	if err := hook.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.isDenomAdmin(ctx, req.RegisterAddress, req.Denom); err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetBankHook(ctx, req.Denom); found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "denom %s already has a bank hook", req.Denom)
+	}
+
+	if ok := k.GetWasmKeeper().HasContractInfo(ctx, contract); !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "contract does not exist: %s", contract)
+	}
+
+	if hook.AfterSend && k.GetParams(ctx).EndBlockGasLimit == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidBankHook, "after_send delivery is disabled")
+	}
+
+	k.SetBankHook(ctx, hook)
+	k.SetRegistration(ctx, types.NewRegistration(types.CategoryBank, req.ContractAddress, req.RegisterAddress, ctx.BlockHeight(), req.Denom))
+
+	return &types.MsgRegisterBankResponse{}, nil
+}
+
+func (k msgServer) UnregisterBank(goCtx context.Context, req *types.MsgUnregisterBank) (*types.MsgUnregisterBankResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.RegisterAddress); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid register address")
+	}
+
+	if _, found := k.GetBankHook(ctx, req.Denom); !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "denom %s has no bank hook", req.Denom)
+	}
+
+	if err := k.isDenomAdmin(ctx, req.RegisterAddress, req.Denom); err != nil {
+		return nil, err
+	}
+
+	k.DeleteBankHook(ctx, req.Denom)
+
+	return &types.MsgUnregisterBankResponse{}, nil
+}
+
+// isDenomAdmin returns an error if the sender is not the token factory admin of the denom.
+func (k msgServer) isDenomAdmin(ctx sdk.Context, sender, denom string) error {
+	metadata, err := k.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Admin == "" || metadata.Admin != sender {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "sender is not the admin of denom %s", denom)
+	}
+
+	return nil
+}
+
 func (k msgServer) isContractSenderAuthorized(ctx sdk.Context, sender string, contract sdk.AccAddress) error {
 	if ok := k.GetWasmKeeper().HasContractInfo(ctx, contract); !ok {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "contract does not exist: %s", contract)
@@ -131,8 +296,8 @@ func (k msgServer) isContractSenderAuthorized(ctx sdk.Context, sender string, co
 	return nil
 }
 
-func (k msgServer) handleContractRegister(ctx sdk.Context, sender, contractAddr string, keyPrefix []byte, prefixModuleName string) error {
-	contract, err := sdk.AccAddressFromBech32(contractAddr)
+func (k msgServer) handleContractRegister(ctx sdk.Context, sender string, sub types.Subscription, keyPrefix []byte, prefixModuleName string) error {
+	contract, err := sdk.AccAddressFromBech32(sub.ContractAddress)
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
 	}
@@ -145,7 +310,12 @@ func (k msgServer) handleContractRegister(ctx sdk.Context, sender, contractAddr
 		return err
 	}
 
-	k.SetContract(ctx, keyPrefix, contract)
+	if sub.Deferred && k.GetParams(ctx).EndBlockGasLimit == 0 {
+		return errorsmod.Wrap(types.ErrInvalidSubscription, "deferred delivery is disabled")
+	}
+
+	k.SetSubscription(ctx, keyPrefix, contract, sub)
+	k.SetRegistration(ctx, types.NewRegistration(prefixModuleName, sub.ContractAddress, sender, ctx.BlockHeight(), ""))
 
 	return nil
 }
@@ -168,3 +338,30 @@ func (k msgServer) handleContractRemoval(ctx sdk.Context, sender, contractAddr s
 
 	return nil
 }
+
+func (k msgServer) UnjailContract(goCtx context.Context, req *types.MsgUnjailContract) (*types.MsgUnjailContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(req.SenderAddress); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid contract address")
+	}
+
+	if err := k.isContractSenderAuthorized(ctx, req.SenderAddress, contract); err != nil {
+		return nil, err
+	}
+
+	status := k.GetContractStatus(ctx, contract)
+	if !status.IsJailed {
+		return nil, errorsmod.Wrapf(types.ErrContractNotJailed, "contract %s", req.ContractAddress)
+	}
+
+	status.Unjail()
+	k.SetContractStatus(ctx, contract, status)
+
+	return &types.MsgUnjailContractResponse{}, nil
+}
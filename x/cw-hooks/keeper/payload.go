@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
+)
+
+// Payload is the sudo message of a hook event in every payload version.
+type Payload struct {
+	V1 []byte
+	V2 []byte
+}
+
+// NewPayload marshals the sudo message of a hook event. v1 is the original sudo message,
+// version 2 sends v2Data under the event type, e.g. {"after_delegation_modified":{...}}.
+func NewPayload(eventType string, v1, v2Data any) (Payload, error) {
+	v1Bz, err := json.Marshal(v1)
+	if err != nil {
+		return Payload{}, err
+	}
+
+	v2Bz, err := json.Marshal(map[string]any{eventType: v2Data})
+	if err != nil {
+		return Payload{}, err
+	}
+
+	return Payload{V1: v1Bz, V2: v2Bz}, nil
+}
+
+// NewPayloadV1 marshals the original sudo message of a hook event, for events without
+// contracts receiving version 2.
+func NewPayloadV1(v1 any) (Payload, error) {
+	v1Bz, err := json.Marshal(v1)
+	if err != nil {
+		return Payload{}, err
+	}
+
+	return Payload{V1: v1Bz}, nil
+}
+
+// Version returns the sudo message in the payload version of a subscription.
+func (p Payload) Version(version uint32) []byte {
+	if version == types.PayloadVersion2 {
+		return p.V2
+	}
+	return p.V1
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/keeper"
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
+)
+
+func (s *KeeperTestSuite) TestPayloadVersions() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))))
+
+	v1Contract := s.InstantiateContract(sender.String(), "", wasmContract)
+	v2Contract := s.InstantiateContract(sender.String(), "", wasmContract)
+
+	_, err := s.msgServer.RegisterStaking(s.Ctx, &types.MsgRegisterStaking{
+		ContractAddress: v2Contract,
+		RegisterAddress: sender.String(),
+		PayloadVersion:  types.LatestPayloadVersion + 1,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidSubscription)
+
+	// Deferred contracts keep the sudo message in the queue, which shows the version each
+	// contract receives.
+	_, err = s.msgServer.RegisterStaking(s.Ctx, &types.MsgRegisterStaking{
+		ContractAddress: v1Contract,
+		RegisterAddress: sender.String(),
+		Deferred:        true,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.RegisterStaking(s.Ctx, &types.MsgRegisterStaking{
+		ContractAddress: v2Contract,
+		RegisterAddress: sender.String(),
+		Deferred:        true,
+		PayloadVersion:  types.PayloadVersion2,
+	})
+	s.Require().NoError(err)
+
+	resp, err := s.queryClient.Subscriptions(s.Ctx, &types.QuerySubscriptionsRequest{ContractAddress: v2Contract})
+	s.Require().NoError(err)
+	s.Require().Equal(types.PayloadVersion2, resp.Staking.PayloadVersion)
+
+	k := s.App.AppKeepers.CWHooksKeeper
+	payload := keeper.Payload{V1: []byte(`{"v1":{}}`), V2: []byte(`{"v2":{}}`)}
+	k.ExecuteMessageOnContracts(s.Ctx, types.KeyPrefixStaking, types.HookEvent{Type: types.EventAfterDelegationModified}, payload)
+
+	msgs := map[string][]byte{}
+	for _, hook := range k.GetAllQueuedHooks(s.Ctx) {
+		msgs[hook.ContractAddress] = hook.Msg
+	}
+	s.Require().Equal(payload.V1, msgs[v1Contract])
+	s.Require().Equal(payload.V2, msgs[v2Contract])
+
+	genesis := k.ExportGenesis(s.Ctx)
+	s.Require().NoError(types.ValidateGenesis(*genesis))
+}
+
+func TestNewPayload(t *testing.T) {
+	payload, err := keeper.NewPayload(types.EventAfterDelegationModified, keeper.SudoMsgAfterDelegationModified{
+		AfterDelegationModified: &keeper.Delegation{Shares: "1.0"},
+	}, &keeper.DelegationV2{Shares: "1.0", Tokens: "1"})
+	require.NoError(t, err)
+
+	require.JSONEq(t, `{"after_delegation_modified":{"validator_address":"","delegator_address":"","shares":"1.0"}}`, string(payload.V1))
+	require.JSONEq(t, `{"after_delegation_modified":{"validator_address":"","delegator_address":"","shares":"1.0","tokens":"1"}}`, string(payload.V2))
+
+	require.Equal(t, payload.V1, payload.Version(0))
+	require.Equal(t, payload.V1, payload.Version(types.PayloadVersion1))
+	require.Equal(t, payload.V2, payload.Version(types.PayloadVersion2))
+}
+
+func TestNewProposalV2(t *testing.T) {
+	submitTime := time.Date(2024, 5, 17, 13, 45, 30, 0, time.UTC)
+	depositEndTime := submitTime.Add(time.Hour)
+	tally := v1.NewTallyResult(sdkmath.NewInt(10), sdkmath.NewInt(1), sdkmath.NewInt(2), sdkmath.ZeroInt())
+
+	prop := v1.Proposal{
+		Id:               7,
+		Messages:         []*codectypes.Any{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: []byte{1, 2}}},
+		Status:           v1.StatusVotingPeriod,
+		FinalTallyResult: &tally,
+		SubmitTime:       &submitTime,
+		DepositEndTime:   &depositEndTime,
+		TotalDeposit:     sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(100))),
+		Title:            "title",
+	}
+
+	// The v1 submit time is only the seconds of the submit time, unchanged for existing contracts
+	require.Equal(t, "30", keeper.NewProposal(prop).SubmitTime)
+
+	p := keeper.NewProposalV2(prop)
+	require.Equal(t, "2024-05-17T13:45:30Z", p.SubmitTime)
+	require.Equal(t, "1715953530", p.SubmitTimeUnix)
+	require.Equal(t, "2024-05-17T14:45:30Z", p.DepositEndTime)
+	require.Empty(t, p.VotingEndTime)
+	require.Equal(t, "PROPOSAL_STATUS_VOTING_PERIOD", p.Status)
+	require.Equal(t, []keeper.ProposalMessage{{TypeURL: "/cosmos.bank.v1beta1.MsgSend", Value: []byte{1, 2}}}, p.Messages)
+
+	// The tally is only final once the voting period ended
+	require.Nil(t, p.FinalTallyResult)
+
+	prop.Status = v1.StatusPassed
+	p = keeper.NewProposalV2(prop)
+	require.Equal(t, &keeper.TallyResult{YesCount: "10", AbstainCount: "1", NoCount: "2", NoWithVetoCount: "0"}, p.FinalTallyResult)
+
+	bz, err := json.Marshal(p)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"messages":[{"type_url":"/cosmos.bank.v1beta1.MsgSend","value":"AQI="}]`)
+}
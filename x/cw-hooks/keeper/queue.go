@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
+)
+
+// QueueHook queues the sudo message for delivery to a deferred contract at the end of the
+// block. The hook is dropped if the queue quota of the contract is full, which is recorded
+// on the status of the contract.
+func (k Keeper) QueueHook(ctx context.Context, category string, contractAddr sdk.AccAddress, eventType string, msgBz []byte) {
+	err := k.queueHook(ctx, types.QueuedHook{
+		ContractAddress: contractAddr.String(),
+		Category:        category,
+		EventType:       eventType,
+		Msg:             msgBz,
+	})
+	if err != nil {
+		k.recordDroppedHook(ctx, contractAddr, category, eventType)
+	}
+}
+
+// queueHook appends the hook to the queue at the current height. Returns an error if the
+// queue quota of the hook is full.
+func (k Keeper) queueHook(ctx context.Context, hook types.QueuedHook) error {
+	quotaKey, maxLength := queueQuota(hook)
+	length := k.getQueueLength(ctx, quotaKey)
+	if length >= maxLength {
+		return errorsmod.Wrapf(types.ErrQueueFull, "%d hooks queued", length)
+	}
+
+	hook.Id = k.nextQueueID(ctx)
+	hook.QueuedHeight = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	k.setQueuedHook(ctx, hook)
+	k.setQueueLength(ctx, quotaKey, length+1)
+	return nil
+}
+
+// recordDroppedHook records a deferred hook dropped because the queue quota of the
+// contract was full on the status of the contract, and emits an event.
+func (k Keeper) recordDroppedHook(ctx context.Context, contractAddr sdk.AccAddress, category, eventType string) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k.Logger(ctx).Error("queue quota is full, dropping hook", "contract", contractAddr.String(), "category", category, "event", eventType)
+
+	status := k.GetContractStatus(ctx, contractAddr)
+	status.RecordDroppedHook(sdkCtx.BlockHeight())
+	k.SetContractStatus(ctx, contractAddr, status)
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeHookDropped,
+		sdk.NewAttribute(types.AttributeKeyContract, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyCategory, category),
+		sdk.NewAttribute(types.AttributeKeyEventType, eventType),
+	))
+}
+
+// queueQuota returns the store key of the queue length the hook counts against, and the
+// maximum length. Bank hooks count against the quota of their denom, other hooks against
+// the quota of their contract.
+func queueQuota(hook types.QueuedHook) ([]byte, uint64) {
+	if hook.Category == types.CategoryBank {
+		return append(slices.Clone(types.KeyPrefixDenomQueueLength), hook.Denom...), types.MaxQueuedHooksPerDenom
+	}
+
+	addr := sdk.MustAccAddressFromBech32(hook.ContractAddress)
+	return append(slices.Clone(types.KeyPrefixContractQueueLength), addr.Bytes()...), types.MaxQueuedHooksPerContract
+}
+
+// GetAllQueuedHooks returns all queued hooks in delivery order.
+func (k Keeper) GetAllQueuedHooks(ctx context.Context) []types.QueuedHook {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixQueue)
+	defer iterator.Close() //nolint:errcheck
+
+	list := []types.QueuedHook{}
+	for ; iterator.Valid(); iterator.Next() {
+		var hook types.QueuedHook
+		k.cdc.MustUnmarshal(iterator.Value(), &hook)
+		list = append(list, hook)
+	}
+	return list
+}
+
+// GetPaginatedQueuedHooks returns the queued hooks in delivery order.
+func (k Keeper) GetPaginatedQueuedHooks(ctx context.Context, pag *query.PageRequest) (*types.QueryQueuedHooksResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KeyPrefixQueue)
+
+	results, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		store,
+		pag,
+		func(_ []byte, value *types.QueuedHook) (*types.QueuedHook, error) {
+			return value, nil
+		},
+		func() *types.QueuedHook {
+			return &types.QueuedHook{}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks := make([]types.QueuedHook, 0, len(results))
+	for _, hook := range results {
+		hooks = append(hooks, *hook)
+	}
+
+	return &types.QueryQueuedHooksResponse{
+		Hooks:      hooks,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DeliverQueuedHooks delivers the queued hooks in order until the end block gas limit
+// or the maximum number of hooks per block is reached. Every dequeued hook costs a fixed
+// amount of gas on top of its execution, including hooks which are dropped. Hooks which
+// do not fit in the remaining gas stay queued for the next block.
+func (k Keeper) DeliverQueuedHooks(ctx context.Context) {
+	p := k.GetParams(ctx)
+	if p.EndBlockGasLimit == 0 {
+		return
+	}
+
+	remaining := p.EndBlockGasLimit
+	for i := 0; i < types.MaxQueuedHooksPerBlock && remaining >= p.ContractGasLimit+types.QueuedHookGas; i++ {
+		hook, found := k.peekQueuedHook(ctx)
+		if !found {
+			return
+		}
+		k.deleteQueuedHook(ctx, hook)
+		remaining -= types.QueuedHookGas
+
+		addr := sdk.MustAccAddressFromBech32(hook.ContractAddress)
+
+		// Drop hooks of contracts which unregistered or were jailed since
+		if !k.isQueuedHookRegistered(ctx, hook, addr) || k.isContractJailed(ctx, addr, p) {
+			continue
+		}
+
+		remaining -= min(k.executeOnContract(ctx, addr, p, hook.Msg), remaining)
+	}
+}
+
+// isQueuedHookRegistered returns true if the contract is still registered to receive
+// the queued hook.
+func (k Keeper) isQueuedHookRegistered(ctx context.Context, hook types.QueuedHook, addr sdk.AccAddress) bool {
+	if hook.Category == types.CategoryBank {
+		bankHook, found := k.GetBankHook(ctx, hook.Denom)
+		return found && bankHook.AfterSend && bankHook.ContractAddress == hook.ContractAddress
+	}
+
+	keyPrefix, ok := types.GetCategoryKeyPrefix(hook.Category)
+	return ok && k.IsContractRegistered(ctx, keyPrefix, addr)
+}
+
+func (k Keeper) setQueuedHook(ctx context.Context, hook types.QueuedHook) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	loadedPrefix := prefix.NewStore(store, types.KeyPrefixQueue)
+	loadedPrefix.Set(sdk.Uint64ToBigEndian(hook.Id), k.cdc.MustMarshal(&hook))
+}
+
+func (k Keeper) deleteQueuedHook(ctx context.Context, hook types.QueuedHook) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	loadedPrefix := prefix.NewStore(store, types.KeyPrefixQueue)
+	loadedPrefix.Delete(sdk.Uint64ToBigEndian(hook.Id))
+
+	quotaKey, _ := queueQuota(hook)
+	if length := k.getQueueLength(ctx, quotaKey); length > 0 {
+		k.setQueueLength(ctx, quotaKey, length-1)
+	}
+}
+
+// getQueueLength returns the number of queued hooks of the quota.
+func (k Keeper) getQueueLength(ctx context.Context, quotaKey []byte) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(quotaKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setQueueLength stores the number of queued hooks of the quota, removing empty quotas.
+func (k Keeper) setQueueLength(ctx context.Context, quotaKey []byte, length uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if length == 0 {
+		store.Delete(quotaKey)
+		return
+	}
+	store.Set(quotaKey, sdk.Uint64ToBigEndian(length))
+}
+
+// peekQueuedHook returns the next hook to deliver.
+func (k Keeper) peekQueuedHook(ctx context.Context) (types.QueuedHook, bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixQueue)
+	defer iterator.Close() //nolint:errcheck
+
+	if !iterator.Valid() {
+		return types.QueuedHook{}, false
+	}
+
+	var hook types.QueuedHook
+	k.cdc.MustUnmarshal(iterator.Value(), &hook)
+	return hook, true
+}
+
+// nextQueueID returns the id of the next queued hook and increments the sequence.
+func (k Keeper) nextQueueID(ctx context.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	var id uint64
+	if bz := store.Get(types.KeyQueueSequence); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(types.KeyQueueSequence, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/keeper"
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
+)
+
+func (s *KeeperTestSuite) TestDeferredHooks() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))))
+
+	contractAddress := s.InstantiateContract(sender.String(), "", wasmContract)
+	contract := sdk.MustAccAddressFromBech32(contractAddress)
+
+	k := s.App.AppKeepers.CWHooksKeeper
+
+	// Deferred delivery requires an end block gas limit
+	err := k.SetParams(s.Ctx, types.NewParams(250_000, 5, 0, 0))
+	s.Require().NoError(err)
+
+	register := &types.MsgRegisterStaking{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+		Deferred:        true,
+	}
+	_, err = s.msgServer.RegisterStaking(s.Ctx, register)
+	s.Require().ErrorIs(err, types.ErrInvalidSubscription)
+
+	// The end block gas limit fits a single queued hook
+	err = k.SetParams(s.Ctx, types.NewParams(250_000, 5, 0, 250_000+types.QueuedHookGas))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.RegisterStaking(s.Ctx, register)
+	s.Require().NoError(err)
+
+	// Hooks are queued instead of executed
+	event := types.HookEvent{Type: types.EventAfterDelegationModified}
+	for i := 0; i < 3; i++ {
+		k.ExecuteMessageOnContracts(s.Ctx, types.KeyPrefixStaking, event, keeper.Payload{V1: []byte(`{"unknown_hook":{}}`)})
+	}
+	s.Require().Zero(k.GetContractStatus(s.Ctx, contract).FailureCount)
+
+	resp, err := s.queryClient.QueuedHooks(s.Ctx, &types.QueryQueuedHooksRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Hooks, 3)
+	s.Require().Equal(uint64(0), resp.Hooks[0].Id)
+	s.Require().Equal(contractAddress, resp.Hooks[0].ContractAddress)
+	s.Require().Equal(types.CategoryStaking, resp.Hooks[0].Category)
+	s.Require().Equal(types.EventAfterDelegationModified, resp.Hooks[0].EventType)
+
+	// A failing execution uses less gas than the contract gas limit, the next hook
+	// no longer fits in the remaining end block gas
+	err = keeper.EndBlocker(s.Ctx, k)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), k.GetContractStatus(s.Ctx, contract).FailureCount)
+	s.Require().Len(k.GetAllQueuedHooks(s.Ctx), 2)
+
+	err = keeper.EndBlocker(s.Ctx, k)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), k.GetContractStatus(s.Ctx, contract).FailureCount)
+
+	queued := k.GetAllQueuedHooks(s.Ctx)
+	s.Require().Len(queued, 1)
+	s.Require().Equal(uint64(2), queued[0].Id)
+
+	// Queued hooks of unregistered contracts are dropped
+	_, err = s.msgServer.UnregisterStaking(s.Ctx, &types.MsgUnregisterStaking{
+		ContractAddress: contractAddress,
+		RegisterAddress: sender.String(),
+	})
+	s.Require().NoError(err)
+
+	err = keeper.EndBlocker(s.Ctx, k)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), k.GetContractStatus(s.Ctx, contract).FailureCount)
+	s.Require().Empty(k.GetAllQueuedHooks(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestQueueLimits() {
+	s.SetupTest()
+	_, _, contract := testdata.KeyTestPubAddr()
+	k := s.App.AppKeepers.CWHooksKeeper
+
+	// The end block gas limit fits a single queued hook
+	err := k.SetParams(s.Ctx, types.NewParams(250_000, 5, 0, 250_000+types.QueuedHookGas))
+	s.Require().NoError(err)
+
+	for i := 0; i < 3; i++ {
+		k.QueueHook(s.Ctx, types.CategoryStaking, contract, types.EventAfterDelegationModified, []byte(`{}`))
+	}
+
+	// Dropped hooks of unregistered contracts still cost the fixed gas
+	err = keeper.EndBlocker(s.Ctx, k)
+	s.Require().NoError(err)
+	s.Require().Len(k.GetAllQueuedHooks(s.Ctx), 2)
+
+	// At most the maximum number of hooks are dequeued per block
+	err = k.SetParams(s.Ctx, types.NewParams(250_000, 5, 0, 1_000_000_000))
+	s.Require().NoError(err)
+
+	for i := 0; i < types.MaxQueuedHooksPerBlock; i++ {
+		k.QueueHook(s.Ctx, types.CategoryStaking, contract, types.EventAfterDelegationModified, []byte(`{}`))
+	}
+
+	err = keeper.EndBlocker(s.Ctx, k)
+	s.Require().NoError(err)
+	s.Require().Len(k.GetAllQueuedHooks(s.Ctx), 2)
+
+	// Hooks queued while the quota of the contract is full are dropped and recorded
+	for i := 0; i < types.MaxQueuedHooksPerContract-2; i++ {
+		k.QueueHook(s.Ctx, types.CategoryStaking, contract, types.EventAfterDelegationModified, []byte(`{}`))
+	}
+	s.Require().Len(k.GetAllQueuedHooks(s.Ctx), types.MaxQueuedHooksPerContract)
+	s.Require().Zero(k.GetContractStatus(s.Ctx, contract).DroppedHooks)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	k.QueueHook(ctx, types.CategoryStaking, contract, types.EventAfterDelegationModified, []byte(`{}`))
+	s.Require().Len(k.GetAllQueuedHooks(s.Ctx), types.MaxQueuedHooksPerContract)
+
+	status := k.GetContractStatus(s.Ctx, contract)
+	s.Require().Equal(uint64(1), status.DroppedHooks)
+	s.Require().Equal(s.Ctx.BlockHeight(), status.LastDroppedHeight)
+	s.Require().Zero(status.FailureCount)
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal(types.EventTypeHookDropped, events[0].Type)
+
+	// Other contracts have their own quota
+	_, _, other := testdata.KeyTestPubAddr()
+	k.QueueHook(s.Ctx, types.CategoryStaking, other, types.EventAfterDelegationModified, []byte(`{}`))
+	s.Require().Len(k.GetAllQueuedHooks(s.Ctx), types.MaxQueuedHooksPerContract+1)
+	s.Require().Zero(k.GetContractStatus(s.Ctx, other).DroppedHooks)
+
+	// Delivered hooks free up the quota
+	err = keeper.EndBlocker(s.Ctx, k)
+	s.Require().NoError(err)
+
+	k.QueueHook(s.Ctx, types.CategoryStaking, contract, types.EventAfterDelegationModified, []byte(`{}`))
+	s.Require().Len(k.GetAllQueuedHooks(s.Ctx), types.MaxQueuedHooksPerContract+1-types.MaxQueuedHooksPerBlock+1)
+	s.Require().Equal(uint64(1), k.GetContractStatus(s.Ctx, contract).DroppedHooks)
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
+)
+
+// SetRegistration records who registered the contract and when.
+func (k Keeper) SetRegistration(ctx context.Context, reg types.Registration) {
+	key, err := reg.Key()
+	if err != nil {
+		panic(err)
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(key, k.cdc.MustMarshal(&reg))
+}
+
+// GetRegistration returns the registration of a contract for the category. Contracts
+// registered before registrations were recorded have no registration.
+func (k Keeper) GetRegistration(ctx context.Context, category string, contractAddr sdk.AccAddress, denom string) (types.Registration, bool) {
+	key, err := types.NewRegistration(category, contractAddr.String(), "", 0, denom).Key()
+	if err != nil {
+		return types.Registration{}, false
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(key)
+	if bz == nil {
+		return types.Registration{}, false
+	}
+
+	var reg types.Registration
+	k.cdc.MustUnmarshal(bz, &reg)
+	return reg, true
+}
+
+// DeleteRegistration removes the registration of a contract for the category.
+func (k Keeper) DeleteRegistration(ctx context.Context, category string, contractAddr sdk.AccAddress, denom string) {
+	key, err := types.NewRegistration(category, contractAddr.String(), "", 0, denom).Key()
+	if err != nil {
+		return
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(key)
+}
+
+// GetContractRegistrations returns the registrations of a contract in every category,
+// with one registration per denom for bank hooks.
+func (k Keeper) GetContractRegistrations(ctx context.Context, contractAddr sdk.AccAddress) []types.Registration {
+	list := []types.Registration{}
+
+	for _, category := range []string{types.CategoryStaking, types.CategoryGovernance, types.CategoryDistribution, types.CategorySlashing} {
+		keyPrefix, _ := types.GetCategoryKeyPrefix(category)
+		if !k.IsContractRegistered(ctx, keyPrefix, contractAddr) {
+			continue
+		}
+
+		reg, found := k.GetRegistration(ctx, category, contractAddr, "")
+		if !found {
+			reg = types.NewRegistration(category, contractAddr.String(), "", 0, "")
+		}
+		list = append(list, reg)
+	}
+
+	keyPrefix, _ := types.GetRegistrationKeyPrefix(types.CategoryBank)
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, append(keyPrefix, address.MustLengthPrefix(contractAddr)...))
+	defer iterator.Close() //nolint:errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var reg types.Registration
+		k.cdc.MustUnmarshal(iterator.Value(), &reg)
+		list = append(list, reg)
+	}
+
+	return list
+}
+
+// GetAllRegistrations returns the registrations of all contracts.
+func (k Keeper) GetAllRegistrations(ctx context.Context) []types.Registration {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixRegistration)
+	defer iterator.Close() //nolint:errcheck
+
+	list := []types.Registration{}
+	for ; iterator.Valid(); iterator.Next() {
+		var reg types.Registration
+		k.cdc.MustUnmarshal(iterator.Value(), &reg)
+		list = append(list, reg)
+	}
+	return list
+}
+
+// GetPaginatedRegistrations returns the registrations of the category ordered by contract.
+func (k Keeper) GetPaginatedRegistrations(ctx context.Context, keyPrefix []byte, pag *query.PageRequest) (*types.QueryRegistrationsResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+
+	results, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		store,
+		pag,
+		func(_ []byte, value *types.Registration) (*types.Registration, error) {
+			return value, nil
+		},
+		func() *types.Registration {
+			return &types.Registration{}
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	registrations := make([]types.Registration, 0, len(results))
+	for _, reg := range results {
+		registrations = append(registrations, *reg)
+	}
+
+	return &types.QueryRegistrationsResponse{
+		Registrations: registrations,
+		Pagination:    pageRes,
+	}, nil
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"context"
+
+	evidencetypes "cosmossdk.io/x/evidence/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
+)
+
+// SlashingHooks sends the jailing, unjailing and tombstoning of validators to the
+// registered slashing contracts. The SDK does not provide slashing hooks, so they
+// are called by the keepers returned from NewSlashingStakingKeeper and
+// NewEvidenceSlashingKeeper.
+type SlashingHooks struct {
+	k Keeper
+}
+
+func (k Keeper) SlashingHooks() SlashingHooks {
+	return SlashingHooks{k: k}
+}
+
+type ValidatorSlashing struct {
+	Moniker          string `json:"moniker"`
+	ValidatorAddress string `json:"validator_address"`
+	ConsensusAddress string `json:"consensus_address"`
+}
+
+func NewValidatorSlashing(val stakingtypes.ValidatorI, consAddr sdk.ConsAddress) *ValidatorSlashing {
+	return &ValidatorSlashing{
+		Moniker:          val.GetMoniker(),
+		ValidatorAddress: val.GetOperator(),
+		ConsensusAddress: consAddr.String(),
+	}
+}
+
+type SudoMsgAfterValidatorJailed struct {
+	AfterValidatorJailed *ValidatorSlashing `json:"after_validator_jailed"`
+}
+
+type SudoMsgAfterValidatorUnjailed struct {
+	AfterValidatorUnjailed *ValidatorSlashing `json:"after_validator_unjailed"`
+}
+
+type SudoMsgAfterValidatorTombstoned struct {
+	AfterValidatorTombstoned *ValidatorSlashing `json:"after_validator_tombstoned"`
+}
+
+func (h SlashingHooks) AfterValidatorJailed(ctx context.Context, consAddr sdk.ConsAddress) error {
+	return h.executeValidatorHook(ctx, consAddr, types.EventAfterValidatorJailed, func(v *ValidatorSlashing) any {
+		return SudoMsgAfterValidatorJailed{AfterValidatorJailed: v}
+	})
+}
+
+func (h SlashingHooks) AfterValidatorUnjailed(ctx context.Context, consAddr sdk.ConsAddress) error {
+	return h.executeValidatorHook(ctx, consAddr, types.EventAfterValidatorUnjailed, func(v *ValidatorSlashing) any {
+		return SudoMsgAfterValidatorUnjailed{AfterValidatorUnjailed: v}
+	})
+}
+
+func (h SlashingHooks) AfterValidatorTombstoned(ctx context.Context, consAddr sdk.ConsAddress) error {
+	return h.executeValidatorHook(ctx, consAddr, types.EventAfterValidatorTombstoned, func(v *ValidatorSlashing) any {
+		return SudoMsgAfterValidatorTombstoned{AfterValidatorTombstoned: v}
+	})
+}
+
+func (h SlashingHooks) executeValidatorHook(ctx context.Context, consAddr sdk.ConsAddress, eventType string, newSudoMsg func(*ValidatorSlashing) any) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockHeight() <= skipUntilHeight {
+		return nil
+	}
+
+	val, err := h.k.GetStakingKeeper().ValidatorByConsAddr(ctx, consAddr)
+	if val == nil {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	// Both payload versions are the same
+	slashing := NewValidatorSlashing(val, consAddr)
+	payload, err := NewPayload(eventType, newSudoMsg(slashing), slashing)
+	if err != nil {
+		return nil
+	}
+
+	h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixSlashing, types.NewValidatorEvent(eventType, valAddr), payload)
+	return nil
+}
+
+// slashingStakingKeeper wraps the staking keeper used by x/slashing to call the
+// slashing hooks after a validator is jailed or unjailed.
+type slashingStakingKeeper struct {
+	slashingtypes.StakingKeeper
+	k *Keeper
+}
+
+// NewSlashingStakingKeeper wraps the staking keeper passed to x/slashing. The keeper is
+// a pointer as x/slashing is created before the x/cw-hooks keeper.
+func NewSlashingStakingKeeper(sk slashingtypes.StakingKeeper, k *Keeper) slashingtypes.StakingKeeper {
+	return slashingStakingKeeper{
+		StakingKeeper: sk,
+		k:             k,
+	}
+}
+
+func (s slashingStakingKeeper) Jail(ctx context.Context, consAddr sdk.ConsAddress) error {
+	if err := s.StakingKeeper.Jail(ctx, consAddr); err != nil {
+		return err
+	}
+
+	return s.k.SlashingHooks().AfterValidatorJailed(ctx, consAddr)
+}
+
+func (s slashingStakingKeeper) Unjail(ctx context.Context, consAddr sdk.ConsAddress) error {
+	if err := s.StakingKeeper.Unjail(ctx, consAddr); err != nil {
+		return err
+	}
+
+	return s.k.SlashingHooks().AfterValidatorUnjailed(ctx, consAddr)
+}
+
+// evidenceSlashingKeeper wraps the slashing keeper used by x/evidence to call the
+// slashing hooks after a validator is tombstoned.
+type evidenceSlashingKeeper struct {
+	evidencetypes.SlashingKeeper
+	k *Keeper
+}
+
+// NewEvidenceSlashingKeeper wraps the slashing keeper passed to x/evidence. The keeper
+// is a pointer as x/evidence is created before the x/cw-hooks keeper.
+func NewEvidenceSlashingKeeper(sk evidencetypes.SlashingKeeper, k *Keeper) evidencetypes.SlashingKeeper {
+	return evidenceSlashingKeeper{
+		SlashingKeeper: sk,
+		k:              k,
+	}
+}
+
+func (s evidenceSlashingKeeper) Tombstone(ctx context.Context, consAddr sdk.ConsAddress) error {
+	if err := s.SlashingKeeper.Tombstone(ctx, consAddr); err != nil {
+		return err
+	}
+
+	return s.k.SlashingHooks().AfterValidatorTombstoned(ctx, consAddr)
+}
@@ -54,6 +54,64 @@ func NewDelegation(del stakingtypes.DelegationI) *Delegation {
 	}
 }
 
+// ValidatorV2 is the validator of payload version 2.
+type ValidatorV2 struct {
+	Moniker          string `json:"moniker"`
+	ValidatorAddress string `json:"validator_address"`
+	CommissionRate   string `json:"commission_rate"`
+	Tokens           string `json:"tokens"`
+	DelegatorShares  string `json:"delegator_shares"`
+	BondStatus       string `json:"bond_status"`
+	Jailed           bool   `json:"jailed"`
+}
+
+func NewValidatorV2(val stakingtypes.ValidatorI) *ValidatorV2 {
+	return &ValidatorV2{
+		Moniker:          val.GetMoniker(),
+		ValidatorAddress: val.GetOperator(),
+		CommissionRate:   val.GetCommission().String(),
+		Tokens:           val.GetTokens().String(),
+		DelegatorShares:  val.GetDelegatorShares().String(),
+		BondStatus:       val.GetStatus().String(),
+		Jailed:           val.IsJailed(),
+	}
+}
+
+// ValidatorSlashedV2 is the slashed validator of payload version 2, with the tokens
+// which are about to be slashed.
+type ValidatorSlashedV2 struct {
+	Moniker          string `json:"moniker"`
+	ValidatorAddress string `json:"validator_address"`
+	SlashFraction    string `json:"slash_fraction"`
+	SlashedTokens    string `json:"slashed_tokens"`
+}
+
+func NewValidatorSlashedV2(val stakingtypes.ValidatorI, fraction sdkmath.LegacyDec) *ValidatorSlashedV2 {
+	return &ValidatorSlashedV2{
+		Moniker:          val.GetMoniker(),
+		ValidatorAddress: val.GetOperator(),
+		SlashFraction:    fraction.String(),
+		SlashedTokens:    fraction.MulInt(val.GetTokens()).TruncateInt().String(),
+	}
+}
+
+// DelegationV2 is the delegation of payload version 2, with the tokens behind its shares.
+type DelegationV2 struct {
+	ValidatorAddress string `json:"validator_address"`
+	DelegatorAddress string `json:"delegator_address"`
+	Shares           string `json:"shares"`
+	Tokens           string `json:"tokens"`
+}
+
+func NewDelegationV2(del stakingtypes.DelegationI, val stakingtypes.ValidatorI) *DelegationV2 {
+	return &DelegationV2{
+		ValidatorAddress: del.GetValidatorAddr(),
+		DelegatorAddress: del.GetDelegatorAddr(),
+		Shares:           del.GetShares().String(),
+		Tokens:           val.TokensFromShares(del.GetShares()).TruncateInt().String(),
+	}
+}
+
 // Validators
 type SudoMsgAfterValidatorCreated struct {
 	AfterValidatorCreated *Validator `json:"after_validator_created"`
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"encoding/json"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -42,14 +41,15 @@ func (h StakingHooks) AfterValidatorCreated(ctx context.Context, valAddr sdk.Val
 		return err
 	}
 
-	msgBz, err := json.Marshal(SudoMsgAfterValidatorCreated{
+	payload, err := NewPayload(types.EventAfterValidatorCreated, SudoMsgAfterValidatorCreated{
 		AfterValidatorCreated: NewValidator(val),
-	})
+	}, NewValidatorV2(val))
 	if err != nil {
 		return nil
 	}
 
-	return h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, msgBz)
+	h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, types.NewValidatorEvent(types.EventAfterValidatorCreated, valAddr), payload)
+	return nil
 }
 
 // AfterValidatorRemoved performs clean up after a validator is removed
@@ -68,14 +68,15 @@ func (h StakingHooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddre
 		return err
 	}
 
-	msgBz, err := json.Marshal(SudoMsgAfterValidatorRemoved{
+	payload, err := NewPayload(types.EventAfterValidatorRemoved, SudoMsgAfterValidatorRemoved{
 		AfterValidatorRemoved: NewValidator(val),
-	})
+	}, NewValidatorV2(val))
 	if err != nil {
 		return nil
 	}
 
-	return h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, msgBz)
+	h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, types.NewValidatorEvent(types.EventAfterValidatorRemoved, valAddr), payload)
+	return nil
 }
 
 // increment period
@@ -85,6 +86,13 @@ func (h StakingHooks) BeforeDelegationCreated(ctx context.Context, delAddr sdk.A
 		return nil
 	}
 
+	// Skip the store reads and payloads of the delegation without subscribers
+	event := types.NewValidatorEvent(types.EventBeforeDelegationCreated, valAddr)
+	subscribers := h.k.getSubscribers(ctx, types.KeyPrefixStaking, event)
+	if subscribers.empty() {
+		return nil
+	}
+
 	del, err := h.k.GetStakingKeeper().Delegation(ctx, delAddr, valAddr)
 	h.k.Logger(ctx).Debug("BeforeDelegationCreated: ", del)
 	if del == nil {
@@ -94,14 +102,15 @@ func (h StakingHooks) BeforeDelegationCreated(ctx context.Context, delAddr sdk.A
 		return err
 	}
 
-	msgBz, err := json.Marshal(SudoMsgBeforeDelegationCreated{
+	payload, err := h.newDelegationPayload(ctx, event.Type, SudoMsgBeforeDelegationCreated{
 		BeforeDelegationCreated: NewDelegation(del),
-	})
+	}, del, valAddr, subscribers)
 	if err != nil {
-		return nil
+		return err
 	}
 
-	return h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, msgBz)
+	h.k.executeOnSubscribers(ctx, types.KeyPrefixStaking, subscribers, event, payload)
+	return nil
 }
 
 // withdraw delegation rewards (which also increments period)
@@ -111,6 +120,13 @@ func (h StakingHooks) BeforeDelegationSharesModified(ctx context.Context, delAdd
 		return nil
 	}
 
+	// Skip the store reads and payloads of the delegation without subscribers
+	event := types.NewValidatorEvent(types.EventBeforeDelegationSharesModified, valAddr)
+	subscribers := h.k.getSubscribers(ctx, types.KeyPrefixStaking, event)
+	if subscribers.empty() {
+		return nil
+	}
+
 	del, err := h.k.GetStakingKeeper().Delegation(ctx, delAddr, valAddr)
 	h.k.Logger(ctx).Debug("BeforeDelegationSharesModified: ", del)
 	if del == nil {
@@ -120,14 +136,15 @@ func (h StakingHooks) BeforeDelegationSharesModified(ctx context.Context, delAdd
 		return err
 	}
 
-	msgBz, err := json.Marshal(SudoMsgBeforeDelegationSharesModified{
+	payload, err := h.newDelegationPayload(ctx, event.Type, SudoMsgBeforeDelegationSharesModified{
 		BeforeDelegationSharesModified: NewDelegation(del),
-	})
+	}, del, valAddr, subscribers)
 	if err != nil {
-		return nil
+		return err
 	}
 
-	return h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, msgBz)
+	h.k.executeOnSubscribers(ctx, types.KeyPrefixStaking, subscribers, event, payload)
+	return nil
 }
 
 // create new delegation period record
@@ -137,6 +154,13 @@ func (h StakingHooks) AfterDelegationModified(ctx context.Context, delAddr sdk.A
 		return nil
 	}
 
+	// Skip the store reads and payloads of the delegation without subscribers
+	event := types.NewValidatorEvent(types.EventAfterDelegationModified, valAddr)
+	subscribers := h.k.getSubscribers(ctx, types.KeyPrefixStaking, event)
+	if subscribers.empty() {
+		return nil
+	}
+
 	del, err := h.k.GetStakingKeeper().Delegation(ctx, delAddr, valAddr)
 	h.k.Logger(ctx).Debug("BeforeDelegationSharesModified: ", del)
 	if del == nil {
@@ -146,14 +170,15 @@ func (h StakingHooks) AfterDelegationModified(ctx context.Context, delAddr sdk.A
 		return err
 	}
 
-	msgBz, err := json.Marshal(SudoMsgAfterDelegationModified{
+	payload, err := h.newDelegationPayload(ctx, event.Type, SudoMsgAfterDelegationModified{
 		AfterDelegationModified: NewDelegation(del),
-	})
+	}, del, valAddr, subscribers)
 	if err != nil {
-		return nil
+		return err
 	}
 
-	return h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, msgBz)
+	h.k.executeOnSubscribers(ctx, types.KeyPrefixStaking, subscribers, event, payload)
+	return nil
 }
 
 // record the slash event
@@ -172,14 +197,15 @@ func (h StakingHooks) BeforeValidatorSlashed(ctx context.Context, valAddr sdk.Va
 		return err
 	}
 
-	msgBz, err := json.Marshal(SudoMsgBeforeValidatorSlashed{
+	payload, err := NewPayload(types.EventBeforeValidatorSlashed, SudoMsgBeforeValidatorSlashed{
 		BeforeValidatorSlashed: NewValidatorSlashed(val, fraction),
-	})
+	}, NewValidatorSlashedV2(val, fraction))
 	if err != nil {
 		return nil
 	}
 
-	return h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, msgBz)
+	h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, types.NewValidatorEvent(types.EventBeforeValidatorSlashed, valAddr), payload)
+	return nil
 }
 
 func (h StakingHooks) BeforeValidatorModified(ctx context.Context, valAddr sdk.ValAddress) error {
@@ -197,14 +223,15 @@ func (h StakingHooks) BeforeValidatorModified(ctx context.Context, valAddr sdk.V
 		return err
 	}
 
-	msgBz, err := json.Marshal(SudoMsgBeforeValidatorModified{
+	payload, err := NewPayload(types.EventBeforeValidatorModified, SudoMsgBeforeValidatorModified{
 		BeforeValidatorModified: NewValidator(val),
-	})
+	}, NewValidatorV2(val))
 	if err != nil {
 		return nil
 	}
 
-	return h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, msgBz)
+	h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, types.NewValidatorEvent(types.EventBeforeValidatorModified, valAddr), payload)
+	return nil
 }
 
 func (h StakingHooks) AfterValidatorBonded(ctx context.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
@@ -222,14 +249,15 @@ func (h StakingHooks) AfterValidatorBonded(ctx context.Context, _ sdk.ConsAddres
 		return err
 	}
 
-	msgBz, err := json.Marshal(SudoMsgAfterValidatorBonded{
+	payload, err := NewPayload(types.EventAfterValidatorBonded, SudoMsgAfterValidatorBonded{
 		AfterValidatorBonded: NewValidator(val),
-	})
+	}, NewValidatorV2(val))
 	if err != nil {
 		return nil
 	}
 
-	return h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, msgBz)
+	h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, types.NewValidatorEvent(types.EventAfterValidatorBonded, valAddr), payload)
+	return nil
 }
 
 func (h StakingHooks) AfterValidatorBeginUnbonding(ctx context.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
@@ -247,14 +275,15 @@ func (h StakingHooks) AfterValidatorBeginUnbonding(ctx context.Context, _ sdk.Co
 		return err
 	}
 
-	msgBz, err := json.Marshal(SudoMsgAfterValidatorBeginUnbonding{
+	payload, err := NewPayload(types.EventAfterValidatorBeginUnbonding, SudoMsgAfterValidatorBeginUnbonding{
 		AfterValidatorBeginUnbonding: NewValidator(val),
-	})
+	}, NewValidatorV2(val))
 	if err != nil {
 		return nil
 	}
 
-	return h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, msgBz)
+	h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, types.NewValidatorEvent(types.EventAfterValidatorBeginUnbonding, valAddr), payload)
+	return nil
 }
 
 func (h StakingHooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
@@ -263,6 +292,13 @@ func (h StakingHooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.A
 		return nil
 	}
 
+	// Skip the store reads and payloads of the delegation without subscribers
+	event := types.NewValidatorEvent(types.EventBeforeDelegationRemoved, valAddr)
+	subscribers := h.k.getSubscribers(ctx, types.KeyPrefixStaking, event)
+	if subscribers.empty() {
+		return nil
+	}
+
 	del, err := h.k.GetStakingKeeper().Delegation(ctx, delAddr, valAddr)
 	h.k.Logger(ctx).Debug("BeforeDelegationRemoved: ", del)
 	if del == nil {
@@ -272,16 +308,32 @@ func (h StakingHooks) BeforeDelegationRemoved(ctx context.Context, delAddr sdk.A
 		return err
 	}
 
-	msgBz, err := json.Marshal(SudoMsgBeforeDelegationRemoved{
+	payload, err := h.newDelegationPayload(ctx, event.Type, SudoMsgBeforeDelegationRemoved{
 		BeforeDelegationRemoved: NewDelegation(del),
-	})
+	}, del, valAddr, subscribers)
 	if err != nil {
-		return nil
+		return err
 	}
 
-	return h.k.ExecuteMessageOnContracts(ctx, types.KeyPrefixStaking, msgBz)
+	h.k.executeOnSubscribers(ctx, types.KeyPrefixStaking, subscribers, event, payload)
+	return nil
 }
 
 func (StakingHooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
 	return nil
 }
+
+// newDelegationPayload builds the payload of a delegation event. The validator of the
+// version 2 payload is only read when a subscribed contract receives version 2.
+func (h StakingHooks) newDelegationPayload(ctx context.Context, eventType string, v1 any, del stakingtypes.DelegationI, valAddr sdk.ValAddress, subscribers hookSubscribers) (Payload, error) {
+	if !subscribers.receivesVersion(types.PayloadVersion2) {
+		return NewPayloadV1(v1)
+	}
+
+	val, err := h.k.GetStakingKeeper().Validator(ctx, valAddr)
+	if err != nil {
+		return Payload{}, err
+	}
+
+	return NewPayload(eventType, v1, NewDelegationV2(del, val))
+}
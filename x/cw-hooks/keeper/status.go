@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
+)
+
+// GetContractStatus returns the status of a contract. Contracts without a stored
+// status have never failed.
+func (k Keeper) GetContractStatus(ctx context.Context, contractAddr sdk.AccAddress) types.ContractStatus {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	loadedPrefix := prefix.NewStore(store, types.KeyPrefixContractStatus)
+
+	bz := loadedPrefix.Get(contractAddr.Bytes())
+	if bz == nil {
+		return types.NewContractStatus(contractAddr.String())
+	}
+
+	var status types.ContractStatus
+	k.cdc.MustUnmarshal(bz, &status)
+	return status
+}
+
+// SetContractStatus stores the status of a contract. Statuses without failures or
+// dropped hooks are removed from the store.
+func (k Keeper) SetContractStatus(ctx context.Context, contractAddr sdk.AccAddress, status types.ContractStatus) {
+	if !status.IsJailed && status.FailureCount == 0 && status.DroppedHooks == 0 {
+		k.DeleteContractStatus(ctx, contractAddr)
+		return
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	loadedPrefix := prefix.NewStore(store, types.KeyPrefixContractStatus)
+	loadedPrefix.Set(contractAddr.Bytes(), k.cdc.MustMarshal(&status))
+}
+
+// DeleteContractStatus removes the status of a contract.
+func (k Keeper) DeleteContractStatus(ctx context.Context, contractAddr sdk.AccAddress) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	loadedPrefix := prefix.NewStore(store, types.KeyPrefixContractStatus)
+	loadedPrefix.Delete(contractAddr.Bytes())
+}
+
+// GetAllContractStatuses returns the stored status of all contracts.
+func (k Keeper) GetAllContractStatuses(ctx context.Context) []types.ContractStatus {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixContractStatus)
+	defer iterator.Close() //nolint:errcheck
+
+	list := []types.ContractStatus{}
+	for ; iterator.Valid(); iterator.Next() {
+		var status types.ContractStatus
+		k.cdc.MustUnmarshal(iterator.Value(), &status)
+		list = append(list, status)
+	}
+	return list
+}
+
+// isContractJailed returns true if the contract is jailed. A jailed contract is
+// unjailed once the jail duration has passed.
+func (k Keeper) isContractJailed(ctx context.Context, contractAddr sdk.AccAddress, p types.Params) bool {
+	status := k.GetContractStatus(ctx, contractAddr)
+	if !status.IsJailed {
+		return false
+	}
+
+	if status.IsJailExpired(sdk.UnwrapSDKContext(ctx).BlockHeight(), p.JailDuration) {
+		status.Unjail()
+		k.SetContractStatus(ctx, contractAddr, status)
+		return false
+	}
+
+	return true
+}
+
+// recordContractResult updates the status of a contract after it was executed.
+func (k Keeper) recordContractResult(ctx context.Context, contractAddr sdk.AccAddress, p types.Params, execErr error) {
+	status := k.GetContractStatus(ctx, contractAddr)
+
+	if execErr == nil {
+		// Only write when there are strikes to clear
+		if status.FailureCount > 0 {
+			status.FailureCount = 0
+			k.SetContractStatus(ctx, contractAddr, status)
+		}
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if status.RecordFailure(execErr, sdkCtx.BlockHeight(), p.MaxStrikes) {
+		k.Logger(ctx).Info("jailed hook contract", "contract", contractAddr.String(), "error", status.LastError)
+	}
+	k.SetContractStatus(ctx, contractAddr, status)
+}
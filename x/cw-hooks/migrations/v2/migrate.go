@@ -0,0 +1,41 @@
+package v2
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/types"
+)
+
+// ParamsKey is the key of the params at consensus version 1.
+var ParamsKey = []byte{0x00}
+
+// Migrate migrates the x/cw-hooks module state from the consensus version 1 to
+// version 2. Specifically, it sets the max strikes, jail duration and end block gas
+// limit params, which did not exist at version 1, to their default values.
+func Migrate(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	if bz := store.Get(ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	defaults := types.DefaultParams()
+	params.MaxStrikes = defaults.MaxStrikes
+	params.JailDuration = defaults.JailDuration
+	params.EndBlockGasLimit = defaults.EndBlockGasLimit
+
+	// The end block gas limit must fit at least one queued hook
+	if params.EndBlockGasLimit < params.ContractGasLimit+types.QueuedHookGas {
+		params.EndBlockGasLimit = params.ContractGasLimit + types.QueuedHookGas
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
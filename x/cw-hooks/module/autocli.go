@@ -27,6 +27,66 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "governance-contracts",
 					Short:     "Show all governance contracts",
 				},
+				{
+					RpcMethod: "DistributionContracts",
+					Use:       "distribution-contracts",
+					Short:     "Show all distribution contracts",
+				},
+				{
+					RpcMethod: "SlashingContracts",
+					Use:       "slashing-contracts",
+					Short:     "Show all slashing contracts",
+				},
+				{
+					RpcMethod: "BankHooks",
+					Use:       "bank-hooks",
+					Short:     "Show the contracts attached to token factory denoms",
+				},
+				{
+					RpcMethod: "BankHook",
+					Use:       "bank-hook [denom]",
+					Short:     "Show the contract attached to a token factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "ContractStatus",
+					Use:       "contract-status [contract_address]",
+					Short:     "Show the failures and jail status of a contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+					},
+				},
+				{
+					RpcMethod: "ContractRegistrations",
+					Use:       "contract-registrations [contract_address]",
+					Short:     "Show every hook category a contract is registered for, and who registered it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+					},
+				},
+				{
+					RpcMethod: "Registrations",
+					Use:       "registrations [category]",
+					Short:     "Show who registered the contracts of a hook category and at what height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "category"},
+					},
+				},
+				{
+					RpcMethod: "QueuedHooks",
+					Use:       "queued-hooks",
+					Short:     "Show the hooks waiting to be delivered to deferred contracts",
+				},
+				{
+					RpcMethod: "Subscriptions",
+					Use:       "subscriptions [contract_address]",
+					Short:     "Show the events a contract is subscribed to in each hook category",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -37,6 +97,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "RegisterStaking",
 					Use:       "register-staking [contract_address] [register_address]",
 					Short:     "Register a staking contract for sudo message updates",
+					Long:      "Register a staking contract for sudo message updates. Use --event-types to only receive some events, e.g. after_delegation_modified, and --validator-addresses to only receive events of some validators. Use --deferred to receive the events at the end of the block, and --payload-version 2 to receive the version 2 payloads.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "contract_address"},
 						{ProtoField: "register_address"},
@@ -46,6 +107,7 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "RegisterGovernance",
 					Use:       "register-governance [contract_address] [register_address]",
 					Short:     "Register a governance contract for sudo message updates",
+					Long:      "Register a governance contract for sudo message updates. Use --event-types to only receive some events, e.g. after_proposal_vote, and --proposal-ids to only receive events of some proposals. Use --deferred to receive the events at the end of the block, and --payload-version 2 to receive the version 2 payloads.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "contract_address"},
 						{ProtoField: "register_address"},
@@ -69,6 +131,73 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "register_address"},
 					},
 				},
+				{
+					RpcMethod: "RegisterDistribution",
+					Use:       "register-distribution [contract_address] [register_address]",
+					Short:     "Register a distribution contract for sudo message updates",
+					Long:      "Register a distribution contract for sudo message updates. Use --event-types to only receive some events, e.g. after_delegator_rewards_withdrawn, and --validator-addresses to only receive events of some validators. Use --deferred to receive the events at the end of the block, and --payload-version 2 to receive the version 2 payloads.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+						{ProtoField: "register_address"},
+					},
+				},
+				{
+					RpcMethod: "UnregisterDistribution",
+					Use:       "unregister-distribution [contract_address] [register_address]",
+					Short:     "Remove a distribution contract from receiving sudo message updates",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+						{ProtoField: "register_address"},
+					},
+				},
+				{
+					RpcMethod: "RegisterSlashing",
+					Use:       "register-slashing [contract_address] [register_address]",
+					Short:     "Register a slashing contract for sudo message updates",
+					Long:      "Register a slashing contract for sudo message updates. Use --event-types to only receive some events, e.g. after_validator_jailed, and --validator-addresses to only receive events of some validators. Use --deferred to receive the events at the end of the block, and --payload-version 2 to receive the version 2 payloads.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+						{ProtoField: "register_address"},
+					},
+				},
+				{
+					RpcMethod: "UnregisterSlashing",
+					Use:       "unregister-slashing [contract_address] [register_address]",
+					Short:     "Remove a slashing contract from receiving sudo message updates",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+						{ProtoField: "register_address"},
+					},
+				},
+				{
+					RpcMethod: "RegisterBank",
+					Use:       "register-bank [contract_address] [register_address] [denom]",
+					Short:     "Attach a contract to a token factory denom to receive its sends",
+					Long:      "Attach a contract to a token factory denom to receive its sends. Only the denom admin can attach a contract. Use --before-send to let the contract reject sends, and --after-send to notify the contract of sends at the end of the block.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+						{ProtoField: "register_address"},
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "UnregisterBank",
+					Use:       "unregister-bank [register_address] [denom]",
+					Short:     "Remove the contract attached to a token factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "register_address"},
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod: "UnjailContract",
+					Use:       "unjail [contract_address] [sender_address]",
+					Short:     "Unjail a contract which was jailed for failing hook executions",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+						{ProtoField: "sender_address"},
+					},
+				},
 				{
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
@@ -0,0 +1,67 @@
+package module
+
+import (
+	"google.golang.org/grpc"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/CosmosContracts/juno/v29/x/cw-hooks/keeper"
+)
+
+var _ module.HasServices = DistributionAppModule{}
+
+// DistributionAppModule wraps the x/distribution module so its msg server calls the
+// x/cw-hooks distribution hooks after reward and commission withdrawals.
+type DistributionAppModule struct {
+	distribution.AppModule
+
+	keeper keeper.Keeper
+}
+
+// NewDistributionAppModule wraps an x/distribution AppModule.
+func NewDistributionAppModule(am distribution.AppModule, k keeper.Keeper) DistributionAppModule {
+	return DistributionAppModule{
+		AppModule: am,
+		keeper:    k,
+	}
+}
+
+// RegisterServices registers the x/distribution services with the msg server wrapped
+// by keeper.NewDistributionMsgServer.
+func (am DistributionAppModule) RegisterServices(cfg module.Configurator) {
+	am.AppModule.RegisterServices(distributionConfigurator{
+		Configurator: cfg,
+		keeper:       am.keeper,
+	})
+}
+
+type distributionConfigurator struct {
+	module.Configurator
+
+	keeper keeper.Keeper
+}
+
+func (c distributionConfigurator) MsgServer() gogogrpc.Server {
+	return distributionMsgServiceRegistrar{
+		Server: c.Configurator.MsgServer(),
+		keeper: c.keeper,
+	}
+}
+
+type distributionMsgServiceRegistrar struct {
+	gogogrpc.Server
+
+	keeper keeper.Keeper
+}
+
+func (r distributionMsgServiceRegistrar) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	if ms, ok := ss.(distrtypes.MsgServer); ok {
+		ss = keeper.NewDistributionMsgServer(ms, r.keeper)
+	}
+
+	r.Server.RegisterService(sd, ss)
+}
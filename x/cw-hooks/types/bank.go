@@ -0,0 +1,36 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tokenfactorytypes "github.com/CosmosContracts/juno/v29/x/tokenfactory/types"
+)
+
+// NewBankHook creates the bank hook of a token factory denom.
+func NewBankHook(denom, contractAddress string, beforeSend, afterSend bool) BankHook {
+	return BankHook{
+		Denom:           denom,
+		ContractAddress: contractAddress,
+		BeforeSend:      beforeSend,
+		AfterSend:       afterSend,
+	}
+}
+
+// Validate validates the denom, contract and events of the bank hook.
+func (h BankHook) Validate() error {
+	if _, _, err := tokenfactorytypes.DeconstructDenom(h.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidBankHook, "invalid token factory denom %s: %s", h.Denom, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(h.ContractAddress); err != nil {
+		return err
+	}
+
+	if !h.BeforeSend && !h.AfterSend {
+		return errorsmod.Wrap(ErrInvalidBankHook, "bank hook must receive before_send or after_send")
+	}
+
+	return nil
+}
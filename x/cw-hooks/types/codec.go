@@ -16,6 +16,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterGovernance{}, "juno/x/cwhooks/MsgRegisterGovernance")
 	legacy.RegisterAminoMsg(cdc, &MsgUnregisterGovernance{}, "juno/x/cwhooks/MsgUnregisterGovernance")
 	legacy.RegisterAminoMsg(cdc, &MsgUnregisterStaking{}, "juno/x/cwhooks/MsgUnregisterStaking")
+	legacy.RegisterAminoMsg(cdc, &MsgUnjailContract{}, "juno/x/cwhooks/MsgUnjailContract")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterDistribution{}, "juno/x/cwhooks/MsgRegisterDistribution")
+	legacy.RegisterAminoMsg(cdc, &MsgUnregisterDistribution{}, "juno/x/cwhooks/MsgUnregisterDistr")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterSlashing{}, "juno/x/cwhooks/MsgRegisterSlashing")
+	legacy.RegisterAminoMsg(cdc, &MsgUnregisterSlashing{}, "juno/x/cwhooks/MsgUnregisterSlashing")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterBank{}, "juno/x/cwhooks/MsgRegisterBank")
+	legacy.RegisterAminoMsg(cdc, &MsgUnregisterBank{}, "juno/x/cwhooks/MsgUnregisterBank")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -26,6 +33,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgRegisterStaking{},
 		&MsgUnregisterGovernance{},
 		&MsgUnregisterStaking{},
+		&MsgUnjailContract{},
+		&MsgRegisterDistribution{},
+		&MsgUnregisterDistribution{},
+		&MsgRegisterSlashing{},
+		&MsgUnregisterSlashing{},
+		&MsgRegisterBank{},
+		&MsgUnregisterBank{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,58 @@
+package types
+
+// MaxErrorLength is the maximum length of an execution error stored on a contract status.
+const MaxErrorLength = 256
+
+// NewContractStatus creates the status of a contract without any failures.
+func NewContractStatus(contractAddress string) ContractStatus {
+	return ContractStatus{
+		ContractAddress: contractAddress,
+	}
+}
+
+// Jail jails the contract at the given height.
+func (s *ContractStatus) Jail(height int64) {
+	s.IsJailed = true
+	s.JailHeight = height
+}
+
+// Unjail unjails the contract and resets its strikes.
+func (s *ContractStatus) Unjail() {
+	s.IsJailed = false
+	s.JailHeight = 0
+	s.FailureCount = 0
+}
+
+// RecordDroppedHook records a deferred hook dropped at the given height because the
+// queue quota of the contract was full.
+func (s *ContractStatus) RecordDroppedHook(height int64) {
+	s.DroppedHooks++
+	s.LastDroppedHeight = height
+}
+
+// IsJailExpired returns true if the contract has been jailed for at least the
+// given number of blocks. A zero jail duration never expires.
+func (s ContractStatus) IsJailExpired(height int64, jailDuration uint64) bool {
+	if !s.IsJailed || jailDuration == 0 {
+		return false
+	}
+
+	return height >= s.JailHeight+int64(jailDuration)
+}
+
+// RecordFailure records a failed execution. The contract is jailed once it has
+// failed more than maxStrikes times in a row. Returns true if the contract was jailed.
+func (s *ContractStatus) RecordFailure(err error, height int64, maxStrikes uint64) bool {
+	s.LastError = err.Error()
+	if len(s.LastError) > MaxErrorLength {
+		s.LastError = s.LastError[:MaxErrorLength]
+	}
+
+	s.FailureCount++
+	if s.FailureCount > maxStrikes {
+		s.Jail(height)
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrContractJailed      = errorsmod.Register(ModuleName, 1, "contract is jailed")
+	ErrContractNotJailed   = errorsmod.Register(ModuleName, 2, "contract is not jailed")
+	ErrInvalidSubscription = errorsmod.Register(ModuleName, 3, "invalid subscription")
+	ErrInvalidBankHook     = errorsmod.Register(ModuleName, 4, "invalid bank hook")
+	ErrSendRejected        = errorsmod.Register(ModuleName, 5, "send rejected by bank hook contract")
+	ErrInvalidRegistration = errorsmod.Register(ModuleName, 6, "invalid registration")
+	ErrQueueFull           = errorsmod.Register(ModuleName, 7, "hook queue quota is full")
+)
@@ -0,0 +1,12 @@
+package types
+
+// Events emitted by the module.
+const (
+	// EventTypeHookDropped is emitted when a deferred hook is dropped because the queue
+	// quota of the contract is full.
+	EventTypeHookDropped = "cw_hooks_hook_dropped"
+
+	AttributeKeyContract  = "contract"
+	AttributeKeyCategory  = "category"
+	AttributeKeyEventType = "event_type"
+)
@@ -2,6 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -33,15 +37,86 @@ func GetGenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMe
 	return &genesisState
 }
 
+// CategoryContracts are the contracts registered for a category in the genesis state.
+type CategoryContracts struct {
+	Category          string
+	KeyPrefix         []byte
+	ContractAddresses []string
+	Subscriptions     []Subscription
+}
+
+// GetCategoryContracts returns the registered contracts and custom subscriptions of every category.
+func (gs GenesisState) GetCategoryContracts() []CategoryContracts {
+	return []CategoryContracts{
+		{CategoryStaking, KeyPrefixStaking, gs.StakingContractAddresses, gs.StakingSubscriptions},
+		{CategoryGovernance, KeyPrefixGov, gs.GovContractAddresses, gs.GovSubscriptions},
+		{CategoryDistribution, KeyPrefixDistribution, gs.DistributionContractAddresses, gs.DistributionSubscriptions},
+		{CategorySlashing, KeyPrefixSlashing, gs.SlashingContractAddresses, gs.SlashingSubscriptions},
+	}
+}
+
 func ValidateGenesis(data GenesisState) error {
-	for _, v := range data.StakingContractAddresses {
-		if _, err := sdk.AccAddressFromBech32(v); err != nil {
+	for _, c := range data.GetCategoryContracts() {
+		for _, v := range c.ContractAddresses {
+			if _, err := sdk.AccAddressFromBech32(v); err != nil {
+				return err
+			}
+		}
+
+		for _, sub := range c.Subscriptions {
+			if !slices.Contains(c.ContractAddresses, sub.ContractAddress) {
+				return errorsmod.Wrapf(ErrInvalidSubscription, "%s contract %s is not registered", c.Category, sub.ContractAddress)
+			}
+			if err := sub.Validate(c.Category); err != nil {
+				return err
+			}
+		}
+	}
+
+	contractQueueLengths := make(map[string]int)
+	denomQueueLengths := make(map[string]int)
+	for _, hook := range data.QueuedHooks {
+		if _, err := sdk.AccAddressFromBech32(hook.ContractAddress); err != nil {
+			return err
+		}
+
+		if hook.Category == CategoryBank {
+			denomQueueLengths[hook.Denom]++
+			if denomQueueLengths[hook.Denom] > MaxQueuedHooksPerDenom {
+				return fmt.Errorf("queued hooks of denom %s exceed the maximum of %d", hook.Denom, MaxQueuedHooksPerDenom)
+			}
+			continue
+		}
+
+		if _, ok := GetCategoryKeyPrefix(hook.Category); !ok {
+			return fmt.Errorf("unknown category %s of queued hook %d", hook.Category, hook.Id)
+		}
+
+		contractQueueLengths[hook.ContractAddress]++
+		if contractQueueLengths[hook.ContractAddress] > MaxQueuedHooksPerContract {
+			return fmt.Errorf("queued hooks of contract %s exceed the maximum of %d", hook.ContractAddress, MaxQueuedHooksPerContract)
+		}
+	}
+
+	denoms := make(map[string]bool, len(data.BankHooks))
+	for _, hook := range data.BankHooks {
+		if err := hook.Validate(); err != nil {
+			return err
+		}
+		if denoms[hook.Denom] {
+			return errorsmod.Wrapf(ErrInvalidBankHook, "duplicate bank hook for denom %s", hook.Denom)
+		}
+		denoms[hook.Denom] = true
+	}
+
+	for _, reg := range data.Registrations {
+		if err := reg.Validate(); err != nil {
 			return err
 		}
 	}
 
-	for _, v := range data.GovContractAddresses {
-		if _, err := sdk.AccAddressFromBech32(v); err != nil {
+	for _, status := range data.ContractStatuses {
+		if _, err := sdk.AccAddressFromBech32(status.ContractAddress); err != nil {
 			return err
 		}
 	}
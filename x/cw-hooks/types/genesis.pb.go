@@ -33,6 +33,30 @@ type GenesisState struct {
 	StakingContractAddresses []string `protobuf:"bytes,2,rep,name=staking_contract_addresses,json=stakingContractAddresses,proto3" json:"staking_contract_addresses,omitempty"`
 	// gov_contract_addresses
 	GovContractAddresses []string `protobuf:"bytes,3,rep,name=gov_contract_addresses,json=govContractAddresses,proto3" json:"gov_contract_addresses,omitempty"`
+	// contract_statuses are the failures and jail statuses of hook contracts
+	ContractStatuses []ContractStatus `protobuf:"bytes,4,rep,name=contract_statuses,json=contractStatuses,proto3" json:"contract_statuses"`
+	// staking_subscriptions are the subscriptions of staking contracts which filter
+	// their events or receive them deferred
+	StakingSubscriptions []Subscription `protobuf:"bytes,5,rep,name=staking_subscriptions,json=stakingSubscriptions,proto3" json:"staking_subscriptions"`
+	// gov_subscriptions are the subscriptions of governance contracts which filter
+	// their events or receive them deferred
+	GovSubscriptions []Subscription `protobuf:"bytes,6,rep,name=gov_subscriptions,json=govSubscriptions,proto3" json:"gov_subscriptions"`
+	// queued_hooks are the hooks waiting to be delivered to deferred contracts
+	QueuedHooks []QueuedHook `protobuf:"bytes,7,rep,name=queued_hooks,json=queuedHooks,proto3" json:"queued_hooks"`
+	// distribution_contract_addresses
+	DistributionContractAddresses []string `protobuf:"bytes,8,rep,name=distribution_contract_addresses,json=distributionContractAddresses,proto3" json:"distribution_contract_addresses,omitempty"`
+	// slashing_contract_addresses
+	SlashingContractAddresses []string `protobuf:"bytes,9,rep,name=slashing_contract_addresses,json=slashingContractAddresses,proto3" json:"slashing_contract_addresses,omitempty"`
+	// distribution_subscriptions are the subscriptions of distribution contracts which
+	// filter their events or receive them deferred
+	DistributionSubscriptions []Subscription `protobuf:"bytes,10,rep,name=distribution_subscriptions,json=distributionSubscriptions,proto3" json:"distribution_subscriptions"`
+	// slashing_subscriptions are the subscriptions of slashing contracts which filter
+	// their events or receive them deferred
+	SlashingSubscriptions []Subscription `protobuf:"bytes,11,rep,name=slashing_subscriptions,json=slashingSubscriptions,proto3" json:"slashing_subscriptions"`
+	// bank_hooks are the contracts attached to token factory denoms
+	BankHooks []BankHook `protobuf:"bytes,12,rep,name=bank_hooks,json=bankHooks,proto3" json:"bank_hooks"`
+	// registrations record who registered each contract and when
+	Registrations []Registration `protobuf:"bytes,13,rep,name=registrations,proto3" json:"registrations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -89,10 +113,89 @@ func (m *GenesisState) GetGovContractAddresses() []string {
 	return nil
 }
 
+func (m *GenesisState) GetContractStatuses() []ContractStatus {
+	if m != nil {
+		return m.ContractStatuses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStakingSubscriptions() []Subscription {
+	if m != nil {
+		return m.StakingSubscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovSubscriptions() []Subscription {
+	if m != nil {
+		return m.GovSubscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedHooks() []QueuedHook {
+	if m != nil {
+		return m.QueuedHooks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionContractAddresses() []string {
+	if m != nil {
+		return m.DistributionContractAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashingContractAddresses() []string {
+	if m != nil {
+		return m.SlashingContractAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionSubscriptions() []Subscription {
+	if m != nil {
+		return m.DistributionSubscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashingSubscriptions() []Subscription {
+	if m != nil {
+		return m.SlashingSubscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBankHooks() []BankHook {
+	if m != nil {
+		return m.BankHooks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRegistrations() []Registration {
+	if m != nil {
+		return m.Registrations
+	}
+	return nil
+}
+
 // Params defines the set of module parameters.
 type Params struct {
 	// contract_gas_limit is the contract call gas limit
 	ContractGasLimit uint64 `protobuf:"varint,1,opt,name=contract_gas_limit,json=contractGasLimit,proto3" json:"contract_gas_limit,omitempty"`
+	// max_strikes is the number of consecutive failed executions a contract is
+	// allowed before it is jailed. Zero jails a contract on its first failure.
+	MaxStrikes uint64 `protobuf:"varint,2,opt,name=max_strikes,json=maxStrikes,proto3" json:"max_strikes,omitempty"`
+	// jail_duration is the number of blocks after which a jailed contract is
+	// automatically unjailed. Zero keeps contracts jailed until they are unjailed.
+	JailDuration uint64 `protobuf:"varint,3,opt,name=jail_duration,json=jailDuration,proto3" json:"jail_duration,omitempty"`
+	// end_block_gas_limit is the gas the module spends per block on delivering
+	// queued hooks to deferred contracts. Zero disables deferred delivery.
+	EndBlockGasLimit uint64 `protobuf:"varint,4,opt,name=end_block_gas_limit,json=endBlockGasLimit,proto3" json:"end_block_gas_limit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -135,6 +238,27 @@ func (m *Params) GetContractGasLimit() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxStrikes() uint64 {
+	if m != nil {
+		return m.MaxStrikes
+	}
+	return 0
+}
+
+func (m *Params) GetJailDuration() uint64 {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetEndBlockGasLimit() uint64 {
+	if m != nil {
+		return m.EndBlockGasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "juno.cwhooks.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "juno.cwhooks.v1.Params")
@@ -143,29 +267,48 @@ func init() {
 func init() { proto.RegisterFile("juno/cwhooks/v1/genesis.proto", fileDescriptor_d384a01656df5cd8) }
 
 var fileDescriptor_d384a01656df5cd8 = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x2a, 0xcd, 0xcb,
-	0xd7, 0x4f, 0x2e, 0xcf, 0xc8, 0xcf, 0xcf, 0x2e, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x07, 0x49, 0xeb, 0x41, 0xa5,
-	0xf5, 0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x8d, 0x94,
-	0x64, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x98, 0xa7, 0x0f, 0xe1, 0x40, 0xa5, 0x44, 0xd2,
-	0xf3, 0xd3, 0xf3, 0x21, 0xe2, 0x20, 0x16, 0x44, 0x54, 0xe9, 0x13, 0x23, 0x17, 0x8f, 0x3b, 0xc4,
-	0x9a, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x2b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62,
-	0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x71, 0x3d, 0x34, 0x6b, 0xf5, 0x02, 0xc0, 0xd2, 0x4e,
-	0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa, 0x43, 0x28, 0x8c,
-	0x4b, 0xaa, 0xb8, 0x24, 0x31, 0x3b, 0x33, 0x2f, 0x3d, 0x3e, 0x39, 0x3f, 0xaf, 0xa4, 0x28, 0x31,
-	0xb9, 0x24, 0x3e, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb5, 0x58, 0x82, 0x49, 0x81, 0x59,
-	0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0xc3, 0x1c, 0x21, 0x72, 0xc1, 0x25, 0x45,
-	0x99, 0x79, 0xe9, 0x41, 0x12, 0x50, 0xbd, 0xce, 0x50, 0xad, 0x8e, 0x30, 0x9d, 0x42, 0x7e, 0x5c,
-	0x62, 0xe9, 0xf9, 0x65, 0xd8, 0xcc, 0x64, 0x26, 0x60, 0xa6, 0x48, 0x7a, 0x7e, 0x19, 0x86, 0x79,
-	0x4a, 0x36, 0x5c, 0x6c, 0x10, 0x4f, 0x08, 0xe9, 0x70, 0x09, 0xc1, 0x4d, 0x4d, 0x4f, 0x2c, 0x8e,
-	0xcf, 0xc9, 0xcc, 0xcd, 0x2c, 0x01, 0xfb, 0x9c, 0x25, 0x48, 0x00, 0x26, 0xe3, 0x9e, 0x58, 0xec,
-	0x03, 0x12, 0xb7, 0x62, 0x79, 0xb1, 0x40, 0x9e, 0xd1, 0xc9, 0xeb, 0xc4, 0x23, 0x39, 0xc6, 0x0b,
-	0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86,
-	0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x0c, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73,
-	0xf5, 0x9d, 0xc1, 0xce, 0x81, 0xd9, 0x5d, 0xac, 0x0f, 0x8e, 0xdb, 0x0a, 0xfd, 0xe4, 0x72, 0x5d,
-	0x48, 0xf4, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x63, 0xc1, 0x18, 0x10, 0x00, 0x00,
-	0xff, 0xff, 0xb6, 0x83, 0xf9, 0x1b, 0xfb, 0x01, 0x00, 0x00,
+	// 648 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe3, 0x27, 0x79, 0x42, 0xb3, 0x49, 0x45, 0xbb, 0xa4, 0xc5, 0x49, 0xd5, 0xa4, 0x2a,
+	0x97, 0x0a, 0xd1, 0x98, 0x96, 0x5b, 0x0f, 0x48, 0x75, 0x2b, 0x15, 0x10, 0x42, 0x25, 0x91, 0x50,
+	0xd5, 0x8b, 0x59, 0xbf, 0xc8, 0x75, 0x1d, 0x7b, 0x53, 0xcf, 0x3a, 0x2d, 0xdf, 0x82, 0x8f, 0xc0,
+	0x91, 0x23, 0x48, 0xdc, 0xb9, 0xf6, 0x58, 0x71, 0xe2, 0x84, 0x50, 0x7b, 0x80, 0x8f, 0x81, 0x76,
+	0xbd, 0x0e, 0x36, 0x6e, 0xd5, 0x5c, 0xa2, 0xec, 0xbc, 0xfc, 0xe6, 0xbf, 0x33, 0xeb, 0x41, 0xcb,
+	0xc7, 0x71, 0x48, 0x35, 0xeb, 0xf4, 0x88, 0x52, 0x1f, 0xb4, 0xf1, 0x86, 0xe6, 0x3a, 0xa1, 0x03,
+	0x1e, 0xf4, 0x46, 0x11, 0x65, 0x14, 0xdf, 0xe5, 0xee, 0x9e, 0x74, 0xf7, 0xc6, 0x1b, 0xed, 0x79,
+	0x12, 0x78, 0x21, 0xd5, 0xc4, 0x6f, 0x12, 0xd3, 0x6e, 0x59, 0x14, 0x02, 0x0a, 0x86, 0x38, 0x69,
+	0xc9, 0x41, 0xba, 0x9a, 0x2e, 0x75, 0x69, 0x62, 0xe7, 0xff, 0xa4, 0xb5, 0x50, 0x33, 0xe5, 0x0b,
+	0xf7, 0xea, 0xd7, 0x19, 0xd4, 0xd8, 0x4b, 0x54, 0x0c, 0x18, 0x61, 0x0e, 0xde, 0x42, 0xd5, 0x11,
+	0x89, 0x48, 0x00, 0xaa, 0xb2, 0xa2, 0xac, 0xd5, 0x37, 0xef, 0xf7, 0xfe, 0x51, 0xd5, 0xdb, 0x17,
+	0x6e, 0xbd, 0x76, 0xfe, 0xa3, 0x5b, 0xfa, 0xf8, 0xeb, 0xd3, 0x43, 0xa5, 0x2f, 0x33, 0xf0, 0x1b,
+	0xd4, 0x06, 0x46, 0x7c, 0x2f, 0x74, 0x0d, 0x8b, 0x86, 0x2c, 0x22, 0x16, 0x33, 0x88, 0x6d, 0x47,
+	0x0e, 0x80, 0x03, 0xea, 0x7f, 0x2b, 0xe5, 0xb5, 0x9a, 0xae, 0x7e, 0xfb, 0xb2, 0xde, 0x94, 0xba,
+	0xb7, 0x13, 0xdf, 0x80, 0x45, 0x5e, 0xe8, 0xf6, 0x55, 0x99, 0xbb, 0x23, 0x53, 0xb7, 0xd3, 0x4c,
+	0xfc, 0x0a, 0x2d, 0xba, 0x74, 0x7c, 0x1d, 0xb3, 0x7c, 0x0b, 0xb3, 0xe9, 0xd2, 0x71, 0x91, 0xd7,
+	0x47, 0xf3, 0x13, 0x16, 0x30, 0xc2, 0x62, 0x8e, 0xaa, 0xac, 0x94, 0xd7, 0xea, 0x9b, 0xdd, 0xc2,
+	0x75, 0xd3, 0xf4, 0x81, 0x08, 0xd4, 0x2b, 0xfc, 0xda, 0xfd, 0x39, 0x2b, 0x67, 0x75, 0x00, 0x1f,
+	0xa0, 0x85, 0xf4, 0xee, 0x10, 0x9b, 0x60, 0x45, 0xde, 0x88, 0x79, 0x34, 0x04, 0xf5, 0x7f, 0xc1,
+	0x5d, 0x2e, 0x70, 0x07, 0x99, 0x28, 0x49, 0x6d, 0x4a, 0x42, 0xd6, 0x05, 0x78, 0x1f, 0xcd, 0xf3,
+	0xdb, 0xe7, 0xa9, 0xd5, 0xe9, 0xa9, 0x73, 0x2e, 0x1d, 0xe7, 0x89, 0xbb, 0xa8, 0x71, 0x12, 0x3b,
+	0xb1, 0x63, 0x1b, 0x22, 0x4f, 0xbd, 0x23, 0x60, 0x4b, 0x05, 0xd8, 0x6b, 0x11, 0xf4, 0x8c, 0x52,
+	0x5f, 0xa2, 0xea, 0x27, 0x13, 0x0b, 0xe0, 0xb7, 0xa8, 0x6b, 0x7b, 0xc0, 0x22, 0xcf, 0x8c, 0x39,
+	0xf6, 0xba, 0xf1, 0xcc, 0xdc, 0x32, 0x9e, 0xe5, 0x2c, 0xa0, 0x38, 0xa7, 0x03, 0xb4, 0x04, 0x43,
+	0x02, 0x47, 0x37, 0x3c, 0xa8, 0xda, 0x2d, 0xf4, 0x56, 0x9a, 0x5c, 0x24, 0x9b, 0xa8, 0x9d, 0xd3,
+	0x9e, 0x6f, 0x2e, 0x9a, 0xbe, 0xb9, 0xad, 0x2c, 0x26, 0xdf, 0xe5, 0x43, 0xb4, 0x38, 0x51, 0x9f,
+	0xe7, 0xd7, 0xa7, 0xe7, 0x2f, 0xa4, 0x88, 0x3c, 0xfb, 0x29, 0x42, 0x26, 0x09, 0x7d, 0x39, 0xbf,
+	0x86, 0xe0, 0xb5, 0x0a, 0x3c, 0x9d, 0x84, 0x7e, 0x66, 0x7a, 0x35, 0x53, 0x9e, 0x01, 0x3f, 0x47,
+	0xb3, 0x91, 0xe3, 0x72, 0xe9, 0x24, 0x91, 0x34, 0x7b, 0x83, 0xa4, 0x7e, 0x26, 0x4a, 0x62, 0xf2,
+	0x99, 0xab, 0x9f, 0x15, 0x54, 0x4d, 0x56, 0x02, 0x7e, 0x84, 0xf0, 0x64, 0x4c, 0x2e, 0x01, 0x63,
+	0xe8, 0x05, 0x1e, 0x13, 0x7b, 0xa4, 0xf2, 0xf7, 0x8b, 0xd9, 0x23, 0xf0, 0x92, 0xdb, 0x71, 0x17,
+	0xd5, 0x03, 0x72, 0x66, 0xf0, 0xf6, 0xf9, 0x62, 0x3d, 0xf0, 0x30, 0x14, 0x90, 0xb3, 0x41, 0x62,
+	0xc1, 0x0f, 0xd0, 0xec, 0x31, 0xf1, 0x86, 0x86, 0x1d, 0x27, 0xb5, 0xd4, 0xb2, 0x08, 0x69, 0x70,
+	0xe3, 0xae, 0xb4, 0xe1, 0x75, 0x74, 0xcf, 0x09, 0x6d, 0xc3, 0x1c, 0x52, 0xcb, 0xcf, 0x14, 0xad,
+	0x24, 0x45, 0x9d, 0xd0, 0xd6, 0xb9, 0x27, 0x2d, 0xba, 0x55, 0xf9, 0xfd, 0xa1, 0xab, 0xe8, 0x2f,
+	0xce, 0x2f, 0x3b, 0xca, 0xc5, 0x65, 0x47, 0xf9, 0x79, 0xd9, 0x51, 0xde, 0x5f, 0x75, 0x4a, 0x17,
+	0x57, 0x9d, 0xd2, 0xf7, 0xab, 0x4e, 0xe9, 0xf0, 0xb1, 0xeb, 0xb1, 0xa3, 0xd8, 0xec, 0x59, 0x34,
+	0xd0, 0x76, 0xc4, 0xa3, 0x4a, 0x1f, 0x0f, 0x68, 0x62, 0x93, 0x9e, 0x69, 0xd6, 0xe9, 0x7a, 0xb2,
+	0x4c, 0xd9, 0xbb, 0x91, 0x03, 0x66, 0x55, 0x2c, 0xd2, 0x27, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
+	0x1f, 0x9c, 0x3b, 0x19, 0xdd, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -190,6 +333,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ContractGasLimit != that1.ContractGasLimit {
 		return false
 	}
+	if this.MaxStrikes != that1.MaxStrikes {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	if this.EndBlockGasLimit != that1.EndBlockGasLimit {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -212,6 +364,136 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Registrations) > 0 {
+		for iNdEx := len(m.Registrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Registrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.BankHooks) > 0 {
+		for iNdEx := len(m.BankHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BankHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.SlashingSubscriptions) > 0 {
+		for iNdEx := len(m.SlashingSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashingSubscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DistributionSubscriptions) > 0 {
+		for iNdEx := len(m.DistributionSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionSubscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SlashingContractAddresses) > 0 {
+		for iNdEx := len(m.SlashingContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingContractAddresses[iNdEx])
+			copy(dAtA[i:], m.SlashingContractAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.SlashingContractAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DistributionContractAddresses) > 0 {
+		for iNdEx := len(m.DistributionContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DistributionContractAddresses[iNdEx])
+			copy(dAtA[i:], m.DistributionContractAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.DistributionContractAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.QueuedHooks) > 0 {
+		for iNdEx := len(m.QueuedHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.GovSubscriptions) > 0 {
+		for iNdEx := len(m.GovSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovSubscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.StakingSubscriptions) > 0 {
+		for iNdEx := len(m.StakingSubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakingSubscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ContractStatuses) > 0 {
+		for iNdEx := len(m.ContractStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.GovContractAddresses) > 0 {
 		for iNdEx := len(m.GovContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.GovContractAddresses[iNdEx])
@@ -263,6 +545,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EndBlockGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EndBlockGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.JailDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.JailDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxStrikes != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxStrikes))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ContractGasLimit != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ContractGasLimit))
 		i--
@@ -302,6 +599,66 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContractStatuses) > 0 {
+		for _, e := range m.ContractStatuses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StakingSubscriptions) > 0 {
+		for _, e := range m.StakingSubscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovSubscriptions) > 0 {
+		for _, e := range m.GovSubscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedHooks) > 0 {
+		for _, e := range m.QueuedHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionContractAddresses) > 0 {
+		for _, s := range m.DistributionContractAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashingContractAddresses) > 0 {
+		for _, s := range m.SlashingContractAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionSubscriptions) > 0 {
+		for _, e := range m.DistributionSubscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashingSubscriptions) > 0 {
+		for _, e := range m.SlashingSubscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BankHooks) > 0 {
+		for _, e := range m.BankHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Registrations) > 0 {
+		for _, e := range m.Registrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -314,6 +671,15 @@ func (m *Params) Size() (n int) {
 	if m.ContractGasLimit != 0 {
 		n += 1 + sovGenesis(uint64(m.ContractGasLimit))
 	}
+	if m.MaxStrikes != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxStrikes))
+	}
+	if m.JailDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.JailDuration))
+	}
+	if m.EndBlockGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.EndBlockGasLimit))
+	}
 	return n
 }
 
@@ -449,6 +815,342 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.GovContractAddresses = append(m.GovContractAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractStatuses = append(m.ContractStatuses, ContractStatus{})
+			if err := m.ContractStatuses[len(m.ContractStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingSubscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingSubscriptions = append(m.StakingSubscriptions, Subscription{})
+			if err := m.StakingSubscriptions[len(m.StakingSubscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovSubscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovSubscriptions = append(m.GovSubscriptions, Subscription{})
+			if err := m.GovSubscriptions[len(m.GovSubscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedHooks = append(m.QueuedHooks, QueuedHook{})
+			if err := m.QueuedHooks[len(m.QueuedHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionContractAddresses = append(m.DistributionContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingContractAddresses = append(m.SlashingContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionSubscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionSubscriptions = append(m.DistributionSubscriptions, Subscription{})
+			if err := m.DistributionSubscriptions[len(m.DistributionSubscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingSubscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingSubscriptions = append(m.SlashingSubscriptions, Subscription{})
+			if err := m.SlashingSubscriptions[len(m.SlashingSubscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankHooks = append(m.BankHooks, BankHook{})
+			if err := m.BankHooks[len(m.BankHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Registrations = append(m.Registrations, Registration{})
+			if err := m.Registrations[len(m.Registrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -518,6 +1220,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStrikes", wireType)
+			}
+			m.MaxStrikes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStrikes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			m.JailDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlockGasLimit", wireType)
+			}
+			m.EndBlockGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlockGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -11,3 +11,99 @@ var (
 	KeyPrefixStaking = []byte{0x01}
 	KeyPrefixGov     = []byte{0x02}
 )
+
+// KeyPrefixContractStatus stores the failures and jail status of hook contracts.
+var KeyPrefixContractStatus = []byte{0x03}
+
+var (
+	// KeyPrefixQueue stores the hooks waiting to be delivered to deferred contracts.
+	KeyPrefixQueue = []byte{0x04}
+	// KeyQueueSequence stores the id of the next queued hook.
+	KeyQueueSequence = []byte{0x05}
+)
+
+var (
+	KeyPrefixDistribution = []byte{0x06}
+	KeyPrefixSlashing     = []byte{0x07}
+)
+
+// KeyPrefixBank stores the contracts attached to token factory denoms, keyed by denom.
+var KeyPrefixBank = []byte{0x08}
+
+// KeyPrefixRegistration stores who registered each contract, keyed by the key prefix of
+// the category, the length prefixed contract address and the denom of bank registrations.
+var KeyPrefixRegistration = []byte{0x09}
+
+var (
+	// KeyPrefixContractQueueLength stores the number of queued deferred hooks of each
+	// contract, keyed by the contract address.
+	KeyPrefixContractQueueLength = []byte{0x0a}
+	// KeyPrefixDenomQueueLength stores the number of queued after_send hooks of each
+	// token factory denom, keyed by the denom.
+	KeyPrefixDenomQueueLength = []byte{0x0b}
+)
+
+// Limits of the queue of deferred hooks.
+const (
+	// MaxQueuedHooksPerContract is the maximum number of queued deferred hooks of a
+	// contract. Hooks queued while the quota of the contract is full are dropped and
+	// recorded on the status of the contract.
+	MaxQueuedHooksPerContract = 1_000
+	// MaxQueuedHooksPerDenom is the maximum number of queued after_send hooks of a denom.
+	// Sends of the denom fail while the quota of the denom is full.
+	MaxQueuedHooksPerDenom = 1_000
+	// MaxQueuedHooksPerBlock is the maximum number of queued hooks dequeued per block.
+	MaxQueuedHooksPerBlock = 100
+	// QueuedHookGas is the gas charged to the end block gas limit for every dequeued hook,
+	// including hooks dropped without being delivered. Sends queueing after_send hooks
+	// pay it upfront.
+	QueuedHookGas = 10_000
+)
+
+// Categories contracts can register for.
+const (
+	CategoryStaking      = "staking"
+	CategoryGovernance   = "governance"
+	CategoryDistribution = "distribution"
+	CategorySlashing     = "slashing"
+	// CategoryBank contracts are registered per denom instead of per contract, so the
+	// category has no key prefix of registered contracts.
+	CategoryBank = "bank"
+)
+
+// categoryKeyPrefixes maps the categories to the key prefix of their registered contracts.
+var categoryKeyPrefixes = map[string][]byte{
+	CategoryStaking:      KeyPrefixStaking,
+	CategoryGovernance:   KeyPrefixGov,
+	CategoryDistribution: KeyPrefixDistribution,
+	CategorySlashing:     KeyPrefixSlashing,
+}
+
+// GetCategoryKeyPrefix returns the key prefix of the contracts registered for the category.
+func GetCategoryKeyPrefix(category string) ([]byte, bool) {
+	keyPrefix, ok := categoryKeyPrefixes[category]
+	return keyPrefix, ok
+}
+
+// GetRegistrationKeyPrefix returns the key prefix of the registrations of the category.
+func GetRegistrationKeyPrefix(category string) ([]byte, bool) {
+	if category == CategoryBank {
+		return append(KeyPrefixRegistration, KeyPrefixBank...), true
+	}
+
+	keyPrefix, ok := GetCategoryKeyPrefix(category)
+	if !ok {
+		return nil, false
+	}
+	return append(KeyPrefixRegistration, keyPrefix...), true
+}
+
+// GetKeyPrefixCategory returns the category of the contracts registered under the key prefix.
+func GetKeyPrefixCategory(keyPrefix []byte) string {
+	for category, p := range categoryKeyPrefixes {
+		if string(p) == string(keyPrefix) {
+			return category
+		}
+	}
+	return ""
+}
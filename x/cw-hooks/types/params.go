@@ -1,18 +1,28 @@
 package types
 
+import "fmt"
+
 // DefaultParams returns default parameters
 func DefaultParams() Params {
-	return NewParams(250_000)
+	return NewParams(250_000, 3, 0, 10_000_000)
 }
 
 // NewParams creates a new Params object
-func NewParams(contractGasLimit uint64) Params {
+func NewParams(contractGasLimit, maxStrikes, jailDuration, endBlockGasLimit uint64) Params {
 	return Params{
 		ContractGasLimit: contractGasLimit,
+		MaxStrikes:       maxStrikes,
+		JailDuration:     jailDuration,
+		EndBlockGasLimit: endBlockGasLimit,
 	}
 }
 
 // Validate performs basic validation.
-func (Params) Validate() error {
+func (p Params) Validate() error {
+	// The end block gas limit must fit at least one queued hook
+	if p.EndBlockGasLimit != 0 && p.EndBlockGasLimit < p.ContractGasLimit+QueuedHookGas {
+		return fmt.Errorf("end block gas limit %d must be zero or at least the contract gas limit %d plus the queued hook gas %d", p.EndBlockGasLimit, p.ContractGasLimit, QueuedHookGas)
+	}
+
 	return nil
 }
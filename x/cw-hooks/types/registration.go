@@ -0,0 +1,54 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// NewRegistration creates the registration of a contract for a category. The denom is
+// only set for bank registrations.
+func NewRegistration(category, contractAddress, registerAddress string, height int64, denom string) Registration {
+	return Registration{
+		Category:        category,
+		ContractAddress: contractAddress,
+		RegisterAddress: registerAddress,
+		Height:          height,
+		Denom:           denom,
+	}
+}
+
+// Key returns the store key of the registration.
+func (r Registration) Key() ([]byte, error) {
+	keyPrefix, ok := GetRegistrationKeyPrefix(r.Category)
+	if !ok {
+		return nil, errorsmod.Wrapf(ErrInvalidRegistration, "unknown category %s", r.Category)
+	}
+
+	contract, err := sdk.AccAddressFromBech32(r.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append(keyPrefix, address.MustLengthPrefix(contract)...), r.Denom...), nil
+}
+
+// Validate validates the category, addresses and denom of the registration.
+func (r Registration) Validate() error {
+	if _, err := r.Key(); err != nil {
+		return err
+	}
+
+	if r.RegisterAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(r.RegisterAddress); err != nil {
+			return err
+		}
+	}
+
+	if (r.Category == CategoryBank) != (r.Denom != "") {
+		return errorsmod.Wrap(ErrInvalidRegistration, "only bank registrations have a denom")
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Staking event types, matching the sudo message sent to the contract.
+const (
+	EventAfterValidatorCreated          = "after_validator_created"
+	EventAfterValidatorRemoved          = "after_validator_removed"
+	EventBeforeValidatorModified        = "before_validator_modified"
+	EventAfterValidatorBonded           = "after_validator_bonded"
+	EventAfterValidatorBeginUnbonding   = "after_validator_begin_unbonding"
+	EventBeforeValidatorSlashed         = "before_validator_slashed"
+	EventBeforeDelegationCreated        = "before_delegation_created"
+	EventBeforeDelegationSharesModified = "before_delegation_shares_modified"
+	EventAfterDelegationModified        = "after_delegation_modified"
+	EventBeforeDelegationRemoved        = "before_delegation_removed"
+)
+
+// Governance event types, matching the sudo message sent to the contract.
+const (
+	EventAfterProposalSubmission        = "after_proposal_submission"
+	EventAfterProposalDeposit           = "after_proposal_deposit"
+	EventAfterProposalVote              = "after_proposal_vote"
+	EventAfterProposalVotingPeriodEnded = "after_proposal_voting_period_ended"
+)
+
+// Distribution event types, matching the sudo message sent to the contract.
+const (
+	EventAfterDelegatorRewardsWithdrawn    = "after_delegator_rewards_withdrawn"
+	EventAfterValidatorCommissionWithdrawn = "after_validator_commission_withdrawn"
+)
+
+// Slashing event types, matching the sudo message sent to the contract.
+const (
+	EventAfterValidatorJailed     = "after_validator_jailed"
+	EventAfterValidatorUnjailed   = "after_validator_unjailed"
+	EventAfterValidatorTombstoned = "after_validator_tombstoned"
+)
+
+// Bank event types, matching the sudo message sent to the contract.
+const (
+	EventBeforeSend = "before_send"
+	EventAfterSend  = "after_send"
+)
+
+// Payload versions of the sudo messages sent to the contracts. Version 1 is the
+// original payload and is used when a contract does not choose a version.
+const (
+	PayloadVersion1 uint32 = 1
+	PayloadVersion2 uint32 = 2
+
+	LatestPayloadVersion = PayloadVersion2
+)
+
+// StakingEventTypes are the event types a staking contract can subscribe to.
+var StakingEventTypes = []string{
+	EventAfterValidatorCreated,
+	EventAfterValidatorRemoved,
+	EventBeforeValidatorModified,
+	EventAfterValidatorBonded,
+	EventAfterValidatorBeginUnbonding,
+	EventBeforeValidatorSlashed,
+	EventBeforeDelegationCreated,
+	EventBeforeDelegationSharesModified,
+	EventAfterDelegationModified,
+	EventBeforeDelegationRemoved,
+}
+
+// GovEventTypes are the event types a governance contract can subscribe to.
+var GovEventTypes = []string{
+	EventAfterProposalSubmission,
+	EventAfterProposalDeposit,
+	EventAfterProposalVote,
+	EventAfterProposalVotingPeriodEnded,
+}
+
+// DistributionEventTypes are the event types a distribution contract can subscribe to.
+var DistributionEventTypes = []string{
+	EventAfterDelegatorRewardsWithdrawn,
+	EventAfterValidatorCommissionWithdrawn,
+}
+
+// SlashingEventTypes are the event types a slashing contract can subscribe to.
+var SlashingEventTypes = []string{
+	EventAfterValidatorJailed,
+	EventAfterValidatorUnjailed,
+	EventAfterValidatorTombstoned,
+}
+
+// categoryEventTypes maps the categories to the event types contracts can subscribe to.
+var categoryEventTypes = map[string][]string{
+	CategoryStaking:      StakingEventTypes,
+	CategoryGovernance:   GovEventTypes,
+	CategoryDistribution: DistributionEventTypes,
+	CategorySlashing:     SlashingEventTypes,
+}
+
+// HookEvent describes a hook which is about to be sent to the subscribed contracts.
+type HookEvent struct {
+	Type             string
+	ValidatorAddress string
+	ProposalID       uint64
+}
+
+// NewValidatorEvent creates a hook event for the given validator.
+func NewValidatorEvent(eventType string, valAddr sdk.ValAddress) HookEvent {
+	return HookEvent{
+		Type:             eventType,
+		ValidatorAddress: valAddr.String(),
+	}
+}
+
+// NewGovEvent creates a governance hook event for the given proposal.
+func NewGovEvent(eventType string, proposalID uint64) HookEvent {
+	return HookEvent{
+		Type:       eventType,
+		ProposalID: proposalID,
+	}
+}
+
+// NewValidatorSubscription creates the subscription of a contract registered for a
+// category with validator events, e.g. staking.
+func NewValidatorSubscription(contractAddress string, eventTypes, validatorAddresses []string, deferred bool, payloadVersion uint32) Subscription {
+	return Subscription{
+		ContractAddress:    contractAddress,
+		EventTypes:         eventTypes,
+		ValidatorAddresses: validatorAddresses,
+		Deferred:           deferred,
+		PayloadVersion:     payloadVersion,
+	}
+}
+
+// NewGovSubscription creates the subscription of a governance contract.
+func NewGovSubscription(contractAddress string, eventTypes []string, proposalIDs []uint64, deferred bool, payloadVersion uint32) Subscription {
+	return Subscription{
+		ContractAddress: contractAddress,
+		EventTypes:      eventTypes,
+		ProposalIds:     proposalIDs,
+		Deferred:        deferred,
+		PayloadVersion:  payloadVersion,
+	}
+}
+
+// IsDefault returns true if the subscription receives every event in the original
+// payload version during the transaction which triggered it.
+func (s Subscription) IsDefault() bool {
+	return len(s.EventTypes) == 0 && len(s.ValidatorAddresses) == 0 && len(s.ProposalIds) == 0 && !s.Deferred &&
+		s.GetPayloadVersionOrDefault() == PayloadVersion1
+}
+
+// GetPayloadVersionOrDefault returns the payload version the contract receives,
+// defaulting to version 1.
+func (s Subscription) GetPayloadVersionOrDefault() uint32 {
+	if s.PayloadVersion == 0 {
+		return PayloadVersion1
+	}
+	return s.PayloadVersion
+}
+
+// Matches returns true if the contract is subscribed to the event.
+func (s Subscription) Matches(event HookEvent) bool {
+	if len(s.EventTypes) > 0 && !slices.Contains(s.EventTypes, event.Type) {
+		return false
+	}
+
+	if len(s.ValidatorAddresses) > 0 && !slices.Contains(s.ValidatorAddresses, event.ValidatorAddress) {
+		return false
+	}
+
+	if len(s.ProposalIds) > 0 && !slices.Contains(s.ProposalIds, event.ProposalID) {
+		return false
+	}
+
+	return true
+}
+
+// Validate validates the subscription of a contract registered for the category.
+func (s Subscription) Validate(category string) error {
+	eventTypes, ok := categoryEventTypes[category]
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidSubscription, "unknown category %s", category)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(s.ContractAddress); err != nil {
+		return err
+	}
+
+	if s.PayloadVersion > LatestPayloadVersion {
+		return errorsmod.Wrapf(ErrInvalidSubscription, "unknown payload version %d, expected at most %d", s.PayloadVersion, LatestPayloadVersion)
+	}
+
+	for _, t := range s.EventTypes {
+		if !slices.Contains(eventTypes, t) {
+			return errorsmod.Wrapf(ErrInvalidSubscription, "unknown %s event type %s, expected one of %v", category, t, eventTypes)
+		}
+	}
+
+	// Governance events are filtered by proposal, all other events by validator
+	if category == CategoryGovernance {
+		if len(s.ValidatorAddresses) > 0 {
+			return errorsmod.Wrap(ErrInvalidSubscription, "governance subscriptions can not filter by validator address")
+		}
+		return nil
+	}
+
+	if len(s.ProposalIds) > 0 {
+		return errorsmod.Wrapf(ErrInvalidSubscription, "%s subscriptions can not filter by proposal id", category)
+	}
+
+	for _, v := range s.ValidatorAddresses {
+		if _, err := sdk.ValAddressFromBech32(v); err != nil {
+			return errorsmod.Wrapf(ErrInvalidSubscription, "invalid validator address %s: %s", v, err)
+		}
+	}
+
+	return nil
+}
@@ -118,6 +118,15 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 type MsgRegisterStaking struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	RegisterAddress string `protobuf:"bytes,2,opt,name=register_address,json=registerAddress,proto3" json:"register_address,omitempty"`
+	// event_types are the staking events the contract receives. Empty receives all events.
+	EventTypes []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// validator_addresses limits the events to these validators. Empty receives events for all validators.
+	ValidatorAddresses []string `protobuf:"bytes,4,rep,name=validator_addresses,json=validatorAddresses,proto3" json:"validator_addresses,omitempty"`
+	// deferred delivers the events at the end of the block instead of during the transaction.
+	Deferred bool `protobuf:"varint,5,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	// payload_version is the version of the sudo message payloads the contract receives.
+	// 0 and 1 are the original payloads, 2 adds timestamps, token amounts and tallies.
+	PayloadVersion uint32 `protobuf:"varint,6,opt,name=payload_version,json=payloadVersion,proto3" json:"payload_version,omitempty"`
 }
 
 func (m *MsgRegisterStaking) Reset()         { *m = MsgRegisterStaking{} }
@@ -194,6 +203,15 @@ var xxx_messageInfo_MsgRegisterStakingResponse proto.InternalMessageInfo
 type MsgRegisterGovernance struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	RegisterAddress string `protobuf:"bytes,2,opt,name=register_address,json=registerAddress,proto3" json:"register_address,omitempty"`
+	// event_types are the governance events the contract receives. Empty receives all events.
+	EventTypes []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// proposal_ids limits the events to these proposals. Empty receives events for all proposals.
+	ProposalIds []uint64 `protobuf:"varint,4,rep,packed,name=proposal_ids,json=proposalIds,proto3" json:"proposal_ids,omitempty"`
+	// deferred delivers the events at the end of the block instead of during the transaction.
+	Deferred bool `protobuf:"varint,5,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	// payload_version is the version of the sudo message payloads the contract receives.
+	// 0 and 1 are the original payloads, 2 adds timestamps, token amounts and tallies.
+	PayloadVersion uint32 `protobuf:"varint,6,opt,name=payload_version,json=payloadVersion,proto3" json:"payload_version,omitempty"`
 }
 
 func (m *MsgRegisterGovernance) Reset()         { *m = MsgRegisterGovernance{} }
@@ -418,749 +436,3975 @@ func (m *MsgUnregisterStakingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnregisterStakingResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "juno.cwhooks.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "juno.cwhooks.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgRegisterStaking)(nil), "juno.cwhooks.v1.MsgRegisterStaking")
-	proto.RegisterType((*MsgRegisterStakingResponse)(nil), "juno.cwhooks.v1.MsgRegisterStakingResponse")
-	proto.RegisterType((*MsgRegisterGovernance)(nil), "juno.cwhooks.v1.MsgRegisterGovernance")
-	proto.RegisterType((*MsgRegisterGovernanceResponse)(nil), "juno.cwhooks.v1.MsgRegisterGovernanceResponse")
-	proto.RegisterType((*MsgUnregisterGovernance)(nil), "juno.cwhooks.v1.MsgUnregisterGovernance")
-	proto.RegisterType((*MsgUnregisterGovernanceResponse)(nil), "juno.cwhooks.v1.MsgUnregisterGovernanceResponse")
-	proto.RegisterType((*MsgUnregisterStaking)(nil), "juno.cwhooks.v1.MsgUnregisterStaking")
-	proto.RegisterType((*MsgUnregisterStakingResponse)(nil), "juno.cwhooks.v1.MsgUnregisterStakingResponse")
+// MsgUnjailContract
+type MsgUnjailContract struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	SenderAddress   string `protobuf:"bytes,2,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
 }
 
-func init() { proto.RegisterFile("juno/cwhooks/v1/tx.proto", fileDescriptor_2868e302cb80fd0b) }
-
-var fileDescriptor_2868e302cb80fd0b = []byte{
-	// 607 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xbf, 0x6f, 0xd3, 0x4e,
-	0x14, 0xf7, 0xf5, 0xfb, 0xa5, 0x52, 0x0e, 0xa4, 0x50, 0x2b, 0x28, 0xa9, 0xd5, 0x3a, 0xa9, 0x0b,
-	0x55, 0x14, 0x14, 0xbb, 0x2d, 0x12, 0x12, 0xd9, 0x48, 0xc4, 0x0f, 0x21, 0x45, 0x42, 0xa9, 0x58,
-	0xba, 0x54, 0xae, 0x73, 0xba, 0x98, 0x62, 0x5f, 0x74, 0x77, 0x09, 0xed, 0x86, 0x98, 0x10, 0x13,
-	0x33, 0x53, 0x47, 0xc6, 0x0c, 0xfc, 0x01, 0x8c, 0x1d, 0x2b, 0x26, 0x26, 0x84, 0x92, 0x21, 0x30,
-	0xf0, 0x3f, 0x20, 0xdb, 0x67, 0x27, 0x75, 0xdc, 0x38, 0x63, 0x96, 0x28, 0x7e, 0xef, 0xf3, 0x7e,
-	0x7c, 0x3e, 0x7e, 0xef, 0x19, 0x16, 0x5e, 0xf7, 0x5c, 0x62, 0x58, 0x6f, 0x3b, 0x84, 0x9c, 0x30,
-	0xa3, 0xbf, 0x67, 0xf0, 0x53, 0xbd, 0x4b, 0x09, 0x27, 0x72, 0xd6, 0xf3, 0xe8, 0xc2, 0xa3, 0xf7,
-	0xf7, 0x94, 0x35, 0xd3, 0xb1, 0x5d, 0x62, 0xf8, 0xbf, 0x01, 0x46, 0xc9, 0x5b, 0x84, 0x39, 0x84,
-	0x19, 0x0e, 0xc3, 0x5e, 0xac, 0xc3, 0xb0, 0x70, 0xac, 0x07, 0x8e, 0x23, 0xff, 0xc9, 0x08, 0x1e,
-	0x84, 0x2b, 0x87, 0x09, 0x26, 0x81, 0xdd, 0xfb, 0x27, 0xac, 0x9b, 0xf1, 0x3e, 0x30, 0x72, 0x11,
-	0xb3, 0x45, 0x90, 0xf6, 0x0d, 0xc0, 0x6c, 0x93, 0xe1, 0x57, 0xdd, 0xb6, 0xc9, 0xd1, 0x4b, 0x93,
-	0x9a, 0x0e, 0x93, 0x1f, 0xc2, 0x8c, 0xd9, 0xe3, 0x1d, 0x42, 0x6d, 0x7e, 0x56, 0x00, 0x25, 0x50,
-	0xce, 0xd4, 0x0b, 0xdf, 0xbf, 0x56, 0x73, 0xa2, 0xda, 0xe3, 0x76, 0x9b, 0x22, 0xc6, 0x0e, 0x38,
-	0xb5, 0x5d, 0xdc, 0x9a, 0x40, 0xe5, 0x1a, 0x5c, 0xed, 0xfa, 0x19, 0x0a, 0x2b, 0x25, 0x50, 0xbe,
-	0xb9, 0x9f, 0xd7, 0x63, 0x4c, 0xf5, 0xa0, 0x40, 0x3d, 0x73, 0xf1, 0xb3, 0x28, 0x7d, 0x19, 0x0f,
-	0x2a, 0xa0, 0x25, 0x22, 0x6a, 0x8f, 0x3e, 0x9c, 0x17, 0xa5, 0xdf, 0xe7, 0x45, 0xe9, 0xfd, 0x78,
-	0x50, 0x99, 0xe4, 0xfc, 0x38, 0x1e, 0x54, 0x54, 0x9f, 0xc1, 0x69, 0xc4, 0x21, 0xd6, 0xae, 0xb6,
-	0x0e, 0xf3, 0x31, 0x53, 0x0b, 0xb1, 0x2e, 0x71, 0x19, 0xd2, 0x46, 0x00, 0xca, 0x4d, 0x86, 0x5b,
-	0x08, 0xdb, 0x8c, 0x23, 0x7a, 0xc0, 0xcd, 0x13, 0xdb, 0xc5, 0x72, 0x03, 0xde, 0xb6, 0x88, 0xcb,
-	0xa9, 0x69, 0xf1, 0x23, 0x33, 0x60, 0x93, 0xca, 0x33, 0x1b, 0x46, 0x08, 0xb3, 0x97, 0x84, 0x8a,
-	0xbc, 0x51, 0x92, 0x95, 0xb4, 0x24, 0x61, 0x84, 0x30, 0xd7, 0x1a, 0xd3, 0xb4, 0x67, 0xf2, 0x79,
-	0xec, 0xb7, 0x66, 0xd9, 0xc7, 0xe8, 0x68, 0x1b, 0x50, 0x99, 0xb5, 0x46, 0x1a, 0xfc, 0x01, 0xf0,
-	0xce, 0x94, 0xfb, 0x19, 0xe9, 0x23, 0xea, 0x9a, 0xae, 0x85, 0x96, 0x48, 0x86, 0xa7, 0xa9, 0x32,
-	0xdc, 0xbd, 0x5e, 0x86, 0x09, 0x23, 0xad, 0x08, 0x37, 0x13, 0x1d, 0x91, 0x18, 0x7f, 0x41, 0x30,
-	0x2c, 0x2e, 0x5d, 0x66, 0x39, 0x9e, 0xa7, 0xca, 0xb1, 0x93, 0xb0, 0x13, 0x09, 0x9c, 0xb4, 0x2d,
-	0x58, 0xbc, 0xc6, 0x15, 0x49, 0x32, 0x06, 0x30, 0x77, 0x05, 0xb3, 0x7c, 0x5b, 0xf2, 0x24, 0x55,
-	0x8f, 0xed, 0x79, 0x7a, 0x84, 0x7b, 0xa2, 0xc2, 0x8d, 0x24, 0x7b, 0xa8, 0xc4, 0xfe, 0xe7, 0xff,
-	0xe1, 0x7f, 0x4d, 0x86, 0xe5, 0x43, 0x78, 0xeb, 0xca, 0x3d, 0x2c, 0xcd, 0xdc, 0xb1, 0xd8, 0xbd,
-	0x51, 0xca, 0x69, 0x88, 0xb0, 0x86, 0x6c, 0xc1, 0x6c, 0xfc, 0x1a, 0x6d, 0x27, 0x05, 0xc7, 0x40,
-	0xca, 0xfd, 0x05, 0x40, 0x51, 0x11, 0x1b, 0xae, 0xcd, 0xbe, 0xce, 0x7b, 0x89, 0x3d, 0xc6, 0x61,
-	0x4a, 0x75, 0x21, 0x58, 0x54, 0xea, 0x0d, 0x94, 0x13, 0x2e, 0xcb, 0xce, 0xbc, 0x6e, 0x27, 0x38,
-	0x45, 0x5f, 0x0c, 0x17, 0x55, 0xa3, 0x30, 0x97, 0xb8, 0xba, 0xe5, 0xf9, 0x4d, 0x4f, 0x55, 0xdc,
-	0x5d, 0x14, 0x19, 0xd6, 0x54, 0x6e, 0xbc, 0xf3, 0x3e, 0x54, 0xf5, 0x17, 0x17, 0x43, 0x15, 0x5c,
-	0x0e, 0x55, 0xf0, 0x6b, 0xa8, 0x82, 0x4f, 0x23, 0x55, 0xba, 0x1c, 0xa9, 0xd2, 0x8f, 0x91, 0x2a,
-	0x1d, 0xee, 0x62, 0x9b, 0x77, 0x7a, 0xc7, 0xba, 0x45, 0x1c, 0xa3, 0xe1, 0xcf, 0x73, 0x43, 0x2c,
-	0x01, 0x33, 0xa2, 0xb1, 0xac, 0x06, 0x73, 0xc9, 0xcf, 0xba, 0x88, 0x1d, 0xaf, 0xfa, 0xdf, 0xde,
-	0x07, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xdf, 0x0d, 0x79, 0x24, 0x08, 0x00, 0x00,
+func (m *MsgUnjailContract) Reset()         { *m = MsgUnjailContract{} }
+func (m *MsgUnjailContract) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailContract) ProtoMessage()    {}
+func (*MsgUnjailContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{10}
+}
+func (m *MsgUnjailContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailContract.Merge(m, src)
+}
+func (m *MsgUnjailContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailContract.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgUnjailContract proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a governance operation for updating the x/clock module
-	// parameters. The authority is hard-coded to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// RegisterStaking.
-	RegisterStaking(ctx context.Context, in *MsgRegisterStaking, opts ...grpc.CallOption) (*MsgRegisterStakingResponse, error)
-	// UnregisterStaking.
-	UnregisterStaking(ctx context.Context, in *MsgUnregisterStaking, opts ...grpc.CallOption) (*MsgUnregisterStakingResponse, error)
-	// RegisterGovernance.
-	RegisterGovernance(ctx context.Context, in *MsgRegisterGovernance, opts ...grpc.CallOption) (*MsgRegisterGovernanceResponse, error)
-	// UnregisterGovernance.
-	UnregisterGovernance(ctx context.Context, in *MsgUnregisterGovernance, opts ...grpc.CallOption) (*MsgUnregisterGovernanceResponse, error)
+// MsgUnjailContractResponse
+type MsgUnjailContractResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUnjailContractResponse) Reset()         { *m = MsgUnjailContractResponse{} }
+func (m *MsgUnjailContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailContractResponse) ProtoMessage()    {}
+func (*MsgUnjailContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{11}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUnjailContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUnjailContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUnjailContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailContractResponse.Merge(m, src)
+}
+func (m *MsgUnjailContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailContractResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RegisterStaking(ctx context.Context, in *MsgRegisterStaking, opts ...grpc.CallOption) (*MsgRegisterStakingResponse, error) {
-	out := new(MsgRegisterStakingResponse)
-	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/RegisterStaking", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUnjailContractResponse proto.InternalMessageInfo
+
+// MsgRegisterDistribution
+type MsgRegisterDistribution struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	RegisterAddress string `protobuf:"bytes,2,opt,name=register_address,json=registerAddress,proto3" json:"register_address,omitempty"`
+	// event_types are the distribution events the contract receives. Empty receives all events.
+	EventTypes []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// validator_addresses limits the events to these validators. Empty receives events for all validators.
+	ValidatorAddresses []string `protobuf:"bytes,4,rep,name=validator_addresses,json=validatorAddresses,proto3" json:"validator_addresses,omitempty"`
+	// deferred delivers the events at the end of the block instead of during the transaction.
+	Deferred bool `protobuf:"varint,5,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	// payload_version is the version of the sudo message payloads the contract receives.
+	// 0 and 1 are the original payloads, 2 adds timestamps, token amounts and tallies.
+	PayloadVersion uint32 `protobuf:"varint,6,opt,name=payload_version,json=payloadVersion,proto3" json:"payload_version,omitempty"`
 }
 
-func (c *msgClient) UnregisterStaking(ctx context.Context, in *MsgUnregisterStaking, opts ...grpc.CallOption) (*MsgUnregisterStakingResponse, error) {
-	out := new(MsgUnregisterStakingResponse)
-	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/UnregisterStaking", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterDistribution) Reset()         { *m = MsgRegisterDistribution{} }
+func (m *MsgRegisterDistribution) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDistribution) ProtoMessage()    {}
+func (*MsgRegisterDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{12}
+}
+func (m *MsgRegisterDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRegisterDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDistribution.Merge(m, src)
+}
+func (m *MsgRegisterDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDistribution.DiscardUnknown(m)
 }
 
-func (c *msgClient) RegisterGovernance(ctx context.Context, in *MsgRegisterGovernance, opts ...grpc.CallOption) (*MsgRegisterGovernanceResponse, error) {
-	out := new(MsgRegisterGovernanceResponse)
-	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/RegisterGovernance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRegisterDistribution proto.InternalMessageInfo
+
+// MsgRegisterDistributionResponse
+type MsgRegisterDistributionResponse struct {
 }
 
-func (c *msgClient) UnregisterGovernance(ctx context.Context, in *MsgUnregisterGovernance, opts ...grpc.CallOption) (*MsgUnregisterGovernanceResponse, error) {
-	out := new(MsgUnregisterGovernanceResponse)
-	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/UnregisterGovernance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterDistributionResponse) Reset()         { *m = MsgRegisterDistributionResponse{} }
+func (m *MsgRegisterDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDistributionResponse) ProtoMessage()    {}
+func (*MsgRegisterDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{13}
+}
+func (m *MsgRegisterDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a governance operation for updating the x/clock module
-	// parameters. The authority is hard-coded to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// RegisterStaking.
-	RegisterStaking(context.Context, *MsgRegisterStaking) (*MsgRegisterStakingResponse, error)
-	// UnregisterStaking.
-	UnregisterStaking(context.Context, *MsgUnregisterStaking) (*MsgUnregisterStakingResponse, error)
-	// RegisterGovernance.
-	RegisterGovernance(context.Context, *MsgRegisterGovernance) (*MsgRegisterGovernanceResponse, error)
-	// UnregisterGovernance.
-	UnregisterGovernance(context.Context, *MsgUnregisterGovernance) (*MsgUnregisterGovernanceResponse, error)
+func (m *MsgRegisterDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDistributionResponse.Merge(m, src)
+}
+func (m *MsgRegisterDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDistributionResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgRegisterDistributionResponse proto.InternalMessageInfo
+
+// MsgUnregisterDistribution
+type MsgUnregisterDistribution struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	RegisterAddress string `protobuf:"bytes,2,opt,name=register_address,json=registerAddress,proto3" json:"register_address,omitempty"`
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgUnregisterDistribution) Reset()         { *m = MsgUnregisterDistribution{} }
+func (m *MsgUnregisterDistribution) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterDistribution) ProtoMessage()    {}
+func (*MsgUnregisterDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{14}
 }
-func (*UnimplementedMsgServer) RegisterStaking(ctx context.Context, req *MsgRegisterStaking) (*MsgRegisterStakingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterStaking not implemented")
+func (m *MsgUnregisterDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UnregisterStaking(ctx context.Context, req *MsgUnregisterStaking) (*MsgUnregisterStakingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterStaking not implemented")
+func (m *MsgUnregisterDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RegisterGovernance(ctx context.Context, req *MsgRegisterGovernance) (*MsgRegisterGovernanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterGovernance not implemented")
+func (m *MsgUnregisterDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterDistribution.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UnregisterGovernance(ctx context.Context, req *MsgUnregisterGovernance) (*MsgUnregisterGovernanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnregisterGovernance not implemented")
+func (m *MsgUnregisterDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterDistribution.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUnregisterDistribution proto.InternalMessageInfo
+
+// MsgUnregisterDistributionResponse
+type MsgUnregisterDistributionResponse struct {
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.cwhooks.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgUnregisterDistributionResponse) Reset()         { *m = MsgUnregisterDistributionResponse{} }
+func (m *MsgUnregisterDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterDistributionResponse) ProtoMessage()    {}
+func (*MsgUnregisterDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{15}
+}
+func (m *MsgUnregisterDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUnregisterDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterDistributionResponse.Merge(m, src)
+}
+func (m *MsgUnregisterDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterDistributionResponse.DiscardUnknown(m)
 }
 
-func _Msg_RegisterStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterStaking)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterStaking(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.cwhooks.v1.Msg/RegisterStaking",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterStaking(ctx, req.(*MsgRegisterStaking))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgUnregisterDistributionResponse proto.InternalMessageInfo
+
+// MsgRegisterSlashing
+type MsgRegisterSlashing struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	RegisterAddress string `protobuf:"bytes,2,opt,name=register_address,json=registerAddress,proto3" json:"register_address,omitempty"`
+	// event_types are the slashing events the contract receives. Empty receives all events.
+	EventTypes []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// validator_addresses limits the events to these validators. Empty receives events for all validators.
+	ValidatorAddresses []string `protobuf:"bytes,4,rep,name=validator_addresses,json=validatorAddresses,proto3" json:"validator_addresses,omitempty"`
+	// deferred delivers the events at the end of the block instead of during the transaction.
+	Deferred bool `protobuf:"varint,5,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	// payload_version is the version of the sudo message payloads the contract receives.
+	// 0 and 1 are the original payloads, 2 adds timestamps, token amounts and tallies.
+	PayloadVersion uint32 `protobuf:"varint,6,opt,name=payload_version,json=payloadVersion,proto3" json:"payload_version,omitempty"`
 }
 
-func _Msg_UnregisterStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnregisterStaking)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnregisterStaking(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.cwhooks.v1.Msg/UnregisterStaking",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnregisterStaking(ctx, req.(*MsgUnregisterStaking))
+func (m *MsgRegisterSlashing) Reset()         { *m = MsgRegisterSlashing{} }
+func (m *MsgRegisterSlashing) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSlashing) ProtoMessage()    {}
+func (*MsgRegisterSlashing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{16}
+}
+func (m *MsgRegisterSlashing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSlashing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSlashing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_RegisterGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterGovernance)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterGovernance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.cwhooks.v1.Msg/RegisterGovernance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterGovernance(ctx, req.(*MsgRegisterGovernance))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRegisterSlashing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSlashing.Merge(m, src)
 }
-
-func _Msg_UnregisterGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnregisterGovernance)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnregisterGovernance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.cwhooks.v1.Msg/UnregisterGovernance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnregisterGovernance(ctx, req.(*MsgUnregisterGovernance))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRegisterSlashing) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSlashing) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSlashing.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "juno.cwhooks.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "RegisterStaking",
-			Handler:    _Msg_RegisterStaking_Handler,
-		},
-		{
-			MethodName: "UnregisterStaking",
-			Handler:    _Msg_UnregisterStaking_Handler,
-		},
-		{
-			MethodName: "RegisterGovernance",
-			Handler:    _Msg_RegisterGovernance_Handler,
-		},
-		{
-			MethodName: "UnregisterGovernance",
-			Handler:    _Msg_UnregisterGovernance_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "juno/cwhooks/v1/tx.proto",
+var xxx_messageInfo_MsgRegisterSlashing proto.InternalMessageInfo
+
+// MsgRegisterSlashingResponse
+type MsgRegisterSlashingResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterSlashingResponse) Reset()         { *m = MsgRegisterSlashingResponse{} }
+func (m *MsgRegisterSlashingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSlashingResponse) ProtoMessage()    {}
+func (*MsgRegisterSlashingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{17}
+}
+func (m *MsgRegisterSlashingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSlashingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSlashingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRegisterSlashingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSlashingResponse.Merge(m, src)
+}
+func (m *MsgRegisterSlashingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSlashingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSlashingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRegisterSlashingResponse proto.InternalMessageInfo
+
+// MsgUnregisterSlashing
+type MsgUnregisterSlashing struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	RegisterAddress string `protobuf:"bytes,2,opt,name=register_address,json=registerAddress,proto3" json:"register_address,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUnregisterSlashing) Reset()         { *m = MsgUnregisterSlashing{} }
+func (m *MsgUnregisterSlashing) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterSlashing) ProtoMessage()    {}
+func (*MsgUnregisterSlashing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{18}
+}
+func (m *MsgUnregisterSlashing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterSlashing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterSlashing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUnregisterSlashing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterSlashing.Merge(m, src)
 }
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnregisterSlashing) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterSlashing) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterSlashing.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUnregisterSlashing proto.InternalMessageInfo
+
+// MsgUnregisterSlashingResponse
+type MsgUnregisterSlashingResponse struct {
 }
 
-func (m *MsgRegisterStaking) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnregisterSlashingResponse) Reset()         { *m = MsgUnregisterSlashingResponse{} }
+func (m *MsgUnregisterSlashingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterSlashingResponse) ProtoMessage()    {}
+func (*MsgUnregisterSlashingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{19}
+}
+func (m *MsgUnregisterSlashingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterSlashingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterSlashingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRegisterStaking) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnregisterSlashingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterSlashingResponse.Merge(m, src)
+}
+func (m *MsgUnregisterSlashingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterSlashingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterSlashingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RegisterAddress) > 0 {
-		i -= len(m.RegisterAddress)
-		copy(dAtA[i:], m.RegisterAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUnregisterSlashingResponse proto.InternalMessageInfo
+
+// MsgRegisterBank
+type MsgRegisterBank struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// register_address must be the admin of the denom.
+	RegisterAddress string `protobuf:"bytes,2,opt,name=register_address,json=registerAddress,proto3" json:"register_address,omitempty"`
+	// denom is the token factory denom the contract is attached to.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// before_send lets the contract reject sends of the denom.
+	BeforeSend bool `protobuf:"varint,4,opt,name=before_send,json=beforeSend,proto3" json:"before_send,omitempty"`
+	// after_send notifies the contract of sends of the denom at the end of the block.
+	AfterSend bool `protobuf:"varint,5,opt,name=after_send,json=afterSend,proto3" json:"after_send,omitempty"`
 }
 
-func (m *MsgRegisterStakingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterBank) Reset()         { *m = MsgRegisterBank{} }
+func (m *MsgRegisterBank) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBank) ProtoMessage()    {}
+func (*MsgRegisterBank) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{20}
+}
+func (m *MsgRegisterBank) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterBank) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterBank.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRegisterStakingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterBank) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBank.Merge(m, src)
+}
+func (m *MsgRegisterBank) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterBank) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBank.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterStakingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRegisterBank proto.InternalMessageInfo
+
+// MsgRegisterBankResponse
+type MsgRegisterBankResponse struct {
 }
 
-func (m *MsgRegisterGovernance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterBankResponse) Reset()         { *m = MsgRegisterBankResponse{} }
+func (m *MsgRegisterBankResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterBankResponse) ProtoMessage()    {}
+func (*MsgRegisterBankResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{21}
+}
+func (m *MsgRegisterBankResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterBankResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterBankResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRegisterBankResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterBankResponse.Merge(m, src)
+}
+func (m *MsgRegisterBankResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterBankResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterBankResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterGovernance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRegisterBankResponse proto.InternalMessageInfo
+
+// MsgUnregisterBank
+type MsgUnregisterBank struct {
+	// register_address must be the admin of the denom.
+	RegisterAddress string `protobuf:"bytes,1,opt,name=register_address,json=registerAddress,proto3" json:"register_address,omitempty"`
+	// denom is the token factory denom to remove the contract from.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgRegisterGovernance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RegisterAddress) > 0 {
-		i -= len(m.RegisterAddress)
-		copy(dAtA[i:], m.RegisterAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgUnregisterBank) Reset()         { *m = MsgUnregisterBank{} }
+func (m *MsgUnregisterBank) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterBank) ProtoMessage()    {}
+func (*MsgUnregisterBank) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{22}
+}
+func (m *MsgUnregisterBank) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterBank) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterBank.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUnregisterBank) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterBank.Merge(m, src)
+}
+func (m *MsgUnregisterBank) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterBank) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterBank.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterGovernanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnregisterBank proto.InternalMessageInfo
+
+// MsgUnregisterBankResponse
+type MsgUnregisterBankResponse struct {
+}
+
+func (m *MsgUnregisterBankResponse) Reset()         { *m = MsgUnregisterBankResponse{} }
+func (m *MsgUnregisterBankResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnregisterBankResponse) ProtoMessage()    {}
+func (*MsgUnregisterBankResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2868e302cb80fd0b, []int{23}
+}
+func (m *MsgUnregisterBankResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnregisterBankResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnregisterBankResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUnregisterBankResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnregisterBankResponse.Merge(m, src)
+}
+func (m *MsgUnregisterBankResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnregisterBankResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnregisterBankResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterGovernanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUnregisterBankResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "juno.cwhooks.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "juno.cwhooks.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterStaking)(nil), "juno.cwhooks.v1.MsgRegisterStaking")
+	proto.RegisterType((*MsgRegisterStakingResponse)(nil), "juno.cwhooks.v1.MsgRegisterStakingResponse")
+	proto.RegisterType((*MsgRegisterGovernance)(nil), "juno.cwhooks.v1.MsgRegisterGovernance")
+	proto.RegisterType((*MsgRegisterGovernanceResponse)(nil), "juno.cwhooks.v1.MsgRegisterGovernanceResponse")
+	proto.RegisterType((*MsgUnregisterGovernance)(nil), "juno.cwhooks.v1.MsgUnregisterGovernance")
+	proto.RegisterType((*MsgUnregisterGovernanceResponse)(nil), "juno.cwhooks.v1.MsgUnregisterGovernanceResponse")
+	proto.RegisterType((*MsgUnregisterStaking)(nil), "juno.cwhooks.v1.MsgUnregisterStaking")
+	proto.RegisterType((*MsgUnregisterStakingResponse)(nil), "juno.cwhooks.v1.MsgUnregisterStakingResponse")
+	proto.RegisterType((*MsgUnjailContract)(nil), "juno.cwhooks.v1.MsgUnjailContract")
+	proto.RegisterType((*MsgUnjailContractResponse)(nil), "juno.cwhooks.v1.MsgUnjailContractResponse")
+	proto.RegisterType((*MsgRegisterDistribution)(nil), "juno.cwhooks.v1.MsgRegisterDistribution")
+	proto.RegisterType((*MsgRegisterDistributionResponse)(nil), "juno.cwhooks.v1.MsgRegisterDistributionResponse")
+	proto.RegisterType((*MsgUnregisterDistribution)(nil), "juno.cwhooks.v1.MsgUnregisterDistribution")
+	proto.RegisterType((*MsgUnregisterDistributionResponse)(nil), "juno.cwhooks.v1.MsgUnregisterDistributionResponse")
+	proto.RegisterType((*MsgRegisterSlashing)(nil), "juno.cwhooks.v1.MsgRegisterSlashing")
+	proto.RegisterType((*MsgRegisterSlashingResponse)(nil), "juno.cwhooks.v1.MsgRegisterSlashingResponse")
+	proto.RegisterType((*MsgUnregisterSlashing)(nil), "juno.cwhooks.v1.MsgUnregisterSlashing")
+	proto.RegisterType((*MsgUnregisterSlashingResponse)(nil), "juno.cwhooks.v1.MsgUnregisterSlashingResponse")
+	proto.RegisterType((*MsgRegisterBank)(nil), "juno.cwhooks.v1.MsgRegisterBank")
+	proto.RegisterType((*MsgRegisterBankResponse)(nil), "juno.cwhooks.v1.MsgRegisterBankResponse")
+	proto.RegisterType((*MsgUnregisterBank)(nil), "juno.cwhooks.v1.MsgUnregisterBank")
+	proto.RegisterType((*MsgUnregisterBankResponse)(nil), "juno.cwhooks.v1.MsgUnregisterBankResponse")
 }
 
-func (m *MsgRegisterGovernanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("juno/cwhooks/v1/tx.proto", fileDescriptor_2868e302cb80fd0b) }
+
+var fileDescriptor_2868e302cb80fd0b = []byte{
+	// 1119 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x93, 0xb6, 0xda, 0xbc, 0x76, 0x9b, 0xad, 0x37, 0x50, 0xd7, 0xdd, 0xfc, 0x73, 0x97,
+	0x12, 0x05, 0x9a, 0xec, 0x16, 0x09, 0x89, 0x5c, 0x80, 0x74, 0xf9, 0x2b, 0x55, 0x42, 0x2e, 0xec,
+	0xa1, 0x97, 0xe0, 0xc6, 0x53, 0xd7, 0xdb, 0xc4, 0x13, 0xcd, 0xb8, 0xa1, 0xbd, 0x21, 0x4e, 0x88,
+	0x13, 0x5f, 0x00, 0xb1, 0x37, 0x38, 0x56, 0xb0, 0x1f, 0x80, 0xe3, 0x1e, 0x57, 0x7b, 0x42, 0x42,
+	0x42, 0xa8, 0x3d, 0x04, 0x90, 0xb8, 0xf0, 0x01, 0x10, 0xf2, 0xbf, 0xa9, 0x63, 0x3b, 0x76, 0xa8,
+	0x7a, 0x08, 0x52, 0x2f, 0x55, 0xfd, 0xde, 0x6f, 0xe6, 0xcd, 0xfb, 0xfd, 0xde, 0xcc, 0xbc, 0x09,
+	0x08, 0x8f, 0x8e, 0x0c, 0xdc, 0xe8, 0x7c, 0x76, 0x80, 0xf1, 0x21, 0x6d, 0x0c, 0xee, 0x37, 0xcc,
+	0xe3, 0x7a, 0x9f, 0x60, 0x13, 0xf3, 0x39, 0xcb, 0x53, 0x77, 0x3d, 0xf5, 0xc1, 0x7d, 0x71, 0x49,
+	0xe9, 0xe9, 0x06, 0x6e, 0xd8, 0x7f, 0x1d, 0x8c, 0xb8, 0xdc, 0xc1, 0xb4, 0x87, 0x69, 0xa3, 0x47,
+	0x35, 0x6b, 0x6c, 0x8f, 0x6a, 0xae, 0x63, 0xc5, 0x71, 0xb4, 0xed, 0xaf, 0x86, 0xf3, 0xe1, 0xba,
+	0xf2, 0x1a, 0xd6, 0xb0, 0x63, 0xb7, 0xfe, 0x73, 0xad, 0x85, 0xe0, 0x3a, 0x34, 0x64, 0x20, 0xaa,
+	0xbb, 0x83, 0xa4, 0x9f, 0x38, 0xc8, 0x6d, 0x53, 0xed, 0x93, 0xbe, 0xaa, 0x98, 0xe8, 0x23, 0x85,
+	0x28, 0x3d, 0xca, 0xbf, 0x0e, 0x59, 0xe5, 0xc8, 0x3c, 0xc0, 0x44, 0x37, 0x4f, 0x04, 0xae, 0xcc,
+	0x55, 0xb3, 0x2d, 0xe1, 0xf9, 0x93, 0x8d, 0xbc, 0x1b, 0xed, 0x6d, 0x55, 0x25, 0x88, 0xd2, 0x1d,
+	0x93, 0xe8, 0x86, 0x26, 0x5f, 0x40, 0xf9, 0x26, 0xcc, 0xf5, 0xed, 0x19, 0x84, 0x74, 0x99, 0xab,
+	0xce, 0x6f, 0x2e, 0xd7, 0x03, 0x99, 0xd6, 0x9d, 0x00, 0xad, 0xec, 0xd3, 0x5f, 0x4b, 0xa9, 0xef,
+	0x87, 0xa7, 0x35, 0x4e, 0x76, 0x47, 0x34, 0xdf, 0xf8, 0xf2, 0x71, 0x29, 0xf5, 0xfb, 0xe3, 0x52,
+	0xea, 0x8b, 0xe1, 0x69, 0xed, 0x62, 0xce, 0xaf, 0x86, 0xa7, 0xb5, 0xa2, 0x9d, 0xc1, 0x31, 0xcb,
+	0x21, 0xb0, 0x5c, 0x69, 0x05, 0x96, 0x03, 0x26, 0x19, 0xd1, 0x3e, 0x36, 0x28, 0x92, 0xbe, 0xc9,
+	0x00, 0xbf, 0x4d, 0x35, 0x19, 0x69, 0x3a, 0x35, 0x11, 0xd9, 0x31, 0x95, 0x43, 0xdd, 0xd0, 0xf8,
+	0x2d, 0xb8, 0xd5, 0xc1, 0x86, 0x49, 0x94, 0x8e, 0xd9, 0x56, 0x9c, 0x6c, 0x12, 0xf3, 0xcc, 0x79,
+	0x23, 0x5c, 0xb3, 0x35, 0x09, 0x71, 0xe7, 0x65, 0x93, 0xa4, 0x93, 0x26, 0xf1, 0x46, 0x78, 0x93,
+	0x94, 0x60, 0x1e, 0x0d, 0x90, 0x61, 0xb6, 0xcd, 0x93, 0x3e, 0xa2, 0x42, 0xa6, 0x9c, 0xa9, 0x66,
+	0x65, 0xb0, 0x4d, 0x1f, 0x5b, 0x16, 0x5e, 0x86, 0xdb, 0x03, 0xa5, 0xab, 0xab, 0x8a, 0x89, 0x59,
+	0x18, 0x44, 0x85, 0x19, 0x0b, 0xd8, 0xaa, 0x3c, 0x7f, 0xb2, 0x51, 0x70, 0x03, 0x3d, 0xf4, 0x50,
+	0xa3, 0x11, 0xf9, 0x41, 0xc0, 0x8e, 0x28, 0x2f, 0xc2, 0x0d, 0x15, 0xed, 0x23, 0x42, 0x90, 0x2a,
+	0xcc, 0x96, 0xb9, 0xea, 0x0d, 0x99, 0x7d, 0xf3, 0x2f, 0x43, 0xae, 0xaf, 0x9c, 0x74, 0xb1, 0xa2,
+	0xb6, 0x07, 0x88, 0x50, 0x1d, 0x1b, 0xc2, 0x5c, 0x99, 0xab, 0xde, 0x94, 0x17, 0x5d, 0xf3, 0x43,
+	0xc7, 0xda, 0xdc, 0xf2, 0x0b, 0x16, 0x62, 0xc2, 0xd2, 0xad, 0x12, 0xd6, 0x2d, 0x20, 0x84, 0x74,
+	0x07, 0xc4, 0xb0, 0x95, 0xa9, 0xf7, 0x77, 0x1a, 0x5e, 0xf0, 0xb9, 0xdf, 0xc3, 0x03, 0x44, 0x0c,
+	0xc5, 0xe8, 0xa0, 0xff, 0x93, 0x80, 0x15, 0x58, 0xe8, 0x13, 0xdc, 0xc7, 0x54, 0xe9, 0xb6, 0x75,
+	0xd5, 0x51, 0x6e, 0x46, 0x9e, 0xf7, 0x6c, 0x1f, 0xa8, 0x57, 0xa4, 0xc7, 0xbb, 0x89, 0x7a, 0xdc,
+	0x1d, 0xaf, 0xc7, 0x05, 0xb5, 0x52, 0x09, 0x0a, 0x91, 0x0e, 0xa6, 0xca, 0x5f, 0x9c, 0xb3, 0xdf,
+	0x0c, 0x32, 0xc5, 0xba, 0x34, 0xdf, 0x4f, 0xa4, 0x63, 0x3d, 0xe2, 0x58, 0x89, 0xc8, 0x49, 0xaa,
+	0x40, 0x69, 0x8c, 0x8b, 0x51, 0x32, 0xe4, 0x20, 0x3f, 0x82, 0x99, 0xba, 0x83, 0xa6, 0xf9, 0x4e,
+	0x22, 0x1f, 0x6b, 0x71, 0x7c, 0x78, 0x1b, 0xb6, 0x08, 0x77, 0xa2, 0xec, 0x8c, 0x89, 0x5f, 0x38,
+	0x58, 0xb2, 0x01, 0x8f, 0x14, 0xbd, 0xbb, 0xe5, 0x26, 0x72, 0x35, 0x34, 0xbc, 0x09, 0x8b, 0x14,
+	0x19, 0xea, 0x7f, 0x20, 0xe1, 0xa6, 0x83, 0xf7, 0x28, 0x78, 0xcb, 0x4f, 0x41, 0x60, 0x2e, 0x8b,
+	0x80, 0x72, 0x14, 0x01, 0xfe, 0x3c, 0xa4, 0x55, 0x58, 0x09, 0x19, 0x59, 0xea, 0xdf, 0x65, 0xec,
+	0x7d, 0xe1, 0xed, 0x9c, 0x07, 0x3a, 0x35, 0x89, 0xbe, 0x77, 0x64, 0xea, 0xd8, 0xb8, 0xbe, 0x70,
+	0xae, 0xe0, 0x80, 0xbb, 0xd4, 0x8e, 0x8e, 0x52, 0xc3, 0xdd, 0xd1, 0x51, 0x2e, 0x26, 0xe6, 0x9f,
+	0x9c, 0x2b, 0x35, 0x99, 0x6a, 0x39, 0x2f, 0x77, 0x0b, 0x07, 0xb2, 0x92, 0xd6, 0xa0, 0x32, 0x36,
+	0x57, 0xc6, 0xc8, 0xb7, 0x19, 0xb8, 0xed, 0xbf, 0xab, 0xbb, 0x0a, 0x3d, 0xb8, 0xee, 0xa5, 0xae,
+	0xa6, 0xb4, 0x1f, 0x24, 0xaa, 0x28, 0xc5, 0xf4, 0x52, 0xae, 0x12, 0x52, 0x01, 0x56, 0x23, 0xcc,
+	0x4c, 0xc0, 0x3f, 0x38, 0xbb, 0x9b, 0xf2, 0x9d, 0xdd, 0x53, 0x27, 0xe1, 0xe5, 0x9a, 0x98, 0x70,
+	0x46, 0x6e, 0x13, 0x13, 0x76, 0x30, 0x32, 0x7e, 0x4c, 0xdb, 0xcf, 0x1e, 0x8f, 0xac, 0x96, 0x62,
+	0x1c, 0x4e, 0x51, 0x25, 0xe7, 0x61, 0x56, 0x45, 0x06, 0xee, 0x09, 0x19, 0x6b, 0xa4, 0xec, 0x7c,
+	0x58, 0xf5, 0xbd, 0x87, 0xf6, 0x31, 0x41, 0x6d, 0xeb, 0xee, 0x12, 0x66, 0xec, 0x6a, 0x03, 0xc7,
+	0xb4, 0x83, 0x0c, 0x95, 0x2f, 0x00, 0x28, 0xfb, 0x56, 0x60, 0xdb, 0xef, 0x54, 0x63, 0xd6, 0xb6,
+	0x58, 0xee, 0xd1, 0xfb, 0x2f, 0x92, 0xdc, 0xe2, 0xf8, 0x2a, 0xb3, 0x18, 0x72, 0x5f, 0x5a, 0x7e,
+	0x13, 0x23, 0xf4, 0x07, 0xef, 0xe2, 0x27, 0x01, 0x4a, 0x43, 0x6c, 0x70, 0x97, 0x66, 0x23, 0xed,
+	0x63, 0xa3, 0xd9, 0x4a, 0xcc, 0xa6, 0x1c, 0x57, 0x2a, 0x76, 0x3e, 0xab, 0x81, 0x43, 0xde, 0x9f,
+	0xd1, 0xe6, 0x3f, 0x59, 0xc8, 0x6c, 0x53, 0x8d, 0xdf, 0x85, 0x85, 0x91, 0xd7, 0x71, 0x39, 0xf4,
+	0xaa, 0x0d, 0xbc, 0x3e, 0xc5, 0x6a, 0x12, 0xc2, 0x8b, 0xc1, 0x77, 0x20, 0x17, 0x7c, 0x9b, 0xae,
+	0x45, 0x0d, 0x0e, 0x80, 0xc4, 0x57, 0x26, 0x00, 0xb1, 0x20, 0x3a, 0x2c, 0x85, 0x3b, 0xd3, 0x97,
+	0x22, 0xd7, 0x18, 0x84, 0x89, 0x1b, 0x13, 0xc1, 0x58, 0xa8, 0x2e, 0xf0, 0x11, 0xaf, 0xb5, 0xf5,
+	0xb8, 0xd5, 0x5e, 0xe0, 0xc4, 0xfa, 0x64, 0x38, 0x16, 0x8d, 0x40, 0x3e, 0xf2, 0x15, 0x52, 0x8d,
+	0x5f, 0xb4, 0x2f, 0xe2, 0xbd, 0x49, 0x91, 0x2c, 0xe6, 0xa7, 0xb0, 0x18, 0x68, 0x6e, 0xa5, 0xe8,
+	0x39, 0xfc, 0x18, 0xb1, 0x96, 0x8c, 0xf1, 0x67, 0x15, 0xd9, 0x43, 0x56, 0xe3, 0xd8, 0xf1, 0x23,
+	0xa3, 0xb3, 0x8a, 0x6b, 0x77, 0xf8, 0x63, 0x78, 0x71, 0x4c, 0xab, 0x53, 0x8b, 0x67, 0x68, 0x24,
+	0xee, 0xe6, 0xe4, 0x58, 0x16, 0x79, 0x1f, 0x6e, 0x85, 0x5a, 0x8a, 0xbb, 0xb1, 0xd5, 0xed, 0xa2,
+	0xc4, 0x57, 0x27, 0x41, 0xf9, 0x2b, 0x33, 0xe2, 0xe6, 0x5b, 0x4f, 0x28, 0x6f, 0x2f, 0x56, 0x7d,
+	0x32, 0x1c, 0x8b, 0xb6, 0x0b, 0x0b, 0x23, 0x57, 0x4b, 0x39, 0x6e, 0xad, 0x16, 0x42, 0xac, 0x26,
+	0x21, 0x46, 0x2b, 0x70, 0xe4, 0x94, 0x95, 0xe2, 0x57, 0x67, 0xcf, 0x5f, 0x4b, 0xc6, 0x78, 0x11,
+	0xc4, 0xd9, 0xcf, 0x87, 0xa7, 0x35, 0xae, 0xf5, 0xe1, 0xd3, 0xb3, 0x22, 0xf7, 0xec, 0xac, 0xc8,
+	0xfd, 0x76, 0x56, 0xe4, 0xbe, 0x3e, 0x2f, 0xa6, 0x9e, 0x9d, 0x17, 0x53, 0x3f, 0x9f, 0x17, 0x53,
+	0xbb, 0xf7, 0x34, 0xdd, 0x3c, 0x38, 0xda, 0xab, 0x77, 0x70, 0xaf, 0xb1, 0x65, 0x9f, 0xe1, 0x5e,
+	0x15, 0xd3, 0x06, 0x3b, 0x74, 0x37, 0x9c, 0x53, 0xd7, 0x6e, 0xc6, 0xf6, 0xe6, 0xec, 0x5f, 0x1b,
+	0x5f, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0x72, 0x46, 0x1f, 0x2d, 0x16, 0x15, 0x00, 0x00,
 }
 
-func (m *MsgUnregisterGovernance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a governance operation for updating the x/clock module
+	// parameters. The authority is hard-coded to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterStaking.
+	RegisterStaking(ctx context.Context, in *MsgRegisterStaking, opts ...grpc.CallOption) (*MsgRegisterStakingResponse, error)
+	// UnregisterStaking.
+	UnregisterStaking(ctx context.Context, in *MsgUnregisterStaking, opts ...grpc.CallOption) (*MsgUnregisterStakingResponse, error)
+	// RegisterGovernance.
+	RegisterGovernance(ctx context.Context, in *MsgRegisterGovernance, opts ...grpc.CallOption) (*MsgRegisterGovernanceResponse, error)
+	// UnregisterGovernance.
+	UnregisterGovernance(ctx context.Context, in *MsgUnregisterGovernance, opts ...grpc.CallOption) (*MsgUnregisterGovernanceResponse, error)
+	// UnjailContract.
+	UnjailContract(ctx context.Context, in *MsgUnjailContract, opts ...grpc.CallOption) (*MsgUnjailContractResponse, error)
+	// RegisterDistribution.
+	RegisterDistribution(ctx context.Context, in *MsgRegisterDistribution, opts ...grpc.CallOption) (*MsgRegisterDistributionResponse, error)
+	// UnregisterDistribution.
+	UnregisterDistribution(ctx context.Context, in *MsgUnregisterDistribution, opts ...grpc.CallOption) (*MsgUnregisterDistributionResponse, error)
+	// RegisterSlashing.
+	RegisterSlashing(ctx context.Context, in *MsgRegisterSlashing, opts ...grpc.CallOption) (*MsgRegisterSlashingResponse, error)
+	// UnregisterSlashing.
+	UnregisterSlashing(ctx context.Context, in *MsgUnregisterSlashing, opts ...grpc.CallOption) (*MsgUnregisterSlashingResponse, error)
+	// RegisterBank attaches a contract to a token factory denom.
+	RegisterBank(ctx context.Context, in *MsgRegisterBank, opts ...grpc.CallOption) (*MsgRegisterBankResponse, error)
+	// UnregisterBank removes the contract of a token factory denom.
+	UnregisterBank(ctx context.Context, in *MsgUnregisterBank, opts ...grpc.CallOption) (*MsgUnregisterBankResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUnregisterGovernance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterStaking(ctx context.Context, in *MsgRegisterStaking, opts ...grpc.CallOption) (*MsgRegisterStakingResponse, error) {
+	out := new(MsgRegisterStakingResponse)
+	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/RegisterStaking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUnregisterGovernance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RegisterAddress) > 0 {
-		i -= len(m.RegisterAddress)
-		copy(dAtA[i:], m.RegisterAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) UnregisterStaking(ctx context.Context, in *MsgUnregisterStaking, opts ...grpc.CallOption) (*MsgUnregisterStakingResponse, error) {
+	out := new(MsgUnregisterStakingResponse)
+	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/UnregisterStaking", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) RegisterGovernance(ctx context.Context, in *MsgRegisterGovernance, opts ...grpc.CallOption) (*MsgRegisterGovernanceResponse, error) {
+	out := new(MsgRegisterGovernanceResponse)
+	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/RegisterGovernance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUnregisterGovernanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UnregisterGovernance(ctx context.Context, in *MsgUnregisterGovernance, opts ...grpc.CallOption) (*MsgUnregisterGovernanceResponse, error) {
+	out := new(MsgUnregisterGovernanceResponse)
+	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/UnregisterGovernance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUnregisterGovernanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UnjailContract(ctx context.Context, in *MsgUnjailContract, opts ...grpc.CallOption) (*MsgUnjailContractResponse, error) {
+	out := new(MsgUnjailContractResponse)
+	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/UnjailContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUnregisterGovernanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RegisterDistribution(ctx context.Context, in *MsgRegisterDistribution, opts ...grpc.CallOption) (*MsgRegisterDistributionResponse, error) {
+	out := new(MsgRegisterDistributionResponse)
+	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/RegisterDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUnregisterStaking) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UnregisterDistribution(ctx context.Context, in *MsgUnregisterDistribution, opts ...grpc.CallOption) (*MsgUnregisterDistributionResponse, error) {
+	out := new(MsgUnregisterDistributionResponse)
+	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/UnregisterDistribution", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUnregisterStaking) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RegisterSlashing(ctx context.Context, in *MsgRegisterSlashing, opts ...grpc.CallOption) (*MsgRegisterSlashingResponse, error) {
+	out := new(MsgRegisterSlashingResponse)
+	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/RegisterSlashing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUnregisterStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RegisterAddress) > 0 {
-		i -= len(m.RegisterAddress)
-		copy(dAtA[i:], m.RegisterAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) UnregisterSlashing(ctx context.Context, in *MsgUnregisterSlashing, opts ...grpc.CallOption) (*MsgUnregisterSlashingResponse, error) {
+	out := new(MsgUnregisterSlashingResponse)
+	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/UnregisterSlashing", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) RegisterBank(ctx context.Context, in *MsgRegisterBank, opts ...grpc.CallOption) (*MsgRegisterBankResponse, error) {
+	out := new(MsgRegisterBankResponse)
+	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/RegisterBank", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUnregisterStakingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UnregisterBank(ctx context.Context, in *MsgUnregisterBank, opts ...grpc.CallOption) (*MsgUnregisterBankResponse, error) {
+	out := new(MsgUnregisterBankResponse)
+	err := c.cc.Invoke(ctx, "/juno.cwhooks.v1.Msg/UnregisterBank", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUnregisterStakingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a governance operation for updating the x/clock module
+	// parameters. The authority is hard-coded to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterStaking.
+	RegisterStaking(context.Context, *MsgRegisterStaking) (*MsgRegisterStakingResponse, error)
+	// UnregisterStaking.
+	UnregisterStaking(context.Context, *MsgUnregisterStaking) (*MsgUnregisterStakingResponse, error)
+	// RegisterGovernance.
+	RegisterGovernance(context.Context, *MsgRegisterGovernance) (*MsgRegisterGovernanceResponse, error)
+	// UnregisterGovernance.
+	UnregisterGovernance(context.Context, *MsgUnregisterGovernance) (*MsgUnregisterGovernanceResponse, error)
+	// UnjailContract.
+	UnjailContract(context.Context, *MsgUnjailContract) (*MsgUnjailContractResponse, error)
+	// RegisterDistribution.
+	RegisterDistribution(context.Context, *MsgRegisterDistribution) (*MsgRegisterDistributionResponse, error)
+	// UnregisterDistribution.
+	UnregisterDistribution(context.Context, *MsgUnregisterDistribution) (*MsgUnregisterDistributionResponse, error)
+	// RegisterSlashing.
+	RegisterSlashing(context.Context, *MsgRegisterSlashing) (*MsgRegisterSlashingResponse, error)
+	// UnregisterSlashing.
+	UnregisterSlashing(context.Context, *MsgUnregisterSlashing) (*MsgUnregisterSlashingResponse, error)
+	// RegisterBank attaches a contract to a token factory denom.
+	RegisterBank(context.Context, *MsgRegisterBank) (*MsgRegisterBankResponse, error)
+	// UnregisterBank removes the contract of a token factory denom.
+	UnregisterBank(context.Context, *MsgUnregisterBank) (*MsgUnregisterBankResponse, error)
 }
 
-func (m *MsgUnregisterStakingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RegisterStaking(ctx context.Context, req *MsgRegisterStaking) (*MsgRegisterStakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterStaking not implemented")
+}
+func (*UnimplementedMsgServer) UnregisterStaking(ctx context.Context, req *MsgUnregisterStaking) (*MsgUnregisterStakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterStaking not implemented")
+}
+func (*UnimplementedMsgServer) RegisterGovernance(ctx context.Context, req *MsgRegisterGovernance) (*MsgRegisterGovernanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterGovernance not implemented")
+}
+func (*UnimplementedMsgServer) UnregisterGovernance(ctx context.Context, req *MsgUnregisterGovernance) (*MsgUnregisterGovernanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterGovernance not implemented")
+}
+func (*UnimplementedMsgServer) UnjailContract(ctx context.Context, req *MsgUnjailContract) (*MsgUnjailContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailContract not implemented")
+}
+func (*UnimplementedMsgServer) RegisterDistribution(ctx context.Context, req *MsgRegisterDistribution) (*MsgRegisterDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDistribution not implemented")
+}
+func (*UnimplementedMsgServer) UnregisterDistribution(ctx context.Context, req *MsgUnregisterDistribution) (*MsgUnregisterDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterDistribution not implemented")
+}
+func (*UnimplementedMsgServer) RegisterSlashing(ctx context.Context, req *MsgRegisterSlashing) (*MsgRegisterSlashingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSlashing not implemented")
+}
+func (*UnimplementedMsgServer) UnregisterSlashing(ctx context.Context, req *MsgUnregisterSlashing) (*MsgUnregisterSlashingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterSlashing not implemented")
+}
+func (*UnimplementedMsgServer) RegisterBank(ctx context.Context, req *MsgRegisterBank) (*MsgRegisterBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBank not implemented")
+}
+func (*UnimplementedMsgServer) UnregisterBank(ctx context.Context, req *MsgUnregisterBank) (*MsgUnregisterBankResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterBank not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.cwhooks.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Msg_RegisterStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterStaking)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterStaking(ctx, in)
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.cwhooks.v1.Msg/RegisterStaking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterStaking(ctx, req.(*MsgRegisterStaking))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UnregisterStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterStaking)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterStaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.cwhooks.v1.Msg/UnregisterStaking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterStaking(ctx, req.(*MsgUnregisterStaking))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterStaking) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterGovernance)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterGovernance(ctx, in)
 	}
-	l = len(m.RegisterAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.cwhooks.v1.Msg/RegisterGovernance",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterGovernance(ctx, req.(*MsgRegisterGovernance))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterStakingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UnregisterGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterGovernance)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterGovernance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.cwhooks.v1.Msg/UnregisterGovernance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterGovernance(ctx, req.(*MsgUnregisterGovernance))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterGovernance) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UnjailContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailContract)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailContract(ctx, in)
 	}
-	l = len(m.RegisterAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.cwhooks.v1.Msg/UnjailContract",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailContract(ctx, req.(*MsgUnjailContract))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRegisterGovernanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterDistribution)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.cwhooks.v1.Msg/RegisterDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterDistribution(ctx, req.(*MsgRegisterDistribution))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUnregisterGovernance) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UnregisterDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterDistribution)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterDistribution(ctx, in)
 	}
-	l = len(m.RegisterAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.cwhooks.v1.Msg/UnregisterDistribution",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterDistribution(ctx, req.(*MsgUnregisterDistribution))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUnregisterGovernanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RegisterSlashing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSlashing)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSlashing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.cwhooks.v1.Msg/RegisterSlashing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSlashing(ctx, req.(*MsgRegisterSlashing))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUnregisterStaking) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UnregisterSlashing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterSlashing)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterSlashing(ctx, in)
 	}
-	l = len(m.RegisterAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.cwhooks.v1.Msg/UnregisterSlashing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterSlashing(ctx, req.(*MsgUnregisterSlashing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterBank)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.cwhooks.v1.Msg/RegisterBank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterBank(ctx, req.(*MsgRegisterBank))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnregisterBank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnregisterBank)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnregisterBank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.cwhooks.v1.Msg/UnregisterBank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnregisterBank(ctx, req.(*MsgUnregisterBank))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "juno.cwhooks.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterStaking",
+			Handler:    _Msg_RegisterStaking_Handler,
+		},
+		{
+			MethodName: "UnregisterStaking",
+			Handler:    _Msg_UnregisterStaking_Handler,
+		},
+		{
+			MethodName: "RegisterGovernance",
+			Handler:    _Msg_RegisterGovernance_Handler,
+		},
+		{
+			MethodName: "UnregisterGovernance",
+			Handler:    _Msg_UnregisterGovernance_Handler,
+		},
+		{
+			MethodName: "UnjailContract",
+			Handler:    _Msg_UnjailContract_Handler,
+		},
+		{
+			MethodName: "RegisterDistribution",
+			Handler:    _Msg_RegisterDistribution_Handler,
+		},
+		{
+			MethodName: "UnregisterDistribution",
+			Handler:    _Msg_UnregisterDistribution_Handler,
+		},
+		{
+			MethodName: "RegisterSlashing",
+			Handler:    _Msg_RegisterSlashing_Handler,
+		},
+		{
+			MethodName: "UnregisterSlashing",
+			Handler:    _Msg_UnregisterSlashing_Handler,
+		},
+		{
+			MethodName: "RegisterBank",
+			Handler:    _Msg_RegisterBank_Handler,
+		},
+		{
+			MethodName: "UnregisterBank",
+			Handler:    _Msg_UnregisterBank_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "juno/cwhooks/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterStaking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterStaking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PayloadVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PayloadVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Deferred {
+		i--
+		if m.Deferred {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ValidatorAddresses) > 0 {
+		for iNdEx := len(m.ValidatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAddresses[iNdEx])
+			copy(dAtA[i:], m.ValidatorAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EventTypes) > 0 {
+		for iNdEx := len(m.EventTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EventTypes[iNdEx])
+			copy(dAtA[i:], m.EventTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.EventTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RegisterAddress) > 0 {
+		i -= len(m.RegisterAddress)
+		copy(dAtA[i:], m.RegisterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterStakingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterStakingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterStakingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterGovernance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterGovernance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterGovernance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PayloadVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PayloadVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Deferred {
+		i--
+		if m.Deferred {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ProposalIds) > 0 {
+		dAtA3 := make([]byte, len(m.ProposalIds)*10)
+		var j2 int
+		for _, num := range m.ProposalIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EventTypes) > 0 {
+		for iNdEx := len(m.EventTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EventTypes[iNdEx])
+			copy(dAtA[i:], m.EventTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.EventTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RegisterAddress) > 0 {
+		i -= len(m.RegisterAddress)
+		copy(dAtA[i:], m.RegisterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterGovernanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterGovernanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterGovernanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterGovernance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterGovernance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterGovernance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RegisterAddress) > 0 {
+		i -= len(m.RegisterAddress)
+		copy(dAtA[i:], m.RegisterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterGovernanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterGovernanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterGovernanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterStaking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterStaking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterStaking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RegisterAddress) > 0 {
+		i -= len(m.RegisterAddress)
+		copy(dAtA[i:], m.RegisterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterStakingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterStakingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterStakingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PayloadVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PayloadVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Deferred {
+		i--
+		if m.Deferred {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ValidatorAddresses) > 0 {
+		for iNdEx := len(m.ValidatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAddresses[iNdEx])
+			copy(dAtA[i:], m.ValidatorAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EventTypes) > 0 {
+		for iNdEx := len(m.EventTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EventTypes[iNdEx])
+			copy(dAtA[i:], m.EventTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.EventTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RegisterAddress) > 0 {
+		i -= len(m.RegisterAddress)
+		copy(dAtA[i:], m.RegisterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RegisterAddress) > 0 {
+		i -= len(m.RegisterAddress)
+		copy(dAtA[i:], m.RegisterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSlashing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSlashing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PayloadVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PayloadVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Deferred {
+		i--
+		if m.Deferred {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ValidatorAddresses) > 0 {
+		for iNdEx := len(m.ValidatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAddresses[iNdEx])
+			copy(dAtA[i:], m.ValidatorAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EventTypes) > 0 {
+		for iNdEx := len(m.EventTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EventTypes[iNdEx])
+			copy(dAtA[i:], m.EventTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.EventTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RegisterAddress) > 0 {
+		i -= len(m.RegisterAddress)
+		copy(dAtA[i:], m.RegisterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSlashingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSlashingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSlashingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterSlashing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterSlashing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RegisterAddress) > 0 {
+		i -= len(m.RegisterAddress)
+		copy(dAtA[i:], m.RegisterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterSlashingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterSlashingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterSlashingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterBank) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBank) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBank) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AfterSend {
+		i--
+		if m.AfterSend {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BeforeSend {
+		i--
+		if m.BeforeSend {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RegisterAddress) > 0 {
+		i -= len(m.RegisterAddress)
+		copy(dAtA[i:], m.RegisterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterBankResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBankResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBankResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterBank) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterBank) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterBank) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RegisterAddress) > 0 {
+		i -= len(m.RegisterAddress)
+		copy(dAtA[i:], m.RegisterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RegisterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnregisterBankResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnregisterBankResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnregisterBankResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RegisterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.EventTypes) > 0 {
+		for _, s := range m.EventTypes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ValidatorAddresses) > 0 {
+		for _, s := range m.ValidatorAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Deferred {
+		n += 2
+	}
+	if m.PayloadVersion != 0 {
+		n += 1 + sovTx(uint64(m.PayloadVersion))
+	}
+	return n
+}
+
+func (m *MsgRegisterStakingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterGovernance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RegisterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.EventTypes) > 0 {
+		for _, s := range m.EventTypes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ProposalIds) > 0 {
+		l = 0
+		for _, e := range m.ProposalIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.Deferred {
+		n += 2
+	}
+	if m.PayloadVersion != 0 {
+		n += 1 + sovTx(uint64(m.PayloadVersion))
+	}
+	return n
+}
+
+func (m *MsgRegisterGovernanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnregisterGovernance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RegisterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnregisterGovernanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnregisterStaking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RegisterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnregisterStakingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnjailContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RegisterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.EventTypes) > 0 {
+		for _, s := range m.EventTypes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ValidatorAddresses) > 0 {
+		for _, s := range m.ValidatorAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Deferred {
+		n += 2
+	}
+	if m.PayloadVersion != 0 {
+		n += 1 + sovTx(uint64(m.PayloadVersion))
+	}
+	return n
+}
+
+func (m *MsgRegisterDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnregisterDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RegisterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnregisterDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterSlashing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RegisterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.EventTypes) > 0 {
+		for _, s := range m.EventTypes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ValidatorAddresses) > 0 {
+		for _, s := range m.ValidatorAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Deferred {
+		n += 2
+	}
+	if m.PayloadVersion != 0 {
+		n += 1 + sovTx(uint64(m.PayloadVersion))
+	}
+	return n
+}
+
+func (m *MsgRegisterSlashingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnregisterSlashing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RegisterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnregisterSlashingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterBank) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RegisterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BeforeSend {
+		n += 2
+	}
+	if m.AfterSend {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRegisterBankResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnregisterBank) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RegisterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnregisterBankResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTypes = append(m.EventTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddresses = append(m.ValidatorAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deferred", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deferred = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadVersion", wireType)
+			}
+			m.PayloadVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterStakingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterStakingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterGovernance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterGovernance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterGovernance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTypes = append(m.EventTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProposalIds = append(m.ProposalIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ProposalIds) == 0 {
+					m.ProposalIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProposalIds = append(m.ProposalIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalIds", wireType)
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deferred", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deferred = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadVersion", wireType)
+			}
+			m.PayloadVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterGovernanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterGovernanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterGovernanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterGovernance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterGovernance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterGovernance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterGovernanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterGovernanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterGovernanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterStaking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterStaking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterStakingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterStakingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTypes = append(m.EventTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddresses = append(m.ValidatorAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deferred", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deferred = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadVersion", wireType)
+			}
+			m.PayloadVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUnregisterStakingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1183,15 +4427,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1219,13 +4463,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1235,24 +4479,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RegisterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1275,7 +4518,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1298,10 +4541,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1325,7 +4568,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterStaking) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterSlashing) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1348,10 +4591,10 @@ func (m *MsgRegisterStaking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterStaking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterSlashing: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1418,6 +4661,109 @@ func (m *MsgRegisterStaking) Unmarshal(dAtA []byte) error {
 			}
 			m.RegisterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTypes = append(m.EventTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddresses = append(m.ValidatorAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deferred", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deferred = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadVersion", wireType)
+			}
+			m.PayloadVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1439,7 +4785,7 @@ func (m *MsgRegisterStaking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterStakingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterSlashingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1462,10 +4808,10 @@ func (m *MsgRegisterStakingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterStakingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterSlashingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterSlashingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1489,7 +4835,7 @@ func (m *MsgRegisterStakingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterGovernance) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterSlashing) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1512,10 +4858,10 @@ func (m *MsgRegisterGovernance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterGovernance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterSlashing: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterGovernance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1603,7 +4949,7 @@ func (m *MsgRegisterGovernance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterGovernanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterSlashingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1626,10 +4972,10 @@ func (m *MsgRegisterGovernanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterGovernanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterSlashingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterGovernanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterSlashingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1653,7 +4999,7 @@ func (m *MsgRegisterGovernanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterGovernance) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterBank) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1676,10 +5022,10 @@ func (m *MsgUnregisterGovernance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterGovernance: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterBank: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterGovernance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterBank: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1746,6 +5092,78 @@ func (m *MsgUnregisterGovernance) Unmarshal(dAtA []byte) error {
 			}
 			m.RegisterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BeforeSend = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterSend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AfterSend = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1767,7 +5185,7 @@ func (m *MsgUnregisterGovernance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterGovernanceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterBankResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1790,10 +5208,10 @@ func (m *MsgUnregisterGovernanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterGovernanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterBankResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterGovernanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterBankResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1817,7 +5235,7 @@ func (m *MsgUnregisterGovernanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterStaking) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterBank) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1840,15 +5258,15 @@ func (m *MsgUnregisterStaking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterStaking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterBank: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterStaking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterBank: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1876,11 +5294,11 @@ func (m *MsgUnregisterStaking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.RegisterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1908,7 +5326,7 @@ func (m *MsgUnregisterStaking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegisterAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1931,7 +5349,7 @@ func (m *MsgUnregisterStaking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterStakingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnregisterBankResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1954,10 +5372,10 @@ func (m *MsgUnregisterStakingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterStakingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnregisterBankResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterStakingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnregisterBankResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
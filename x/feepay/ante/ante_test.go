@@ -0,0 +1,477 @@
+package ante_test
+
+import (
+	"fmt"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/stretchr/testify/suite"
+	protov2 "google.golang.org/protobuf/proto"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/CosmosContracts/juno/v29/testutil"
+	"github.com/CosmosContracts/juno/v29/x/feepay/ante"
+	feepayhelpers "github.com/CosmosContracts/juno/v29/x/feepay/helpers"
+	feepaykeeper "github.com/CosmosContracts/juno/v29/x/feepay/keeper"
+	feepaytypes "github.com/CosmosContracts/juno/v29/x/feepay/types"
+	globalfeetypes "github.com/CosmosContracts/juno/v29/x/globalfee/types"
+)
+
+// Define an empty ante handle
+var (
+	EmptyAnte = func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+)
+
+type AnteTestSuite struct {
+	testutil.KeeperTestHelper
+
+	bankKeeper   bankkeeper.Keeper
+	feepayKeeper feepaykeeper.Keeper
+}
+
+func (s *AnteTestSuite) SetupTest() {
+	s.Setup()
+	s.bankKeeper = s.App.AppKeepers.BankKeeper
+	s.feepayKeeper = s.App.AppKeepers.FeePayKeeper
+
+	// A gas price of 0.0025ujuno makes the fee of the mock tx 500ujuno
+	err := s.App.AppKeepers.GlobalFeeKeeper.SetParams(s.Ctx, globalfeetypes.Params{
+		MinimumGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ujuno", sdkmath.LegacyNewDecWithPrec(25, 4))),
+	})
+	s.Require().NoError(err)
+}
+
+func TestAnteSuite(t *testing.T) {
+	suite.Run(t, new(AnteTestSuite))
+}
+
+// newDeductFeeDecorator returns a FeePay decorator for a tx flagged as a FeePay transaction.
+func (s *AnteTestSuite) newDeductFeeDecorator(isFeePayTx *bool) ante.DeductFeeDecorator {
+	keepers := s.App.AppKeepers
+	return ante.NewDeductFeeDecorator(s.feepayKeeper, keepers.AccountKeeper, keepers.BankKeeper, keepers.FeeGrantKeeper, isFeePayTx)
+}
+
+// registerContract registers a mock contract with FeePay and funds its balance.
+func (s *AnteTestSuite) registerContract(balance, walletLimit uint64) sdk.AccAddress {
+	_, _, contract := testdata.KeyTestPubAddr()
+	balances := sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewIntFromUint64(balance)))
+	s.feepayKeeper.SetFeePayContract(s.Ctx, feepaytypes.FeePayContract{
+		ContractAddress: contract.String(),
+		Balances:        balances,
+		WalletLimit:     walletLimit,
+	})
+	s.FundModuleAcc(feepaytypes.ModuleName, balances)
+	return contract
+}
+
+func (s *AnteTestSuite) getBalance(contract sdk.AccAddress) uint64 {
+	fpc, err := s.feepayKeeper.GetContract(s.Ctx, contract.String())
+	s.Require().NoError(err)
+	return fpc.Balances.AmountOf("ujuno").Uint64()
+}
+
+func newExecuteMsg(sender, contract sdk.AccAddress) *wasmtypes.MsgExecuteContract {
+	return &wasmtypes.MsgExecuteContract{
+		Sender:   sender.String(),
+		Contract: contract.String(),
+		Msg:      []byte("{}"),
+	}
+}
+
+func (s *AnteTestSuite) TestMultiMessageFeePay() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1))))
+
+	contractA := s.registerContract(1_000, 5)
+	contractB := s.registerContract(1_000, 1)
+	_, _, unregistered := testdata.KeyTestPubAddr()
+
+	// Only txs executing registered contracts are FeePay txs
+	tx := NewMockTx(sender, newExecuteMsg(sender, contractA), newExecuteMsg(sender, contractB), newExecuteMsg(sender, contractA))
+	s.Require().True(feepayhelpers.IsValidFeePayTransaction(s.Ctx, s.feepayKeeper, tx))
+	s.Require().False(feepayhelpers.IsValidFeePayTransaction(s.Ctx, s.feepayKeeper, NewMockTx(sender, newExecuteMsg(sender, contractA), newExecuteMsg(sender, unregistered))))
+	s.Require().False(feepayhelpers.IsValidFeePayTransaction(s.Ctx, s.feepayKeeper, NewMockTx(sender, newExecuteMsg(sender, contractA), testdata.NewTestMsg(sender))))
+	s.Require().False(feepayhelpers.IsValidFeePayTransaction(s.Ctx, s.feepayKeeper, NewMockTx(sender)))
+
+	// The fee is split by the number of messages executing each contract
+	isFeePayTx := true
+	_, err := s.newDeductFeeDecorator(&isFeePayTx).AnteHandle(s.Ctx, tx, false, EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().True(isFeePayTx)
+
+	s.Require().Equal(uint64(1_000-334), s.getBalance(contractA))
+	s.Require().Equal(uint64(1_000-166), s.getBalance(contractB))
+
+	fpcA, err := s.feepayKeeper.GetContract(s.Ctx, contractA.String())
+	s.Require().NoError(err)
+	uses, err := s.feepayKeeper.GetContractUses(s.Ctx, fpcA, sender.String())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), uses)
+
+	// The wallet reached the limit of contract B, so no contract covers the fee
+	_, err = s.newDeductFeeDecorator(&isFeePayTx).AnteHandle(s.Ctx, tx, false, EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().False(isFeePayTx)
+
+	s.Require().Equal(uint64(1_000-334), s.getBalance(contractA))
+	s.Require().Equal(uint64(1_000-166), s.getBalance(contractB))
+	s.Require().Equal(sdkmath.NewInt(500), s.bankKeeper.GetBalance(s.Ctx, s.App.AppKeepers.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), "ujuno").Amount)
+}
+
+func (s *AnteTestSuite) TestAuthzFeePay() {
+	s.SetupTest()
+	_, _, granter := testdata.KeyTestPubAddr()
+	_, _, grantee := testdata.KeyTestPubAddr()
+	s.FundAcc(grantee, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1))))
+
+	contract := s.registerContract(1_000, 5)
+	_, _, unregistered := testdata.KeyTestPubAddr()
+
+	// Executes nested in authz messages are counted against the inner contract
+	authzMsg := authz.NewMsgExec(grantee, []sdk.Msg{newExecuteMsg(granter, contract)})
+	nestedAuthzMsg := authz.NewMsgExec(grantee, []sdk.Msg{&authzMsg})
+	tx := NewMockTx(grantee, &nestedAuthzMsg)
+	s.Require().True(feepayhelpers.IsValidFeePayTransaction(s.Ctx, s.feepayKeeper, tx))
+
+	isFeePayTx := true
+	_, err := s.newDeductFeeDecorator(&isFeePayTx).AnteHandle(s.Ctx, tx, false, EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().True(isFeePayTx)
+	s.Require().Equal(uint64(500), s.getBalance(contract))
+
+	fpc, err := s.feepayKeeper.GetContract(s.Ctx, contract.String())
+	s.Require().NoError(err)
+	uses, err := s.feepayKeeper.GetContractUses(s.Ctx, fpc, grantee.String())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), uses)
+
+	unregisteredMsg := authz.NewMsgExec(grantee, []sdk.Msg{newExecuteMsg(granter, unregistered)})
+	s.Require().False(feepayhelpers.IsValidFeePayTransaction(s.Ctx, s.feepayKeeper, NewMockTx(grantee, &unregisteredMsg)))
+
+	sendMsg := authz.NewMsgExec(grantee, []sdk.Msg{banktypes.NewMsgSend(granter, grantee, sdk.NewCoins())})
+	s.Require().False(feepayhelpers.IsValidFeePayTransaction(s.Ctx, s.feepayKeeper, NewMockTx(grantee, &sendMsg)))
+
+	// Received ICS20 packets are never FeePay txs, even if ibc-hooks executes a registered
+	// contract, as the packet is not proven yet
+	data := transfertypes.NewFungibleTokenPacketData("ujuno", "1", granter.String(), contract.String(), fmt.Sprintf(`{"wasm":{"contract":"%s","msg":{}}}`, contract))
+	recvPacket := &channeltypes.MsgRecvPacket{
+		Packet: channeltypes.Packet{Data: data.GetBytes()},
+		Signer: grantee.String(),
+	}
+	s.Require().False(feepayhelpers.IsValidFeePayTransaction(s.Ctx, s.feepayKeeper, NewMockTx(grantee, recvPacket)))
+}
+
+func (s *AnteTestSuite) TestEligibilityQueryFeePay() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1))))
+
+	contract := s.registerContract(1_000, 5)
+	fpc, err := s.feepayKeeper.GetContract(s.Ctx, contract.String())
+	s.Require().NoError(err)
+	fpc.EligibilityQuery = true
+	s.feepayKeeper.SetFeePayContract(s.Ctx, *fpc)
+
+	tx := NewMockTx(sender, newExecuteMsg(sender, contract))
+	s.Require().True(feepayhelpers.IsValidFeePayTransaction(s.Ctx, s.feepayKeeper, tx))
+
+	// The gas left of the transaction must cover the query gas limit, or the contract
+	// is not queried
+	ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(feepaytypes.DefaultEligibilityQueryGasLimit - 1))
+	isFeePayTx := true
+	_, err = s.newDeductFeeDecorator(&isFeePayTx).AnteHandle(ctx, tx, false, EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().False(isFeePayTx)
+	unqueriedGas := ctx.GasMeter().GasConsumed()
+
+	// The mock contract cannot answer the eligibility query, so it does not sponsor
+	// the wallet, and the query gas is consumed from the transaction gas
+	ctx = s.Ctx.WithGasMeter(storetypes.NewGasMeter(200_000))
+	isFeePayTx = true
+	_, err = s.newDeductFeeDecorator(&isFeePayTx).AnteHandle(ctx, tx, false, EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().False(isFeePayTx)
+	s.Require().Greater(ctx.GasMeter().GasConsumed(), unqueriedGas)
+
+	balance := s.getBalance(contract)
+	s.Require().Equal(uint64(1_000), balance)
+
+	fpc, err = s.feepayKeeper.GetContract(s.Ctx, contract.String())
+	s.Require().NoError(err)
+	uses, err := s.feepayKeeper.GetContractUses(s.Ctx, fpc, sender.String())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), uses)
+
+	// Without an eligibility query gas limit, the contract is not queried
+	params := s.feepayKeeper.GetParams(s.Ctx)
+	params.EligibilityQueryGasLimit = 0
+	s.Require().NoError(s.feepayKeeper.SetParams(s.Ctx, params))
+
+	isFeePayTx = true
+	_, err = s.newDeductFeeDecorator(&isFeePayTx).AnteHandle(s.Ctx, tx, false, EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().False(isFeePayTx)
+	s.Require().Equal(balance, s.getBalance(contract))
+}
+
+func (s *AnteTestSuite) TestMultiDenomFeePay() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1))))
+
+	// A gas price of 0.001uusdc makes the fee of the mock tx 200uusdc
+	err := s.App.AppKeepers.GlobalFeeKeeper.SetParams(s.Ctx, globalfeetypes.Params{
+		MinimumGasPrices: sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("ujuno", sdkmath.LegacyNewDecWithPrec(25, 4)),
+			sdk.NewDecCoinFromDec("uusdc", sdkmath.LegacyNewDecWithPrec(1, 3)),
+		),
+	})
+	s.Require().NoError(err)
+
+	_, _, contract := testdata.KeyTestPubAddr()
+	balances := sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(499)), sdk.NewCoin("uusdc", sdkmath.NewInt(300)))
+	s.feepayKeeper.SetFeePayContract(s.Ctx, feepaytypes.FeePayContract{
+		ContractAddress: contract.String(),
+		Balances:        balances,
+		WalletLimit:     5,
+	})
+	s.FundModuleAcc(feepaytypes.ModuleName, balances)
+
+	// The ujuno balance cannot cover the fee, so it is paid in uusdc
+	tx := NewMockTx(sender, newExecuteMsg(sender, contract))
+	isFeePayTx := true
+	_, err = s.newDeductFeeDecorator(&isFeePayTx).AnteHandle(s.Ctx, tx, false, EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().True(isFeePayTx)
+
+	fpc, err := s.feepayKeeper.GetContract(s.Ctx, contract.String())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(499)), sdk.NewCoin("uusdc", sdkmath.NewInt(100))), fpc.Balances)
+	s.Require().Equal(sdkmath.NewInt(200), s.bankKeeper.GetBalance(s.Ctx, s.App.AppKeepers.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), "uusdc").Amount)
+
+	// No funded denom covers the fee anymore
+	isFeePayTx = true
+	_, err = s.newDeductFeeDecorator(&isFeePayTx).AnteHandle(s.Ctx, tx, false, EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().False(isFeePayTx)
+}
+
+func (s *AnteTestSuite) TestSpendLimitFeePay() {
+	s.SetupTest()
+	_, _, walletA := testdata.KeyTestPubAddr()
+	_, _, walletB := testdata.KeyTestPubAddr()
+	_, _, walletC := testdata.KeyTestPubAddr()
+
+	// Each wallet may spend one 500ujuno fee, and all wallets two fees
+	contract := s.registerContract(10_000, 5)
+	fpc, err := s.feepayKeeper.GetContract(s.Ctx, contract.String())
+	s.Require().NoError(err)
+	fpc.WalletSpendLimit = sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(999)))
+	fpc.ContractSpendLimit = sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_000)))
+	s.feepayKeeper.SetFeePayContract(s.Ctx, *fpc)
+
+	handle := func(wallet sdk.AccAddress) bool {
+		isFeePayTx := true
+		_, err := s.newDeductFeeDecorator(&isFeePayTx).AnteHandle(s.Ctx, NewMockTx(wallet, newExecuteMsg(wallet, contract)), false, EmptyAnte)
+		s.Require().NoError(err)
+		return isFeePayTx
+	}
+
+	for _, wallet := range []sdk.AccAddress{walletA, walletB, walletC} {
+		s.FundAcc(wallet, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1))))
+	}
+
+	s.Require().True(handle(walletA))
+	s.Require().False(handle(walletA), "wallet spend limit")
+	s.Require().True(handle(walletB))
+	s.Require().False(handle(walletC), "contract spend limit")
+	s.Require().Equal(uint64(10_000-1_000), s.getBalance(contract))
+
+	res, err := feepaykeeper.NewQueryServerImpl(s.feepayKeeper).FeePaySpend(s.Ctx, &feepaytypes.QueryFeePaySpendRequest{
+		ContractAddress: contract.String(),
+		WalletAddress:   walletA.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(500))), res.WalletSpent)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_000))), res.ContractSpent)
+
+	// The spend resets with the limit window
+	fpc, err = s.feepayKeeper.GetContract(s.Ctx, contract.String())
+	s.Require().NoError(err)
+	fpc.LimitWindowBlocks = 10
+	s.feepayKeeper.SetFeePayContract(s.Ctx, *fpc)
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 10)
+
+	s.Require().True(handle(walletC))
+}
+
+func (s *AnteTestSuite) TestFeePaySimulate() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	contract := s.registerContract(600, 1)
+	queryServer := feepaykeeper.NewQueryServerImpl(s.feepayKeeper)
+
+	simulate := func(req *feepaytypes.QueryFeePaySimulateRequest) *feepaytypes.QueryFeePaySimulateResponse {
+		res, err := queryServer.FeePaySimulate(s.Ctx, req)
+		s.Require().NoError(err)
+		return res
+	}
+
+	// A single execution of the contract, with the gas of the mock tx
+	res := simulate(&feepaytypes.QueryFeePaySimulateRequest{
+		ContractAddress: contract.String(),
+		SenderAddress:   sender.String(),
+		Gas:             200000,
+	})
+	s.Require().True(res.Sponsored)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(500))), res.Fee)
+	s.Require().Empty(res.Reason)
+
+	// The simulation does not write any state
+	s.Require().Equal(uint64(600), s.getBalance(contract))
+	s.Require().True(simulate(&feepaytypes.QueryFeePaySimulateRequest{
+		ContractAddress: contract.String(),
+		SenderAddress:   sender.String(),
+		Gas:             200000,
+	}).Sponsored)
+
+	res = simulate(&feepaytypes.QueryFeePaySimulateRequest{
+		ContractAddress: contract.String(),
+		SenderAddress:   sender.String(),
+		Gas:             400000,
+	})
+	s.Require().False(res.Sponsored)
+	s.Require().Empty(res.Fee)
+	s.Require().Contains(res.Reason, feepaytypes.ErrContractNotEnoughFunds.Error())
+
+	// An unsigned tx, which matches the outcome of the ante handler
+	txBuilder := s.App.TxConfig().NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(newExecuteMsg(sender, contract)))
+	txBuilder.SetGasLimit(200000)
+	txBytes, err := s.App.TxConfig().TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	res = simulate(&feepaytypes.QueryFeePaySimulateRequest{TxBytes: txBytes})
+	s.Require().True(res.Sponsored)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(500))), res.Fee)
+
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1))))
+	isFeePayTx := true
+	_, err = s.newDeductFeeDecorator(&isFeePayTx).AnteHandle(s.Ctx, NewMockTx(sender, newExecuteMsg(sender, contract)), false, EmptyAnte)
+	s.Require().NoError(err)
+	s.Require().True(isFeePayTx)
+	s.Require().Equal(uint64(100), s.getBalance(contract))
+
+	res = simulate(&feepaytypes.QueryFeePaySimulateRequest{TxBytes: txBytes})
+	s.Require().False(res.Sponsored)
+	s.Require().Contains(res.Reason, feepaytypes.ErrWalletExceededUsageLimit.Error())
+
+	// Txs with a fee or other messages are not FeePay transactions
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(500))))
+	txBytes, err = s.App.TxConfig().TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+	s.Require().False(simulate(&feepaytypes.QueryFeePaySimulateRequest{TxBytes: txBytes}).Sponsored)
+
+	txBuilder = s.App.TxConfig().NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(newExecuteMsg(sender, contract), banktypes.NewMsgSend(sender, contract, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1))))))
+	txBytes, err = s.App.TxConfig().TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+	s.Require().False(simulate(&feepaytypes.QueryFeePaySimulateRequest{TxBytes: txBytes}).Sponsored)
+
+	// Unknown contracts are not sponsored
+	_, _, unregistered := testdata.KeyTestPubAddr()
+	s.Require().False(simulate(&feepaytypes.QueryFeePaySimulateRequest{
+		ContractAddress: unregistered.String(),
+		SenderAddress:   sender.String(),
+		Gas:             200000,
+	}).Sponsored)
+}
+
+func (s *AnteTestSuite) TestSplitFeePayFee() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, contractA := testdata.KeyTestPubAddr()
+	_, _, contractB := testdata.KeyTestPubAddr()
+	_, _, contractC := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		name         string
+		contracts    []sdk.AccAddress
+		fee          int64
+		expectedFees []int64
+	}{
+		{"single message", []sdk.AccAddress{contractA}, 500, []int64{500}},
+		{"same contract", []sdk.AccAddress{contractA, contractA}, 501, []int64{501}},
+		{"two contracts", []sdk.AccAddress{contractA, contractB}, 501, []int64{251, 250}},
+		{"by message count", []sdk.AccAddress{contractB, contractA, contractB}, 500, []int64{334, 166}},
+		{"three contracts", []sdk.AccAddress{contractA, contractB, contractC}, 100, []int64{34, 33, 33}},
+		{"zero fee", []sdk.AccAddress{contractA, contractB}, 0, []int64{0, 0}},
+	}
+
+	for _, tc := range testCases {
+		msgs := make([]*wasmtypes.MsgExecuteContract, 0, len(tc.contracts))
+		for _, contract := range tc.contracts {
+			msgs = append(msgs, newExecuteMsg(sender, contract))
+		}
+
+		shares := feepaytypes.SplitFeePayFee(msgs, sdkmath.NewInt(tc.fee))
+		s.Require().Len(shares, len(tc.expectedFees), tc.name)
+		for i, share := range shares {
+			s.Require().Equal(tc.expectedFees[i], share.Fee.Int64(), tc.name)
+		}
+	}
+}
+
+type MockTx struct {
+	feePayer sdk.AccAddress
+	msgs     []sdk.Msg
+}
+
+func NewMockTx(feePayer sdk.AccAddress, msgs ...sdk.Msg) MockTx {
+	return MockTx{
+		feePayer: feePayer,
+		msgs:     msgs,
+	}
+}
+
+func (MockTx) GetGas() uint64 {
+	return 200000
+}
+
+func (MockTx) GetFee() sdk.Coins {
+	return sdk.NewCoins()
+}
+
+func (tx MockTx) FeePayer() []byte {
+	return tx.feePayer
+}
+
+func (MockTx) FeeGranter() []byte {
+	return nil
+}
+
+func (tx MockTx) GetMsgs() []sdk.Msg {
+	return tx.msgs
+}
+
+func (MockTx) GetMsgsV2() ([]protov2.Message, error) {
+	return nil, nil
+}
+
+func (MockTx) ValidateBasic() error {
+	return nil
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"math"
 
-	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
-
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
@@ -18,7 +16,6 @@ import (
 
 	feepaykeeper "github.com/CosmosContracts/juno/v29/x/feepay/keeper"
 	feepaytypes "github.com/CosmosContracts/juno/v29/x/feepay/types"
-	globalfeekeeper "github.com/CosmosContracts/juno/v29/x/globalfee/keeper"
 )
 
 // DeductFeeDecorator deducts fees from the first signer of the tx
@@ -26,28 +23,24 @@ import (
 // Call next AnteHandler if fees successfully deducted
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 //
-// Additionally, the Deduct Fee ante is a fork of the SDK's DeductFeeDecorator. This decorator looks for single
-// message transactions with no provided fee. If they correspond to a registered FeePay Contract, the FeePay
-// module will cover the cost of the fee (if the balance permits).
+// Additionally, the Deduct Fee ante is a fork of the SDK's DeductFeeDecorator. This decorator looks for
+// transactions with no provided fee which only execute registered FeePay Contracts. The FeePay module will
+// then cover the cost of the fee from the contracts (if their balances permit).
 type DeductFeeDecorator struct {
-	feepayKeeper    feepaykeeper.Keeper
-	globalfeeKeeper globalfeekeeper.Keeper
-	accountKeeper   ante.AccountKeeper
-	bankKeeper      bankkeeper.Keeper
-	feegrantKeeper  ante.FeegrantKeeper
-	bondDenom       string
-	isFeePayTx      *bool
+	feepayKeeper   feepaykeeper.Keeper
+	accountKeeper  ante.AccountKeeper
+	bankKeeper     bankkeeper.Keeper
+	feegrantKeeper ante.FeegrantKeeper
+	isFeePayTx     *bool
 }
 
-func NewDeductFeeDecorator(fpk feepaykeeper.Keeper, gfk globalfeekeeper.Keeper, ak ante.AccountKeeper, bk bankkeeper.Keeper, fgk ante.FeegrantKeeper, bondDenom string, isFeePayTx *bool) DeductFeeDecorator {
+func NewDeductFeeDecorator(fpk feepaykeeper.Keeper, ak ante.AccountKeeper, bk bankkeeper.Keeper, fgk ante.FeegrantKeeper, isFeePayTx *bool) DeductFeeDecorator {
 	return DeductFeeDecorator{
-		feepayKeeper:    fpk,
-		globalfeeKeeper: gfk,
-		accountKeeper:   ak,
-		bankKeeper:      bk,
-		feegrantKeeper:  fgk,
-		bondDenom:       bondDenom,
-		isFeePayTx:      isFeePayTx,
+		feepayKeeper:   fpk,
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+		feegrantKeeper: fgk,
+		isFeePayTx:     isFeePayTx,
 	}
 }
 
@@ -161,63 +154,20 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	return nil
 }
 
-// Handle zero fee transactions for fee prepay module
+// Handle zero fee transactions for fee prepay module. The fee pay contracts executed by
+// the transaction cover its fee, see the ChargeFeePayFee method of the FeePay keeper.
 func (dfd DeductFeeDecorator) handleZeroFees(ctx sdk.Context, deductFeesFromAcc sdk.AccountI, tx sdk.Tx, _ sdk.Coins) error {
-	msg := tx.GetMsgs()[0]
-	cw := msg.(*wasmtypes.MsgExecuteContract)
-
-	// Get the fee pay contract
-	feepayContract, err := dfd.feepayKeeper.GetContract(ctx, cw.GetContract())
-	if err != nil {
-		return errorsmod.Wrapf(err, "error getting contract %s", cw.GetContract())
-	}
-
-	// Get the fee price in the chain denom
-	feePrice := sdk.DecCoin{}
-	for _, c := range dfd.globalfeeKeeper.GetParams(ctx).MinimumGasPrices {
-		if c.Denom == dfd.bondDenom {
-			feePrice = c
-		}
-	}
-
-	if feePrice == (sdk.DecCoin{}) {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "fee price not found for denom %s in globalfee keeper", dfd.bondDenom)
+	executeMsgs, ok := feepaytypes.GetFeePayExecuteMsgs(tx.GetMsgs())
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "FeePay transactions must only execute contracts")
 	}
 
 	// Get the tx gas
 	feeTx := tx.(sdk.FeeTx)
-	gas := sdkmath.LegacyNewDec(int64(feeTx.GetGas()))
-
-	requiredFee := feePrice.Amount.Mul(gas).Ceil().RoundInt()
-
-	// Check if wallet exceeded usage limit on contract
-	accBech32 := deductFeesFromAcc.GetAddress().String()
-	if dfd.feepayKeeper.HasWalletExceededUsageLimit(ctx, feepayContract, accBech32) {
-		return errorsmod.Wrapf(feepaytypes.ErrWalletExceededUsageLimit, "wallet has exceeded usage limit (%d)", feepayContract.WalletLimit)
-	}
-
-	// Check if the contract has enough funds to cover the fee
-	if !dfd.feepayKeeper.CanContractCoverFee(feepayContract, requiredFee.Uint64()) {
-		return errorsmod.Wrapf(feepaytypes.ErrContractNotEnoughFunds, "contract has insufficient funds; expected: %d, got: %d", requiredFee.Uint64(), feepayContract.Balance)
-	}
-
-	// Create an array of coins, storing the required fee
-	payment := sdk.NewCoins(sdk.NewCoin(feePrice.Denom, requiredFee))
-
-	// Cover the fees of the transaction, send from FeePay Module to FeeCollector Module
-	if err := dfd.bankKeeper.SendCoinsFromModuleToModule(ctx, feepaytypes.ModuleName, authtypes.FeeCollectorName, payment); err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "error transferring funds from FeePay to FeeCollector; %s", err)
-	}
-
-	// Deduct the fee from the contract balance
-	dfd.feepayKeeper.SetContractBalance(ctx, feepayContract, feepayContract.Balance-requiredFee.Uint64())
-
-	// Increment wallet usage
-	if err := dfd.feepayKeeper.IncrementContractUses(ctx, feepayContract, accBech32, 1); err != nil {
-		return errorsmod.Wrapf(err, "error incrementing contract uses")
-	}
+	gas := feeTx.GetGas()
 
-	return nil
+	_, err := dfd.feepayKeeper.ChargeFeePayFee(ctx, deductFeesFromAcc.GetAddress().String(), executeMsgs, gas)
+	return err
 }
 
 // DeductFees deducts fees from the given account.
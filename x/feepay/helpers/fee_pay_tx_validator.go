@@ -3,36 +3,33 @@ package helpers
 import (
 	"context"
 
-	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	feepaykeeper "github.com/CosmosContracts/juno/v29/x/feepay/keeper"
+	feepaytypes "github.com/CosmosContracts/juno/v29/x/feepay/types"
 )
 
 // Check if a transaction should be processed as a FeePay transaction.
-// A valid FeePay transaction has no fee and only 1 message which
-// executes a CW contract.
-//
-// TODO: Future allow for multiple msgs.
+// A valid FeePay transaction has no fee and only messages which
+// execute CW contracts registered with FeePay, directly or through authz.
 func IsValidFeePayTransaction(ctx context.Context, feePayKeeper feepaykeeper.Keeper, feeTx sdk.FeeTx) bool {
-	// Defaults to false
-	isValid := false
+	// Check if the fee pay module is enabled, and if fee is zero
+	if !feePayKeeper.GetParams(ctx).EnableFeepay || !feeTx.GetFee().IsZero() {
+		return false
+	}
 
-	// Check if the fee pay module is enabled
-	isEnabled := feePayKeeper.GetParams(ctx).EnableFeepay
+	// Check if all messages are CW contract executions
+	executeMsgs, ok := feepaytypes.GetFeePayExecuteMsgs(feeTx.GetMsgs())
+	if !ok {
+		return false
+	}
 
-	// Check if fee is zero, and tx has only 1 message for executing a contract
-	if isEnabled && feeTx.GetFee().IsZero() && len(feeTx.GetMsgs()) == 1 {
-		// Check if the message is a CW contract execution
-		if cw, ok := (feeTx.GetMsgs()[0]).(*wasmtypes.MsgExecuteContract); ok {
-			// Check if the contract is registered
-			if _, err := feePayKeeper.GetContract(ctx, cw.Contract); err == nil {
-				isValid = true
-			}
+	// Check if all contracts are registered
+	for _, cw := range executeMsgs {
+		if !feePayKeeper.IsContractRegistered(ctx, cw.Contract) {
+			return false
 		}
 	}
 
-	// Return if the tx is valid
-	return isValid
+	return true
 }
@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"time"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
-	"cosmossdk.io/math"
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
@@ -107,6 +111,18 @@ func (k Keeper) RegisterContract(ctx context.Context, rfp *types.MsgRegisterFeeP
 		return types.ErrInvalidWalletLimit
 	}
 
+	if err := types.ValidateLimitWindow(rfp.FeePayContract.LimitWindowBlocks, rfp.FeePayContract.LimitWindowDuration); err != nil {
+		return err
+	}
+
+	if err := types.ValidateSpendLimit(rfp.FeePayContract.WalletSpendLimit); err != nil {
+		return err
+	}
+
+	if err := types.ValidateSpendLimit(rfp.FeePayContract.ContractSpendLimit); err != nil {
+		return err
+	}
+
 	// Return false because the contract was already registered
 	if k.IsContractRegistered(ctx, rfp.FeePayContract.ContractAddress) {
 		return globalerrors.ErrContractAlreadyRegistered
@@ -130,6 +146,10 @@ func (k Keeper) RegisterContract(ctx context.Context, rfp *types.MsgRegisterFeeP
 		return err
 	}
 
+	// Contracts are registered without funds, the balances and shares only change by funding
+	rfp.FeePayContract.Balances = nil
+	rfp.FeePayContract.TotalShares = nil
+
 	k.SetFeePayContract(ctx, *rfp.FeePayContract)
 	return nil
 }
@@ -143,7 +163,7 @@ func (k Keeper) SetFeePayContract(ctx context.Context, feepay types.FeePayContra
 	contractsPrefix.Set(key, bz)
 }
 
-// Unregister contract (loop through usage store & remove all usage entries for contract)
+// Unregister contract (remove all usage entries for contract & refund the funders)
 func (k Keeper) UnregisterContract(ctx context.Context, rfp *types.MsgUnregisterFeePayContract) error {
 	if _, err := sdk.AccAddressFromBech32(rfp.SenderAddress); err != nil {
 		return err
@@ -180,15 +200,23 @@ func (k Keeper) UnregisterContract(ctx context.Context, rfp *types.MsgUnregister
 	prefixStore.Delete([]byte(rfp.ContractAddress))
 
 	// Remove all usage entries for contract
-	prefixStore = prefix.NewStore(store, StoreKeyContractUses)
-	iterator := storetypes.KVStorePrefixIterator(prefixStore, []byte(rfp.ContractAddress))
+	if err := k.WalletUsages.Clear(ctx, collections.NewPrefixedPairRange[string, string](rfp.ContractAddress)); err != nil {
+		return err
+	}
 
-	for ; iterator.Valid(); iterator.Next() {
-		store.Delete(iterator.Key())
+	// Remove the spend entry for contract
+	prefixStore = prefix.NewStore(store, StoreKeyContractSpend)
+	prefixStore.Delete([]byte(rfp.ContractAddress))
+
+	// Refund the funders their share, the rest is refunded to the contract manager
+	coins, err := k.refundFunders(ctx, contract)
+	if err != nil {
+		return err
 	}
 
-	// Calculate coins to refund
-	coins := sdk.NewCoins(sdk.NewCoin(k.bondDenom, math.NewIntFromUint64(contract.Balance)))
+	if coins.IsZero() {
+		return nil
+	}
 
 	// Default refund address to admin, fallback to creator
 	var refundAddr string
@@ -202,87 +230,245 @@ func (k Keeper) UnregisterContract(ctx context.Context, rfp *types.MsgUnregister
 	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(refundAddr), coins)
 }
 
-// Set the contract balance in the KV store
-func (k Keeper) SetContractBalance(ctx context.Context, fpc *types.FeePayContract, newBalance uint64) {
+// Set the contract balances in the KV store
+func (k Keeper) SetContractBalances(ctx context.Context, fpc *types.FeePayContract, newBalances sdk.Coins) {
 	// Get the existing contract in KV store
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	contractsPrefix := prefix.NewStore(store, StoreKeyContracts)
 
-	// Set new balance and save to KV store
-	fpc.Balance = newBalance
+	// Set new balances and save to KV store
+	fpc.Balances = newBalances
 	contractsPrefix.Set([]byte(fpc.ContractAddress), k.cdc.MustMarshal(fpc))
 }
 
+// Check if a denom has a global fee minimum gas price, and can therefore fund fee pay contracts
+func (k Keeper) IsFundableDenom(ctx context.Context, denom string) bool {
+	for _, price := range k.globalfeeKeeper.GetParams(ctx).MinimumGasPrices {
+		if price.Denom == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Fund an existing fee pay contract
 func (k Keeper) FundContract(ctx context.Context, fpc *types.FeePayContract, senderAddr sdk.AccAddress, coins sdk.Coins) error {
-	// Only transfer the bond denom
-	var transferCoin sdk.Coin
+	// Only transfer denoms the fees can be paid in
 	for _, c := range coins {
-		if c.Denom == k.bondDenom {
-			transferCoin = c
+		if !k.IsFundableDenom(ctx, c.Denom) {
+			return types.ErrInvalidJunoFundAmount.Wrapf("denom '%s' has no global fee minimum gas price", c.Denom)
 		}
 	}
 
-	// Ensure the transfer coin was set
-	if transferCoin == (sdk.Coin{}) {
-		return types.ErrInvalidJunoFundAmount.Wrapf("contract must be funded with '%s'", k.bondDenom)
-	}
-
 	// Transfer from sender to module
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, types.ModuleName, coins); err != nil {
 		return err
 	}
 
-	// Increment the fpc balance
-	k.SetContractBalance(ctx, fpc, fpc.Balance+transferCoin.Amount.Uint64())
-	return nil
+	// Shares of denoms which were spent entirely are worthless, burn them so they do not
+	// dilute the shares of the funder
+	if err := k.burnDepletedShares(ctx, fpc, coins); err != nil {
+		return err
+	}
+
+	// Mint the shares of the funder at the current ratio, then increment the fpc balances
+	shares := types.MintFunderShares(fpc.Balances, fpc.TotalShares, coins)
+	fpc.TotalShares = fpc.TotalShares.Add(shares...)
+	k.SetContractBalances(ctx, fpc, fpc.Balances.Add(coins...))
+	return k.AddFunderContribution(ctx, fpc.ContractAddress, senderAddr.String(), coins, shares)
 }
 
-// Check if a fee pay contract has a balance greater than or equal to the fee
-func (Keeper) CanContractCoverFee(fpc *types.FeePayContract, fee uint64) bool {
-	return fpc.Balance >= fee
+// Check if a fee pay contract has a balance greater than or equal to the fee, in the fee denom
+func (Keeper) CanContractCoverFee(fpc *types.FeePayContract, fee sdk.Coin) bool {
+	return fpc.Balances.AmountOf(fee.Denom).GTE(fee.Amount)
 }
 
-// Get the number of times a wallet has interacted with a fee pay contract (err only if contract not registered)
+// Get the number of times a wallet has interacted with a fee pay contract in the current
+// limit window (err only if contract not registered)
 func (k Keeper) GetContractUses(ctx context.Context, fpc *types.FeePayContract, walletAddress string) (uint64, error) {
-	// Get usage from store
-	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	contractUsesPrefix := prefix.NewStore(store, StoreKeyContractUses)
-	key := []byte(fpc.ContractAddress + "-" + walletAddress)
-	bz := contractUsesPrefix.Get(key)
-
-	var walletUsage types.FeePayWalletUsage
-	if err := k.cdc.Unmarshal(bz, &walletUsage); err != nil {
+	walletUsage, err := k.getWalletUsage(ctx, fpc, walletAddress)
+	if err != nil {
 		return 0, err
 	}
 
 	return walletUsage.Uses, nil
 }
 
+// Get the usage of a wallet on a fee pay contract in the current limit window
+func (k Keeper) getWalletUsage(ctx context.Context, fpc *types.FeePayContract, walletAddress string) (types.FeePayWalletUsage, error) {
+	walletUsage, err := k.WalletUsages.Get(ctx, collections.Join(fpc.ContractAddress, walletAddress))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.FeePayWalletUsage{}, err
+	}
+
+	// Uses and fees from a previous window have been reset
+	if window := k.getLimitWindow(ctx, fpc); walletUsage.Window != window {
+		walletUsage = types.FeePayWalletUsage{Window: window}
+	}
+
+	walletUsage.ContractAddress = fpc.ContractAddress
+	walletUsage.WalletAddress = walletAddress
+	return walletUsage, nil
+}
+
+// Set the usage of a wallet on a fee pay contract
+func (k Keeper) SetWalletUsage(ctx context.Context, walletUsage types.FeePayWalletUsage) error {
+	return k.WalletUsages.Set(ctx, collections.Join(walletUsage.ContractAddress, walletUsage.WalletAddress), walletUsage)
+}
+
+// Get the stored usage of every wallet on every fee pay contract
+func (k Keeper) GetAllWalletUsages(ctx context.Context) ([]types.FeePayWalletUsage, error) {
+	iterator, err := k.WalletUsages.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iterator.Values()
+}
+
+// Get the stored usage of the wallets on a fee pay contract, paginated
+func (k Keeper) GetWalletUsages(ctx context.Context, contractAddress string, pag *query.PageRequest) (*types.QueryFeePayWalletUsagesResponse, error) {
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.WalletUsages,
+		pag,
+		func(_ collections.Pair[string, string], value types.FeePayWalletUsage) (types.FeePayWalletUsage, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](contractAddress),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFeePayWalletUsagesResponse{
+		WalletUsages: results,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// Get the current wallet limit window of a fee pay contract
+func (Keeper) getLimitWindow(ctx context.Context, fpc *types.FeePayContract) uint64 {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return fpc.LimitWindow(sdkCtx.BlockHeight(), sdkCtx.BlockTime())
+}
+
 // Set the number of times a wallet has interacted with a fee pay contract
 func (k Keeper) IncrementContractUses(ctx context.Context, fpc *types.FeePayContract, walletAddress string, increment uint64) error {
-	uses, err := k.GetContractUses(ctx, fpc, walletAddress)
+	walletUsage, err := k.getWalletUsage(ctx, fpc, walletAddress)
 	if err != nil {
 		return err
 	}
 
-	// Get store, key, & value for setting usage
+	walletUsage.Uses += increment
+	return k.SetWalletUsage(ctx, walletUsage)
+}
+
+// Get the fees a fee pay contract has sponsored for a wallet in the current limit window
+func (k Keeper) GetWalletSpent(ctx context.Context, fpc *types.FeePayContract, walletAddress string) (sdk.Coins, error) {
+	walletUsage, err := k.getWalletUsage(ctx, fpc, walletAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return walletUsage.Spent, nil
+}
+
+// Get the fees a fee pay contract has sponsored for all wallets in the current limit window
+func (k Keeper) GetContractSpent(ctx context.Context, fpc *types.FeePayContract) (sdk.Coins, error) {
+	contractSpend, err := k.getContractSpend(ctx, fpc)
+	if err != nil {
+		return nil, err
+	}
+
+	return contractSpend.Spent, nil
+}
+
+// Get the spend of a fee pay contract in the current limit window
+func (k Keeper) getContractSpend(ctx context.Context, fpc *types.FeePayContract) (types.FeePayContractSpend, error) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	contractUsesPrefix := prefix.NewStore(store, StoreKeyContractUses)
-	key := []byte(fpc.ContractAddress + "-" + walletAddress)
-	bz, err := k.cdc.Marshal(&types.FeePayWalletUsage{
-		ContractAddress: fpc.ContractAddress,
-		WalletAddress:   walletAddress,
-		Uses:            uses + increment,
-	})
+	contractSpendPrefix := prefix.NewStore(store, StoreKeyContractSpend)
+	bz := contractSpendPrefix.Get([]byte(fpc.ContractAddress))
+
+	var contractSpend types.FeePayContractSpend
+	if err := k.cdc.Unmarshal(bz, &contractSpend); err != nil {
+		return types.FeePayContractSpend{}, err
+	}
+
+	// Fees from a previous window have been reset
+	if window := k.getLimitWindow(ctx, fpc); contractSpend.Window != window {
+		contractSpend = types.FeePayContractSpend{Window: window}
+	}
+
+	contractSpend.ContractAddress = fpc.ContractAddress
+	return contractSpend, nil
+}
+
+// Add a fee sponsored for a wallet to the spend of the wallet and of the fee pay contract
+func (k Keeper) AddContractSpend(ctx context.Context, fpc *types.FeePayContract, walletAddress string, fee sdk.Coin) error {
+	walletUsage, err := k.getWalletUsage(ctx, fpc, walletAddress)
+	if err != nil {
+		return err
+	}
+
+	walletUsage.Spent = walletUsage.Spent.Add(fee)
+	if err := k.SetWalletUsage(ctx, walletUsage); err != nil {
+		return err
+	}
+
+	contractSpend, err := k.getContractSpend(ctx, fpc)
 	if err != nil {
 		return err
 	}
 
-	contractUsesPrefix.Set(key, bz)
+	contractSpend.Spent = contractSpend.Spent.Add(fee)
+	k.SetContractSpend(ctx, contractSpend)
 	return nil
 }
 
+// Set the spend of a fee pay contract
+func (k Keeper) SetContractSpend(ctx context.Context, contractSpend types.FeePayContractSpend) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	contractSpendPrefix := prefix.NewStore(store, StoreKeyContractSpend)
+	contractSpendPrefix.Set([]byte(contractSpend.ContractAddress), k.cdc.MustMarshal(&contractSpend))
+}
+
+// Get the spend of every fee pay contract
+func (k Keeper) GetAllContractSpends(ctx context.Context) []types.FeePayContractSpend {
+	var contractSpends []types.FeePayContractSpend
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, StoreKeyContractSpend)
+	defer iterator.Close() //nolint:errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var c types.FeePayContractSpend
+		k.cdc.MustUnmarshal(iterator.Value(), &c)
+
+		contractSpends = append(contractSpends, c)
+	}
+
+	return contractSpends
+}
+
+// Check if a fee pay contract may sponsor the fee for a wallet without exceeding the
+// wallet or the contract spend limit (defaults to false if the spend cannot be read)
+func (k Keeper) CanSpendFee(ctx context.Context, fpc *types.FeePayContract, walletAddress string, fee sdk.Coin) bool {
+	walletSpent, err := k.GetWalletSpent(ctx, fpc, walletAddress)
+	if err != nil {
+		return false
+	}
+
+	contractSpent, err := k.GetContractSpent(ctx, fpc)
+	if err != nil {
+		return false
+	}
+
+	return types.WithinSpendLimit(fpc.WalletSpendLimit, walletSpent, fee) &&
+		types.WithinSpendLimit(fpc.ContractSpendLimit, contractSpent, fee)
+}
+
 // Check if a wallet exceeded usage limit (defaults to true if contract not registered)
 func (k Keeper) HasWalletExceededUsageLimit(ctx context.Context, fpc *types.FeePayContract, walletAddress string) bool {
 	// Get account uses
@@ -295,8 +481,66 @@ func (k Keeper) HasWalletExceededUsageLimit(ctx context.Context, fpc *types.FeeP
 	return uses >= fpc.WalletLimit
 }
 
-// Update the wallet limit of an existing fee pay contract
-func (k Keeper) UpdateContractWalletLimit(ctx context.Context, fpc *types.FeePayContract, senderAddress string, walletLimit uint64) error {
+// Get the remaining allowance of a wallet on a fee pay contract in the current limit
+// window, and the height or time at which the window rolls over
+func (k Keeper) GetWalletAllowance(ctx context.Context, fpc *types.FeePayContract, walletAddress string) (*types.QueryFeePayWalletAllowanceResponse, error) {
+	uses, err := k.GetContractUses(ctx, fpc, walletAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	var remaining uint64
+	if uses < fpc.WalletLimit {
+		remaining = fpc.WalletLimit - uses
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	resetHeight, resetTime := fpc.LimitWindowReset(sdkCtx.BlockHeight(), sdkCtx.BlockTime())
+
+	return &types.QueryFeePayWalletAllowanceResponse{
+		Uses:        uses,
+		Remaining:   remaining,
+		ResetHeight: resetHeight,
+		ResetTime:   resetTime,
+	}, nil
+}
+
+// Update the wallet limit and limit window of an existing fee pay contract. The contract
+// can always update its own wallet limit.
+func (k Keeper) UpdateContractWalletLimit(ctx context.Context, fpc *types.FeePayContract, senderAddress string, walletLimit uint64, windowBlocks uint64, windowDuration time.Duration) error {
+	// Check if a cw contract
+	contractAddr, err := sdk.AccAddressFromBech32(fpc.ContractAddress)
+	if err != nil {
+		return err
+	}
+
+	if ok := k.wasmKeeper.HasContractInfo(ctx, contractAddr); !ok {
+		return globalerrors.ErrInvalidCWContract
+	}
+
+	// Get the contract info & ensure sender is the manager, unless the contract
+	// updates itself
+	if senderAddress != fpc.ContractAddress {
+		contractInfo := k.wasmKeeper.GetContractInfo(ctx, contractAddr)
+
+		if ok, err := k.IsContractManager(senderAddress, contractInfo); !ok {
+			return err
+		}
+	}
+
+	// Update the store with the new limit
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	contractsPrefix := prefix.NewStore(store, StoreKeyContracts)
+	fpc.WalletLimit = walletLimit
+	fpc.LimitWindowBlocks = windowBlocks
+	fpc.LimitWindowDuration = windowDuration
+	contractsPrefix.Set([]byte(fpc.ContractAddress), k.cdc.MustMarshal(fpc))
+
+	return nil
+}
+
+// Update whether a fee pay contract decides which wallets are sponsored
+func (k Keeper) UpdateContractEligibility(ctx context.Context, fpc *types.FeePayContract, senderAddress string, eligibilityQuery bool) error {
 	// Check if a cw contract
 	contractAddr, err := sdk.AccAddressFromBech32(fpc.ContractAddress)
 	if err != nil {
@@ -314,15 +558,85 @@ func (k Keeper) UpdateContractWalletLimit(ctx context.Context, fpc *types.FeePay
 		return err
 	}
 
-	// Update the store with the new limit
+	// Update the store with the new eligibility mode
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	contractsPrefix := prefix.NewStore(store, StoreKeyContracts)
-	fpc.WalletLimit = walletLimit
+	fpc.EligibilityQuery = eligibilityQuery
 	contractsPrefix.Set([]byte(fpc.ContractAddress), k.cdc.MustMarshal(fpc))
 
 	return nil
 }
 
+// Update the fee spend limits of an existing fee pay contract
+func (k Keeper) UpdateContractSpendLimit(ctx context.Context, fpc *types.FeePayContract, senderAddress string, walletSpendLimit, contractSpendLimit sdk.Coins) error {
+	// Check if a cw contract
+	contractAddr, err := sdk.AccAddressFromBech32(fpc.ContractAddress)
+	if err != nil {
+		return err
+	}
+
+	if ok := k.wasmKeeper.HasContractInfo(ctx, contractAddr); !ok {
+		return globalerrors.ErrInvalidCWContract
+	}
+
+	// Get the contract info & ensure sender is the manager
+	contractInfo := k.wasmKeeper.GetContractInfo(ctx, contractAddr)
+
+	if ok, err := k.IsContractManager(senderAddress, contractInfo); !ok {
+		return err
+	}
+
+	// Update the store with the new spend limits
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	contractsPrefix := prefix.NewStore(store, StoreKeyContracts)
+	fpc.WalletSpendLimit = walletSpendLimit
+	fpc.ContractSpendLimit = contractSpendLimit
+	contractsPrefix.Set([]byte(fpc.ContractAddress), k.cdc.MustMarshal(fpc))
+
+	return nil
+}
+
+// Query a fee pay contract for whether it sponsors the fee of the executions for the
+// wallet. The smart query is capped by the eligibility query gas limit of the module.
+// Returns the gas used by the query, and an error if the wallet is not sponsored.
+func (k Keeper) QueryWalletEligibility(ctx context.Context, fpc *types.FeePayContract, walletAddress string, executeMsgs []*wasmtypes.MsgExecuteContract) (uint64, error) {
+	gasLimit := k.GetParams(ctx).EligibilityQueryGasLimit
+	if gasLimit == 0 {
+		return 0, errorsmod.Wrap(types.ErrWalletNotEligible, "eligibility queries are disabled")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(fpc.ContractAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	reqBz, err := types.NewEligibilityQueryMessage(walletAddress, executeMsgs)
+	if err != nil {
+		return 0, err
+	}
+
+	// Query the contract with its own gas meter
+	gasLimitCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	var resBz []byte
+	globalerrors.QueryContract(k.wasmKeeper, gasLimitCtx, contractAddr, reqBz, &resBz, &err)
+	gasUsed := gasLimitCtx.GasMeter().GasConsumedToLimit()
+	if err != nil {
+		return gasUsed, errorsmod.Wrapf(types.ErrWalletNotEligible, "eligibility query of contract %s failed: %s", fpc.ContractAddress, err)
+	}
+
+	var res types.EligibilityResponse
+	if err := json.Unmarshal(resBz, &res); err != nil {
+		return gasUsed, errorsmod.Wrapf(types.ErrWalletNotEligible, "invalid eligibility response of contract %s: %s", fpc.ContractAddress, err)
+	}
+
+	if !res.Eligible {
+		return gasUsed, errorsmod.Wrapf(types.ErrWalletNotEligible, "contract %s does not sponsor wallet %s", fpc.ContractAddress, walletAddress)
+	}
+
+	return gasUsed, nil
+}
+
 // Check if a wallet is eligible to interact with a contract
 func (k Keeper) IsWalletEligible(ctx context.Context, fpc *types.FeePayContract, walletAddress string) (bool, error) {
 	// Check if wallet has exceeded usage limit
@@ -330,6 +644,13 @@ func (k Keeper) IsWalletEligible(ctx context.Context, fpc *types.FeePayContract,
 		return false, types.ErrWalletExceededUsageLimit
 	}
 
+	// Let the contract decide if the wallet is eligible, without messages
+	if fpc.EligibilityQuery {
+		if _, err := k.QueryWalletEligibility(ctx, fpc, walletAddress, nil); err != nil {
+			return false, err
+		}
+	}
+
 	return true, nil
 }
 
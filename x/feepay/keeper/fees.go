@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"context"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CosmosContracts/juno/v29/x/feepay/types"
+)
+
+// Pay the fee of a FeePay transaction from the fee pay contracts it executes. The fee is
+// split between the executed contracts, and every contract must be able to cover its
+// share for the wallet before any fee is deducted. Each contract pays its share in the
+// first denom of the global fee minimum gas prices it holds enough funds of and may spend
+// within its fee spend limits. Returns the fee paid to the fee collector.
+func (k Keeper) ChargeFeePayFee(ctx context.Context, walletAddress string, executeMsgs []*wasmtypes.MsgExecuteContract, gas uint64) (sdk.Coins, error) {
+	// Get the fee prices the fee can be paid in
+	feePrices := k.globalfeeKeeper.GetParams(ctx).MinimumGasPrices
+	if len(feePrices) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "no fee price found in globalfee keeper")
+	}
+
+	// Split the fee in every denom between the executed contracts
+	denomShares := make([][]types.FeePayShare, len(feePrices))
+	for i, feePrice := range feePrices {
+		denomShares[i] = types.SplitFeePayFee(executeMsgs, gasFee(feePrice, gas).Amount)
+	}
+
+	shares := denomShares[0]
+	feepayContracts := make([]*types.FeePayContract, 0, len(shares))
+	fees := make([]sdk.Coin, 0, len(shares))
+
+	for i, share := range shares {
+		// Get the fee pay contract
+		feepayContract, err := k.GetContract(ctx, share.ContractAddress)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "error getting contract %s", share.ContractAddress)
+		}
+
+		// Check if wallet exceeded usage limit on contract
+		if k.HasWalletExceededUsageLimit(ctx, feepayContract, walletAddress) {
+			return nil, errorsmod.Wrapf(types.ErrWalletExceededUsageLimit, "wallet has exceeded usage limit (%d) of contract %s", feepayContract.WalletLimit, share.ContractAddress)
+		}
+
+		// Find the first denom the contract can cover its share of the fee in, without
+		// exceeding its fee spend limits
+		priceIndex := -1
+		overSpendLimit := false
+		for j, feePrice := range feePrices {
+			fee := sdk.NewCoin(feePrice.Denom, denomShares[j][i].Fee)
+			if !k.CanContractCoverFee(feepayContract, fee) {
+				continue
+			}
+
+			if !k.CanSpendFee(ctx, feepayContract, walletAddress, fee) {
+				overSpendLimit = true
+				continue
+			}
+
+			priceIndex = j
+			break
+		}
+
+		if priceIndex < 0 && overSpendLimit {
+			return nil, errorsmod.Wrapf(types.ErrSpendLimitExceeded, "contract %s cannot sponsor its share of the fee within its spend limits", share.ContractAddress)
+		}
+
+		if priceIndex < 0 {
+			return nil, errorsmod.Wrapf(types.ErrContractNotEnoughFunds, "contract %s has insufficient funds to cover its share of the fee in any denom; got: %s", share.ContractAddress, feepayContract.Balances)
+		}
+
+		// Let the contract decide if the wallet is sponsored
+		if feepayContract.EligibilityQuery {
+			if err := k.checkContractEligibility(ctx, feepayContract, walletAddress, executeMsgs); err != nil {
+				return nil, err
+			}
+		}
+
+		feepayContracts = append(feepayContracts, feepayContract)
+		fees = append(fees, sdk.NewCoin(feePrices[priceIndex].Denom, denomShares[priceIndex][i].Fee))
+	}
+
+	// Create an array of coins, storing the required fee
+	payment := sdk.NewCoins().Add(fees...)
+
+	// Cover the fees of the transaction, send from FeePay Module to FeeCollector Module
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, payment); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "error transferring funds from FeePay to FeeCollector; %s", err)
+	}
+
+	for i, feepayContract := range feepayContracts {
+		// Deduct the share of the fee from the contract balances
+		k.SetContractBalances(ctx, feepayContract, feepayContract.Balances.Sub(fees[i]))
+
+		// Increment wallet usage, once per contract
+		if err := k.IncrementContractUses(ctx, feepayContract, walletAddress, 1); err != nil {
+			return nil, errorsmod.Wrapf(err, "error incrementing contract uses")
+		}
+
+		// Add the share of the fee to the wallet and contract spend
+		if err := k.AddContractSpend(ctx, feepayContract, walletAddress, fees[i]); err != nil {
+			return nil, errorsmod.Wrapf(err, "error adding contract spend")
+		}
+	}
+
+	return payment, nil
+}
+
+// Simulate whether FeePay sponsors the fee of the executions for the wallet, without
+// writing any state. Runs the same checks as FeePay transactions. Returns the fee which
+// would be paid to the fee collector, and the reason if the fee is not sponsored.
+func (k Keeper) SimulateFeePayFee(ctx context.Context, walletAddress string, executeMsgs []*wasmtypes.MsgExecuteContract, gas uint64) (sdk.Coins, error) {
+	if !k.GetParams(ctx).EnableFeepay {
+		return nil, types.ErrFeePayDisabled
+	}
+
+	// Charge the fee in a cached context which is never written, with the gas of the
+	// transaction to cover the eligibility queries
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	return k.ChargeFeePayFee(cacheCtx.WithGasMeter(storetypes.NewGasMeter(gas)), walletAddress, executeMsgs, gas)
+}
+
+// Simulate whether FeePay sponsors the fee of an encoded transaction, which does not
+// need to be signed. The fee is charged to the fee granter if set, otherwise the fee
+// payer, and the gas is the gas limit of the transaction.
+func (k Keeper) SimulateFeePayTx(ctx context.Context, txBytes []byte) (sdk.Coins, error) {
+	tx, err := authtx.DefaultTxDecoder(k.cdc)(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if !feeTx.GetFee().IsZero() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "FeePay transactions must not provide a fee")
+	}
+
+	executeMsgs, ok := types.GetFeePayExecuteMsgs(tx.GetMsgs())
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "FeePay transactions must only execute contracts")
+	}
+
+	wallet := feeTx.FeePayer()
+	if granter := feeTx.FeeGranter(); granter != nil {
+		wallet = granter
+	}
+
+	return k.SimulateFeePayFee(ctx, sdk.AccAddress(wallet).String(), executeMsgs, feeTx.GetGas())
+}
+
+// checkContractEligibility queries the contract for whether it sponsors the wallet. The
+// gas left of the transaction must cover the query gas limit before the contract is
+// queried, and the query gas is consumed on the gas meter of the transaction, so it is
+// paid by the fee of the transaction and counts against the block gas even when the
+// contract does not sponsor the wallet.
+func (k Keeper) checkContractEligibility(ctx context.Context, fpc *types.FeePayContract, walletAddress string, executeMsgs []*wasmtypes.MsgExecuteContract) error {
+	gasMeter := sdk.UnwrapSDKContext(ctx).GasMeter()
+	if gasLimit := k.GetParams(ctx).EligibilityQueryGasLimit; gasMeter.GasRemaining() < gasLimit {
+		return errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "transaction gas left %d cannot cover the eligibility query gas limit %d of contract %s", gasMeter.GasRemaining(), gasLimit, fpc.ContractAddress)
+	}
+
+	gasUsed, err := k.QueryWalletEligibility(ctx, fpc, walletAddress, executeMsgs)
+	gasMeter.ConsumeGas(gasUsed, "feepay eligibility query")
+	return err
+}
+
+// gasFee returns the fee of the given amount of gas at the fee price, rounded up.
+func gasFee(feePrice sdk.DecCoin, gas uint64) sdk.Coin {
+	return sdk.NewCoin(feePrice.Denom, feePrice.Amount.MulInt(sdkmath.NewIntFromUint64(gas)).Ceil().TruncateInt())
+}
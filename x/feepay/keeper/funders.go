@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmosContracts/juno/v29/x/feepay/types"
+)
+
+// Get the contribution of a funder to a fee pay contract
+func (k Keeper) GetFunder(ctx context.Context, contractAddress, funderAddress string) (types.FeePayFunder, error) {
+	funder, err := k.Funders.Get(ctx, collections.Join(contractAddress, funderAddress))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.FeePayFunder{}, errorsmod.Wrapf(types.ErrNotFunder, "%s has not funded contract %s", funderAddress, contractAddress)
+	}
+
+	return funder, err
+}
+
+// Set the contribution of a funder to a fee pay contract
+func (k Keeper) SetFunder(ctx context.Context, funder types.FeePayFunder) error {
+	return k.Funders.Set(ctx, collections.Join(funder.ContractAddress, funder.FunderAddress), funder)
+}
+
+// Add funds to the contribution of a funder to a fee pay contract, and the shares minted for them
+func (k Keeper) AddFunderContribution(ctx context.Context, contractAddress, funderAddress string, coins, shares sdk.Coins) error {
+	funder, err := k.Funders.Get(ctx, collections.Join(contractAddress, funderAddress))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	funder.ContractAddress = contractAddress
+	funder.FunderAddress = funderAddress
+	funder.Contributed = funder.Contributed.Add(coins...)
+	funder.Shares = funder.Shares.Add(shares...)
+	return k.SetFunder(ctx, funder)
+}
+
+// Get the contributions of every funder of a fee pay contract
+func (k Keeper) GetContractFunders(ctx context.Context, contractAddress string) ([]types.FeePayFunder, error) {
+	iterator, err := k.Funders.Iterate(ctx, collections.NewPrefixedPairRange[string, string](contractAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	return iterator.Values()
+}
+
+// Get the contributions of every funder of every fee pay contract
+func (k Keeper) GetAllFunders(ctx context.Context) ([]types.FeePayFunder, error) {
+	iterator, err := k.Funders.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iterator.Values()
+}
+
+// Get the contributions of the funders of a fee pay contract, paginated
+func (k Keeper) GetFunders(ctx context.Context, contractAddress string, pag *query.PageRequest) (*types.QueryFeePayFundersResponse, error) {
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Funders,
+		pag,
+		func(_ collections.Pair[string, string], value types.FeePayFunder) (types.FeePayFunder, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](contractAddress),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFeePayFundersResponse{
+		Funders:    results,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Get the share of a funder of the unspent funds of a fee pay contract
+func (k Keeper) GetFunderUnspent(ctx context.Context, fpc *types.FeePayContract, funderAddress string) (types.FeePayFunder, sdk.Coins, error) {
+	funder, err := k.GetFunder(ctx, fpc.ContractAddress, funderAddress)
+	if err != nil {
+		return types.FeePayFunder{}, nil, err
+	}
+
+	return funder, types.FunderShare(fpc.Balances, funder.Shares, fpc.TotalShares), nil
+}
+
+// Withdraw the share of a funder of the unspent funds of a fee pay contract, and burn
+// the shares of the funder. The funder no longer has a contribution to the contract
+// afterwards.
+func (k Keeper) WithdrawFunderShare(ctx context.Context, fpc *types.FeePayContract, funderAddress string) (sdk.Coins, error) {
+	funderAddr, err := sdk.AccAddressFromBech32(funderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	funder, unspent, err := k.GetFunderUnspent(ctx, fpc, funderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Funders.Remove(ctx, collections.Join(funder.ContractAddress, funder.FunderAddress)); err != nil {
+		return nil, err
+	}
+
+	if !unspent.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funderAddr, unspent); err != nil {
+			return nil, err
+		}
+	}
+
+	fpc.TotalShares = fpc.TotalShares.Sub(funder.Shares...)
+	k.SetContractBalances(ctx, fpc, fpc.Balances.Sub(unspent...))
+	return unspent, nil
+}
+
+// Burn the shares of every funder of a fee pay contract in the denoms of the coins which
+// have no balance left, as the fees spent all of the funds in the denom.
+func (k Keeper) burnDepletedShares(ctx context.Context, fpc *types.FeePayContract, coins sdk.Coins) error {
+	depleted := make(map[string]bool)
+	for _, c := range coins {
+		if fpc.Balances.AmountOf(c.Denom).IsZero() && fpc.TotalShares.AmountOf(c.Denom).IsPositive() {
+			depleted[c.Denom] = true
+		}
+	}
+
+	if len(depleted) == 0 {
+		return nil
+	}
+
+	funders, err := k.GetContractFunders(ctx, fpc.ContractAddress)
+	if err != nil {
+		return err
+	}
+
+	for _, funder := range funders {
+		burned := sdk.NewCoins()
+		for _, s := range funder.Shares {
+			if depleted[s.Denom] {
+				burned = burned.Add(s)
+			}
+		}
+
+		if burned.IsZero() {
+			continue
+		}
+
+		funder.Shares = funder.Shares.Sub(burned...)
+		fpc.TotalShares = fpc.TotalShares.Sub(burned...)
+		if err := k.SetFunder(ctx, funder); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Refund every funder of a fee pay contract their share of the unspent funds, and
+// remove their contributions. Returns the funds left after the refunds.
+func (k Keeper) refundFunders(ctx context.Context, fpc *types.FeePayContract) (sdk.Coins, error) {
+	funders, err := k.GetContractFunders(ctx, fpc.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every share is of the balances before any refund
+	remaining := fpc.Balances
+	for _, funder := range funders {
+		share := types.FunderShare(fpc.Balances, funder.Shares, fpc.TotalShares)
+		if share.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(funder.FunderAddress), share); err != nil {
+			return nil, err
+		}
+
+		remaining = remaining.Sub(share...)
+	}
+
+	if err := k.Funders.Clear(ctx, collections.NewPrefixedPairRange[string, string](fpc.ContractAddress)); err != nil {
+		return nil, err
+	}
+
+	return remaining, nil
+}
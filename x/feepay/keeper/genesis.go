@@ -16,9 +16,24 @@ func (k Keeper) InitGenesis(
 	}
 
 	for _, feepay := range data.FeePayContracts {
-		// TODO: future, add all wallet interactions for exports?
 		k.SetFeePayContract(ctx, feepay)
 	}
+
+	for _, walletUsage := range data.WalletUsages {
+		if err := k.SetWalletUsage(ctx, walletUsage); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, contractSpend := range data.ContractSpends {
+		k.SetContractSpend(ctx, contractSpend)
+	}
+
+	for _, funder := range data.Funders {
+		if err := k.SetFunder(ctx, funder); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis export module state
@@ -26,8 +41,21 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	params := k.GetParams(ctx)
 	contracts := k.GetAllContracts(ctx)
 
+	walletUsages, err := k.GetAllWalletUsages(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	funders, err := k.GetAllFunders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		Params:          params,
 		FeePayContracts: contracts,
+		WalletUsages:    walletUsages,
+		ContractSpends:  k.GetAllContractSpends(ctx),
+		Funders:         funders,
 	}
 }
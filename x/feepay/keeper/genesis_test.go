@@ -3,6 +3,11 @@ package keeper_test
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/CosmosContracts/juno/v29/x/feepay/types"
 )
 
@@ -38,3 +43,42 @@ func (s *KeeperTestSuite) TestFeeShareInitGenesis() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestFeePayExportGenesis() {
+	s.SetupTest()
+	k := s.App.AppKeepers.FeePayKeeper
+
+	_, _, contract := testdata.KeyTestPubAddr()
+	_, _, wallet := testdata.KeyTestPubAddr()
+	spent := sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(500)))
+
+	genesis := s.genesis
+	genesis.FeePayContracts = []types.FeePayContract{{ContractAddress: contract.String(), WalletLimit: 5, Balances: spent, TotalShares: spent}}
+	genesis.WalletUsages = []types.FeePayWalletUsage{{ContractAddress: contract.String(), WalletAddress: wallet.String(), Uses: 2, Spent: spent}}
+	genesis.ContractSpends = []types.FeePayContractSpend{{ContractAddress: contract.String(), Spent: spent}}
+	genesis.Funders = []types.FeePayFunder{{ContractAddress: contract.String(), FunderAddress: wallet.String(), Contributed: spent, Shares: spent}}
+	s.Require().NoError(genesis.Validate())
+
+	k.InitGenesis(s.Ctx, genesis)
+
+	// The usages are imported and exported with the contracts
+	fpc, err := k.GetContract(s.Ctx, contract.String())
+	s.Require().NoError(err)
+	uses, err := k.GetContractUses(s.Ctx, fpc, wallet.String())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), uses)
+
+	exported := k.ExportGenesis(s.Ctx)
+	s.Require().Equal(genesis.FeePayContracts, exported.FeePayContracts)
+	s.Require().Equal(genesis.WalletUsages, exported.WalletUsages)
+	s.Require().Equal(genesis.ContractSpends, exported.ContractSpends)
+	s.Require().Equal(genesis.Funders, exported.Funders)
+
+	// The shares of the funders must add up to the total shares of the contract
+	genesis.FeePayContracts = []types.FeePayContract{{ContractAddress: contract.String(), WalletLimit: 5}}
+	s.Require().Error(genesis.Validate())
+
+	// Usages of contracts missing from the genesis are invalid
+	genesis.FeePayContracts = nil
+	s.Require().Error(genesis.Validate())
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	globalerrors "github.com/CosmosContracts/juno/v29/app/helpers"
@@ -46,6 +48,64 @@ func (q queryServer) FeePayContracts(ctx context.Context, req *types.QueryFeePay
 	return res, nil
 }
 
+// FeePayWalletUsages implements types.QueryServer.
+func (q queryServer) FeePayWalletUsages(ctx context.Context, req *types.QueryFeePayWalletUsagesRequest) (*types.QueryFeePayWalletUsagesResponse, error) {
+	// Check if contract address are valid
+	if _, err := sdk.AccAddressFromBech32(req.ContractAddress); err != nil {
+		return nil, globalerrors.ErrInvalidAddress
+	}
+
+	// Ensure the contract is registered
+	if _, err := q.k.GetContract(ctx, req.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	return q.k.GetWalletUsages(ctx, req.ContractAddress, req.Pagination)
+}
+
+// FeePayFunders implements types.QueryServer.
+func (q queryServer) FeePayFunders(ctx context.Context, req *types.QueryFeePayFundersRequest) (*types.QueryFeePayFundersResponse, error) {
+	// Check if contract address are valid
+	if _, err := sdk.AccAddressFromBech32(req.ContractAddress); err != nil {
+		return nil, globalerrors.ErrInvalidAddress
+	}
+
+	// Ensure the contract is registered
+	if _, err := q.k.GetContract(ctx, req.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	return q.k.GetFunders(ctx, req.ContractAddress, req.Pagination)
+}
+
+// FeePayFunder implements types.QueryServer.
+func (q queryServer) FeePayFunder(ctx context.Context, req *types.QueryFeePayFunderRequest) (*types.QueryFeePayFunderResponse, error) {
+	// Check if funder & contract address are valid
+	if _, err := sdk.AccAddressFromBech32(req.ContractAddress); err != nil {
+		return nil, globalerrors.ErrInvalidAddress
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.FunderAddress); err != nil {
+		return nil, globalerrors.ErrInvalidAddress
+	}
+
+	// Get fee pay contract
+	fpc, err := q.k.GetContract(ctx, req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	funder, unspent, err := q.k.GetFunderUnspent(ctx, fpc, req.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFeePayFunderResponse{
+		Funder:  funder,
+		Unspent: unspent,
+	}, nil
+}
+
 // FeePayContractUses implements types.QueryServer.
 func (q queryServer) FeePayContractUses(ctx context.Context, req *types.QueryFeePayContractUsesRequest) (*types.QueryFeePayContractUsesResponse, error) {
 	// Check if wallet & contract address are valid
@@ -101,6 +161,100 @@ func (q queryServer) FeePayWalletIsEligible(ctx context.Context, req *types.Quer
 	}, nil
 }
 
+// FeePayWalletAllowance implements types.QueryServer.
+func (q queryServer) FeePayWalletAllowance(ctx context.Context, req *types.QueryFeePayWalletAllowanceRequest) (*types.QueryFeePayWalletAllowanceResponse, error) {
+	// Check if wallet & contract address are valid
+	if _, err := sdk.AccAddressFromBech32(req.ContractAddress); err != nil {
+		return nil, globalerrors.ErrInvalidAddress
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.WalletAddress); err != nil {
+		return nil, globalerrors.ErrInvalidAddress
+	}
+
+	// Get fee pay contract
+	fpc, err := q.k.GetContract(ctx, req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return q.k.GetWalletAllowance(ctx, fpc, req.WalletAddress)
+}
+
+// FeePaySpend implements types.QueryServer.
+func (q queryServer) FeePaySpend(ctx context.Context, req *types.QueryFeePaySpendRequest) (*types.QueryFeePaySpendResponse, error) {
+	// Check if wallet & contract address are valid
+	if _, err := sdk.AccAddressFromBech32(req.ContractAddress); err != nil {
+		return nil, globalerrors.ErrInvalidAddress
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.WalletAddress); err != nil {
+		return nil, globalerrors.ErrInvalidAddress
+	}
+
+	// Get fee pay contract
+	fpc, err := q.k.GetContract(ctx, req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	walletSpent, err := q.k.GetWalletSpent(ctx, fpc, req.WalletAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	contractSpent, err := q.k.GetContractSpent(ctx, fpc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFeePaySpendResponse{
+		WalletSpent:   walletSpent,
+		ContractSpent: contractSpent,
+	}, nil
+}
+
+// FeePaySimulate implements types.QueryServer.
+func (q queryServer) FeePaySimulate(ctx context.Context, req *types.QueryFeePaySimulateRequest) (*types.QueryFeePaySimulateResponse, error) {
+	var (
+		fee sdk.Coins
+		err error
+	)
+
+	// Simulate the encoded tx, or a single execution of the contract
+	if len(req.TxBytes) > 0 {
+		fee, err = q.k.SimulateFeePayTx(ctx, req.TxBytes)
+	} else {
+		// Check if sender & contract address are valid
+		if _, err := sdk.AccAddressFromBech32(req.ContractAddress); err != nil {
+			return nil, globalerrors.ErrInvalidAddress
+		}
+
+		if _, err := sdk.AccAddressFromBech32(req.SenderAddress); err != nil {
+			return nil, globalerrors.ErrInvalidAddress
+		}
+
+		executeMsgs := []*wasmtypes.MsgExecuteContract{{
+			Sender:   req.SenderAddress,
+			Contract: req.ContractAddress,
+		}}
+		fee, err = q.k.SimulateFeePayFee(ctx, req.SenderAddress, executeMsgs, req.Gas)
+	}
+
+	// The reason the fee is not sponsored is part of the response
+	if err != nil {
+		return &types.QueryFeePaySimulateResponse{
+			Sponsored: false,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return &types.QueryFeePaySimulateResponse{
+		Sponsored: true,
+		Fee:       fee,
+	}, nil
+}
+
 // Params returns the feepay module params
 func (q queryServer) Params(
 	c context.Context,
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -8,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/CosmosContracts/juno/v29/testutil/common/nullify"
+	"github.com/CosmosContracts/juno/v29/x/feepay/keeper"
 	"github.com/CosmosContracts/juno/v29/x/feepay/types"
 )
 
@@ -237,3 +240,166 @@ func (s *KeeperTestSuite) TestQueryUses() {
 		s.Require().Equal(uint64(0), res.Uses)
 	})
 }
+
+func (s *KeeperTestSuite) TestQueryWalletAllowance() {
+	// Get & fund creator
+	_, _, sender := testdata.KeyTestPubAddr()
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))))
+
+	// Instantiate the contractAddr
+	contractAddr := s.InstantiateContract(sender.String(), "", wasmContract)
+
+	// Register the fee pay contract
+	s.registerFeePayContract(sender.String(), contractAddr, 0, 2)
+
+	// Query the keeper directly, the query client does not follow the context height and time
+	queryServer := keeper.NewQueryServerImpl(s.App.AppKeepers.FeePayKeeper)
+	queryAllowance := func() *types.QueryFeePayWalletAllowanceResponse {
+		res, err := queryServer.FeePayWalletAllowance(s.Ctx, &types.QueryFeePayWalletAllowanceRequest{
+			ContractAddress: contractAddr,
+			WalletAddress:   sender.String(),
+		})
+		s.Require().NoError(err)
+		return res
+	}
+
+	useContract := func() {
+		fpc, err := s.App.AppKeepers.FeePayKeeper.GetContract(s.Ctx, contractAddr)
+		s.Require().NoError(err)
+		s.Require().NoError(s.App.AppKeepers.FeePayKeeper.IncrementContractUses(s.Ctx, fpc, sender.String(), 1))
+	}
+
+	s.Run("LifetimeLimit", func() {
+		useContract()
+		useContract()
+
+		// The lifetime limit never resets
+		res := queryAllowance()
+		s.Require().Equal(uint64(2), res.Uses)
+		s.Require().Equal(uint64(0), res.Remaining)
+		s.Require().Equal(int64(0), res.ResetHeight)
+		s.Require().True(res.ResetTime.IsZero())
+	})
+
+	s.Run("BlockWindow", func() {
+		_, err := s.msgServer.UpdateFeePayContractWalletLimit(s.Ctx, &types.MsgUpdateFeePayContractWalletLimit{
+			SenderAddress:     sender.String(),
+			ContractAddress:   contractAddr,
+			WalletLimit:       2,
+			LimitWindowBlocks: 10,
+		})
+		s.Require().NoError(err)
+
+		s.Ctx = s.Ctx.WithBlockHeight(25)
+		useContract()
+
+		res := queryAllowance()
+		s.Require().Equal(uint64(1), res.Uses)
+		s.Require().Equal(uint64(1), res.Remaining)
+		s.Require().Equal(int64(30), res.ResetHeight)
+		s.Require().True(res.ResetTime.IsZero())
+
+		// The uses reset when the window rolls over
+		s.Ctx = s.Ctx.WithBlockHeight(30)
+		res = queryAllowance()
+		s.Require().Equal(uint64(0), res.Uses)
+		s.Require().Equal(uint64(2), res.Remaining)
+		s.Require().Equal(int64(40), res.ResetHeight)
+	})
+
+	s.Run("TimeWindow", func() {
+		_, err := s.msgServer.UpdateFeePayContractWalletLimit(s.Ctx, &types.MsgUpdateFeePayContractWalletLimit{
+			SenderAddress:       sender.String(),
+			ContractAddress:     contractAddr,
+			WalletLimit:         2,
+			LimitWindowDuration: 24 * time.Hour,
+		})
+		s.Require().NoError(err)
+
+		s.Ctx = s.Ctx.WithBlockTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+		useContract()
+		useContract()
+
+		res := queryAllowance()
+		s.Require().Equal(uint64(2), res.Uses)
+		s.Require().Equal(uint64(0), res.Remaining)
+		s.Require().Equal(int64(0), res.ResetHeight)
+		s.Require().Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), res.ResetTime)
+
+		// The uses reset when the window rolls over
+		s.Ctx = s.Ctx.WithBlockTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+		res = queryAllowance()
+		s.Require().Equal(uint64(0), res.Uses)
+		s.Require().Equal(uint64(2), res.Remaining)
+		s.Require().Equal(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), res.ResetTime)
+	})
+}
+
+func (s *KeeperTestSuite) TestQueryWalletUsages() {
+	// Get & fund creator
+	_, _, sender := testdata.KeyTestPubAddr()
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))))
+
+	// Instantiate & register two fee pay contracts
+	contractAddr := s.InstantiateContract(sender.String(), "", wasmContract)
+	otherContractAddr := s.InstantiateContract(sender.String(), "", wasmContract)
+	s.registerFeePayContract(sender.String(), contractAddr, 0, 5)
+	s.registerFeePayContract(sender.String(), otherContractAddr, 0, 5)
+
+	k := s.App.AppKeepers.FeePayKeeper
+	fpc, err := k.GetContract(s.Ctx, contractAddr)
+	s.Require().NoError(err)
+	otherFpc, err := k.GetContract(s.Ctx, otherContractAddr)
+	s.Require().NoError(err)
+
+	// Use both contracts from several wallets
+	for i := 0; i < 3; i++ {
+		_, _, wallet := testdata.KeyTestPubAddr()
+		s.Require().NoError(k.IncrementContractUses(s.Ctx, fpc, wallet.String(), 1))
+		s.Require().NoError(k.IncrementContractUses(s.Ctx, otherFpc, wallet.String(), 1))
+	}
+
+	queryServer := keeper.NewQueryServerImpl(k)
+
+	// Only the usages of the contract are returned, page by page
+	res, err := queryServer.FeePayWalletUsages(s.Ctx, &types.QueryFeePayWalletUsagesRequest{
+		ContractAddress: contractAddr,
+		Pagination:      &query.PageRequest{Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.WalletUsages, 2)
+	s.Require().NotNil(res.Pagination.NextKey)
+
+	usages := res.WalletUsages
+	res, err = queryServer.FeePayWalletUsages(s.Ctx, &types.QueryFeePayWalletUsagesRequest{
+		ContractAddress: contractAddr,
+		Pagination:      &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.WalletUsages, 1)
+	s.Require().Nil(res.Pagination.NextKey)
+
+	for _, usage := range append(usages, res.WalletUsages...) {
+		s.Require().Equal(contractAddr, usage.ContractAddress)
+		s.Require().Equal(uint64(1), usage.Uses)
+	}
+
+	// Unregistering the contract removes all its usages, and only its usages
+	_, err = s.msgServer.UnregisterFeePayContract(s.Ctx, &types.MsgUnregisterFeePayContract{
+		SenderAddress:   sender.String(),
+		ContractAddress: contractAddr,
+	})
+	s.Require().NoError(err)
+
+	allUsages, err := k.GetAllWalletUsages(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(allUsages, 3)
+	for _, usage := range allUsages {
+		s.Require().Equal(otherContractAddr, usage.ContractAddress)
+	}
+
+	_, err = queryServer.FeePayWalletUsages(s.Ctx, &types.QueryFeePayWalletUsagesRequest{
+		ContractAddress: contractAddr,
+	})
+	s.Require().Error(err)
+}
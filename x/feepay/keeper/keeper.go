@@ -6,6 +6,7 @@ import (
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 
+	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 
@@ -15,25 +16,33 @@ import (
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	feepaytypes "github.com/CosmosContracts/juno/v29/x/feepay/types"
+	globalfeekeeper "github.com/CosmosContracts/juno/v29/x/globalfee/keeper"
 )
 
 var (
-	StoreKeyContracts    = []byte("contracts")
-	StoreKeyContractUses = []byte("contract-uses")
+	StoreKeyContracts     = []byte("contracts")
+	StoreKeyContractSpend = []byte("contract-spend")
 )
 
 // Keeper of this module maintains collections of feeshares for contracts
 // registered to receive transaction fees.
 type Keeper struct {
-	cdc          codec.BinaryCodec
+	cdc          codec.Codec
 	storeService storetypes.KVStoreService
 
-	bankKeeper    bankkeeper.Keeper
-	wasmKeeper    wasmkeeper.Keeper
-	accountKeeper authkeeper.AccountKeeper
+	bankKeeper      bankkeeper.Keeper
+	wasmKeeper      wasmkeeper.Keeper
+	accountKeeper   authkeeper.AccountKeeper
+	globalfeeKeeper globalfeekeeper.Keeper
 
 	bondDenom string
 
+	// WalletUsages is keyed by (contract address, wallet address), and Funders by
+	// (contract address, funder address)
+	Schema       collections.Schema
+	WalletUsages collections.Map[collections.Pair[string, string], feepaytypes.FeePayWalletUsage]
+	Funders      collections.Map[collections.Pair[string, string], feepaytypes.FeePayFunder]
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -41,23 +50,49 @@ type Keeper struct {
 
 // NewKeeper creates new instances of the fees Keeper
 func NewKeeper(
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	ss storetypes.KVStoreService,
 	bk bankkeeper.Keeper,
 	wk wasmkeeper.Keeper,
 	ak authkeeper.AccountKeeper,
+	gfk globalfeekeeper.Keeper,
 	bondDenom string,
 	authority string,
 ) Keeper {
-	return Keeper{
-		cdc:           cdc,
-		storeService:  ss,
-		bankKeeper:    bk,
-		wasmKeeper:    wk,
-		accountKeeper: ak,
-		bondDenom:     bondDenom,
-		authority:     authority,
+	sb := collections.NewSchemaBuilder(ss)
+
+	k := Keeper{
+		cdc:             cdc,
+		storeService:    ss,
+		bankKeeper:      bk,
+		wasmKeeper:      wk,
+		accountKeeper:   ak,
+		globalfeeKeeper: gfk,
+		bondDenom:       bondDenom,
+		WalletUsages: collections.NewMap(
+			sb,
+			feepaytypes.WalletUsagesKey,
+			"wallet_usages",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[feepaytypes.FeePayWalletUsage](cdc),
+		),
+		Funders: collections.NewMap(
+			sb,
+			feepaytypes.FundersKey,
+			"funders",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[feepaytypes.FeePayFunder](cdc),
+		),
+		authority: authority,
 	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+
+	k.Schema = schema
+	return k
 }
 
 // GetAuthority returns the x/feeshare module's authority.
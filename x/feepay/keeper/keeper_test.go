@@ -9,11 +9,15 @@ import (
 
 	_ "embed"
 
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	"github.com/CosmosContracts/juno/v29/testutil"
 	"github.com/CosmosContracts/juno/v29/x/feepay/keeper"
 	"github.com/CosmosContracts/juno/v29/x/feepay/types"
+	globalfeetypes "github.com/CosmosContracts/juno/v29/x/globalfee/types"
 )
 
 type KeeperTestSuite struct {
@@ -37,6 +41,15 @@ func (s *KeeperTestSuite) SetupTest() {
 	s.queryClient = types.NewQueryClient(s.QueryHelper)
 	s.msgServer = keeper.NewMsgServerImpl(s.App.AppKeepers.FeePayKeeper)
 	s.wasmMsgServer = wasmkeeper.NewMsgServerImpl(&s.App.AppKeepers.WasmKeeper)
+
+	// Fee pay contracts can only be funded in denoms with a global fee price
+	err := s.App.AppKeepers.GlobalFeeKeeper.SetParams(s.Ctx, globalfeetypes.Params{
+		MinimumGasPrices: sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDecWithPrec(1, 3)),
+			sdk.NewDecCoinFromDec("ujuno", sdkmath.LegacyNewDecWithPrec(25, 4)),
+		),
+	})
+	s.Require().NoError(err)
 }
 
 func TestKeeperTestSuite(t *testing.T) {
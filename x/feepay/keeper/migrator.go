@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/CosmosContracts/juno/v29/x/feepay/migrations/v2"
+	v3 "github.com/CosmosContracts/juno/v29/x/feepay/migrations/v3"
+	v4 "github.com/CosmosContracts/juno/v29/x/feepay/migrations/v4"
+)
+
+// Migrator is a struct for handling in-place state migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 migrates the x/feepay module state from the consensus version 1 to
+// version 2. Specifically, it moves the bond denom balance of every fee pay contract
+// into its multi-denom balances.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	return v2.Migrate(store, m.keeper.cdc, m.keeper.bondDenom)
+}
+
+// Migrate2to3 migrates the x/feepay module state from the consensus version 2 to
+// version 3. Specifically, it moves the wallet usages into the wallet usages
+// collection, dropping those of unregistered contracts.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	return v3.Migrate(ctx, store, m.keeper.cdc, m.keeper.WalletUsages)
+}
+
+// Migrate3to4 migrates the x/feepay module state from the consensus version 3 to
+// version 4. Specifically, it records the balances of every fee pay contract as the
+// contribution of its manager, the admin if exists, or else the creator.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	return v4.Migrate(ctx, store, m.keeper.cdc, m.keeper.Funders, func(contractAddress string) (string, bool) {
+		contractAddr, err := sdk.AccAddressFromBech32(contractAddress)
+		if err != nil {
+			return "", false
+		}
+
+		contractInfo := m.keeper.wasmKeeper.GetContractInfo(ctx, contractAddr)
+		if contractInfo == nil {
+			return "", false
+		}
+
+		if contractInfo.Admin != "" {
+			return contractInfo.Admin, true
+		}
+
+		return contractInfo.Creator, true
+	})
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v29/x/feepay/keeper"
+	v3 "github.com/CosmosContracts/juno/v29/x/feepay/migrations/v3"
+	"github.com/CosmosContracts/juno/v29/x/feepay/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	s.SetupTest()
+	k := s.App.AppKeepers.FeePayKeeper
+
+	bondDenom, err := s.App.AppKeepers.StakingKeeper.BondDenom(s.Ctx)
+	s.Require().NoError(err)
+
+	_, _, funded := testdata.KeyTestPubAddr()
+	_, _, empty := testdata.KeyTestPubAddr()
+	k.SetFeePayContract(s.Ctx, types.FeePayContract{
+		ContractAddress: funded.String(),
+		Balance:         1_000_000,
+		WalletLimit:     1,
+	})
+	k.SetFeePayContract(s.Ctx, types.FeePayContract{
+		ContractAddress: empty.String(),
+		WalletLimit:     1,
+	})
+
+	s.Require().NoError(keeper.NewMigrator(k).Migrate1to2(s.Ctx))
+
+	// The balance is moved to the balances in the bond denom
+	fpc, err := k.GetContract(s.Ctx, funded.String())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), fpc.Balance)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, sdkmath.NewInt(1_000_000))), fpc.Balances)
+
+	fpc, err = k.GetContract(s.Ctx, empty.String())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), fpc.Balance)
+	s.Require().True(fpc.Balances.Empty())
+}
+
+func (s *KeeperTestSuite) TestMigrate2to3() {
+	s.SetupTest()
+	k := s.App.AppKeepers.FeePayKeeper
+
+	_, _, registered := testdata.KeyTestPubAddr()
+	_, _, unregistered := testdata.KeyTestPubAddr()
+	_, _, wallet := testdata.KeyTestPubAddr()
+	k.SetFeePayContract(s.Ctx, types.FeePayContract{
+		ContractAddress: registered.String(),
+		WalletLimit:     5,
+	})
+
+	// Store the usages in the version 2 layout
+	store := prefix.NewStore(s.Ctx.KVStore(s.App.AppKeepers.GetKey(types.StoreKey)), v3.ContractUsesKey)
+	for _, contract := range []string{registered.String(), unregistered.String()} {
+		usage := types.FeePayWalletUsage{ContractAddress: contract, WalletAddress: wallet.String(), Uses: 3}
+		store.Set([]byte(contract+"-"+wallet.String()), s.App.AppCodec().MustMarshal(&usage))
+	}
+
+	s.Require().NoError(keeper.NewMigrator(k).Migrate2to3(s.Ctx))
+
+	// The usage of the registered contract is moved, the other one is dropped
+	usage, err := k.WalletUsages.Get(s.Ctx, collections.Join(registered.String(), wallet.String()))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), usage.Uses)
+
+	usages, err := k.GetAllWalletUsages(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(usages, 1)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	s.Require().False(iterator.Valid())
+}
+
+func (s *KeeperTestSuite) TestMigrate3to4() {
+	s.SetupTest()
+	k := s.App.AppKeepers.FeePayKeeper
+	_, _, creator := testdata.KeyTestPubAddr()
+	_, _, admin := testdata.KeyTestPubAddr()
+	s.FundAcc(creator, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))))
+
+	balances := sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_000)))
+	creatorContract := s.InstantiateContract(creator.String(), "", wasmContract)
+	adminContract := s.InstantiateContract(creator.String(), admin.String(), wasmContract)
+	emptyContract := s.InstantiateContract(creator.String(), "", wasmContract)
+	for _, contract := range []string{creatorContract, adminContract} {
+		k.SetFeePayContract(s.Ctx, types.FeePayContract{ContractAddress: contract, Balances: balances})
+	}
+	k.SetFeePayContract(s.Ctx, types.FeePayContract{ContractAddress: emptyContract})
+
+	s.Require().NoError(keeper.NewMigrator(k).Migrate3to4(s.Ctx))
+
+	// The balances are contributed by the contract manager, who holds all of the shares
+	funder, err := k.GetFunder(s.Ctx, creatorContract, creator.String())
+	s.Require().NoError(err)
+	s.Require().Equal(balances, funder.Contributed)
+	s.Require().Equal(balances, funder.Shares)
+
+	fpc, err := k.GetContract(s.Ctx, creatorContract)
+	s.Require().NoError(err)
+	s.Require().Equal(balances, fpc.TotalShares)
+
+	funder, err = k.GetFunder(s.Ctx, adminContract, admin.String())
+	s.Require().NoError(err)
+	s.Require().Equal(balances, funder.Contributed)
+
+	funders, err := k.GetAllFunders(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(funders, 2)
+}
@@ -28,8 +28,9 @@ func NewMsgServerImpl(k Keeper) types.MsgServer {
 
 // Register a new fee pay contract.
 func (ms msgServer) RegisterFeePayContract(ctx context.Context, msg *types.MsgRegisterFeePayContract) (*types.MsgRegisterFeePayContractResponse, error) {
-	// Prevent client from overriding initial contract balance of zero
+	// Prevent client from overriding initial contract balances of zero
 	msg.FeePayContract.Balance = uint64(0)
+	msg.FeePayContract.Balances = nil
 	return &types.MsgRegisterFeePayContractResponse{}, ms.RegisterContract(ctx, msg)
 }
 
@@ -43,7 +44,7 @@ func (ms msgServer) FundFeePayContract(ctx context.Context, msg *types.MsgFundFe
 		return nil, err
 	}
 
-	if len(msg.Amount) != 1 {
+	if msg.Amount.Empty() || !msg.Amount.IsValid() {
 		return nil, types.ErrInvalidJunoFundAmount
 	}
 
@@ -62,6 +63,30 @@ func (ms msgServer) FundFeePayContract(ctx context.Context, msg *types.MsgFundFe
 	return &types.MsgFundFeePayContractResponse{}, ms.FundContract(ctx, contract, senderAddr, msg.Amount)
 }
 
+// WithdrawFeePayContractFunds refunds a funder their share of the unspent funds of a contract.
+func (ms msgServer) WithdrawFeePayContractFunds(ctx context.Context, msg *types.MsgWithdrawFeePayContractFunds) (*types.MsgWithdrawFeePayContractFundsResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return nil, errorsmod.Wrapf(globalerrors.ErrInvalidAddress, "invalid funder address: %s", msg.FunderAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	// Get the contract
+	contract, err := ms.GetContract(ctx, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := ms.WithdrawFunderShare(ctx, contract, msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawFeePayContractFundsResponse{Amount: amount}, nil
+}
+
 // Update the wallet limit of a fee pay contract.
 func (ms msgServer) UpdateFeePayContractWalletLimit(ctx context.Context, msg *types.MsgUpdateFeePayContractWalletLimit) (*types.MsgUpdateFeePayContractWalletLimitResponse, error) {
 	if _, err := sdk.AccAddressFromBech32(msg.SenderAddress); err != nil {
@@ -82,7 +107,57 @@ func (ms msgServer) UpdateFeePayContractWalletLimit(ctx context.Context, msg *ty
 		return nil, errorsmod.Wrapf(types.ErrInvalidWalletLimit, "invalid wallet limit: %d", msg.WalletLimit)
 	}
 
-	return &types.MsgUpdateFeePayContractWalletLimitResponse{}, ms.UpdateContractWalletLimit(ctx, contract, msg.SenderAddress, msg.WalletLimit)
+	if err := types.ValidateLimitWindow(msg.LimitWindowBlocks, msg.LimitWindowDuration); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateFeePayContractWalletLimitResponse{}, ms.UpdateContractWalletLimit(ctx, contract, msg.SenderAddress, msg.WalletLimit, msg.LimitWindowBlocks, msg.LimitWindowDuration)
+}
+
+// Update whether a fee pay contract decides which wallets are sponsored.
+func (ms msgServer) UpdateFeePayContractEligibility(ctx context.Context, msg *types.MsgUpdateFeePayContractEligibility) (*types.MsgUpdateFeePayContractEligibilityResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.SenderAddress); err != nil {
+		return nil, err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	// Get the contract
+	contract, err := ms.GetContract(ctx, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateFeePayContractEligibilityResponse{}, ms.UpdateContractEligibility(ctx, contract, msg.SenderAddress, msg.EligibilityQuery)
+}
+
+// Update the fee spend limits of a fee pay contract.
+func (ms msgServer) UpdateFeePayContractSpendLimit(ctx context.Context, msg *types.MsgUpdateFeePayContractSpendLimit) (*types.MsgUpdateFeePayContractSpendLimitResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.SenderAddress); err != nil {
+		return nil, err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	// Get the contract
+	contract, err := ms.GetContract(ctx, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateSpendLimit(msg.WalletSpendLimit); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateSpendLimit(msg.ContractSpendLimit); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateFeePayContractSpendLimitResponse{}, ms.UpdateContractSpendLimit(ctx, contract, msg.SenderAddress, msg.WalletSpendLimit, msg.ContractSpendLimit)
 }
 
 // UpdateParams updates the parameters of the module.
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	_ "embed"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -9,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	// govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/CosmosContracts/juno/v29/x/feepay/keeper"
 	"github.com/CosmosContracts/juno/v29/x/feepay/types"
 )
 
@@ -205,6 +207,13 @@ func (s *KeeperTestSuite) TestFundFeePayContract() {
 			amount:          sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(100_000_000_000))),
 			shouldErr:       true,
 		},
+		{
+			desc:            "Fail - Denom Without Global Fee Price",
+			contractAddress: contract,
+			senderAddress:   sender.String(),
+			amount:          sdk.NewCoins(sdk.NewCoin("uatom", sdkmath.NewInt(1_000_000))),
+			shouldErr:       true,
+		},
 		{
 			desc:            "Success - Contract Funded",
 			contractAddress: contract,
@@ -212,6 +221,13 @@ func (s *KeeperTestSuite) TestFundFeePayContract() {
 			amount:          sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_000_000))),
 			shouldErr:       false,
 		},
+		{
+			desc:            "Success - Contract Funded In Multiple Denoms",
+			contractAddress: contract,
+			senderAddress:   sender.String(),
+			amount:          sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000)), sdk.NewCoin("ujuno", sdkmath.NewInt(1_000_000))),
+			shouldErr:       false,
+		},
 	} {
 		tc := tc
 
@@ -229,6 +245,11 @@ func (s *KeeperTestSuite) TestFundFeePayContract() {
 			}
 		})
 	}
+
+	// Funds of every denom are added to the contract balances
+	fpc, err := s.App.AppKeepers.FeePayKeeper.GetContract(s.Ctx, contract)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000)), sdk.NewCoin("ujuno", sdkmath.NewInt(2_000_000))), fpc.Balances)
 }
 
 func (s *KeeperTestSuite) TestUpdateFeePayContractWalletLimit() {
@@ -249,6 +270,8 @@ func (s *KeeperTestSuite) TestUpdateFeePayContractWalletLimit() {
 		contractAddress string
 		senderAddress   string
 		walletLimit     uint64
+		windowBlocks    uint64
+		windowDuration  time.Duration
 		shouldErr       bool
 	}{
 		{
@@ -300,14 +323,49 @@ func (s *KeeperTestSuite) TestUpdateFeePayContractWalletLimit() {
 			walletLimit:     21,
 			shouldErr:       false,
 		},
+		{
+			desc:            "Success - Update Creator Contract With Block Window",
+			contractAddress: creatorContract,
+			senderAddress:   sender.String(),
+			walletLimit:     5,
+			windowBlocks:    100,
+			shouldErr:       false,
+		},
+		{
+			desc:            "Success - Update Creator Contract With Time Window",
+			contractAddress: creatorContract,
+			senderAddress:   sender.String(),
+			walletLimit:     5,
+			windowDuration:  24 * time.Hour,
+			shouldErr:       false,
+		},
+		{
+			desc:            "Fail - Update Creator Contract With Block And Time Window",
+			contractAddress: creatorContract,
+			senderAddress:   sender.String(),
+			walletLimit:     5,
+			windowBlocks:    100,
+			windowDuration:  24 * time.Hour,
+			shouldErr:       true,
+		},
+		{
+			desc:            "Fail - Update Creator Contract With Negative Time Window",
+			contractAddress: creatorContract,
+			senderAddress:   sender.String(),
+			walletLimit:     5,
+			windowDuration:  -time.Hour,
+			shouldErr:       true,
+		},
 	} {
 		tc := tc
 
 		s.Run(tc.desc, func() {
 			_, err := s.msgServer.UpdateFeePayContractWalletLimit(s.Ctx, &types.MsgUpdateFeePayContractWalletLimit{
-				SenderAddress:   tc.senderAddress,
-				ContractAddress: tc.contractAddress,
-				WalletLimit:     tc.walletLimit,
+				SenderAddress:       tc.senderAddress,
+				ContractAddress:     tc.contractAddress,
+				WalletLimit:         tc.walletLimit,
+				LimitWindowBlocks:   tc.windowBlocks,
+				LimitWindowDuration: tc.windowDuration,
 			})
 
 			if tc.shouldErr {
@@ -318,3 +376,204 @@ func (s *KeeperTestSuite) TestUpdateFeePayContractWalletLimit() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateFeePayContractSpendLimit() {
+	s.SetupTest()
+	_, _, sender := testdata.KeyTestPubAddr()
+	_, _, admin := testdata.KeyTestPubAddr()
+	s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))))
+
+	contract := s.InstantiateContract(sender.String(), "", wasmContract)
+	s.registerFeePayContract(sender.String(), contract, 0, 1)
+
+	for _, tc := range []struct {
+		desc               string
+		senderAddress      string
+		walletSpendLimit   sdk.Coins
+		contractSpendLimit sdk.Coins
+		shouldErr          bool
+	}{
+		{
+			desc:             "Fail - Update Contract As Non Creator",
+			senderAddress:    admin.String(),
+			walletSpendLimit: sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_000))),
+			shouldErr:        true,
+		},
+		{
+			desc:             "Fail - Update Contract With Invalid Limit",
+			senderAddress:    sender.String(),
+			walletSpendLimit: sdk.Coins{sdk.Coin{Denom: "ujuno", Amount: sdkmath.NewInt(-1)}},
+			shouldErr:        true,
+		},
+		{
+			desc:               "Success - Update Contract As Creator",
+			senderAddress:      sender.String(),
+			walletSpendLimit:   sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_000))),
+			contractSpendLimit: sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(100_000))),
+			shouldErr:          false,
+		},
+		{
+			desc:          "Success - Remove Limits",
+			senderAddress: sender.String(),
+			shouldErr:     false,
+		},
+	} {
+		tc := tc
+
+		s.Run(tc.desc, func() {
+			_, err := s.msgServer.UpdateFeePayContractSpendLimit(s.Ctx, &types.MsgUpdateFeePayContractSpendLimit{
+				SenderAddress:      tc.senderAddress,
+				ContractAddress:    contract,
+				WalletSpendLimit:   tc.walletSpendLimit,
+				ContractSpendLimit: tc.contractSpendLimit,
+			})
+
+			if tc.shouldErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			fpc, err := s.App.AppKeepers.FeePayKeeper.GetContract(s.Ctx, contract)
+			s.Require().NoError(err)
+			s.Require().True(tc.walletSpendLimit.Equal(fpc.WalletSpendLimit))
+			s.Require().True(tc.contractSpendLimit.Equal(fpc.ContractSpendLimit))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestWithdrawFeePayContractFunds() {
+	s.SetupTest()
+	k := s.App.AppKeepers.FeePayKeeper
+	_, _, creator := testdata.KeyTestPubAddr()
+	_, _, funderA := testdata.KeyTestPubAddr()
+	_, _, funderB := testdata.KeyTestPubAddr()
+	_, _, stranger := testdata.KeyTestPubAddr()
+	s.FundAcc(creator, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))))
+	s.FundAcc(funderA, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(3_000))))
+	s.FundAcc(funderB, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_000))))
+
+	contract := s.InstantiateContract(creator.String(), "", wasmContract)
+	s.registerFeePayContract(creator.String(), contract, 0, 1)
+
+	for _, funder := range []sdk.AccAddress{funderA, funderB} {
+		_, err := s.msgServer.FundFeePayContract(s.Ctx, &types.MsgFundFeePayContract{
+			SenderAddress:   funder.String(),
+			ContractAddress: contract,
+			Amount:          s.bankKeeper.GetAllBalances(s.Ctx, funder),
+		})
+		s.Require().NoError(err)
+	}
+
+	// Half of the funds are spent on fees
+	fpc, err := k.GetContract(s.Ctx, contract)
+	s.Require().NoError(err)
+	k.SetContractBalances(s.Ctx, fpc, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(2_000))))
+
+	_, err = s.msgServer.WithdrawFeePayContractFunds(s.Ctx, &types.MsgWithdrawFeePayContractFunds{
+		FunderAddress:   stranger.String(),
+		ContractAddress: contract,
+	})
+	s.Require().ErrorIs(err, types.ErrNotFunder)
+
+	// Each funder is refunded a share of the unspent funds proportional to its shares
+	res, err := s.msgServer.WithdrawFeePayContractFunds(s.Ctx, &types.MsgWithdrawFeePayContractFunds{
+		FunderAddress:   funderB.String(),
+		ContractAddress: contract,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(500))), res.Amount)
+	s.Require().Equal(sdkmath.NewInt(500), s.bankKeeper.GetBalance(s.Ctx, funderB, "ujuno").Amount)
+
+	_, err = s.msgServer.WithdrawFeePayContractFunds(s.Ctx, &types.MsgWithdrawFeePayContractFunds{
+		FunderAddress:   funderB.String(),
+		ContractAddress: contract,
+	})
+	s.Require().ErrorIs(err, types.ErrNotFunder)
+
+	funder, err := keeper.NewQueryServerImpl(k).FeePayFunder(s.Ctx, &types.QueryFeePayFunderRequest{
+		ContractAddress: contract,
+		FunderAddress:   funderA.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(3_000))), funder.Funder.Contributed)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(3_000))), funder.Funder.Shares)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_500))), funder.Unspent)
+
+	// A later funder does not pay for the fees spent before it funded, its shares are
+	// minted at the current ratio of shares to balances
+	s.FundAcc(funderB, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_500))))
+	_, err = s.msgServer.FundFeePayContract(s.Ctx, &types.MsgFundFeePayContract{
+		SenderAddress:   funderB.String(),
+		ContractAddress: contract,
+		Amount:          sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_500))),
+	})
+	s.Require().NoError(err)
+
+	funder, err = keeper.NewQueryServerImpl(k).FeePayFunder(s.Ctx, &types.QueryFeePayFunderRequest{
+		ContractAddress: contract,
+		FunderAddress:   funderB.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(3_000))), funder.Funder.Shares)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_500))), funder.Unspent)
+
+	// Unregistering refunds the remaining funders
+	_, err = s.msgServer.UnregisterFeePayContract(s.Ctx, &types.MsgUnregisterFeePayContract{
+		SenderAddress:   creator.String(),
+		ContractAddress: contract,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdkmath.NewInt(1_500), s.bankKeeper.GetBalance(s.Ctx, funderA, "ujuno").Amount)
+	s.Require().Equal(sdkmath.NewInt(2_000), s.bankKeeper.GetBalance(s.Ctx, funderB, "ujuno").Amount)
+	s.Require().True(s.bankKeeper.GetBalance(s.Ctx, creator, "ujuno").IsZero())
+
+	funders, err := k.GetAllFunders(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(funders)
+}
+
+func (s *KeeperTestSuite) TestFundDepletedFeePayContract() {
+	s.SetupTest()
+	k := s.App.AppKeepers.FeePayKeeper
+	_, _, creator := testdata.KeyTestPubAddr()
+	_, _, funderA := testdata.KeyTestPubAddr()
+	_, _, funderB := testdata.KeyTestPubAddr()
+	s.FundAcc(creator, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(1_000_000))))
+	s.FundAcc(funderA, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_000))))
+	s.FundAcc(funderB, sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_000))))
+
+	contract := s.InstantiateContract(creator.String(), "", wasmContract)
+	s.registerFeePayContract(creator.String(), contract, 0, 1)
+
+	fund := func(funder sdk.AccAddress) {
+		_, err := s.msgServer.FundFeePayContract(s.Ctx, &types.MsgFundFeePayContract{
+			SenderAddress:   funder.String(),
+			ContractAddress: contract,
+			Amount:          sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_000))),
+		})
+		s.Require().NoError(err)
+	}
+	fund(funderA)
+
+	// All of the funds are spent on fees
+	fpc, err := k.GetContract(s.Ctx, contract)
+	s.Require().NoError(err)
+	k.SetContractBalances(s.Ctx, fpc, sdk.NewCoins())
+
+	// The worthless shares are burned, and do not dilute the shares of the next funder
+	fund(funderB)
+
+	funder, err := k.GetFunder(s.Ctx, contract, funderA.String())
+	s.Require().NoError(err)
+	s.Require().True(funder.Shares.IsZero())
+
+	funder, err = k.GetFunder(s.Ctx, contract, funderB.String())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("ujuno", sdkmath.NewInt(1_000))), funder.Shares)
+
+	fpc, err = k.GetContract(s.Ctx, contract)
+	s.Require().NoError(err)
+	s.Require().Equal(funder.Shares, fpc.TotalShares)
+}
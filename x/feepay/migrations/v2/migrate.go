@@ -0,0 +1,52 @@
+package v2
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmosContracts/juno/v29/x/feepay/types"
+)
+
+// ContractsKey is the prefix of the fee pay contracts at consensus version 1.
+var ContractsKey = []byte("contracts")
+
+// Migrate migrates the x/feepay module state from the consensus version 1 to
+// version 2. Specifically, it moves the uint64 balance of every fee pay contract,
+// which was always in the bond denom, into its balances.
+func Migrate(store storetypes.KVStore, cdc codec.BinaryCodec, bondDenom string) error {
+	contractsStore := prefix.NewStore(store, ContractsKey)
+
+	iterator := contractsStore.Iterator(nil, nil)
+	defer iterator.Close() //nolint:errcheck
+
+	// Collect the contracts first, as the store cannot be written while iterating
+	var contracts []types.FeePayContract
+	for ; iterator.Valid(); iterator.Next() {
+		var fpc types.FeePayContract
+		if err := cdc.Unmarshal(iterator.Value(), &fpc); err != nil {
+			return err
+		}
+
+		if fpc.Balance > 0 {
+			contracts = append(contracts, fpc)
+		}
+	}
+
+	for _, fpc := range contracts {
+		fpc.Balances = fpc.Balances.Add(sdk.NewCoin(bondDenom, sdkmath.NewIntFromUint64(fpc.Balance)))
+		fpc.Balance = 0
+
+		bz, err := cdc.Marshal(&fpc)
+		if err != nil {
+			return err
+		}
+
+		contractsStore.Set([]byte(fpc.ContractAddress), bz)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package v3
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/CosmosContracts/juno/v29/x/feepay/types"
+)
+
+var (
+	// ContractsKey is the prefix of the fee pay contracts at consensus version 2.
+	ContractsKey = []byte("contracts")
+	// ContractUsesKey is the prefix of the wallet usages at consensus version 2,
+	// keyed by the contract and wallet addresses joined by a dash.
+	ContractUsesKey = []byte("contract-uses")
+)
+
+// Migrate migrates the x/feepay module state from the consensus version 2 to
+// version 3. Specifically, it moves the wallet usages into a collection keyed by
+// (contract address, wallet address), and drops the usages of contracts which
+// were unregistered, as unregistering did not remove them.
+func Migrate(
+	ctx context.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	walletUsages collections.Map[collections.Pair[string, string], types.FeePayWalletUsage],
+) error {
+	contractsStore := prefix.NewStore(store, ContractsKey)
+	contractUsesStore := prefix.NewStore(store, ContractUsesKey)
+
+	iterator := contractUsesStore.Iterator(nil, nil)
+	defer iterator.Close() //nolint:errcheck
+
+	// Collect the usages first, as the store cannot be written while iterating
+	var (
+		keys   [][]byte
+		usages []types.FeePayWalletUsage
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		var walletUsage types.FeePayWalletUsage
+		if err := cdc.Unmarshal(iterator.Value(), &walletUsage); err != nil {
+			return err
+		}
+
+		keys = append(keys, iterator.Key())
+		usages = append(usages, walletUsage)
+	}
+
+	for _, key := range keys {
+		contractUsesStore.Delete(key)
+	}
+
+	for _, walletUsage := range usages {
+		if !contractsStore.Has([]byte(walletUsage.ContractAddress)) {
+			continue
+		}
+
+		if err := walletUsages.Set(ctx, collections.Join(walletUsage.ContractAddress, walletUsage.WalletAddress), walletUsage); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
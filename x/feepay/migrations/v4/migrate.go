@@ -0,0 +1,71 @@
+package v4
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/CosmosContracts/juno/v29/x/feepay/types"
+)
+
+// ContractsKey is the prefix of the fee pay contracts at consensus version 3.
+var ContractsKey = []byte("contracts")
+
+// Migrate migrates the x/feepay module state from the consensus version 3 to
+// version 4. Specifically, it records the balances of every fee pay contract as
+// the contribution of the contract manager, who was refunded the balances on
+// unregister before funders were tracked, and mints the manager the shares of
+// the balances one to one. Contracts without a manager, as their wasm contract
+// no longer exists, are skipped.
+func Migrate(
+	ctx context.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	funders collections.Map[collections.Pair[string, string], types.FeePayFunder],
+	contractManager func(contractAddress string) (string, bool),
+) error {
+	contractsStore := prefix.NewStore(store, ContractsKey)
+
+	iterator := contractsStore.Iterator(nil, nil)
+	defer iterator.Close() //nolint:errcheck
+
+	// Collect the contracts first, as the store cannot be written while iterating
+	var contracts []types.FeePayContract
+	for ; iterator.Valid(); iterator.Next() {
+		var fpc types.FeePayContract
+		if err := cdc.Unmarshal(iterator.Value(), &fpc); err != nil {
+			return err
+		}
+
+		contracts = append(contracts, fpc)
+	}
+
+	for _, fpc := range contracts {
+		if fpc.Balances.IsZero() {
+			continue
+		}
+
+		manager, ok := contractManager(fpc.ContractAddress)
+		if !ok {
+			continue
+		}
+
+		if err := funders.Set(ctx, collections.Join(fpc.ContractAddress, manager), types.FeePayFunder{
+			ContractAddress: fpc.ContractAddress,
+			FunderAddress:   manager,
+			Contributed:     fpc.Balances,
+			Shares:          fpc.Balances,
+		}); err != nil {
+			return err
+		}
+
+		fpc.TotalShares = fpc.Balances
+		contractsStore.Set([]byte(fpc.ContractAddress), cdc.MustMarshal(&fpc))
+	}
+
+	return nil
+}
@@ -34,6 +34,31 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "wallet_address"},
 					},
 				},
+				{
+					RpcMethod: "FeePayWalletUsages",
+					Use:       "usages [contract_address]",
+					Short:     "Query the usage of every wallet on a FeePay contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+					},
+				},
+				{
+					RpcMethod: "FeePayFunders",
+					Use:       "funders [contract_address]",
+					Short:     "Query the contributions of the funders of a FeePay contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+					},
+				},
+				{
+					RpcMethod: "FeePayFunder",
+					Use:       "funder [contract_address] [funder_address]",
+					Short:     "Query the contribution of a funder of a FeePay contract and its share of the unspent funds",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+						{ProtoField: "funder_address"},
+					},
+				},
 				{
 					RpcMethod: "FeePayWalletIsEligible",
 					Use:       "is-eligible [contract_address] [wallet_address]",
@@ -43,6 +68,30 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "wallet_address"},
 					},
 				},
+				{
+					RpcMethod: "FeePayWalletAllowance",
+					Use:       "allowance [contract_address] [wallet_address]",
+					Short:     "Query the remaining allowance of a wallet on a FeePay contract and when it resets",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+						{ProtoField: "wallet_address"},
+					},
+				},
+				{
+					RpcMethod: "FeePaySpend",
+					Use:       "spend [contract_address] [wallet_address]",
+					Short:     "Query the fees a FeePay contract has sponsored for a wallet and for all wallets",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "contract_address"},
+						{ProtoField: "wallet_address"},
+					},
+				},
+				{
+					RpcMethod: "FeePaySimulate",
+					Use:       "simulate",
+					Short:     "Simulate whether FeePay would sponsor the fee of a transaction",
+					Long:      "Simulate whether FeePay would sponsor the fee of an unsigned transaction given with --tx-bytes, or of a single execution of a contract given with --contract-address, --sender-address and --gas. Returns the fee which would be deducted, or the reason FeePay would not sponsor it.",
+				},
 				{
 					RpcMethod: "Params",
 					Use:       "params",
@@ -82,6 +131,15 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount"},
 					},
 				},
+				{
+					RpcMethod: "WithdrawFeePayContractFunds",
+					Use:       "withdraw [funder_address] [contract_address]",
+					Short:     "Withdraw the share of a funder of the unspent funds of a fee pay contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "funder_address"},
+						{ProtoField: "contract_address"},
+					},
+				},
 				{
 					RpcMethod: "UpdateFeePayContractWalletLimit",
 					Use:       "update-wallet-limit [sender_address] [contract_address] [wallet_limit]",
@@ -92,6 +150,25 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "wallet_limit"},
 					},
 				},
+				{
+					RpcMethod: "UpdateFeePayContractEligibility",
+					Use:       "update-eligibility [sender_address] [contract_address] [eligibility_query]",
+					Short:     "Update whether a fee pay contract is queried for the eligibility of wallets",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender_address"},
+						{ProtoField: "contract_address"},
+						{ProtoField: "eligibility_query"},
+					},
+				},
+				{
+					RpcMethod: "UpdateFeePayContractSpendLimit",
+					Use:       "update-spend-limit [sender_address] [contract_address]",
+					Short:     "Update the total fee a fee pay contract may sponsor for each wallet and for all wallets",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "sender_address"},
+						{ProtoField: "contract_address"},
+					},
+				},
 				{
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
@@ -12,6 +12,9 @@ const (
 	registerFeePayContract   = "juno/x/feepay/MsgRegisterFeePayContract"
 	unregisterFeePayContract = "juno/x/feepay/MsgUnregisterFeePayContract"
 	fundFeePayContract       = "juno/x/feepay/MsgFundFeePayContract"
+	withdrawFeePayContract   = "juno/x/feepay/MsgWithdrawFeePayContractFunds"
+	updateEligibility        = "juno/x/feepay/MsgUpdateFeePayContractEligibility"
+	updateSpendLimit         = "juno/x/feepay/MsgUpdateFeePayContractSpendLimit"
 	updateFeeShareParams     = "juno/x/feepay/MsgUpdateParams"
 )
 
@@ -22,6 +25,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterFeePayContract{},
 		&MsgUnregisterFeePayContract{},
 		&MsgFundFeePayContract{},
+		&MsgWithdrawFeePayContractFunds{},
+		&MsgUpdateFeePayContractEligibility{},
+		&MsgUpdateFeePayContractSpendLimit{},
 		&MsgUpdateParams{},
 	)
 
@@ -35,5 +41,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterFeePayContract{}, registerFeePayContract, nil)
 	cdc.RegisterConcrete(&MsgUnregisterFeePayContract{}, unregisterFeePayContract, nil)
 	cdc.RegisterConcrete(&MsgFundFeePayContract{}, fundFeePayContract, nil)
+	cdc.RegisterConcrete(&MsgWithdrawFeePayContractFunds{}, withdrawFeePayContract, nil)
+	cdc.RegisterConcrete(&MsgUpdateFeePayContractEligibility{}, updateEligibility, nil)
+	cdc.RegisterConcrete(&MsgUpdateFeePayContractSpendLimit{}, updateSpendLimit, nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateFeeShareParams, nil)
 }
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"encoding/json"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EligibilityExecuteMsg is a contract execution of a FeePay transaction, as sent
+// to the eligibility query of a contract.
+type EligibilityExecuteMsg struct {
+	// The address of the executed contract.
+	Contract string `json:"contract"`
+	// The JSON execute message.
+	Msg json.RawMessage `json:"msg"`
+	// The funds sent with the execution.
+	Funds sdk.Coins `json:"funds"`
+}
+
+// EligibilityQuery asks a contract whether it sponsors the fee of a FeePay transaction.
+type EligibilityQuery struct {
+	// The address of the wallet paying the fee.
+	Sender string `json:"sender"`
+	// The contract executions of the transaction.
+	Msgs []EligibilityExecuteMsg `json:"msgs"`
+}
+
+// eligibilityQueryMsg is the smart query which carries the eligibility query.
+type eligibilityQueryMsg struct {
+	FeePayEligibility EligibilityQuery `json:"fee_pay_eligibility"`
+}
+
+// EligibilityResponse is the response of a contract to the eligibility query.
+type EligibilityResponse struct {
+	Eligible bool `json:"eligible"`
+}
+
+// NewEligibilityQueryMessage returns the smart query asking a contract whether it
+// sponsors the fee of the given executions for the sender.
+func NewEligibilityQueryMessage(sender string, executeMsgs []*wasmtypes.MsgExecuteContract) ([]byte, error) {
+	msgs := make([]EligibilityExecuteMsg, 0, len(executeMsgs))
+	for _, cw := range executeMsgs {
+		funds := cw.Funds
+		if funds == nil {
+			funds = sdk.Coins{}
+		}
+
+		msgs = append(msgs, EligibilityExecuteMsg{
+			Contract: cw.Contract,
+			Msg:      json.RawMessage(cw.Msg),
+			Funds:    funds,
+		})
+	}
+
+	return json.Marshal(eligibilityQueryMsg{
+		FeePayEligibility: EligibilityQuery{
+			Sender: sender,
+			Msgs:   msgs,
+		},
+	})
+}
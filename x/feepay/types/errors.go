@@ -8,7 +8,12 @@ var (
 	ErrContractNotEnoughFunds   = errorsmod.Register(ModuleName, 1, "contract does not have enough funds")
 	ErrWalletExceededUsageLimit = errorsmod.Register(ModuleName, 2, "wallet exceeded usage limit")
 	ErrInvalidWalletLimit       = errorsmod.Register(ModuleName, 3, "invalid wallet limit; must be between 0 and 1,000,000")
-	ErrInvalidJunoFundAmount    = errorsmod.Register(ModuleName, 4, "fee pay contracts only accept juno funds")
+	ErrInvalidJunoFundAmount    = errorsmod.Register(ModuleName, 4, "fee pay contracts only accept funds in denoms with a global fee minimum gas price")
 	ErrFeePayDisabled           = errorsmod.Register(ModuleName, 5, "the FeePay module is disabled")
 	ErrDeductFees               = errorsmod.Register(ModuleName, 6, "error deducting fees")
+	ErrInvalidLimitWindow       = errorsmod.Register(ModuleName, 7, "invalid wallet limit window")
+	ErrWalletNotEligible        = errorsmod.Register(ModuleName, 8, "wallet is not eligible for the fee pay contract")
+	ErrSpendLimitExceeded       = errorsmod.Register(ModuleName, 9, "fee spend limit exceeded")
+	ErrInvalidSpendLimit        = errorsmod.Register(ModuleName, 10, "invalid fee spend limit")
+	ErrNotFunder                = errorsmod.Register(ModuleName, 11, "address has not funded the fee pay contract")
 )
@@ -0,0 +1,84 @@
+package types
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// GetFeePayExecuteMsgs returns the CW contract executions of the messages, including
+// the executions nested in authz MsgExec messages. It returns false if there are no
+// executions or if any message is not a CW contract execution. Received IBC packets are
+// never sponsored, as the ante runs before the packet is proven and the relayer, not the
+// packet sender, signs the transaction.
+func GetFeePayExecuteMsgs(msgs []sdk.Msg) ([]*wasmtypes.MsgExecuteContract, bool) {
+	executeMsgs := []*wasmtypes.MsgExecuteContract{}
+	if !addFeePayExecuteMsgs(&executeMsgs, msgs) || len(executeMsgs) == 0 {
+		return nil, false
+	}
+
+	return executeMsgs, true
+}
+
+// Loop through all messages and add the CW contract executions, recursively adding the
+// executions of authz messages
+func addFeePayExecuteMsgs(executeMsgs *[]*wasmtypes.MsgExecuteContract, msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *wasmtypes.MsgExecuteContract:
+			*executeMsgs = append(*executeMsgs, msg)
+		case *authz.MsgExec:
+			innerMsgs, err := msg.GetMessages()
+			if err != nil || !addFeePayExecuteMsgs(executeMsgs, innerMsgs) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// FeePayShare is the part of the fee of a FeePay transaction covered by a contract.
+type FeePayShare struct {
+	ContractAddress string
+	// Messages is the number of messages executing the contract.
+	Messages uint64
+	Fee      sdkmath.Int
+}
+
+// SplitFeePayFee splits the fee between the contracts executed by the messages, in the
+// order the contracts are first executed. Each contract covers a share of the fee
+// proportional to the number of messages executing it, rounded down. The first contract
+// covers the remainder of the rounding.
+func SplitFeePayFee(executeMsgs []*wasmtypes.MsgExecuteContract, fee sdkmath.Int) []FeePayShare {
+	shares := []FeePayShare{}
+	index := make(map[string]int)
+	for _, cw := range executeMsgs {
+		i, ok := index[cw.Contract]
+		if !ok {
+			i = len(shares)
+			index[cw.Contract] = i
+			shares = append(shares, FeePayShare{ContractAddress: cw.Contract})
+		}
+		shares[i].Messages++
+	}
+
+	if len(shares) == 0 {
+		return shares
+	}
+
+	total := sdkmath.NewIntFromUint64(uint64(len(executeMsgs)))
+	remainder := fee
+	for i := range shares {
+		shares[i].Fee = fee.Mul(sdkmath.NewIntFromUint64(shares[i].Messages)).Quo(total)
+		remainder = remainder.Sub(shares[i].Fee)
+	}
+	shares[0].Fee = shares[0].Fee.Add(remainder)
+
+	return shares
+}
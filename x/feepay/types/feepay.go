@@ -0,0 +1,113 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasLimitWindow returns true if the wallet limit of the contract resets every
+// window, instead of applying over the lifetime of the contract.
+func (c FeePayContract) HasLimitWindow() bool {
+	return c.LimitWindowBlocks > 0 || c.LimitWindowDuration > 0
+}
+
+// LimitWindow returns the wallet limit window at the given height and time. Windows
+// are aligned to height zero and the unix epoch. Without a window, the limit applies
+// over the lifetime of the contract and the window is always zero.
+func (c FeePayContract) LimitWindow(height int64, blockTime time.Time) uint64 {
+	if c.LimitWindowBlocks > 0 {
+		return uint64(height) / c.LimitWindowBlocks
+	}
+
+	if c.LimitWindowDuration > 0 {
+		return uint64(blockTime.UnixNano() / int64(c.LimitWindowDuration))
+	}
+
+	return 0
+}
+
+// LimitWindowReset returns the height or the block time at which the wallet limit
+// window at the given height and time rolls over. The height is zero without a block
+// window, and the time is zero without a time window.
+func (c FeePayContract) LimitWindowReset(height int64, blockTime time.Time) (int64, time.Time) {
+	window := c.LimitWindow(height, blockTime)
+
+	if c.LimitWindowBlocks > 0 {
+		return int64((window + 1) * c.LimitWindowBlocks), time.Time{}
+	}
+
+	if c.LimitWindowDuration > 0 {
+		return 0, time.Unix(0, int64(window+1)*int64(c.LimitWindowDuration)).UTC()
+	}
+
+	return 0, time.Time{}
+}
+
+// ValidateLimitWindow ensures the wallet limit window of a contract is well formed.
+func ValidateLimitWindow(windowBlocks uint64, windowDuration time.Duration) error {
+	if windowDuration < 0 {
+		return ErrInvalidLimitWindow.Wrapf("window duration cannot be negative: %s", windowDuration)
+	}
+
+	if windowBlocks > 0 && windowDuration > 0 {
+		return ErrInvalidLimitWindow.Wrap("cannot set both a block and a time window")
+	}
+
+	return nil
+}
+
+// WithinSpendLimit returns true if the fee can be sponsored on top of the fees already
+// spent without exceeding the spend limit. An empty limit has no cap, otherwise fees
+// can only be sponsored in the denoms of the limit.
+func WithinSpendLimit(limit, spent sdk.Coins, fee sdk.Coin) bool {
+	if limit.Empty() {
+		return true
+	}
+
+	return spent.AmountOf(fee.Denom).Add(fee.Amount).LTE(limit.AmountOf(fee.Denom))
+}
+
+// ValidateSpendLimit ensures a fee spend limit of a contract is well formed.
+func ValidateSpendLimit(limit sdk.Coins) error {
+	if err := limit.Validate(); err != nil {
+		return ErrInvalidSpendLimit.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+// MintFunderShares returns the pool shares minted for funding a contract with the amount,
+// at the ratio of the total shares to the balances of the contract in each denom, rounded
+// down. Funds in a denom without shares or balance mint shares one to one.
+func MintFunderShares(balances, totalShares, amount sdk.Coins) sdk.Coins {
+	shares := make([]sdk.Coin, 0, len(amount))
+	for _, c := range amount {
+		total := totalShares.AmountOf(c.Denom)
+		balance := balances.AmountOf(c.Denom)
+		if total.IsZero() || balance.IsZero() {
+			shares = append(shares, c)
+			continue
+		}
+
+		shares = append(shares, sdk.NewCoin(c.Denom, c.Amount.Mul(total).Quo(balance)))
+	}
+
+	return sdk.NewCoins(shares...)
+}
+
+// FunderShare returns the share of a funder of the balances of a contract, which is the
+// same fraction of the balances as of the total shares in each denom, rounded down.
+func FunderShare(balances, shares, totalShares sdk.Coins) sdk.Coins {
+	share := make([]sdk.Coin, 0, len(shares))
+	for _, s := range shares {
+		total := totalShares.AmountOf(s.Denom)
+		if total.IsZero() {
+			continue
+		}
+
+		share = append(share, sdk.NewCoin(s.Denom, balances.AmountOf(s.Denom).Mul(s.Amount).Quo(total)))
+	}
+
+	return sdk.NewCoins(share...)
+}
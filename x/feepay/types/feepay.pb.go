@@ -6,16 +6,24 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,10 +36,35 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type FeePayContract struct {
 	// The address of the contract.
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	// The ledger balance of the contract.
+	// Deprecated: the legacy ledger balance of the contract in the bond denom,
+	// moved to balances by the v2 migration.
 	Balance uint64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
-	// The number of times a wallet may interact with the contract.
+	// The number of times a wallet may interact with the contract, per limit
+	// window if the contract has one, or else over the lifetime of the contract.
 	WalletLimit uint64 `protobuf:"varint,3,opt,name=wallet_limit,json=walletLimit,proto3" json:"wallet_limit,omitempty"`
+	// The number of blocks in a wallet limit window. Zero means no block window.
+	LimitWindowBlocks uint64 `protobuf:"varint,4,opt,name=limit_window_blocks,json=limitWindowBlocks,proto3" json:"limit_window_blocks,omitempty"`
+	// The duration of a wallet limit window. Zero means no time window. Cannot
+	// be combined with limit_window_blocks.
+	LimitWindowDuration time.Duration `protobuf:"bytes,5,opt,name=limit_window_duration,json=limitWindowDuration,proto3,stdduration" json:"limit_window_duration"`
+	// Whether the contract decides which wallets are sponsored. The contract is
+	// sent a gas capped smart query with the sender and the messages of every
+	// FeePay transaction, and the query gas is paid with the transaction gas.
+	EligibilityQuery bool `protobuf:"varint,6,opt,name=eligibility_query,json=eligibilityQuery,proto3" json:"eligibility_query,omitempty"`
+	// The ledger balances of the contract, in the denoms with a global fee
+	// minimum gas price.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	// The total fee the contract may sponsor for each wallet, per limit window if
+	// the contract has one, or else over the lifetime of the contract. Fees can
+	// only be sponsored in the listed denoms. Empty means no cap.
+	WalletSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=wallet_spend_limit,json=walletSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"wallet_spend_limit"`
+	// The total fee the contract may sponsor for all wallets, per limit window if
+	// the contract has one, or else over the lifetime of the contract. Fees can
+	// only be sponsored in the listed denoms. Empty means no cap.
+	ContractSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=contract_spend_limit,json=contractSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"contract_spend_limit"`
+	// The total pool shares of the funders of the contract in each denom of the
+	// balances.
+	TotalShares github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=total_shares,json=totalShares,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_shares"`
 }
 
 func (m *FeePayContract) Reset()         { *m = FeePayContract{} }
@@ -88,6 +121,55 @@ func (m *FeePayContract) GetWalletLimit() uint64 {
 	return 0
 }
 
+func (m *FeePayContract) GetLimitWindowBlocks() uint64 {
+	if m != nil {
+		return m.LimitWindowBlocks
+	}
+	return 0
+}
+
+func (m *FeePayContract) GetLimitWindowDuration() time.Duration {
+	if m != nil {
+		return m.LimitWindowDuration
+	}
+	return 0
+}
+
+func (m *FeePayContract) GetEligibilityQuery() bool {
+	if m != nil {
+		return m.EligibilityQuery
+	}
+	return false
+}
+
+func (m *FeePayContract) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *FeePayContract) GetWalletSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WalletSpendLimit
+	}
+	return nil
+}
+
+func (m *FeePayContract) GetContractSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ContractSpendLimit
+	}
+	return nil
+}
+
+func (m *FeePayContract) GetTotalShares() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalShares
+	}
+	return nil
+}
+
 // This object is used to store the number of times a wallet has
 // interacted with a contract.
 type FeePayWalletUsage struct {
@@ -97,6 +179,11 @@ type FeePayWalletUsage struct {
 	WalletAddress string `protobuf:"bytes,2,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
 	// The number of uses corresponding to a wallet.
 	Uses uint64 `protobuf:"varint,3,opt,name=uses,proto3" json:"uses,omitempty"`
+	// The wallet limit window the uses were counted in. The uses reset when the
+	// window rolls over.
+	Window uint64 `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`
+	// The fees sponsored for the wallet in the window.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
 }
 
 func (m *FeePayWalletUsage) Reset()         { *m = FeePayWalletUsage{} }
@@ -153,34 +240,217 @@ func (m *FeePayWalletUsage) GetUses() uint64 {
 	return 0
 }
 
+func (m *FeePayWalletUsage) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *FeePayWalletUsage) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+// This object is used to store the total fee a contract has sponsored
+// for all wallets.
+type FeePayContractSpend struct {
+	// The contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The wallet limit window the fees were sponsored in. The spend resets when
+	// the window rolls over.
+	Window uint64 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
+	// The fees sponsored for all wallets in the window.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *FeePayContractSpend) Reset()         { *m = FeePayContractSpend{} }
+func (m *FeePayContractSpend) String() string { return proto.CompactTextString(m) }
+func (*FeePayContractSpend) ProtoMessage()    {}
+func (*FeePayContractSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14ea6771eacbfed1, []int{2}
+}
+func (m *FeePayContractSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePayContractSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePayContractSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePayContractSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePayContractSpend.Merge(m, src)
+}
+func (m *FeePayContractSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePayContractSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePayContractSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePayContractSpend proto.InternalMessageInfo
+
+func (m *FeePayContractSpend) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *FeePayContractSpend) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *FeePayContractSpend) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+// This object is used to store the funds a funder has contributed to a
+// contract, and the pool shares of the contract balances they were minted.
+type FeePayFunder struct {
+	// The contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The funder address.
+	FunderAddress string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// The funds contributed by the funder.
+	Contributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=contributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"contributed"`
+	// The pool shares of the funder in each denom, which entitle the funder to
+	// the same fraction of the total shares of the contract balances in the
+	// denom. Shares are minted at the current ratio of the total shares to the
+	// balances when funding, so fees spent before lower the value of earlier
+	// shares only.
+	Shares github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=shares,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"shares"`
+}
+
+func (m *FeePayFunder) Reset()         { *m = FeePayFunder{} }
+func (m *FeePayFunder) String() string { return proto.CompactTextString(m) }
+func (*FeePayFunder) ProtoMessage()    {}
+func (*FeePayFunder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14ea6771eacbfed1, []int{3}
+}
+func (m *FeePayFunder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePayFunder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePayFunder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePayFunder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePayFunder.Merge(m, src)
+}
+func (m *FeePayFunder) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePayFunder) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePayFunder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePayFunder proto.InternalMessageInfo
+
+func (m *FeePayFunder) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *FeePayFunder) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *FeePayFunder) GetContributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Contributed
+	}
+	return nil
+}
+
+func (m *FeePayFunder) GetShares() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*FeePayContract)(nil), "juno.feepay.v1.FeePayContract")
 	proto.RegisterType((*FeePayWalletUsage)(nil), "juno.feepay.v1.FeePayWalletUsage")
+	proto.RegisterType((*FeePayContractSpend)(nil), "juno.feepay.v1.FeePayContractSpend")
+	proto.RegisterType((*FeePayFunder)(nil), "juno.feepay.v1.FeePayFunder")
 }
 
 func init() { proto.RegisterFile("juno/feepay/v1/feepay.proto", fileDescriptor_14ea6771eacbfed1) }
 
 var fileDescriptor_14ea6771eacbfed1 = []byte{
-	// 289 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x2a, 0xcd, 0xcb,
-	0xd7, 0x4f, 0x4b, 0x4d, 0x2d, 0x48, 0xac, 0xd4, 0x2f, 0x33, 0x84, 0xb2, 0xf4, 0x0a, 0x8a, 0xf2,
-	0x4b, 0xf2, 0x85, 0xf8, 0x40, 0x92, 0x7a, 0x50, 0xa1, 0x32, 0x43, 0x29, 0xc9, 0xe4, 0xfc, 0xe2,
-	0xdc, 0xfc, 0xe2, 0x78, 0xb0, 0xac, 0x3e, 0x84, 0x03, 0x51, 0xaa, 0x34, 0x89, 0x91, 0x8b, 0xcf,
-	0x2d, 0x35, 0x35, 0x20, 0xb1, 0xd2, 0x39, 0x3f, 0xaf, 0xa4, 0x28, 0x31, 0xb9, 0x44, 0xc8, 0x99,
-	0x4b, 0x20, 0x19, 0xca, 0x8e, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x96, 0x60, 0x54, 0x60,
-	0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04, 0xaa, 0xdd, 0x11, 0x22, 0x13, 0x5c, 0x52,
-	0x94, 0x99, 0x97, 0x1e, 0xc4, 0x0f, 0xd3, 0x01, 0x15, 0x16, 0x92, 0xe0, 0x62, 0x4f, 0x4a, 0xcc,
-	0x49, 0xcc, 0x4b, 0x4e, 0x95, 0x60, 0x52, 0x60, 0xd4, 0x60, 0x09, 0x82, 0x71, 0x85, 0x14, 0xb9,
-	0x78, 0xca, 0x13, 0x73, 0x72, 0x52, 0x4b, 0xe2, 0x73, 0x32, 0x73, 0x33, 0x4b, 0x24, 0x98, 0xc1,
-	0xd2, 0xdc, 0x10, 0x31, 0x1f, 0x90, 0x90, 0xd2, 0x5a, 0x46, 0x2e, 0x41, 0x88, 0xa3, 0xc2, 0xc1,
-	0xa2, 0xa1, 0xc5, 0x89, 0xe9, 0xa9, 0xd4, 0x71, 0x97, 0x3d, 0x17, 0x1f, 0xd4, 0x76, 0x98, 0x11,
-	0x4c, 0x04, 0x8c, 0xe0, 0x85, 0xa8, 0x87, 0x19, 0x20, 0xc4, 0xc5, 0x52, 0x5a, 0x9c, 0x5a, 0x0c,
-	0x75, 0x36, 0x98, 0xed, 0xe4, 0x71, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
-	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
-	0x7a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xce, 0x60, 0xd3, 0x61,
-	0xc1, 0x5c, 0xac, 0x0f, 0x8e, 0xc1, 0x0a, 0x58, 0x1c, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1,
-	0x81, 0x63, 0xc5, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xda, 0xa7, 0x40, 0x31, 0xdf, 0x01, 0x00,
-	0x00,
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xce, 0xa6, 0x69, 0x9a, 0x6e, 0xfa, 0xeb, 0xaf, 0xd9, 0x16, 0xe4, 0x16, 0xc9, 0x0d, 0x3d,
+	0x45, 0xa0, 0xda, 0x0a, 0x88, 0x33, 0x22, 0x41, 0x15, 0x07, 0x0e, 0x90, 0x0a, 0x55, 0xe2, 0x62,
+	0xad, 0xed, 0x8d, 0xbb, 0xd4, 0xf1, 0x06, 0xef, 0xba, 0x25, 0x17, 0x0e, 0x3c, 0x01, 0x47, 0x1e,
+	0x01, 0x21, 0x21, 0xf5, 0xc0, 0x43, 0xf4, 0x58, 0x71, 0x42, 0x1c, 0x28, 0x4a, 0x0e, 0xdc, 0x79,
+	0x02, 0xe4, 0xfd, 0x53, 0x5c, 0x09, 0x09, 0x0e, 0xf1, 0x25, 0xd9, 0x99, 0x6f, 0xf6, 0xfb, 0x76,
+	0x66, 0x76, 0xbc, 0xf0, 0xc6, 0x8b, 0x2c, 0x61, 0xee, 0x90, 0x90, 0x31, 0x9e, 0xb8, 0xc7, 0x5d,
+	0xbd, 0x72, 0xc6, 0x29, 0x13, 0x0c, 0xad, 0xe6, 0xa0, 0xa3, 0x5d, 0xc7, 0xdd, 0xad, 0x16, 0x1e,
+	0xd1, 0x84, 0xb9, 0xf2, 0x57, 0x85, 0x6c, 0xd9, 0x01, 0xe3, 0x23, 0xc6, 0x5d, 0x1f, 0x73, 0xe2,
+	0x1e, 0x77, 0x7d, 0x22, 0x70, 0xd7, 0x0d, 0x18, 0x4d, 0x34, 0xbe, 0xa9, 0x70, 0x4f, 0x5a, 0xae,
+	0x32, 0x34, 0xb4, 0x11, 0xb1, 0x88, 0x29, 0x7f, 0xbe, 0x32, 0x84, 0x11, 0x63, 0x51, 0x4c, 0x5c,
+	0x69, 0xf9, 0xd9, 0xd0, 0x0d, 0xb3, 0x14, 0x0b, 0xca, 0x34, 0xe1, 0xce, 0x69, 0x1d, 0xae, 0xee,
+	0x11, 0xf2, 0x04, 0x4f, 0xfa, 0x2c, 0x11, 0x29, 0x0e, 0x04, 0xea, 0xc3, 0xb5, 0x40, 0xaf, 0x3d,
+	0x1c, 0x86, 0x29, 0xe1, 0xdc, 0x02, 0x6d, 0xd0, 0x59, 0xee, 0x59, 0x9f, 0x3f, 0xed, 0x6e, 0x68,
+	0xd1, 0x07, 0x0a, 0xd9, 0x17, 0x29, 0x4d, 0xa2, 0xc1, 0xff, 0x66, 0x87, 0x76, 0x23, 0x0b, 0x2e,
+	0xf9, 0x38, 0xc6, 0x49, 0x40, 0xac, 0x6a, 0x1b, 0x74, 0x6a, 0x03, 0x63, 0xa2, 0x9b, 0x70, 0xe5,
+	0x04, 0xc7, 0x31, 0x11, 0x5e, 0x4c, 0x47, 0x54, 0x58, 0x0b, 0x12, 0x6e, 0x2a, 0xdf, 0xe3, 0xdc,
+	0x85, 0x1c, 0xb8, 0x2e, 0x31, 0xef, 0x84, 0x26, 0x21, 0x3b, 0xf1, 0xfc, 0x98, 0x05, 0x47, 0xdc,
+	0xaa, 0xc9, 0xc8, 0x96, 0x84, 0x0e, 0x24, 0xd2, 0x93, 0x00, 0x3a, 0x80, 0xd7, 0xae, 0xc4, 0x9b,
+	0x1c, 0xad, 0xc5, 0x36, 0xe8, 0x34, 0xef, 0x6c, 0x3a, 0xaa, 0x08, 0x8e, 0x29, 0x82, 0xf3, 0x50,
+	0x07, 0xf4, 0x1a, 0x67, 0xdf, 0xb6, 0x2b, 0xef, 0x2e, 0xb6, 0xc1, 0x60, 0xbd, 0x40, 0x6b, 0x60,
+	0x74, 0x1b, 0xb6, 0x48, 0x4c, 0x23, 0xea, 0xd3, 0x98, 0x8a, 0x89, 0xf7, 0x32, 0x23, 0xe9, 0xc4,
+	0xaa, 0xb7, 0x41, 0xa7, 0x31, 0x58, 0x2b, 0x00, 0x4f, 0x73, 0x3f, 0x8a, 0x61, 0x43, 0xe7, 0xc8,
+	0xad, 0xa5, 0xf6, 0x82, 0x14, 0xd6, 0xc5, 0xca, 0xdb, 0xe9, 0xe8, 0x76, 0x3a, 0x7d, 0x46, 0x93,
+	0xde, 0xbd, 0x5c, 0xf8, 0xc3, 0xc5, 0x76, 0x27, 0xa2, 0xe2, 0x30, 0xf3, 0x9d, 0x80, 0x8d, 0x74,
+	0x3b, 0xf5, 0xdf, 0x2e, 0x0f, 0x8f, 0x5c, 0x31, 0x19, 0x13, 0x2e, 0x37, 0xf0, 0xf7, 0x3f, 0x4e,
+	0x6f, 0x81, 0xc1, 0xa5, 0x02, 0x7a, 0x0d, 0x91, 0x2e, 0x23, 0x1f, 0x93, 0x24, 0xd4, 0xc5, 0x6c,
+	0x94, 0xa4, 0xbb, 0xa6, 0xb4, 0xf6, 0x73, 0x29, 0xd5, 0xa3, 0x37, 0x00, 0x6e, 0x5c, 0x5e, 0x93,
+	0xe2, 0x11, 0x96, 0x4b, 0x3a, 0x02, 0x32, 0x6a, 0x85, 0x43, 0x70, 0xb8, 0x22, 0x98, 0xc0, 0xb1,
+	0xc7, 0x0f, 0x71, 0x4a, 0xb8, 0x05, 0x4b, 0xd2, 0x6e, 0x4a, 0x95, 0x7d, 0x29, 0xb2, 0xf3, 0xb1,
+	0x0a, 0x5b, 0x6a, 0x64, 0x0e, 0x64, 0x51, 0x9e, 0x71, 0x1c, 0x91, 0xf9, 0x4c, 0xcd, 0x7d, 0xb8,
+	0xaa, 0x9b, 0x6a, 0x28, 0xaa, 0x7f, 0xa1, 0xf8, 0x4f, 0xc5, 0x1b, 0x02, 0x04, 0x6b, 0x19, 0x27,
+	0x5c, 0x0f, 0x95, 0x5c, 0xa3, 0xeb, 0xb0, 0xae, 0xe6, 0x42, 0x0f, 0x90, 0xb6, 0xd0, 0x10, 0x2e,
+	0xe6, 0x7d, 0x13, 0xd6, 0x62, 0x49, 0x55, 0x53, 0xf4, 0x3b, 0x5f, 0x01, 0x5c, 0xbf, 0xfa, 0x89,
+	0x91, 0x1d, 0x9c, 0x4f, 0xc5, 0x7e, 0x27, 0x57, 0xfd, 0x73, 0x72, 0x0b, 0xe5, 0x26, 0xf7, 0xb3,
+	0x0a, 0x57, 0x54, 0x72, 0x7b, 0x59, 0x12, 0x92, 0x74, 0x6e, 0xf7, 0x60, 0x28, 0xe9, 0xfe, 0xfd,
+	0x1e, 0xa8, 0x78, 0x43, 0x90, 0xc2, 0xa6, 0xe4, 0xa4, 0x7e, 0x26, 0x48, 0x58, 0x5a, 0x11, 0x8a,
+	0x22, 0xe8, 0x10, 0xd6, 0xf5, 0x18, 0xd6, 0x4a, 0x92, 0xd3, 0xfc, 0xbd, 0x47, 0x67, 0x53, 0x1b,
+	0x9c, 0x4f, 0x6d, 0xf0, 0x7d, 0x6a, 0x83, 0xb7, 0x33, 0xbb, 0x72, 0x3e, 0xb3, 0x2b, 0x5f, 0x66,
+	0x76, 0xe5, 0xb9, 0x53, 0x20, 0xec, 0x4b, 0x26, 0x73, 0xe7, 0xb8, 0x2b, 0x9f, 0xe6, 0x57, 0xe6,
+	0x71, 0x96, 0xe4, 0x7e, 0x5d, 0x3e, 0x09, 0x77, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x9f, 0xa3,
+	0xb3, 0x8d, 0xb8, 0x07, 0x00, 0x00,
 }
 
 func (m *FeePayContract) Marshal() (dAtA []byte, err error) {
@@ -203,6 +473,85 @@ func (m *FeePayContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TotalShares) > 0 {
+		for iNdEx := len(m.TotalShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeepay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ContractSpendLimit) > 0 {
+		for iNdEx := len(m.ContractSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeepay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.WalletSpendLimit) > 0 {
+		for iNdEx := len(m.WalletSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WalletSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeepay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeepay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.EligibilityQuery {
+		i--
+		if m.EligibilityQuery {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LimitWindowDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LimitWindowDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFeepay(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.LimitWindowBlocks != 0 {
+		i = encodeVarintFeepay(dAtA, i, uint64(m.LimitWindowBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.WalletLimit != 0 {
 		i = encodeVarintFeepay(dAtA, i, uint64(m.WalletLimit))
 		i--
@@ -243,6 +592,25 @@ func (m *FeePayWalletUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeepay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Window != 0 {
+		i = encodeVarintFeepay(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Uses != 0 {
 		i = encodeVarintFeepay(dAtA, i, uint64(m.Uses))
 		i--
@@ -265,6 +633,120 @@ func (m *FeePayWalletUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeePayContractSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePayContractSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePayContractSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeepay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Window != 0 {
+		i = encodeVarintFeepay(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintFeepay(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeePayFunder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePayFunder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePayFunder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeepay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Contributed) > 0 {
+		for iNdEx := len(m.Contributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeepay(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintFeepay(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintFeepay(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeepay(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeepay(v)
 	base := offset
@@ -292,6 +774,38 @@ func (m *FeePayContract) Size() (n int) {
 	if m.WalletLimit != 0 {
 		n += 1 + sovFeepay(uint64(m.WalletLimit))
 	}
+	if m.LimitWindowBlocks != 0 {
+		n += 1 + sovFeepay(uint64(m.LimitWindowBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LimitWindowDuration)
+	n += 1 + l + sovFeepay(uint64(l))
+	if m.EligibilityQuery {
+		n += 2
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovFeepay(uint64(l))
+		}
+	}
+	if len(m.WalletSpendLimit) > 0 {
+		for _, e := range m.WalletSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeepay(uint64(l))
+		}
+	}
+	if len(m.ContractSpendLimit) > 0 {
+		for _, e := range m.ContractSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeepay(uint64(l))
+		}
+	}
+	if len(m.TotalShares) > 0 {
+		for _, e := range m.TotalShares {
+			l = e.Size()
+			n += 1 + l + sovFeepay(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -309,19 +823,593 @@ func (m *FeePayWalletUsage) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovFeepay(uint64(l))
 	}
-	if m.Uses != 0 {
-		n += 1 + sovFeepay(uint64(m.Uses))
+	if m.Uses != 0 {
+		n += 1 + sovFeepay(uint64(m.Uses))
+	}
+	if m.Window != 0 {
+		n += 1 + sovFeepay(uint64(m.Window))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovFeepay(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeePayContractSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovFeepay(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovFeepay(uint64(m.Window))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovFeepay(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeePayFunder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovFeepay(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovFeepay(uint64(l))
+	}
+	if len(m.Contributed) > 0 {
+		for _, e := range m.Contributed {
+			l = e.Size()
+			n += 1 + l + sovFeepay(uint64(l))
+		}
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovFeepay(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFeepay(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeepay(x uint64) (n int) {
+	return sovFeepay(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeePayContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeepay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePayContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePayContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletLimit", wireType)
+			}
+			m.WalletLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitWindowBlocks", wireType)
+			}
+			m.LimitWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitWindowDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LimitWindowDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibilityQuery", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EligibilityQuery = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletSpendLimit = append(m.WalletSpendLimit, types.Coin{})
+			if err := m.WalletSpendLimit[len(m.WalletSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSpendLimit = append(m.ContractSpendLimit, types.Coin{})
+			if err := m.ContractSpendLimit[len(m.ContractSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalShares = append(m.TotalShares, types.Coin{})
+			if err := m.TotalShares[len(m.TotalShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeepay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *FeePayWalletUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeepay
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePayWalletUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePayWalletUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+			}
+			m.Uses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeepay(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovFeepay(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFeepay(x uint64) (n int) {
-	return sovFeepay(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *FeePayContract) Unmarshal(dAtA []byte) error {
+func (m *FeePayContractSpend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -344,10 +1432,10 @@ func (m *FeePayContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeePayContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeePayContractSpend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeePayContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeePayContractSpend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -384,9 +1472,9 @@ func (m *FeePayContract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			m.Balance = 0
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeepay
@@ -396,16 +1484,16 @@ func (m *FeePayContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Balance |= uint64(b&0x7F) << shift
+				m.Window |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletLimit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
-			m.WalletLimit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeepay
@@ -415,11 +1503,26 @@ func (m *FeePayContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WalletLimit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeepay(dAtA[iNdEx:])
@@ -441,7 +1544,7 @@ func (m *FeePayContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeePayWalletUsage) Unmarshal(dAtA []byte) error {
+func (m *FeePayFunder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -464,10 +1567,10 @@ func (m *FeePayWalletUsage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeePayWalletUsage: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeePayFunder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeePayWalletUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeePayFunder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -504,7 +1607,7 @@ func (m *FeePayWalletUsage) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -532,13 +1635,13 @@ func (m *FeePayWalletUsage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributed", wireType)
 			}
-			m.Uses = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeepay
@@ -548,11 +1651,60 @@ func (m *FeePayWalletUsage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Uses |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contributed = append(m.Contributed, types.Coin{})
+			if err := m.Contributed[len(m.Contributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeepay
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeepay
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, types.Coin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeepay(dAtA[iNdEx:])
@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(params Params, feePayContracts []FeePayContract) GenesisState {
@@ -15,9 +19,13 @@ func NewGenesisState(params Params, feePayContracts []FeePayContract) GenesisSta
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		Params: Params{
-			EnableFeepay: true,
+			EnableFeepay:             true,
+			EligibilityQueryGasLimit: DefaultEligibilityQueryGasLimit,
 		},
 		FeePayContracts: []FeePayContract{},
+		WalletUsages:    []FeePayWalletUsage{},
+		ContractSpends:  []FeePayContractSpend{},
+		Funders:         []FeePayFunder{},
 	}
 }
 
@@ -25,11 +33,87 @@ func DefaultGenesisState() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// Loop through all fee pay contracts and validate they
-	// have a valid bech32 address
+	// have a valid bech32 address, valid balances and spend limits
 	for _, contract := range gs.FeePayContracts {
 		if _, err := sdk.AccAddressFromBech32(contract.ContractAddress); err != nil {
 			return err
 		}
+
+		if err := contract.Balances.Validate(); err != nil {
+			return err
+		}
+
+		if err := contract.TotalShares.Validate(); err != nil {
+			return err
+		}
+
+		if err := ValidateSpendLimit(contract.WalletSpendLimit); err != nil {
+			return err
+		}
+
+		if err := ValidateSpendLimit(contract.ContractSpendLimit); err != nil {
+			return err
+		}
+	}
+
+	// Ensure the usages, spends and funders belong to fee pay contracts of the genesis
+	contracts := make(map[string]bool, len(gs.FeePayContracts))
+	for _, contract := range gs.FeePayContracts {
+		contracts[contract.ContractAddress] = true
+	}
+
+	for _, usage := range gs.WalletUsages {
+		if !contracts[usage.ContractAddress] {
+			return fmt.Errorf("wallet usage of unknown fee pay contract %s", usage.ContractAddress)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(usage.WalletAddress); err != nil {
+			return err
+		}
+
+		if err := usage.Spent.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, spend := range gs.ContractSpends {
+		if !contracts[spend.ContractAddress] {
+			return fmt.Errorf("spend of unknown fee pay contract %s", spend.ContractAddress)
+		}
+
+		if err := spend.Spent.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, funder := range gs.Funders {
+		if !contracts[funder.ContractAddress] {
+			return fmt.Errorf("funder of unknown fee pay contract %s", funder.ContractAddress)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(funder.FunderAddress); err != nil {
+			return err
+		}
+
+		if err := funder.Contributed.Validate(); err != nil {
+			return err
+		}
+
+		if err := funder.Shares.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Ensure the shares of the funders add up to the total shares of their contract
+	shares := make(map[string]sdk.Coins, len(gs.FeePayContracts))
+	for _, funder := range gs.Funders {
+		shares[funder.ContractAddress] = shares[funder.ContractAddress].Add(funder.Shares...)
+	}
+
+	for _, contract := range gs.FeePayContracts {
+		if !shares[contract.ContractAddress].Equal(contract.TotalShares) {
+			return fmt.Errorf("shares of the funders of fee pay contract %s (%s) do not add up to its total shares (%s)", contract.ContractAddress, shares[contract.ContractAddress], contract.TotalShares)
+		}
 	}
 
 	return nil
@@ -30,6 +30,14 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// fee_pay_contracts are the feepay module contracts
 	FeePayContracts []FeePayContract `protobuf:"bytes,2,rep,name=fee_pay_contracts,json=feePayContracts,proto3" json:"fee_pay_contracts"`
+	// wallet_usages are the uses and fees sponsored of the wallets on the
+	// feepay module contracts
+	WalletUsages []FeePayWalletUsage `protobuf:"bytes,3,rep,name=wallet_usages,json=walletUsages,proto3" json:"wallet_usages"`
+	// contract_spends are the fees sponsored by the feepay module contracts
+	ContractSpends []FeePayContractSpend `protobuf:"bytes,4,rep,name=contract_spends,json=contractSpends,proto3" json:"contract_spends"`
+	// funders are the contributions of the funders of the feepay module
+	// contracts
+	Funders []FeePayFunder `protobuf:"bytes,5,rep,name=funders,proto3" json:"funders"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,10 +87,35 @@ func (m *GenesisState) GetFeePayContracts() []FeePayContract {
 	return nil
 }
 
+func (m *GenesisState) GetWalletUsages() []FeePayWalletUsage {
+	if m != nil {
+		return m.WalletUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractSpends() []FeePayContractSpend {
+	if m != nil {
+		return m.ContractSpends
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFunders() []FeePayFunder {
+	if m != nil {
+		return m.Funders
+	}
+	return nil
+}
+
 // Params defines the feepay module params
 type Params struct {
 	// enable_feepay defines a parameter to enable the feepay module
 	EnableFeepay bool `protobuf:"varint,1,opt,name=enable_feepay,json=enableFeepay,proto3" json:"enable_feepay,omitempty"`
+	// eligibility_query_gas_limit defines the maximum amount of gas the eligibility
+	// query of a contract can use. Zero disables the eligibility queries, so
+	// contracts which decide their eligible wallets are not sponsored.
+	EligibilityQueryGasLimit uint64 `protobuf:"varint,2,opt,name=eligibility_query_gas_limit,json=eligibilityQueryGasLimit,proto3" json:"eligibility_query_gas_limit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -125,6 +158,13 @@ func (m *Params) GetEnableFeepay() bool {
 	return false
 }
 
+func (m *Params) GetEligibilityQueryGasLimit() uint64 {
+	if m != nil {
+		return m.EligibilityQueryGasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "juno.feepay.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "juno.feepay.v1.Params")
@@ -133,26 +173,35 @@ func init() {
 func init() { proto.RegisterFile("juno/feepay/v1/genesis.proto", fileDescriptor_ac1bd21601b5f553) }
 
 var fileDescriptor_ac1bd21601b5f553 = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x2a, 0xcd, 0xcb,
-	0xd7, 0x4f, 0x4b, 0x4d, 0x2d, 0x48, 0xac, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x03, 0xc9, 0xea, 0x41, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x89, 0x94, 0x48,
-	0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0xa5, 0xd1, 0x8c, 0x85, 0x1a, 0x01,
-	0x96, 0x54, 0x5a, 0xc0, 0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x27, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8,
-	0x92, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48,
-	0x4c, 0x0f, 0xd5, 0x5e, 0xbd, 0x00, 0xb0, 0xac, 0x13, 0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e,
-	0x6f, 0xd0, 0x62, 0x0c, 0x82, 0x6a, 0x10, 0x0a, 0xe5, 0x12, 0x4c, 0x4b, 0x4d, 0x8d, 0x2f, 0x48,
-	0xac, 0x8c, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x29, 0x96, 0x60, 0x52, 0x60, 0xd6,
-	0xe0, 0x36, 0x92, 0x43, 0x37, 0xc5, 0x2d, 0x35, 0x35, 0x20, 0xb1, 0xd2, 0x19, 0xaa, 0x0c, 0xd9,
-	0x34, 0xfe, 0x34, 0x14, 0xa9, 0x62, 0x25, 0x63, 0x2e, 0x36, 0x88, 0x9d, 0x42, 0xca, 0x5c, 0xbc,
-	0xa9, 0x79, 0x89, 0x49, 0x39, 0xa9, 0xf1, 0x10, 0x83, 0xc0, 0x4e, 0xe4, 0x08, 0xe2, 0x81, 0x08,
-	0xba, 0x81, 0xc5, 0xac, 0x58, 0x5e, 0x2c, 0x90, 0x67, 0x74, 0xf2, 0x38, 0xf1, 0x48, 0x8e, 0xf1,
-	0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xbd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc,
-	0x5c, 0x7d, 0xe7, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xb8, 0x55, 0xfa, 0xe0, 0x90, 0xaa, 0x80, 0x85,
-	0x55, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xa0, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x6f, 0x20, 0x5c, 0xf5, 0x9e, 0x01, 0x00, 0x00,
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x7d, 0x4d, 0x08, 0x70, 0x4d, 0x5b, 0xf5, 0x84, 0x90, 0xd5, 0x56, 0x6e, 0x68, 0x97,
+	0x88, 0xc1, 0x56, 0xcb, 0x04, 0x12, 0x03, 0xad, 0x94, 0x32, 0x30, 0xb4, 0xa9, 0xaa, 0x4a, 0x2c,
+	0xa7, 0xb3, 0xfb, 0x6c, 0x0e, 0xd9, 0x3e, 0xe3, 0x77, 0x6e, 0xf1, 0xa7, 0x80, 0x8f, 0xc0, 0xc8,
+	0xc8, 0xc7, 0xe8, 0xd8, 0x91, 0x09, 0xa1, 0x64, 0x80, 0x8f, 0x81, 0x7c, 0x76, 0xc0, 0x89, 0x22,
+	0x16, 0xeb, 0xf4, 0xfe, 0xbf, 0xf7, 0xbb, 0x93, 0xdf, 0xa3, 0x3b, 0xef, 0x8b, 0x54, 0x79, 0x21,
+	0x40, 0x26, 0x4a, 0xef, 0xfa, 0xc0, 0x8b, 0x20, 0x05, 0x94, 0xe8, 0x66, 0xb9, 0xd2, 0x8a, 0xad,
+	0x57, 0xa9, 0x5b, 0xa7, 0xee, 0xf5, 0xc1, 0xd6, 0xa6, 0x48, 0x64, 0xaa, 0x3c, 0xf3, 0xad, 0x91,
+	0xad, 0x47, 0x91, 0x8a, 0x94, 0x39, 0x7a, 0xd5, 0xa9, 0xa9, 0x6e, 0x2f, 0x68, 0x1b, 0x85, 0x09,
+	0xf7, 0x3e, 0x75, 0x68, 0xff, 0xa4, 0xbe, 0xe7, 0x5c, 0x0b, 0x0d, 0xec, 0x39, 0xed, 0x65, 0x22,
+	0x17, 0x09, 0xda, 0x64, 0x40, 0x86, 0xab, 0x87, 0x8f, 0xdd, 0xf9, 0x7b, 0xdd, 0x53, 0x93, 0x1e,
+	0x3d, 0xbc, 0xfd, 0xb1, 0x6b, 0x7d, 0xfd, 0xf5, 0xed, 0x29, 0x19, 0x37, 0x0d, 0xec, 0x82, 0x6e,
+	0x86, 0x00, 0x3c, 0x13, 0x25, 0x0f, 0x54, 0xaa, 0x73, 0x11, 0x68, 0xb4, 0x57, 0x06, 0x9d, 0xe1,
+	0xea, 0xa1, 0xb3, 0x68, 0x19, 0x01, 0x9c, 0x8a, 0xf2, 0xb8, 0xc1, 0xda, 0xb6, 0x8d, 0x70, 0x2e,
+	0x42, 0x76, 0x46, 0xd7, 0x6e, 0x44, 0x1c, 0x83, 0xe6, 0x05, 0x8a, 0x08, 0xd0, 0xee, 0x18, 0xe5,
+	0x93, 0xe5, 0xca, 0x4b, 0x83, 0x5e, 0x54, 0x64, 0xdb, 0xda, 0xbf, 0xf9, 0x57, 0x47, 0x76, 0x49,
+	0x37, 0x66, 0x2f, 0xe4, 0x98, 0x41, 0x7a, 0x85, 0x76, 0xd7, 0x48, 0xf7, 0xff, 0xff, 0xce, 0xf3,
+	0x8a, 0x6d, 0x6b, 0xd7, 0x83, 0x76, 0x82, 0xec, 0x15, 0xbd, 0x1f, 0x16, 0xe9, 0x15, 0xe4, 0x68,
+	0xdf, 0x33, 0xc2, 0x9d, 0xe5, 0xc2, 0x91, 0x81, 0xda, 0xa6, 0x59, 0xdf, 0x5e, 0x4e, 0x7b, 0xf5,
+	0x2f, 0x66, 0xfb, 0x74, 0x0d, 0x52, 0xe1, 0xc7, 0xc0, 0xeb, 0x76, 0x33, 0x91, 0x07, 0xe3, 0x7e,
+	0x5d, 0x1c, 0x99, 0x1a, 0x7b, 0x49, 0xb7, 0x21, 0x96, 0x91, 0xf4, 0x65, 0x2c, 0x75, 0xc9, 0x3f,
+	0x14, 0x90, 0x97, 0x3c, 0x12, 0xc8, 0x63, 0x99, 0x48, 0x6d, 0xaf, 0x0c, 0xc8, 0xb0, 0x3b, 0xb6,
+	0x5b, 0xc8, 0x59, 0x45, 0x9c, 0x08, 0x7c, 0x53, 0xe5, 0x2f, 0xba, 0xbf, 0xbf, 0xec, 0x92, 0xa3,
+	0xd7, 0xb7, 0x13, 0x87, 0xdc, 0x4d, 0x1c, 0xf2, 0x73, 0xe2, 0x90, 0xcf, 0x53, 0xc7, 0xba, 0x9b,
+	0x3a, 0xd6, 0xf7, 0xa9, 0x63, 0xbd, 0x75, 0x23, 0xa9, 0xdf, 0x15, 0xbe, 0x1b, 0xa8, 0xc4, 0x3b,
+	0x56, 0x98, 0x28, 0xfc, 0x3b, 0x18, 0xcf, 0xec, 0xd5, 0xc7, 0xd9, 0x66, 0xe9, 0x32, 0x03, 0xf4,
+	0x7b, 0x66, 0xad, 0x9e, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x4a, 0x4f, 0x7d, 0x51, 0xcc, 0x02,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -177,6 +226,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.EnableFeepay != that1.EnableFeepay {
 		return false
 	}
+	if this.EligibilityQueryGasLimit != that1.EligibilityQueryGasLimit {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -199,6 +251,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Funders) > 0 {
+		for iNdEx := len(m.Funders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ContractSpends) > 0 {
+		for iNdEx := len(m.ContractSpends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSpends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.WalletUsages) > 0 {
+		for iNdEx := len(m.WalletUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WalletUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.FeePayContracts) > 0 {
 		for iNdEx := len(m.FeePayContracts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -246,6 +340,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EligibilityQueryGasLimit != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EligibilityQueryGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.EnableFeepay {
 		i--
 		if m.EnableFeepay {
@@ -284,6 +383,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.WalletUsages) > 0 {
+		for _, e := range m.WalletUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractSpends) > 0 {
+		for _, e := range m.ContractSpends {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Funders) > 0 {
+		for _, e := range m.Funders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -296,6 +413,9 @@ func (m *Params) Size() (n int) {
 	if m.EnableFeepay {
 		n += 2
 	}
+	if m.EligibilityQueryGasLimit != 0 {
+		n += 1 + sovGenesis(uint64(m.EligibilityQueryGasLimit))
+	}
 	return n
 }
 
@@ -401,6 +521,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletUsages = append(m.WalletUsages, FeePayWalletUsage{})
+			if err := m.WalletUsages[len(m.WalletUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSpends = append(m.ContractSpends, FeePayContractSpend{})
+			if err := m.ContractSpends[len(m.ContractSpends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funders = append(m.Funders, FeePayFunder{})
+			if err := m.Funders[len(m.Funders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -471,6 +693,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableFeepay = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibilityQueryGasLimit", wireType)
+			}
+			m.EligibilityQueryGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EligibilityQueryGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,7 +1,11 @@
 package types
 
+import "cosmossdk.io/collections"
+
 const (
 	prefixParamsKey = iota + 1
+	prefixWalletUsagesKey
+	prefixFundersKey
 )
 
 const (
@@ -12,4 +16,8 @@ const (
 	StoreKey = ModuleName
 )
 
-var ParamsKey = []byte{prefixParamsKey}
+var (
+	ParamsKey       = []byte{prefixParamsKey}
+	WalletUsagesKey = collections.NewPrefix(prefixWalletUsagesKey)
+	FundersKey      = collections.NewPrefix(prefixFundersKey)
+)
@@ -1,8 +1,13 @@
 package types
 
+// DefaultEligibilityQueryGasLimit is the default maximum amount of gas the
+// eligibility query of a contract can use.
+const DefaultEligibilityQueryGasLimit uint64 = 100_000
+
 // default minting module parameters
 func DefaultParams() Params {
 	return Params{
-		EnableFeepay: false,
+		EnableFeepay:             false,
+		EligibilityQueryGasLimit: DefaultEligibilityQueryGasLimit,
 	}
 }
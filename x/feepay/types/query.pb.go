@@ -7,24 +7,30 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -326,26 +332,26 @@ func (m *QueryFeePayContractUsesResponse) GetUses() uint64 {
 	return 0
 }
 
-// Message for querying if a wallet is eligible for fee pay contract interactions
-type QueryFeePayWalletIsEligibleRequest struct {
+// Message for querying the stored usage of every wallet on a fee pay contract
+type QueryFeePayWalletUsagesRequest struct {
 	// The contract address.
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	// The wallet address.
-	WalletAddress string `protobuf:"bytes,2,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFeePayWalletIsEligibleRequest) Reset()         { *m = QueryFeePayWalletIsEligibleRequest{} }
-func (m *QueryFeePayWalletIsEligibleRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFeePayWalletIsEligibleRequest) ProtoMessage()    {}
-func (*QueryFeePayWalletIsEligibleRequest) Descriptor() ([]byte, []int) {
+func (m *QueryFeePayWalletUsagesRequest) Reset()         { *m = QueryFeePayWalletUsagesRequest{} }
+func (m *QueryFeePayWalletUsagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePayWalletUsagesRequest) ProtoMessage()    {}
+func (*QueryFeePayWalletUsagesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d6539df905bf35ca, []int{6}
 }
-func (m *QueryFeePayWalletIsEligibleRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryFeePayWalletUsagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFeePayWalletIsEligibleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFeePayWalletUsagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFeePayWalletIsEligibleRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFeePayWalletUsagesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -355,50 +361,53 @@ func (m *QueryFeePayWalletIsEligibleRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryFeePayWalletIsEligibleRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFeePayWalletIsEligibleRequest.Merge(m, src)
+func (m *QueryFeePayWalletUsagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePayWalletUsagesRequest.Merge(m, src)
 }
-func (m *QueryFeePayWalletIsEligibleRequest) XXX_Size() int {
+func (m *QueryFeePayWalletUsagesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFeePayWalletIsEligibleRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFeePayWalletIsEligibleRequest.DiscardUnknown(m)
+func (m *QueryFeePayWalletUsagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePayWalletUsagesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFeePayWalletIsEligibleRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryFeePayWalletUsagesRequest proto.InternalMessageInfo
 
-func (m *QueryFeePayWalletIsEligibleRequest) GetContractAddress() string {
+func (m *QueryFeePayWalletUsagesRequest) GetContractAddress() string {
 	if m != nil {
 		return m.ContractAddress
 	}
 	return ""
 }
 
-func (m *QueryFeePayWalletIsEligibleRequest) GetWalletAddress() string {
+func (m *QueryFeePayWalletUsagesRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.WalletAddress
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-// The response for querying if a wallet is eligible for fee pay contract interactions
-type QueryFeePayWalletIsEligibleResponse struct {
-	// The eligibility of the wallet for fee pay contract interactions
-	Eligible bool `protobuf:"varint,1,opt,name=eligible,proto3" json:"eligible,omitempty"`
+// The response for querying the stored usage of every wallet on a fee pay contract
+type QueryFeePayWalletUsagesResponse struct {
+	// The stored wallet usages, including usages of past limit windows which are
+	// reset on the next interaction
+	WalletUsages []FeePayWalletUsage `protobuf:"bytes,1,rep,name=wallet_usages,json=walletUsages,proto3" json:"wallet_usages"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFeePayWalletIsEligibleResponse) Reset()         { *m = QueryFeePayWalletIsEligibleResponse{} }
-func (m *QueryFeePayWalletIsEligibleResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFeePayWalletIsEligibleResponse) ProtoMessage()    {}
-func (*QueryFeePayWalletIsEligibleResponse) Descriptor() ([]byte, []int) {
+func (m *QueryFeePayWalletUsagesResponse) Reset()         { *m = QueryFeePayWalletUsagesResponse{} }
+func (m *QueryFeePayWalletUsagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePayWalletUsagesResponse) ProtoMessage()    {}
+func (*QueryFeePayWalletUsagesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d6539df905bf35ca, []int{7}
 }
-func (m *QueryFeePayWalletIsEligibleResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryFeePayWalletUsagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFeePayWalletIsEligibleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFeePayWalletUsagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFeePayWalletIsEligibleResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFeePayWalletUsagesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -408,41 +417,52 @@ func (m *QueryFeePayWalletIsEligibleResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryFeePayWalletIsEligibleResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFeePayWalletIsEligibleResponse.Merge(m, src)
+func (m *QueryFeePayWalletUsagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePayWalletUsagesResponse.Merge(m, src)
 }
-func (m *QueryFeePayWalletIsEligibleResponse) XXX_Size() int {
+func (m *QueryFeePayWalletUsagesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFeePayWalletIsEligibleResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFeePayWalletIsEligibleResponse.DiscardUnknown(m)
+func (m *QueryFeePayWalletUsagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePayWalletUsagesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFeePayWalletIsEligibleResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryFeePayWalletUsagesResponse proto.InternalMessageInfo
 
-func (m *QueryFeePayWalletIsEligibleResponse) GetEligible() bool {
+func (m *QueryFeePayWalletUsagesResponse) GetWalletUsages() []FeePayWalletUsage {
 	if m != nil {
-		return m.Eligible
+		return m.WalletUsages
 	}
-	return false
+	return nil
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+func (m *QueryFeePayWalletUsagesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+// Message for querying the contributions of the funders of a fee pay contract
+type QueryFeePayFundersRequest struct {
+	// The contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeePayFundersRequest) Reset()         { *m = QueryFeePayFundersRequest{} }
+func (m *QueryFeePayFundersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePayFundersRequest) ProtoMessage()    {}
+func (*QueryFeePayFundersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d6539df905bf35ca, []int{8}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryFeePayFundersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFeePayFundersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFeePayFundersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -452,36 +472,52 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryFeePayFundersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePayFundersRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryFeePayFundersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryFeePayFundersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePayFundersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryFeePayFundersRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params is the returned Feepay parameter
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryFeePayFundersRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryFeePayFundersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// The response for querying the contributions of the funders of a fee pay contract
+type QueryFeePayFundersResponse struct {
+	// The contributions of the funders
+	Funders []FeePayFunder `protobuf:"bytes,1,rep,name=funders,proto3" json:"funders"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeePayFundersResponse) Reset()         { *m = QueryFeePayFundersResponse{} }
+func (m *QueryFeePayFundersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePayFundersResponse) ProtoMessage()    {}
+func (*QueryFeePayFundersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_d6539df905bf35ca, []int{9}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryFeePayFundersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFeePayFundersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFeePayFundersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -491,816 +527,3694 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryFeePayFundersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePayFundersResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryFeePayFundersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryFeePayFundersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePayFundersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryFeePayFundersResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryFeePayFundersResponse) GetFunders() []FeePayFunder {
 	if m != nil {
-		return m.Params
+		return m.Funders
 	}
-	return Params{}
-}
-
-func init() {
-	proto.RegisterType((*QueryFeePayContractRequest)(nil), "juno.feepay.v1.QueryFeePayContractRequest")
-	proto.RegisterType((*QueryFeePayContractResponse)(nil), "juno.feepay.v1.QueryFeePayContractResponse")
-	proto.RegisterType((*QueryFeePayContractsRequest)(nil), "juno.feepay.v1.QueryFeePayContractsRequest")
-	proto.RegisterType((*QueryFeePayContractsResponse)(nil), "juno.feepay.v1.QueryFeePayContractsResponse")
-	proto.RegisterType((*QueryFeePayContractUsesRequest)(nil), "juno.feepay.v1.QueryFeePayContractUsesRequest")
-	proto.RegisterType((*QueryFeePayContractUsesResponse)(nil), "juno.feepay.v1.QueryFeePayContractUsesResponse")
-	proto.RegisterType((*QueryFeePayWalletIsEligibleRequest)(nil), "juno.feepay.v1.QueryFeePayWalletIsEligibleRequest")
-	proto.RegisterType((*QueryFeePayWalletIsEligibleResponse)(nil), "juno.feepay.v1.QueryFeePayWalletIsEligibleResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "juno.feepay.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "juno.feepay.v1.QueryParamsResponse")
+	return nil
 }
 
-func init() { proto.RegisterFile("juno/feepay/v1/query.proto", fileDescriptor_d6539df905bf35ca) }
-
-var fileDescriptor_d6539df905bf35ca = []byte{
-	// 744 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xce, 0xf4, 0xd7, 0x5f, 0x68, 0x47, 0x4c, 0xdb, 0xb1, 0x94, 0xba, 0xad, 0x5b, 0xd9, 0x82,
-	0x4a, 0x6a, 0x77, 0x48, 0x8b, 0xa0, 0x27, 0x69, 0x43, 0x5b, 0xbd, 0x48, 0xdc, 0x52, 0x04, 0x2f,
-	0x61, 0x92, 0x4e, 0xd7, 0x95, 0xcd, 0xce, 0x76, 0x67, 0x13, 0x0d, 0xa5, 0x17, 0x4f, 0x1e, 0x05,
-	0x2f, 0xde, 0x05, 0x11, 0x4f, 0x1e, 0x3c, 0x7b, 0x13, 0x7a, 0x2c, 0x8a, 0xe0, 0x49, 0x4a, 0x2b,
-	0xf8, 0x6f, 0xc8, 0xce, 0xcc, 0x76, 0xb3, 0xdb, 0xb4, 0x4d, 0xc0, 0x83, 0x97, 0xb0, 0xf3, 0xde,
-	0xfb, 0xde, 0xfb, 0xbe, 0x79, 0xef, 0x4d, 0xa0, 0xf6, 0xb4, 0xe9, 0x31, 0xbc, 0x45, 0xa9, 0x4f,
-	0xda, 0xb8, 0x55, 0xc2, 0xdb, 0x4d, 0x1a, 0xb4, 0x4d, 0x3f, 0x60, 0x21, 0x43, 0x85, 0xc8, 0x67,
-	0x4a, 0x9f, 0xd9, 0x2a, 0x69, 0x63, 0xa4, 0xe1, 0x78, 0x0c, 0x8b, 0x5f, 0x19, 0xa2, 0x15, 0xeb,
-	0x8c, 0x37, 0x18, 0xc7, 0x35, 0xc2, 0xa9, 0xc4, 0xe2, 0x56, 0xa9, 0x46, 0x43, 0x52, 0xc2, 0x3e,
-	0xb1, 0x1d, 0x8f, 0x84, 0x0e, 0xf3, 0x54, 0xec, 0x94, 0x8a, 0x8d, 0xc3, 0x3a, 0x6b, 0x69, 0x97,
-	0xa5, 0xb3, 0x2a, 0x4e, 0x58, 0x1e, 0x94, 0x6b, 0xdc, 0x66, 0x36, 0x93, 0xf6, 0xe8, 0x4b, 0x59,
-	0xa7, 0x6d, 0xc6, 0x6c, 0x97, 0x62, 0xe2, 0x3b, 0x98, 0x78, 0x1e, 0x0b, 0x45, 0xa9, 0x18, 0x33,
-	0x95, 0x91, 0xa5, 0x44, 0x28, 0x68, 0xc6, 0x69, 0x53, 0x8f, 0x72, 0x47, 0x41, 0x0d, 0x02, 0xb5,
-	0x87, 0x11, 0xb1, 0x55, 0x4a, 0x2b, 0xa4, 0x5d, 0x66, 0x5e, 0x18, 0x90, 0x7a, 0x68, 0xd1, 0xed,
-	0x26, 0xe5, 0x21, 0x2a, 0xc3, 0xd1, 0xba, 0x32, 0x55, 0xc9, 0xe6, 0x66, 0x40, 0x39, 0x9f, 0x04,
-	0x57, 0xc1, 0x8d, 0xe1, 0xe5, 0xc9, 0xaf, 0x9f, 0xe6, 0xc7, 0x15, 0xf1, 0x25, 0xe9, 0x59, 0x0f,
-	0x03, 0xc7, 0xb3, 0xad, 0x91, 0x18, 0xa1, 0xcc, 0x46, 0x00, 0xa7, 0xba, 0x96, 0xe0, 0x3e, 0xf3,
-	0x38, 0x45, 0xeb, 0x70, 0x74, 0x8b, 0xd2, 0xaa, 0x4f, 0xda, 0xd5, 0x18, 0x29, 0x6a, 0x5c, 0x58,
-	0xd0, 0xcd, 0x74, 0x4b, 0xcc, 0x74, 0x86, 0xe5, 0xe1, 0xbd, 0x9f, 0x33, 0xb9, 0xf7, 0xbf, 0x3f,
-	0x16, 0x81, 0x55, 0xd8, 0x4a, 0xb9, 0x0c, 0xda, 0xb5, 0x26, 0x8f, 0x75, 0xad, 0x42, 0x98, 0x34,
-	0x4c, 0x55, 0xbb, 0x66, 0x2a, 0x39, 0x51, 0x77, 0x4d, 0xd9, 0x2d, 0xd5, 0x5d, 0xb3, 0x42, 0x6c,
-	0xaa, 0xb0, 0x56, 0x07, 0xd2, 0xf8, 0x0c, 0xe0, 0x74, 0xf7, 0x3a, 0x4a, 0xdc, 0x06, 0x1c, 0xcb,
-	0x8a, 0x8b, 0x6e, 0xf0, 0xbf, 0xfe, 0xd4, 0x8d, 0xa4, 0xd5, 0x71, 0xb4, 0x96, 0xe2, 0x3f, 0x20,
-	0xf8, 0x5f, 0x3f, 0x97, 0xbf, 0xe4, 0x94, 0x12, 0xf0, 0x0e, 0x40, 0xbd, 0x8b, 0x80, 0x0d, 0x4e,
-	0xf9, 0xdf, 0x9c, 0x01, 0x74, 0x17, 0x16, 0x9e, 0x11, 0xd7, 0xa5, 0x49, 0x8a, 0x81, 0x73, 0x52,
-	0x5c, 0x94, 0xf1, 0xf1, 0x10, 0xdd, 0x82, 0x33, 0xa7, 0xf2, 0x54, 0x77, 0x8d, 0xe0, 0x60, 0x93,
-	0x53, 0x49, 0x6e, 0xd0, 0x12, 0xdf, 0xc6, 0x07, 0x00, 0x8d, 0x0e, 0xdc, 0x23, 0x91, 0xf3, 0x3e,
-	0x5f, 0x71, 0x1d, 0xdb, 0xa9, 0xb9, 0xf4, 0xdf, 0xd2, 0xb8, 0x04, 0x67, 0xcf, 0xe4, 0xaa, 0x74,
-	0x6a, 0x70, 0x88, 0x2a, 0x9b, 0x20, 0x39, 0x64, 0x1d, 0x9f, 0x8d, 0x71, 0x88, 0x44, 0x8a, 0x0a,
-	0x09, 0x48, 0x23, 0x6e, 0xa1, 0x51, 0x81, 0x97, 0x52, 0x56, 0x95, 0xe8, 0x0e, 0xcc, 0xfb, 0xc2,
-	0xa2, 0x36, 0x60, 0x22, 0x3b, 0x91, 0x32, 0xbe, 0x73, 0x12, 0x15, 0x60, 0xe1, 0x20, 0x0f, 0xff,
-	0x17, 0x29, 0xd1, 0x5b, 0x00, 0x0b, 0xe9, 0xa6, 0xa0, 0x62, 0x36, 0xcf, 0xe9, 0x2f, 0x8c, 0x36,
-	0xd7, 0x53, 0xac, 0x24, 0x6c, 0xdc, 0x7e, 0x19, 0x91, 0x78, 0xf1, 0xed, 0xd7, 0xeb, 0x81, 0x79,
-	0x34, 0x87, 0x33, 0x0f, 0x5b, 0xdc, 0x0f, 0xbc, 0x93, 0xed, 0xe5, 0x2e, 0x7a, 0x03, 0xe0, 0x48,
-	0x66, 0x47, 0x51, 0x2f, 0xa5, 0xe3, 0x2b, 0xd4, 0x6e, 0xf6, 0x16, 0xac, 0x88, 0x16, 0x13, 0xa2,
-	0x33, 0xe8, 0x4a, 0x96, 0x28, 0x71, 0xdd, 0xe4, 0x35, 0x40, 0x5f, 0x00, 0x44, 0x27, 0xa7, 0x1a,
-	0x99, 0x3d, 0x14, 0xec, 0x58, 0x53, 0x0d, 0xf7, 0x1c, 0xaf, 0x38, 0x3e, 0x48, 0x38, 0x96, 0xd1,
-	0x52, 0x1f, 0x97, 0x89, 0xa3, 0xcd, 0xc2, 0x3b, 0xe9, 0x59, 0xdf, 0x45, 0xdf, 0x01, 0x9c, 0xe8,
-	0x3e, 0xb9, 0x68, 0xe1, 0x0c, 0x6e, 0xa7, 0xac, 0xa4, 0xb6, 0xd8, 0x17, 0x46, 0x69, 0xb2, 0x12,
-	0x4d, 0x6b, 0x68, 0xa5, 0x1f, 0x4d, 0xf1, 0x06, 0x9d, 0xd4, 0xd5, 0x82, 0x79, 0xb9, 0x07, 0xc8,
-	0xe8, 0x4a, 0x29, 0xb5, 0x6a, 0xda, 0xec, 0x99, 0x31, 0x8a, 0xe6, 0x6c, 0x42, 0x73, 0x12, 0x4d,
-	0x64, 0x69, 0xca, 0x15, 0x5b, 0xbe, 0xb7, 0x77, 0xa8, 0x83, 0xfd, 0x43, 0x1d, 0x1c, 0x1c, 0xea,
-	0xe0, 0xd5, 0x91, 0x9e, 0xdb, 0x3f, 0xd2, 0x73, 0x3f, 0x8e, 0xf4, 0xdc, 0x63, 0xd3, 0x76, 0xc2,
-	0x27, 0xcd, 0x9a, 0x59, 0x67, 0x0d, 0x5c, 0x16, 0xef, 0xca, 0xf1, 0x04, 0xca, 0x5c, 0xcf, 0xe3,
-	0x6c, 0x61, 0xdb, 0xa7, 0xbc, 0x96, 0x17, 0x7f, 0xf5, 0x8b, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xde, 0xd1, 0x93, 0xd0, 0xfe, 0x08, 0x00, 0x00,
+func (m *QueryFeePayFundersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// FeePayContract queries a single fee pay contract by address
-	FeePayContract(ctx context.Context, in *QueryFeePayContractRequest, opts ...grpc.CallOption) (*QueryFeePayContractResponse, error)
-	// Retrieve all fee pay contracts
-	FeePayContracts(ctx context.Context, in *QueryFeePayContractsRequest, opts ...grpc.CallOption) (*QueryFeePayContractsResponse, error)
-	// Retrieve the number of uses on a fee pay contract by wallet
-	FeePayContractUses(ctx context.Context, in *QueryFeePayContractUsesRequest, opts ...grpc.CallOption) (*QueryFeePayContractUsesResponse, error)
-	// Query if sender is eligible for fee pay contract interaction
-	FeePayWalletIsEligible(ctx context.Context, in *QueryFeePayWalletIsEligibleRequest, opts ...grpc.CallOption) (*QueryFeePayWalletIsEligibleResponse, error)
-	// Params retrieves the FeePay module params
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+// Message for querying the contribution of a funder of a fee pay contract
+type QueryFeePayFunderRequest struct {
+	// The contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The funder address.
+	FunderAddress string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryFeePayFunderRequest) Reset()         { *m = QueryFeePayFunderRequest{} }
+func (m *QueryFeePayFunderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePayFunderRequest) ProtoMessage()    {}
+func (*QueryFeePayFunderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6539df905bf35ca, []int{10}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryFeePayFunderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) FeePayContract(ctx context.Context, in *QueryFeePayContractRequest, opts ...grpc.CallOption) (*QueryFeePayContractResponse, error) {
-	out := new(QueryFeePayContractResponse)
-	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/FeePayContract", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeePayFunderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePayFunderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) FeePayContracts(ctx context.Context, in *QueryFeePayContractsRequest, opts ...grpc.CallOption) (*QueryFeePayContractsResponse, error) {
-	out := new(QueryFeePayContractsResponse)
-	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/FeePayContracts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFeePayFunderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePayFunderRequest.Merge(m, src)
 }
-
-func (c *queryClient) FeePayContractUses(ctx context.Context, in *QueryFeePayContractUsesRequest, opts ...grpc.CallOption) (*QueryFeePayContractUsesResponse, error) {
-	out := new(QueryFeePayContractUsesResponse)
-	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/FeePayContractUses", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryFeePayFunderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePayFunderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePayFunderRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) FeePayWalletIsEligible(ctx context.Context, in *QueryFeePayWalletIsEligibleRequest, opts ...grpc.CallOption) (*QueryFeePayWalletIsEligibleResponse, error) {
-	out := new(QueryFeePayWalletIsEligibleResponse)
-	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/FeePayWalletIsEligible", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeePayFunderRequest proto.InternalMessageInfo
+
+func (m *QueryFeePayFunderRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeePayFunderRequest) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// FeePayContract queries a single fee pay contract by address
-	FeePayContract(context.Context, *QueryFeePayContractRequest) (*QueryFeePayContractResponse, error)
-	// Retrieve all fee pay contracts
-	FeePayContracts(context.Context, *QueryFeePayContractsRequest) (*QueryFeePayContractsResponse, error)
-	// Retrieve the number of uses on a fee pay contract by wallet
-	FeePayContractUses(context.Context, *QueryFeePayContractUsesRequest) (*QueryFeePayContractUsesResponse, error)
-	// Query if sender is eligible for fee pay contract interaction
-	FeePayWalletIsEligible(context.Context, *QueryFeePayWalletIsEligibleRequest) (*QueryFeePayWalletIsEligibleResponse, error)
-	// Params retrieves the FeePay module params
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// The response for querying the contribution of a funder of a fee pay contract
+type QueryFeePayFunderResponse struct {
+	// The contribution of the funder
+	Funder FeePayFunder `protobuf:"bytes,1,opt,name=funder,proto3" json:"funder"`
+	// The share of the funder of the unspent funds, refunded on withdrawal
+	Unspent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unspent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unspent"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryFeePayFunderResponse) Reset()         { *m = QueryFeePayFunderResponse{} }
+func (m *QueryFeePayFunderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePayFunderResponse) ProtoMessage()    {}
+func (*QueryFeePayFunderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6539df905bf35ca, []int{11}
 }
-
-func (*UnimplementedQueryServer) FeePayContract(ctx context.Context, req *QueryFeePayContractRequest) (*QueryFeePayContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeePayContract not implemented")
+func (m *QueryFeePayFunderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) FeePayContracts(ctx context.Context, req *QueryFeePayContractsRequest) (*QueryFeePayContractsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeePayContracts not implemented")
+func (m *QueryFeePayFunderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePayFunderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) FeePayContractUses(ctx context.Context, req *QueryFeePayContractUsesRequest) (*QueryFeePayContractUsesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeePayContractUses not implemented")
+func (m *QueryFeePayFunderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePayFunderResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) FeePayWalletIsEligible(ctx context.Context, req *QueryFeePayWalletIsEligibleRequest) (*QueryFeePayWalletIsEligibleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeePayWalletIsEligible not implemented")
+func (m *QueryFeePayFunderResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryFeePayFunderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePayFunderResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryFeePayFunderResponse proto.InternalMessageInfo
 
-func _Query_FeePayContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeePayContractRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryFeePayFunderResponse) GetFunder() FeePayFunder {
+	if m != nil {
+		return m.Funder
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeePayContract(ctx, in)
+	return FeePayFunder{}
+}
+
+func (m *QueryFeePayFunderResponse) GetUnspent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Unspent
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.feepay.v1.Query/FeePayContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeePayContract(ctx, req.(*QueryFeePayContractRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_FeePayContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeePayContractsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeePayContracts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.feepay.v1.Query/FeePayContracts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeePayContracts(ctx, req.(*QueryFeePayContractsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// Message for querying if a wallet is eligible for fee pay contract interactions
+type QueryFeePayWalletIsEligibleRequest struct {
+	// The contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The wallet address.
+	WalletAddress string `protobuf:"bytes,2,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
 }
 
-func _Query_FeePayContractUses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeePayContractUsesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeePayContractUses(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.feepay.v1.Query/FeePayContractUses",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeePayContractUses(ctx, req.(*QueryFeePayContractUsesRequest))
+func (m *QueryFeePayWalletIsEligibleRequest) Reset()         { *m = QueryFeePayWalletIsEligibleRequest{} }
+func (m *QueryFeePayWalletIsEligibleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePayWalletIsEligibleRequest) ProtoMessage()    {}
+func (*QueryFeePayWalletIsEligibleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6539df905bf35ca, []int{12}
+}
+func (m *QueryFeePayWalletIsEligibleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePayWalletIsEligibleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePayWalletIsEligibleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFeePayWalletIsEligibleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePayWalletIsEligibleRequest.Merge(m, src)
+}
+func (m *QueryFeePayWalletIsEligibleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePayWalletIsEligibleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePayWalletIsEligibleRequest.DiscardUnknown(m)
 }
 
-func _Query_FeePayWalletIsEligible_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeePayWalletIsEligibleRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeePayWalletIsEligible(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.feepay.v1.Query/FeePayWalletIsEligible",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeePayWalletIsEligible(ctx, req.(*QueryFeePayWalletIsEligibleRequest))
+var xxx_messageInfo_QueryFeePayWalletIsEligibleRequest proto.InternalMessageInfo
+
+func (m *QueryFeePayWalletIsEligibleRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/juno.feepay.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryFeePayWalletIsEligibleRequest) GetWalletAddress() string {
+	if m != nil {
+		return m.WalletAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "juno.feepay.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "FeePayContract",
-			Handler:    _Query_FeePayContract_Handler,
-		},
-		{
-			MethodName: "FeePayContracts",
-			Handler:    _Query_FeePayContracts_Handler,
-		},
-		{
-			MethodName: "FeePayContractUses",
-			Handler:    _Query_FeePayContractUses_Handler,
-		},
-		{
-			MethodName: "FeePayWalletIsEligible",
-			Handler:    _Query_FeePayWalletIsEligible_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "juno/feepay/v1/query.proto",
+// The response for querying if a wallet is eligible for fee pay contract interactions
+type QueryFeePayWalletIsEligibleResponse struct {
+	// The eligibility of the wallet for fee pay contract interactions
+	Eligible bool `protobuf:"varint,1,opt,name=eligible,proto3" json:"eligible,omitempty"`
 }
 
-func (m *QueryFeePayContractRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFeePayWalletIsEligibleResponse) Reset()         { *m = QueryFeePayWalletIsEligibleResponse{} }
+func (m *QueryFeePayWalletIsEligibleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePayWalletIsEligibleResponse) ProtoMessage()    {}
+func (*QueryFeePayWalletIsEligibleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6539df905bf35ca, []int{13}
 }
-
-func (m *QueryFeePayContractRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeePayWalletIsEligibleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryFeePayContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryFeePayWalletIsEligibleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePayWalletIsEligibleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeePayWalletIsEligibleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePayWalletIsEligibleResponse.Merge(m, src)
+}
+func (m *QueryFeePayWalletIsEligibleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePayWalletIsEligibleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePayWalletIsEligibleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFeePayContractResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeePayWalletIsEligibleResponse proto.InternalMessageInfo
+
+func (m *QueryFeePayWalletIsEligibleResponse) GetEligible() bool {
+	if m != nil {
+		return m.Eligible
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryFeePayContractResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Message for querying the remaining allowance of a wallet on a fee pay contract
+type QueryFeePayWalletAllowanceRequest struct {
+	// The contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The wallet address.
+	WalletAddress string `protobuf:"bytes,2,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
 }
 
-func (m *QueryFeePayContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FeePayContract.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryFeePayWalletAllowanceRequest) Reset()         { *m = QueryFeePayWalletAllowanceRequest{} }
+func (m *QueryFeePayWalletAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePayWalletAllowanceRequest) ProtoMessage()    {}
+func (*QueryFeePayWalletAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6539df905bf35ca, []int{14}
+}
+func (m *QueryFeePayWalletAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePayWalletAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePayWalletAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeePayWalletAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePayWalletAllowanceRequest.Merge(m, src)
+}
+func (m *QueryFeePayWalletAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePayWalletAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePayWalletAllowanceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFeePayContractsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeePayWalletAllowanceRequest proto.InternalMessageInfo
+
+func (m *QueryFeePayWalletAllowanceRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFeePayContractsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryFeePayContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryFeePayWalletAllowanceRequest) GetWalletAddress() string {
+	if m != nil {
+		return m.WalletAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryFeePayContractsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// The response for querying the remaining allowance of a wallet on a fee pay contract
+type QueryFeePayWalletAllowanceResponse struct {
+	// The number of uses of the wallet in the current limit window
+	Uses uint64 `protobuf:"varint,1,opt,name=uses,proto3" json:"uses,omitempty"`
+	// The number of uses remaining in the current limit window
+	Remaining uint64 `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	// The height at which the allowance resets. Zero if the contract has no
+	// block window.
+	ResetHeight int64 `protobuf:"varint,3,opt,name=reset_height,json=resetHeight,proto3" json:"reset_height,omitempty"`
+	// The block time at which the allowance resets. Zero if the contract has no
+	// time window.
+	ResetTime time.Time `protobuf:"bytes,4,opt,name=reset_time,json=resetTime,proto3,stdtime" json:"reset_time"`
 }
 
-func (m *QueryFeePayContractsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeePayWalletAllowanceResponse) Reset()         { *m = QueryFeePayWalletAllowanceResponse{} }
+func (m *QueryFeePayWalletAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePayWalletAllowanceResponse) ProtoMessage()    {}
+func (*QueryFeePayWalletAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6539df905bf35ca, []int{15}
 }
-
-func (m *QueryFeePayContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FeePayContracts) > 0 {
-		for iNdEx := len(m.FeePayContracts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeePayContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryFeePayWalletAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePayWalletAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePayWalletAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeePayWalletAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePayWalletAllowanceResponse.Merge(m, src)
+}
+func (m *QueryFeePayWalletAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePayWalletAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePayWalletAllowanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFeePayContractUsesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeePayWalletAllowanceResponse proto.InternalMessageInfo
+
+func (m *QueryFeePayWalletAllowanceResponse) GetUses() uint64 {
+	if m != nil {
+		return m.Uses
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFeePayContractUsesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeePayWalletAllowanceResponse) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
 }
 
-func (m *QueryFeePayContractUsesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WalletAddress) > 0 {
-		i -= len(m.WalletAddress)
-		copy(dAtA[i:], m.WalletAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryFeePayWalletAllowanceResponse) GetResetHeight() int64 {
+	if m != nil {
+		return m.ResetHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFeePayContractUsesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeePayWalletAllowanceResponse) GetResetTime() time.Time {
+	if m != nil {
+		return m.ResetTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *QueryFeePayContractUsesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Message for querying the fees a fee pay contract has sponsored
+type QueryFeePaySpendRequest struct {
+	// The contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The wallet address.
+	WalletAddress string `protobuf:"bytes,2,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
 }
 
-func (m *QueryFeePayContractUsesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Uses != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Uses))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryFeePaySpendRequest) Reset()         { *m = QueryFeePaySpendRequest{} }
+func (m *QueryFeePaySpendRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePaySpendRequest) ProtoMessage()    {}
+func (*QueryFeePaySpendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6539df905bf35ca, []int{16}
 }
-
-func (m *QueryFeePayWalletIsEligibleRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeePaySpendRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePaySpendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePaySpendRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryFeePayWalletIsEligibleRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeePaySpendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePaySpendRequest.Merge(m, src)
+}
+func (m *QueryFeePaySpendRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePaySpendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePaySpendRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFeePayWalletIsEligibleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WalletAddress) > 0 {
-		i -= len(m.WalletAddress)
-		copy(dAtA[i:], m.WalletAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryFeePaySpendRequest proto.InternalMessageInfo
+
+func (m *QueryFeePaySpendRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryFeePayWalletIsEligibleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeePaySpendRequest) GetWalletAddress() string {
+	if m != nil {
+		return m.WalletAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFeePayWalletIsEligibleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// The response for querying the fees a fee pay contract has sponsored
+type QueryFeePaySpendResponse struct {
+	// The fees sponsored for the wallet in the current limit window
+	WalletSpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=wallet_spent,json=walletSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"wallet_spent"`
+	// The fees sponsored for all wallets in the current limit window
+	ContractSpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=contract_spent,json=contractSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"contract_spent"`
 }
 
-func (m *QueryFeePayWalletIsEligibleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Eligible {
-		i--
-		if m.Eligible {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryFeePaySpendResponse) Reset()         { *m = QueryFeePaySpendResponse{} }
+func (m *QueryFeePaySpendResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePaySpendResponse) ProtoMessage()    {}
+func (*QueryFeePaySpendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6539df905bf35ca, []int{17}
+}
+func (m *QueryFeePaySpendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePaySpendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePaySpendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeePaySpendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePaySpendResponse.Merge(m, src)
+}
+func (m *QueryFeePaySpendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePaySpendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePaySpendResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeePaySpendResponse proto.InternalMessageInfo
+
+func (m *QueryFeePaySpendResponse) GetWalletSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WalletSpent
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeePaySpendResponse) GetContractSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ContractSpent
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Message for simulating whether FeePay would sponsor the fee of a transaction.
+// Either tx_bytes, or contract_address, sender_address and gas must be set.
+type QueryFeePaySimulateRequest struct {
+	// The encoded transaction, which does not need to be signed. The fee payer
+	// and the gas limit are taken from the transaction.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// The contract address, to simulate a single contract execution.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The address of the wallet executing the contract.
+	SenderAddress string `protobuf:"bytes,3,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// The gas estimate of the contract execution.
+	Gas uint64 `protobuf:"varint,4,opt,name=gas,proto3" json:"gas,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFeePaySimulateRequest) Reset()         { *m = QueryFeePaySimulateRequest{} }
+func (m *QueryFeePaySimulateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePaySimulateRequest) ProtoMessage()    {}
+func (*QueryFeePaySimulateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6539df905bf35ca, []int{18}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeePaySimulateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryFeePaySimulateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePaySimulateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeePaySimulateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePaySimulateRequest.Merge(m, src)
+}
+func (m *QueryFeePaySimulateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePaySimulateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePaySimulateRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryFeePaySimulateRequest proto.InternalMessageInfo
+
+func (m *QueryFeePaySimulateRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryFeePayContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *QueryFeePaySimulateRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return n
+	return ""
 }
 
-func (m *QueryFeePayContractResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeePaySimulateRequest) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
 	}
-	var l int
-	_ = l
-	l = m.FeePayContract.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryFeePayContractsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFeePaySimulateRequest) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
 	}
-	return n
+	return 0
 }
 
-func (m *QueryFeePayContractsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FeePayContracts) > 0 {
-		for _, e := range m.FeePayContracts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// The response for simulating whether FeePay would sponsor the fee of a transaction
+type QueryFeePaySimulateResponse struct {
+	// Whether FeePay would sponsor the fee of the transaction
+	Sponsored bool `protobuf:"varint,1,opt,name=sponsored,proto3" json:"sponsored,omitempty"`
+	// The fee which would be deducted from the fee pay contracts
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// The reason FeePay would not sponsor the fee of the transaction
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *QueryFeePaySimulateResponse) Reset()         { *m = QueryFeePaySimulateResponse{} }
+func (m *QueryFeePaySimulateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeePaySimulateResponse) ProtoMessage()    {}
+func (*QueryFeePaySimulateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6539df905bf35ca, []int{19}
+}
+func (m *QueryFeePaySimulateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeePaySimulateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeePaySimulateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryFeePaySimulateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeePaySimulateResponse.Merge(m, src)
+}
+func (m *QueryFeePaySimulateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeePaySimulateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeePaySimulateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFeePayContractUsesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.WalletAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryFeePaySimulateResponse proto.InternalMessageInfo
+
+func (m *QueryFeePaySimulateResponse) GetSponsored() bool {
+	if m != nil {
+		return m.Sponsored
 	}
-	return n
+	return false
 }
 
-func (m *QueryFeePayContractUsesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Uses != 0 {
-		n += 1 + sovQuery(uint64(m.Uses))
+func (m *QueryFeePaySimulateResponse) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
 	}
-	return n
+	return nil
 }
 
-func (m *QueryFeePayWalletIsEligibleRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFeePaySimulateResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	l = len(m.WalletAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6539df905bf35ca, []int{20}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFeePayWalletIsEligibleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params is the returned Feepay parameter
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6539df905bf35ca, []int{21}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Eligible {
-		n += 2
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return n
+	return Params{}
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*QueryFeePayContractRequest)(nil), "juno.feepay.v1.QueryFeePayContractRequest")
+	proto.RegisterType((*QueryFeePayContractResponse)(nil), "juno.feepay.v1.QueryFeePayContractResponse")
+	proto.RegisterType((*QueryFeePayContractsRequest)(nil), "juno.feepay.v1.QueryFeePayContractsRequest")
+	proto.RegisterType((*QueryFeePayContractsResponse)(nil), "juno.feepay.v1.QueryFeePayContractsResponse")
+	proto.RegisterType((*QueryFeePayContractUsesRequest)(nil), "juno.feepay.v1.QueryFeePayContractUsesRequest")
+	proto.RegisterType((*QueryFeePayContractUsesResponse)(nil), "juno.feepay.v1.QueryFeePayContractUsesResponse")
+	proto.RegisterType((*QueryFeePayWalletUsagesRequest)(nil), "juno.feepay.v1.QueryFeePayWalletUsagesRequest")
+	proto.RegisterType((*QueryFeePayWalletUsagesResponse)(nil), "juno.feepay.v1.QueryFeePayWalletUsagesResponse")
+	proto.RegisterType((*QueryFeePayFundersRequest)(nil), "juno.feepay.v1.QueryFeePayFundersRequest")
+	proto.RegisterType((*QueryFeePayFundersResponse)(nil), "juno.feepay.v1.QueryFeePayFundersResponse")
+	proto.RegisterType((*QueryFeePayFunderRequest)(nil), "juno.feepay.v1.QueryFeePayFunderRequest")
+	proto.RegisterType((*QueryFeePayFunderResponse)(nil), "juno.feepay.v1.QueryFeePayFunderResponse")
+	proto.RegisterType((*QueryFeePayWalletIsEligibleRequest)(nil), "juno.feepay.v1.QueryFeePayWalletIsEligibleRequest")
+	proto.RegisterType((*QueryFeePayWalletIsEligibleResponse)(nil), "juno.feepay.v1.QueryFeePayWalletIsEligibleResponse")
+	proto.RegisterType((*QueryFeePayWalletAllowanceRequest)(nil), "juno.feepay.v1.QueryFeePayWalletAllowanceRequest")
+	proto.RegisterType((*QueryFeePayWalletAllowanceResponse)(nil), "juno.feepay.v1.QueryFeePayWalletAllowanceResponse")
+	proto.RegisterType((*QueryFeePaySpendRequest)(nil), "juno.feepay.v1.QueryFeePaySpendRequest")
+	proto.RegisterType((*QueryFeePaySpendResponse)(nil), "juno.feepay.v1.QueryFeePaySpendResponse")
+	proto.RegisterType((*QueryFeePaySimulateRequest)(nil), "juno.feepay.v1.QueryFeePaySimulateRequest")
+	proto.RegisterType((*QueryFeePaySimulateResponse)(nil), "juno.feepay.v1.QueryFeePaySimulateResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "juno.feepay.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "juno.feepay.v1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("juno/feepay/v1/query.proto", fileDescriptor_d6539df905bf35ca) }
+
+var fileDescriptor_d6539df905bf35ca = []byte{
+	// 1396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xdd, 0x6f, 0x14, 0x55,
+	0x14, 0xef, 0x6d, 0x6b, 0x69, 0x4f, 0x4b, 0x0b, 0x57, 0xac, 0xdb, 0xa1, 0x6c, 0x61, 0x48, 0xa4,
+	0x14, 0x99, 0x49, 0x8b, 0x24, 0xea, 0x0b, 0x76, 0x0b, 0x05, 0x5f, 0xb4, 0x4c, 0x25, 0x26, 0xbe,
+	0x6c, 0xee, 0xee, 0xde, 0x1d, 0x06, 0x77, 0x67, 0x96, 0xb9, 0xb3, 0x2d, 0x1b, 0xc2, 0x8b, 0x4f,
+	0x3c, 0x19, 0x12, 0x5f, 0x78, 0xd3, 0xc4, 0xc4, 0x54, 0x12, 0x13, 0x4d, 0x0c, 0x3e, 0xc9, 0x9b,
+	0x09, 0x6f, 0x12, 0x89, 0x89, 0x4f, 0x62, 0xc0, 0xc4, 0x7f, 0xc3, 0xcc, 0xfd, 0xd8, 0xf9, 0xd8,
+	0x61, 0x3f, 0x48, 0x9b, 0xf4, 0xa5, 0xdd, 0xb9, 0xf7, 0xdc, 0x73, 0x7e, 0xbf, 0x73, 0xce, 0xdc,
+	0xf3, 0x1b, 0xd0, 0x6e, 0x34, 0x5d, 0xcf, 0xac, 0x52, 0xda, 0x20, 0x2d, 0x73, 0x6b, 0xd9, 0xbc,
+	0xd9, 0xa4, 0x7e, 0xcb, 0x68, 0xf8, 0x5e, 0xe0, 0xe1, 0xe9, 0x70, 0xcf, 0x10, 0x7b, 0xc6, 0xd6,
+	0xb2, 0x76, 0x98, 0xd4, 0x1d, 0xd7, 0x33, 0xf9, 0x5f, 0x61, 0xa2, 0x2d, 0x95, 0x3d, 0x56, 0xf7,
+	0x98, 0x59, 0x22, 0x8c, 0x8a, 0xb3, 0xe6, 0xd6, 0x72, 0x89, 0x06, 0x64, 0xd9, 0x6c, 0x10, 0xdb,
+	0x71, 0x49, 0xe0, 0x78, 0xae, 0xb4, 0xcd, 0xc7, 0x6d, 0x95, 0x55, 0xd9, 0x73, 0xd4, 0xfe, 0x51,
+	0xb9, 0xaf, 0xdc, 0xc4, 0xb1, 0x68, 0x73, 0x62, 0xb3, 0xc8, 0x9f, 0x4c, 0xf1, 0x20, 0xb7, 0x8e,
+	0xd8, 0x9e, 0xed, 0x89, 0xf5, 0xf0, 0x97, 0x5c, 0x9d, 0xb7, 0x3d, 0xcf, 0xae, 0x51, 0x93, 0x34,
+	0x1c, 0x93, 0xb8, 0xae, 0x17, 0x70, 0x28, 0xea, 0xcc, 0x82, 0xdc, 0xe5, 0x4f, 0xa5, 0x66, 0xd5,
+	0x0c, 0x9c, 0x3a, 0x65, 0x01, 0xa9, 0x37, 0x14, 0x98, 0x54, 0x5e, 0x64, 0x16, 0xa4, 0xef, 0xd4,
+	0xa6, 0x4d, 0x5d, 0xca, 0x1c, 0xe9, 0x5b, 0x27, 0xa0, 0x5d, 0x0d, 0x91, 0xaf, 0x53, 0xba, 0x41,
+	0x5a, 0x6b, 0x9e, 0x1b, 0xf8, 0xa4, 0x1c, 0x58, 0xf4, 0x66, 0x93, 0xb2, 0x00, 0xaf, 0xc1, 0xa1,
+	0xb2, 0x5c, 0x2a, 0x92, 0x4a, 0xc5, 0xa7, 0x8c, 0xe5, 0xd0, 0x71, 0xb4, 0x38, 0x51, 0xc8, 0xfd,
+	0xf1, 0xf3, 0xd9, 0x23, 0x92, 0xd9, 0xaa, 0xd8, 0xd9, 0x0c, 0x7c, 0xc7, 0xb5, 0xad, 0x19, 0x75,
+	0x42, 0x2e, 0xeb, 0x3e, 0x1c, 0xcd, 0x0c, 0xc1, 0x1a, 0x9e, 0xcb, 0x28, 0xde, 0x84, 0x43, 0x55,
+	0x4a, 0x8b, 0x0d, 0xd2, 0x2a, 0xaa, 0x93, 0x3c, 0xc6, 0xe4, 0x4a, 0xde, 0x48, 0xd6, 0xd4, 0x48,
+	0x7a, 0x28, 0x4c, 0x3c, 0xfe, 0x7b, 0x61, 0x68, 0xe7, 0xbf, 0x1f, 0x97, 0x90, 0x35, 0x5d, 0x4d,
+	0x6c, 0xe9, 0x34, 0x33, 0x26, 0x53, 0xbc, 0xd6, 0x01, 0xa2, 0x8a, 0xcb, 0x68, 0x6f, 0x19, 0x92,
+	0x4e, 0x58, 0x72, 0x43, 0x94, 0x53, 0x16, 0xde, 0xd8, 0x20, 0x36, 0x95, 0x67, 0xad, 0xd8, 0x49,
+	0xfd, 0x11, 0x82, 0xf9, 0xec, 0x38, 0x92, 0xdc, 0x35, 0x38, 0x9c, 0x26, 0x17, 0x66, 0x70, 0x64,
+	0x30, 0x76, 0x33, 0x49, 0x76, 0x0c, 0x5f, 0x4e, 0xe0, 0x1f, 0xe6, 0xf8, 0x4f, 0xf5, 0xc4, 0x2f,
+	0x30, 0x25, 0x08, 0x7c, 0x87, 0x20, 0x9f, 0x41, 0xe0, 0x1a, 0xa3, 0x6c, 0x37, 0x7b, 0x00, 0x5f,
+	0x80, 0xe9, 0x6d, 0x52, 0xab, 0xd1, 0xc8, 0xc5, 0x70, 0x0f, 0x17, 0x07, 0x85, 0xbd, 0x6a, 0xa2,
+	0xf3, 0xb0, 0xf0, 0x52, 0x9c, 0x32, 0xd7, 0x18, 0x46, 0x9b, 0x8c, 0x0a, 0x70, 0xa3, 0x16, 0xff,
+	0xad, 0xff, 0x90, 0xe4, 0xf7, 0x29, 0xf7, 0x79, 0x8d, 0x11, 0x7b, 0x97, 0xf9, 0xad, 0x67, 0x14,
+	0xe4, 0x55, 0x1a, 0xea, 0x57, 0x94, 0xe0, 0x99, 0xc4, 0x2b, 0x79, 0x5e, 0x05, 0x99, 0x9b, 0x62,
+	0x93, 0x6f, 0xc8, 0x7e, 0x3a, 0x91, 0xdd, 0x4f, 0x31, 0x17, 0xf1, 0x96, 0x9a, 0xda, 0x8e, 0xb9,
+	0xde, 0xbd, 0x7e, 0xda, 0x41, 0x30, 0x17, 0xc3, 0xbf, 0xde, 0x74, 0x2b, 0xd4, 0xdf, 0x9f, 0xa9,
+	0xde, 0x41, 0x89, 0xab, 0xaf, 0x0d, 0x55, 0x66, 0x79, 0x15, 0x0e, 0x54, 0xc5, 0x92, 0xcc, 0xef,
+	0x7c, 0x76, 0x7e, 0xc5, 0xb9, 0x78, 0x6a, 0xd5, 0xb9, 0xdd, 0xcb, 0xea, 0x37, 0x08, 0x72, 0x1d,
+	0x50, 0x77, 0xfb, 0xfd, 0x14, 0xa8, 0xfb, 0x7f, 0x3f, 0x85, 0xbd, 0x7a, 0x3f, 0x1f, 0x67, 0x15,
+	0xbe, 0x9d, 0xcc, 0x0b, 0x30, 0x26, 0xcc, 0xe5, 0x5d, 0xdb, 0x77, 0x2e, 0xe5, 0x31, 0x7c, 0x03,
+	0x0e, 0x34, 0x5d, 0xd6, 0xa0, 0x6e, 0x90, 0x1b, 0xe6, 0xd5, 0x98, 0x4b, 0xe4, 0x51, 0x65, 0x70,
+	0xcd, 0x73, 0xdc, 0xc2, 0xf9, 0xf0, 0xf8, 0x83, 0x67, 0x0b, 0x8b, 0xb6, 0x13, 0x5c, 0x6f, 0x96,
+	0x8c, 0xb2, 0x57, 0x97, 0x33, 0x58, 0xfe, 0x3b, 0xcb, 0x2a, 0x9f, 0x9b, 0x41, 0xab, 0x41, 0x19,
+	0x3f, 0xc0, 0x64, 0xd9, 0x64, 0x00, 0xfd, 0x01, 0x02, 0xbd, 0xe3, 0x1d, 0xfc, 0x90, 0x5d, 0xaa,
+	0x39, 0xb6, 0x53, 0xaa, 0xd1, 0xfd, 0x75, 0x2f, 0xae, 0xc2, 0xc9, 0xae, 0x58, 0x65, 0x01, 0x34,
+	0x18, 0xa7, 0x72, 0x8d, 0x83, 0x1c, 0xb7, 0xda, 0xcf, 0xfa, 0xf7, 0x08, 0x4e, 0x74, 0xf8, 0x58,
+	0xad, 0xd5, 0xbc, 0x6d, 0xe2, 0x96, 0xf7, 0x19, 0xdd, 0x47, 0x59, 0xb5, 0x89, 0x61, 0x7d, 0xf9,
+	0x28, 0xc0, 0xf3, 0x30, 0xe1, 0xd3, 0x3a, 0x71, 0x5c, 0xc7, 0xb5, 0x79, 0xd8, 0x51, 0x2b, 0x5a,
+	0xc0, 0x27, 0x60, 0xca, 0xa7, 0x8c, 0x06, 0xc5, 0xeb, 0xd4, 0xb1, 0xaf, 0x07, 0xb9, 0x91, 0xe3,
+	0x68, 0x71, 0xc4, 0x9a, 0xe4, 0x6b, 0x57, 0xf8, 0x12, 0x5e, 0x03, 0x10, 0x26, 0xa1, 0xfc, 0xca,
+	0x8d, 0xf2, 0x46, 0xd6, 0x0c, 0xa1, 0xcd, 0x0c, 0xa5, 0xcd, 0x8c, 0x4f, 0x94, 0x36, 0x2b, 0x8c,
+	0x87, 0x7d, 0x78, 0xef, 0xd9, 0x02, 0x0a, 0xe3, 0x30, 0x1a, 0x84, 0x3b, 0xfa, 0xd7, 0x08, 0xde,
+	0x8c, 0x11, 0xd8, 0x6c, 0x50, 0xb7, 0xb2, 0xbf, 0x52, 0x7c, 0x77, 0x38, 0x71, 0xd9, 0x48, 0x84,
+	0x32, 0xb1, 0x0c, 0xe4, 0xe0, 0x28, 0x8a, 0x97, 0x11, 0xed, 0xd1, 0xcb, 0x38, 0x29, 0xa2, 0x84,
+	0xc1, 0x03, 0xbc, 0x0d, 0xd3, 0xed, 0xbc, 0xec, 0xed, 0x1d, 0x70, 0x50, 0xc5, 0xe1, 0x81, 0xf5,
+	0xdf, 0x93, 0x23, 0x62, 0xd3, 0xa9, 0x37, 0x6b, 0x24, 0x68, 0xbf, 0x12, 0x73, 0x30, 0x1e, 0xdc,
+	0x2a, 0x96, 0x5a, 0x81, 0xec, 0xb4, 0x29, 0xeb, 0x40, 0x70, 0xab, 0x10, 0x3e, 0x66, 0x96, 0x72,
+	0xf8, 0x15, 0x4a, 0xc9, 0x68, 0xe2, 0x52, 0x1e, 0xe9, 0x55, 0x4a, 0x61, 0xaf, 0x1c, 0x1c, 0x82,
+	0x11, 0x9b, 0x30, 0xde, 0xaa, 0xa3, 0x56, 0xf8, 0x53, 0xff, 0x05, 0x25, 0x84, 0x71, 0xc4, 0x48,
+	0xd6, 0x77, 0x1e, 0x26, 0xf8, 0x2f, 0xcf, 0xa7, 0x15, 0x79, 0x51, 0x44, 0x0b, 0xb8, 0x04, 0x23,
+	0x55, 0x4a, 0xf7, 0x2c, 0xfb, 0xa1, 0x73, 0x3c, 0x0b, 0x63, 0x3e, 0x25, 0xcc, 0x73, 0x05, 0x59,
+	0x4b, 0x3e, 0xe9, 0x47, 0x00, 0x73, 0xe0, 0x1b, 0xc4, 0x27, 0x75, 0xa5, 0x28, 0xf4, 0x0d, 0x78,
+	0x3d, 0xb1, 0x2a, 0x69, 0xbc, 0x07, 0x63, 0x0d, 0xbe, 0x22, 0xe7, 0xcd, 0x6c, 0x7a, 0xde, 0x08,
+	0xfb, 0xc4, 0xa4, 0x11, 0x07, 0x56, 0xee, 0xcf, 0xc0, 0x6b, 0xdc, 0x25, 0xfe, 0x16, 0xc1, 0x74,
+	0x52, 0x6e, 0xe2, 0xa5, 0xb4, 0x9f, 0x97, 0x7f, 0x3b, 0x69, 0x67, 0xfa, 0xb2, 0x15, 0x80, 0xf5,
+	0x77, 0xef, 0x86, 0x20, 0xbe, 0x78, 0xfa, 0xef, 0x57, 0xc3, 0x67, 0xf1, 0x19, 0x33, 0xf5, 0xc9,
+	0xa6, 0x1a, 0xc3, 0xbc, 0x9d, 0x6e, 0xaa, 0x3b, 0xf8, 0x3e, 0x82, 0x99, 0xd4, 0xd7, 0x07, 0xee,
+	0x27, 0xb4, 0x4a, 0xa1, 0xf6, 0x76, 0x7f, 0xc6, 0x12, 0xe8, 0x52, 0x04, 0x74, 0x01, 0x1f, 0x4b,
+	0x03, 0x25, 0xb5, 0x5a, 0xf4, 0x9d, 0x83, 0x7f, 0x43, 0x80, 0x3b, 0xf5, 0x3a, 0x36, 0xfa, 0x08,
+	0x18, 0xfb, 0x00, 0xd1, 0xcc, 0xbe, 0xed, 0x25, 0xc6, 0x8f, 0x22, 0x8c, 0x6b, 0x78, 0x75, 0x80,
+	0x64, 0x9a, 0xe1, 0xa0, 0x30, 0x6f, 0x27, 0xef, 0xcf, 0x3b, 0xf8, 0x61, 0x9b, 0x47, 0x5c, 0x8f,
+	0x77, 0xe5, 0x91, 0xf1, 0xa1, 0xd1, 0x95, 0x47, 0x96, 0xd0, 0xd7, 0x2f, 0x44, 0x3c, 0xde, 0xc1,
+	0x2b, 0x83, 0xf1, 0xe0, 0x08, 0x77, 0x10, 0x1c, 0x4c, 0xa8, 0x5b, 0x7c, 0xba, 0x0b, 0x86, 0xa4,
+	0x58, 0xd7, 0x96, 0xfa, 0x31, 0x95, 0x48, 0x3f, 0x88, 0x90, 0x9e, 0xc7, 0xe7, 0x06, 0x41, 0xaa,
+	0xb4, 0xf2, 0x43, 0x04, 0x53, 0x71, 0xdf, 0x78, 0xb1, 0x67, 0x78, 0x05, 0xf4, 0x74, 0x1f, 0x96,
+	0x12, 0xe7, 0xd5, 0x08, 0xe7, 0x3a, 0xbe, 0xf8, 0x0a, 0x38, 0xcd, 0xdb, 0x49, 0x99, 0x7c, 0x07,
+	0xff, 0x89, 0x60, 0x36, 0x5b, 0x7c, 0xe1, 0x95, 0x9e, 0x05, 0xef, 0x50, 0x95, 0xda, 0xb9, 0x81,
+	0xce, 0x48, 0x5a, 0x56, 0x44, 0xeb, 0x32, 0xbe, 0x34, 0x08, 0x2d, 0x25, 0x02, 0x3b, 0x9b, 0xfe,
+	0x29, 0x82, 0x37, 0x32, 0x45, 0x16, 0x5e, 0xee, 0x09, 0x31, 0x2d, 0x1e, 0xb5, 0x95, 0x41, 0x8e,
+	0x48, 0x52, 0x9b, 0x11, 0xa9, 0x2b, 0x78, 0x7d, 0x10, 0x52, 0x44, 0xf9, 0xea, 0x64, 0xf5, 0x13,
+	0x82, 0xc9, 0x98, 0xae, 0xc1, 0xa7, 0xba, 0x00, 0x8b, 0x6b, 0x33, 0x6d, 0xb1, 0xb7, 0xa1, 0xc4,
+	0xfd, 0x71, 0x84, 0xfb, 0x22, 0x2e, 0x0c, 0x82, 0x3b, 0xd4, 0x36, 0x95, 0x4e, 0xcc, 0x5f, 0xb6,
+	0xe7, 0x90, 0x1a, 0xd7, 0x5d, 0xe7, 0x50, 0x4a, 0xa5, 0x74, 0x9d, 0x43, 0xe9, 0xf9, 0xaf, 0x9f,
+	0xe4, 0xb8, 0x8f, 0xbd, 0x8f, 0x96, 0xf4, 0x5c, 0x1a, 0x3a, 0x53, 0xd1, 0xb7, 0x60, 0x4c, 0xcc,
+	0x4f, 0xac, 0x67, 0xfa, 0x4e, 0x8c, 0x68, 0xed, 0x64, 0x57, 0x1b, 0x15, 0x37, 0x4a, 0x5a, 0x0e,
+	0xcf, 0xa6, 0x23, 0x8b, 0xd1, 0x5c, 0xb8, 0xf2, 0xf8, 0x79, 0x1e, 0x3d, 0x79, 0x9e, 0x47, 0xff,
+	0x3c, 0xcf, 0xa3, 0x7b, 0x2f, 0xf2, 0x43, 0x4f, 0x5e, 0xe4, 0x87, 0xfe, 0x7a, 0x91, 0x1f, 0xfa,
+	0xcc, 0x88, 0x09, 0x8d, 0x35, 0xae, 0x30, 0xda, 0x93, 0x4b, 0xf8, 0xba, 0xa5, 0xbc, 0x71, 0xd1,
+	0x51, 0x1a, 0xe3, 0x72, 0xfd, 0xdc, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x39, 0x8f, 0x54,
+	0x51, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// FeePayContract queries a single fee pay contract by address
+	FeePayContract(ctx context.Context, in *QueryFeePayContractRequest, opts ...grpc.CallOption) (*QueryFeePayContractResponse, error)
+	// Retrieve all fee pay contracts
+	FeePayContracts(ctx context.Context, in *QueryFeePayContractsRequest, opts ...grpc.CallOption) (*QueryFeePayContractsResponse, error)
+	// Retrieve the number of uses on a fee pay contract by wallet
+	FeePayContractUses(ctx context.Context, in *QueryFeePayContractUsesRequest, opts ...grpc.CallOption) (*QueryFeePayContractUsesResponse, error)
+	// Retrieve the stored usage of every wallet on a fee pay contract
+	FeePayWalletUsages(ctx context.Context, in *QueryFeePayWalletUsagesRequest, opts ...grpc.CallOption) (*QueryFeePayWalletUsagesResponse, error)
+	// Retrieve the contributions of the funders of a fee pay contract
+	FeePayFunders(ctx context.Context, in *QueryFeePayFundersRequest, opts ...grpc.CallOption) (*QueryFeePayFundersResponse, error)
+	// Retrieve the contribution of a funder of a fee pay contract and its share
+	// of the unspent funds
+	FeePayFunder(ctx context.Context, in *QueryFeePayFunderRequest, opts ...grpc.CallOption) (*QueryFeePayFunderResponse, error)
+	// Query if sender is eligible for fee pay contract interaction
+	FeePayWalletIsEligible(ctx context.Context, in *QueryFeePayWalletIsEligibleRequest, opts ...grpc.CallOption) (*QueryFeePayWalletIsEligibleResponse, error)
+	// Retrieve the remaining allowance of a wallet on a fee pay contract and when it resets
+	FeePayWalletAllowance(ctx context.Context, in *QueryFeePayWalletAllowanceRequest, opts ...grpc.CallOption) (*QueryFeePayWalletAllowanceResponse, error)
+	// Retrieve the fees a fee pay contract has sponsored for a wallet and for all
+	// wallets in the current limit window
+	FeePaySpend(ctx context.Context, in *QueryFeePaySpendRequest, opts ...grpc.CallOption) (*QueryFeePaySpendResponse, error)
+	// Simulate whether FeePay would sponsor the fee of a transaction, and the fee
+	// it would deduct from the fee pay contracts
+	FeePaySimulate(ctx context.Context, in *QueryFeePaySimulateRequest, opts ...grpc.CallOption) (*QueryFeePaySimulateResponse, error)
+	// Params retrieves the FeePay module params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) FeePayContract(ctx context.Context, in *QueryFeePayContractRequest, opts ...grpc.CallOption) (*QueryFeePayContractResponse, error) {
+	out := new(QueryFeePayContractResponse)
+	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/FeePayContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePayContracts(ctx context.Context, in *QueryFeePayContractsRequest, opts ...grpc.CallOption) (*QueryFeePayContractsResponse, error) {
+	out := new(QueryFeePayContractsResponse)
+	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/FeePayContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePayContractUses(ctx context.Context, in *QueryFeePayContractUsesRequest, opts ...grpc.CallOption) (*QueryFeePayContractUsesResponse, error) {
+	out := new(QueryFeePayContractUsesResponse)
+	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/FeePayContractUses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePayWalletUsages(ctx context.Context, in *QueryFeePayWalletUsagesRequest, opts ...grpc.CallOption) (*QueryFeePayWalletUsagesResponse, error) {
+	out := new(QueryFeePayWalletUsagesResponse)
+	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/FeePayWalletUsages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePayFunders(ctx context.Context, in *QueryFeePayFundersRequest, opts ...grpc.CallOption) (*QueryFeePayFundersResponse, error) {
+	out := new(QueryFeePayFundersResponse)
+	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/FeePayFunders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePayFunder(ctx context.Context, in *QueryFeePayFunderRequest, opts ...grpc.CallOption) (*QueryFeePayFunderResponse, error) {
+	out := new(QueryFeePayFunderResponse)
+	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/FeePayFunder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePayWalletIsEligible(ctx context.Context, in *QueryFeePayWalletIsEligibleRequest, opts ...grpc.CallOption) (*QueryFeePayWalletIsEligibleResponse, error) {
+	out := new(QueryFeePayWalletIsEligibleResponse)
+	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/FeePayWalletIsEligible", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePayWalletAllowance(ctx context.Context, in *QueryFeePayWalletAllowanceRequest, opts ...grpc.CallOption) (*QueryFeePayWalletAllowanceResponse, error) {
+	out := new(QueryFeePayWalletAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/FeePayWalletAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePaySpend(ctx context.Context, in *QueryFeePaySpendRequest, opts ...grpc.CallOption) (*QueryFeePaySpendResponse, error) {
+	out := new(QueryFeePaySpendResponse)
+	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/FeePaySpend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeePaySimulate(ctx context.Context, in *QueryFeePaySimulateRequest, opts ...grpc.CallOption) (*QueryFeePaySimulateResponse, error) {
+	out := new(QueryFeePaySimulateResponse)
+	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/FeePaySimulate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// FeePayContract queries a single fee pay contract by address
+	FeePayContract(context.Context, *QueryFeePayContractRequest) (*QueryFeePayContractResponse, error)
+	// Retrieve all fee pay contracts
+	FeePayContracts(context.Context, *QueryFeePayContractsRequest) (*QueryFeePayContractsResponse, error)
+	// Retrieve the number of uses on a fee pay contract by wallet
+	FeePayContractUses(context.Context, *QueryFeePayContractUsesRequest) (*QueryFeePayContractUsesResponse, error)
+	// Retrieve the stored usage of every wallet on a fee pay contract
+	FeePayWalletUsages(context.Context, *QueryFeePayWalletUsagesRequest) (*QueryFeePayWalletUsagesResponse, error)
+	// Retrieve the contributions of the funders of a fee pay contract
+	FeePayFunders(context.Context, *QueryFeePayFundersRequest) (*QueryFeePayFundersResponse, error)
+	// Retrieve the contribution of a funder of a fee pay contract and its share
+	// of the unspent funds
+	FeePayFunder(context.Context, *QueryFeePayFunderRequest) (*QueryFeePayFunderResponse, error)
+	// Query if sender is eligible for fee pay contract interaction
+	FeePayWalletIsEligible(context.Context, *QueryFeePayWalletIsEligibleRequest) (*QueryFeePayWalletIsEligibleResponse, error)
+	// Retrieve the remaining allowance of a wallet on a fee pay contract and when it resets
+	FeePayWalletAllowance(context.Context, *QueryFeePayWalletAllowanceRequest) (*QueryFeePayWalletAllowanceResponse, error)
+	// Retrieve the fees a fee pay contract has sponsored for a wallet and for all
+	// wallets in the current limit window
+	FeePaySpend(context.Context, *QueryFeePaySpendRequest) (*QueryFeePaySpendResponse, error)
+	// Simulate whether FeePay would sponsor the fee of a transaction, and the fee
+	// it would deduct from the fee pay contracts
+	FeePaySimulate(context.Context, *QueryFeePaySimulateRequest) (*QueryFeePaySimulateResponse, error)
+	// Params retrieves the FeePay module params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) FeePayContract(ctx context.Context, req *QueryFeePayContractRequest) (*QueryFeePayContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePayContract not implemented")
+}
+func (*UnimplementedQueryServer) FeePayContracts(ctx context.Context, req *QueryFeePayContractsRequest) (*QueryFeePayContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePayContracts not implemented")
+}
+func (*UnimplementedQueryServer) FeePayContractUses(ctx context.Context, req *QueryFeePayContractUsesRequest) (*QueryFeePayContractUsesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePayContractUses not implemented")
+}
+func (*UnimplementedQueryServer) FeePayWalletUsages(ctx context.Context, req *QueryFeePayWalletUsagesRequest) (*QueryFeePayWalletUsagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePayWalletUsages not implemented")
+}
+func (*UnimplementedQueryServer) FeePayFunders(ctx context.Context, req *QueryFeePayFundersRequest) (*QueryFeePayFundersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePayFunders not implemented")
+}
+func (*UnimplementedQueryServer) FeePayFunder(ctx context.Context, req *QueryFeePayFunderRequest) (*QueryFeePayFunderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePayFunder not implemented")
+}
+func (*UnimplementedQueryServer) FeePayWalletIsEligible(ctx context.Context, req *QueryFeePayWalletIsEligibleRequest) (*QueryFeePayWalletIsEligibleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePayWalletIsEligible not implemented")
+}
+func (*UnimplementedQueryServer) FeePayWalletAllowance(ctx context.Context, req *QueryFeePayWalletAllowanceRequest) (*QueryFeePayWalletAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePayWalletAllowance not implemented")
+}
+func (*UnimplementedQueryServer) FeePaySpend(ctx context.Context, req *QueryFeePaySpendRequest) (*QueryFeePaySpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePaySpend not implemented")
+}
+func (*UnimplementedQueryServer) FeePaySimulate(ctx context.Context, req *QueryFeePaySimulateRequest) (*QueryFeePaySimulateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeePaySimulate not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_FeePayContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePayContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePayContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feepay.v1.Query/FeePayContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePayContract(ctx, req.(*QueryFeePayContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePayContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePayContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePayContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feepay.v1.Query/FeePayContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePayContracts(ctx, req.(*QueryFeePayContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePayContractUses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePayContractUsesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePayContractUses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feepay.v1.Query/FeePayContractUses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePayContractUses(ctx, req.(*QueryFeePayContractUsesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePayWalletUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePayWalletUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePayWalletUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feepay.v1.Query/FeePayWalletUsages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePayWalletUsages(ctx, req.(*QueryFeePayWalletUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePayFunders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePayFundersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePayFunders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feepay.v1.Query/FeePayFunders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePayFunders(ctx, req.(*QueryFeePayFundersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePayFunder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePayFunderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePayFunder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feepay.v1.Query/FeePayFunder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePayFunder(ctx, req.(*QueryFeePayFunderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePayWalletIsEligible_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePayWalletIsEligibleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePayWalletIsEligible(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feepay.v1.Query/FeePayWalletIsEligible",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePayWalletIsEligible(ctx, req.(*QueryFeePayWalletIsEligibleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePayWalletAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePayWalletAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePayWalletAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feepay.v1.Query/FeePayWalletAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePayWalletAllowance(ctx, req.(*QueryFeePayWalletAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePaySpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePaySpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePaySpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feepay.v1.Query/FeePaySpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePaySpend(ctx, req.(*QueryFeePaySpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeePaySimulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeePaySimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeePaySimulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feepay.v1.Query/FeePaySimulate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeePaySimulate(ctx, req.(*QueryFeePaySimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feepay.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "juno.feepay.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FeePayContract",
+			Handler:    _Query_FeePayContract_Handler,
+		},
+		{
+			MethodName: "FeePayContracts",
+			Handler:    _Query_FeePayContracts_Handler,
+		},
+		{
+			MethodName: "FeePayContractUses",
+			Handler:    _Query_FeePayContractUses_Handler,
+		},
+		{
+			MethodName: "FeePayWalletUsages",
+			Handler:    _Query_FeePayWalletUsages_Handler,
+		},
+		{
+			MethodName: "FeePayFunders",
+			Handler:    _Query_FeePayFunders_Handler,
+		},
+		{
+			MethodName: "FeePayFunder",
+			Handler:    _Query_FeePayFunder_Handler,
+		},
+		{
+			MethodName: "FeePayWalletIsEligible",
+			Handler:    _Query_FeePayWalletIsEligible_Handler,
+		},
+		{
+			MethodName: "FeePayWalletAllowance",
+			Handler:    _Query_FeePayWalletAllowance_Handler,
+		},
+		{
+			MethodName: "FeePaySpend",
+			Handler:    _Query_FeePaySpend_Handler,
+		},
+		{
+			MethodName: "FeePaySimulate",
+			Handler:    _Query_FeePaySimulate_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "juno/feepay/v1/query.proto",
+}
+
+func (m *QueryFeePayContractRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayContractRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayContractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeePayContract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeePayContracts) > 0 {
+		for iNdEx := len(m.FeePayContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeePayContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayContractUsesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayContractUsesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayContractUsesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayContractUsesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayContractUsesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayContractUsesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Uses != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Uses))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayWalletUsagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayWalletUsagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayWalletUsagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayWalletUsagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayWalletUsagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayWalletUsagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WalletUsages) > 0 {
+		for iNdEx := len(m.WalletUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WalletUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayFundersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayFundersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayFundersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayFundersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayFundersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayFundersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Funders) > 0 {
+		for iNdEx := len(m.Funders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayFunderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayFunderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayFunderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayFunderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayFunderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayFunderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unspent) > 0 {
+		for iNdEx := len(m.Unspent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unspent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Funder.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayWalletIsEligibleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayWalletIsEligibleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayWalletIsEligibleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayWalletIsEligibleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayWalletIsEligibleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayWalletIsEligibleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Eligible {
+		i--
+		if m.Eligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayWalletAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayWalletAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayWalletAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePayWalletAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePayWalletAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePayWalletAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ResetTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResetTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if m.ResetHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ResetHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Uses != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Uses))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePaySpendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePaySpendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePaySpendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePaySpendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePaySpendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePaySpendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpent) > 0 {
+		for iNdEx := len(m.ContractSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WalletSpent) > 0 {
+		for iNdEx := len(m.WalletSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WalletSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePaySimulateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePaySimulateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePaySimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeePaySimulateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeePaySimulateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeePaySimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Sponsored {
+		i--
+		if m.Sponsored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFeePayContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePayContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeePayContract.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeePayContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePayContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeePayContracts) > 0 {
+		for _, e := range m.FeePayContracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePayContractUsesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePayContractUsesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Uses != 0 {
+		n += 1 + sovQuery(uint64(m.Uses))
+	}
+	return n
+}
+
+func (m *QueryFeePayWalletUsagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePayWalletUsagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WalletUsages) > 0 {
+		for _, e := range m.WalletUsages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePayFundersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePayFundersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Funders) > 0 {
+		for _, e := range m.Funders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePayFunderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePayFunderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Funder.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Unspent) > 0 {
+		for _, e := range m.Unspent {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeePayWalletIsEligibleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePayWalletIsEligibleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Eligible {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryFeePayWalletAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePayWalletAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Uses != 0 {
+		n += 1 + sovQuery(uint64(m.Uses))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovQuery(uint64(m.Remaining))
+	}
+	if m.ResetHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ResetHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ResetTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeePaySpendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeePaySpendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WalletSpent) > 0 {
+		for _, e := range m.WalletSpent {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ContractSpent) > 0 {
+		for _, e := range m.ContractSpent {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeePaySimulateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *QueryFeePaySimulateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sponsored {
+		n += 2
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryFeePayContractRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePayContractRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePayContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePayContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePayContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePayContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeePayContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePayContractsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePayContractsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePayContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePayContractsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePayContractsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePayContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayContracts = append(m.FeePayContracts, FeePayContract{})
+			if err := m.FeePayContracts[len(m.FeePayContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePayContractUsesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePayContractUsesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePayContractUsesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePayContractUsesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePayContractUsesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePayContractUsesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+			}
+			m.Uses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePayWalletUsagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePayWalletUsagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePayWalletUsagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePayWalletUsagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePayWalletUsagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePayWalletUsagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletUsages = append(m.WalletUsages, FeePayWalletUsage{})
+			if err := m.WalletUsages[len(m.WalletUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePayFundersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePayFundersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePayFundersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePayFundersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePayFundersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePayFundersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funders = append(m.Funders, FeePayFunder{})
+			if err := m.Funders[len(m.Funders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePayFunderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePayFunderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePayFunderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryFeePayContractRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePayFunderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1323,17 +4237,17 @@ func (m *QueryFeePayContractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeePayContractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePayFunderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeePayContractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePayFunderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1343,23 +4257,58 @@ func (m *QueryFeePayContractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Funder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unspent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unspent = append(m.Unspent, types.Coin{})
+			if err := m.Unspent[len(m.Unspent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1382,7 +4331,7 @@ func (m *QueryFeePayContractRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeePayContractResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePayWalletIsEligibleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1405,17 +4354,17 @@ func (m *QueryFeePayContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeePayContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePayWalletIsEligibleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeePayContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePayWalletIsEligibleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePayContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1425,24 +4374,55 @@ func (m *QueryFeePayContractResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeePayContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1465,7 +4445,7 @@ func (m *QueryFeePayContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeePayContractsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePayWalletIsEligibleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1488,17 +4468,119 @@ func (m *QueryFeePayContractsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeePayContractsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePayWalletIsEligibleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeePayContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePayWalletIsEligibleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Eligible = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeePayWalletAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeePayWalletAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeePayWalletAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1508,27 +4590,23 @@ func (m *QueryFeePayContractsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1551,7 +4629,7 @@ func (m *QueryFeePayContractsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeePayContractsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePayWalletAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1574,17 +4652,17 @@ func (m *QueryFeePayContractsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeePayContractsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePayWalletAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeePayContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePayWalletAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePayContracts", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
 			}
-			var msglen int
+			m.Uses = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1594,29 +4672,52 @@ func (m *QueryFeePayContractsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Uses |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetHeight", wireType)
 			}
-			m.FeePayContracts = append(m.FeePayContracts, FeePayContract{})
-			if err := m.FeePayContracts[len(m.FeePayContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ResetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1643,10 +4744,7 @@ func (m *QueryFeePayContractsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ResetTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1671,7 +4769,7 @@ func (m *QueryFeePayContractsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeePayContractUsesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePaySpendRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1694,10 +4792,10 @@ func (m *QueryFeePayContractUsesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeePayContractUsesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePaySpendRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeePayContractUsesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePaySpendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1785,7 +4883,7 @@ func (m *QueryFeePayContractUsesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeePayContractUsesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePaySpendResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1808,17 +4906,17 @@ func (m *QueryFeePayContractUsesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeePayContractUsesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePaySpendResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeePayContractUsesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePaySpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletSpent", wireType)
 			}
-			m.Uses = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1828,11 +4926,60 @@ func (m *QueryFeePayContractUsesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Uses |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletSpent = append(m.WalletSpent, types.Coin{})
+			if err := m.WalletSpent[len(m.WalletSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSpent = append(m.ContractSpent, types.Coin{})
+			if err := m.ContractSpent[len(m.ContractSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1854,7 +5001,7 @@ func (m *QueryFeePayContractUsesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeePayWalletIsEligibleRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePaySimulateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1877,13 +5024,47 @@ func (m *QueryFeePayWalletIsEligibleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeePayWalletIsEligibleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePaySimulateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeePayWalletIsEligibleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePaySimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
@@ -1915,9 +5096,9 @@ func (m *QueryFeePayWalletIsEligibleRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1945,8 +5126,27 @@ func (m *QueryFeePayWalletIsEligibleRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1968,7 +5168,7 @@ func (m *QueryFeePayWalletIsEligibleRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeePayWalletIsEligibleResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeePaySimulateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1991,15 +5191,15 @@ func (m *QueryFeePayWalletIsEligibleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeePayWalletIsEligibleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeePaySimulateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeePayWalletIsEligibleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeePaySimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eligible", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsored", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2016,7 +5216,73 @@ func (m *QueryFeePayWalletIsEligibleResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Eligible = bool(v != 0)
+			m.Sponsored = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
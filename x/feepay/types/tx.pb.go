@@ -14,19 +14,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -270,6 +274,96 @@ func (m *MsgFundFeePayContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFundFeePayContractResponse proto.InternalMessageInfo
 
+// The message to withdraw the share of a funder of the unspent funds of a fee
+// pay contract.
+type MsgWithdrawFeePayContractFunds struct {
+	// The wallet address of the funder.
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// The fee pay contract to withdraw from.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgWithdrawFeePayContractFunds) Reset()         { *m = MsgWithdrawFeePayContractFunds{} }
+func (m *MsgWithdrawFeePayContractFunds) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFeePayContractFunds) ProtoMessage()    {}
+func (*MsgWithdrawFeePayContractFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d739bd30c8846fd5, []int{6}
+}
+func (m *MsgWithdrawFeePayContractFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFeePayContractFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFeePayContractFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawFeePayContractFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFeePayContractFunds.Merge(m, src)
+}
+func (m *MsgWithdrawFeePayContractFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFeePayContractFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFeePayContractFunds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFeePayContractFunds proto.InternalMessageInfo
+
+// The response message for withdrawing the funds of a funder.
+type MsgWithdrawFeePayContractFundsResponse struct {
+	// The unspent funds refunded to the funder.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawFeePayContractFundsResponse) Reset() {
+	*m = MsgWithdrawFeePayContractFundsResponse{}
+}
+func (m *MsgWithdrawFeePayContractFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFeePayContractFundsResponse) ProtoMessage()    {}
+func (*MsgWithdrawFeePayContractFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d739bd30c8846fd5, []int{7}
+}
+func (m *MsgWithdrawFeePayContractFundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFeePayContractFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFeePayContractFundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawFeePayContractFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFeePayContractFundsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawFeePayContractFundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFeePayContractFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFeePayContractFundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFeePayContractFundsResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawFeePayContractFundsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // The message to update a fee pay contract wallet limit.
 type MsgUpdateFeePayContractWalletLimit struct {
 	// The wallet address of the sender.
@@ -278,13 +372,18 @@ type MsgUpdateFeePayContractWalletLimit struct {
 	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	// The new wallet limit.
 	WalletLimit uint64 `protobuf:"varint,3,opt,name=wallet_limit,json=walletLimit,proto3" json:"wallet_limit,omitempty"`
+	// The new number of blocks in a wallet limit window. Zero means no block
+	// window.
+	LimitWindowBlocks uint64 `protobuf:"varint,4,opt,name=limit_window_blocks,json=limitWindowBlocks,proto3" json:"limit_window_blocks,omitempty"`
+	// The new duration of a wallet limit window. Zero means no time window.
+	LimitWindowDuration time.Duration `protobuf:"bytes,5,opt,name=limit_window_duration,json=limitWindowDuration,proto3,stdduration" json:"limit_window_duration"`
 }
 
 func (m *MsgUpdateFeePayContractWalletLimit) Reset()         { *m = MsgUpdateFeePayContractWalletLimit{} }
 func (m *MsgUpdateFeePayContractWalletLimit) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateFeePayContractWalletLimit) ProtoMessage()    {}
 func (*MsgUpdateFeePayContractWalletLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d739bd30c8846fd5, []int{6}
+	return fileDescriptor_d739bd30c8846fd5, []int{8}
 }
 func (m *MsgUpdateFeePayContractWalletLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,7 +424,7 @@ func (m *MsgUpdateFeePayContractWalletLimitResponse) String() string {
 }
 func (*MsgUpdateFeePayContractWalletLimitResponse) ProtoMessage() {}
 func (*MsgUpdateFeePayContractWalletLimitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d739bd30c8846fd5, []int{7}
+	return fileDescriptor_d739bd30c8846fd5, []int{9}
 }
 func (m *MsgUpdateFeePayContractWalletLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,6 +453,178 @@ func (m *MsgUpdateFeePayContractWalletLimitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateFeePayContractWalletLimitResponse proto.InternalMessageInfo
 
+// The message to update whether a fee pay contract decides which wallets are sponsored.
+type MsgUpdateFeePayContractEligibility struct {
+	// The wallet address of the sender.
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// The fee pay contract to update.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Whether the contract is queried for the eligibility of wallets.
+	EligibilityQuery bool `protobuf:"varint,3,opt,name=eligibility_query,json=eligibilityQuery,proto3" json:"eligibility_query,omitempty"`
+}
+
+func (m *MsgUpdateFeePayContractEligibility) Reset()         { *m = MsgUpdateFeePayContractEligibility{} }
+func (m *MsgUpdateFeePayContractEligibility) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeePayContractEligibility) ProtoMessage()    {}
+func (*MsgUpdateFeePayContractEligibility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d739bd30c8846fd5, []int{10}
+}
+func (m *MsgUpdateFeePayContractEligibility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeePayContractEligibility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeePayContractEligibility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeePayContractEligibility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeePayContractEligibility.Merge(m, src)
+}
+func (m *MsgUpdateFeePayContractEligibility) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeePayContractEligibility) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeePayContractEligibility.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeePayContractEligibility proto.InternalMessageInfo
+
+// The response message for updating the eligibility of a fee pay contract.
+type MsgUpdateFeePayContractEligibilityResponse struct {
+}
+
+func (m *MsgUpdateFeePayContractEligibilityResponse) Reset() {
+	*m = MsgUpdateFeePayContractEligibilityResponse{}
+}
+func (m *MsgUpdateFeePayContractEligibilityResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateFeePayContractEligibilityResponse) ProtoMessage() {}
+func (*MsgUpdateFeePayContractEligibilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d739bd30c8846fd5, []int{11}
+}
+func (m *MsgUpdateFeePayContractEligibilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeePayContractEligibilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeePayContractEligibilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeePayContractEligibilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeePayContractEligibilityResponse.Merge(m, src)
+}
+func (m *MsgUpdateFeePayContractEligibilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeePayContractEligibilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeePayContractEligibilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeePayContractEligibilityResponse proto.InternalMessageInfo
+
+// The message to update the fee spend limits of a fee pay contract.
+type MsgUpdateFeePayContractSpendLimit struct {
+	// The wallet address of the sender.
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// The fee pay contract to update.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// The new total fee the contract may sponsor for each wallet. Empty means no
+	// cap.
+	WalletSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=wallet_spend_limit,json=walletSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"wallet_spend_limit"`
+	// The new total fee the contract may sponsor for all wallets. Empty means no
+	// cap.
+	ContractSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=contract_spend_limit,json=contractSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"contract_spend_limit"`
+}
+
+func (m *MsgUpdateFeePayContractSpendLimit) Reset()         { *m = MsgUpdateFeePayContractSpendLimit{} }
+func (m *MsgUpdateFeePayContractSpendLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeePayContractSpendLimit) ProtoMessage()    {}
+func (*MsgUpdateFeePayContractSpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d739bd30c8846fd5, []int{12}
+}
+func (m *MsgUpdateFeePayContractSpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeePayContractSpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeePayContractSpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeePayContractSpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeePayContractSpendLimit.Merge(m, src)
+}
+func (m *MsgUpdateFeePayContractSpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeePayContractSpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeePayContractSpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeePayContractSpendLimit proto.InternalMessageInfo
+
+// The response message for updating the fee spend limits of a fee pay contract.
+type MsgUpdateFeePayContractSpendLimitResponse struct {
+}
+
+func (m *MsgUpdateFeePayContractSpendLimitResponse) Reset() {
+	*m = MsgUpdateFeePayContractSpendLimitResponse{}
+}
+func (m *MsgUpdateFeePayContractSpendLimitResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateFeePayContractSpendLimitResponse) ProtoMessage() {}
+func (*MsgUpdateFeePayContractSpendLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d739bd30c8846fd5, []int{13}
+}
+func (m *MsgUpdateFeePayContractSpendLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFeePayContractSpendLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeePayContractSpendLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeePayContractSpendLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeePayContractSpendLimitResponse.Merge(m, src)
+}
+func (m *MsgUpdateFeePayContractSpendLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeePayContractSpendLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeePayContractSpendLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateFeePayContractSpendLimitResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
@@ -368,7 +639,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d739bd30c8846fd5, []int{8}
+	return fileDescriptor_d739bd30c8846fd5, []int{14}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +677,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d739bd30c8846fd5, []int{9}
+	return fileDescriptor_d739bd30c8846fd5, []int{15}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,8 +713,14 @@ func init() {
 	proto.RegisterType((*MsgUnregisterFeePayContractResponse)(nil), "juno.feepay.v1.MsgUnregisterFeePayContractResponse")
 	proto.RegisterType((*MsgFundFeePayContract)(nil), "juno.feepay.v1.MsgFundFeePayContract")
 	proto.RegisterType((*MsgFundFeePayContractResponse)(nil), "juno.feepay.v1.MsgFundFeePayContractResponse")
+	proto.RegisterType((*MsgWithdrawFeePayContractFunds)(nil), "juno.feepay.v1.MsgWithdrawFeePayContractFunds")
+	proto.RegisterType((*MsgWithdrawFeePayContractFundsResponse)(nil), "juno.feepay.v1.MsgWithdrawFeePayContractFundsResponse")
 	proto.RegisterType((*MsgUpdateFeePayContractWalletLimit)(nil), "juno.feepay.v1.MsgUpdateFeePayContractWalletLimit")
 	proto.RegisterType((*MsgUpdateFeePayContractWalletLimitResponse)(nil), "juno.feepay.v1.MsgUpdateFeePayContractWalletLimitResponse")
+	proto.RegisterType((*MsgUpdateFeePayContractEligibility)(nil), "juno.feepay.v1.MsgUpdateFeePayContractEligibility")
+	proto.RegisterType((*MsgUpdateFeePayContractEligibilityResponse)(nil), "juno.feepay.v1.MsgUpdateFeePayContractEligibilityResponse")
+	proto.RegisterType((*MsgUpdateFeePayContractSpendLimit)(nil), "juno.feepay.v1.MsgUpdateFeePayContractSpendLimit")
+	proto.RegisterType((*MsgUpdateFeePayContractSpendLimitResponse)(nil), "juno.feepay.v1.MsgUpdateFeePayContractSpendLimitResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "juno.feepay.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "juno.feepay.v1.MsgUpdateParamsResponse")
 }
@@ -451,60 +728,81 @@ func init() {
 func init() { proto.RegisterFile("juno/feepay/v1/tx.proto", fileDescriptor_d739bd30c8846fd5) }
 
 var fileDescriptor_d739bd30c8846fd5 = []byte{
-	// 847 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x13, 0xb6, 0x52, 0xa7, 0xa5, 0xbb, 0x58, 0xcb, 0x26, 0x71, 0x89, 0x9d, 0x75, 0x54,
-	0x36, 0x0d, 0xd4, 0x26, 0xa9, 0x84, 0x20, 0x17, 0x44, 0x22, 0x95, 0x22, 0x11, 0xa9, 0x0a, 0x42,
-	0x20, 0x2e, 0xd1, 0x24, 0x99, 0xb8, 0x86, 0x78, 0xc6, 0xf2, 0x4c, 0xda, 0xe6, 0x86, 0x7a, 0x42,
-	0x70, 0x41, 0xe2, 0x06, 0x07, 0x2a, 0x71, 0x41, 0x9c, 0x72, 0xe0, 0x8c, 0xc4, 0xad, 0xc7, 0x0a,
-	0x2e, 0x5c, 0xf8, 0xa1, 0x16, 0x29, 0x9c, 0xe0, 0x1f, 0xe0, 0x80, 0x6c, 0x8f, 0xdd, 0x26, 0xb1,
-	0xeb, 0xf6, 0xb0, 0x52, 0x2f, 0x6d, 0x66, 0xbe, 0xef, 0xbd, 0xf9, 0xde, 0x37, 0xf3, 0x5e, 0x02,
-	0xb2, 0x1f, 0x8d, 0x30, 0xd1, 0x07, 0x08, 0xd9, 0x70, 0xac, 0x1f, 0x54, 0x75, 0x76, 0xa4, 0xd9,
-	0x0e, 0x61, 0x44, 0x5c, 0x73, 0x01, 0xcd, 0x07, 0xb4, 0x83, 0xaa, 0xf4, 0x1c, 0xb4, 0x4c, 0x4c,
-	0x74, 0xef, 0xaf, 0x4f, 0x91, 0xe4, 0x1e, 0xa1, 0x16, 0xa1, 0x7a, 0x17, 0x52, 0xa4, 0x1f, 0x54,
-	0xbb, 0x88, 0xc1, 0xaa, 0xde, 0x23, 0x26, 0xe6, 0x78, 0x96, 0xe3, 0x16, 0x35, 0xdc, 0xd4, 0x16,
-	0x35, 0x38, 0x90, 0xf7, 0x81, 0x8e, 0xb7, 0xd2, 0xfd, 0x05, 0x87, 0x1e, 0x1a, 0xc4, 0x20, 0xfe,
-	0xbe, 0xfb, 0x89, 0xef, 0xbe, 0x60, 0x10, 0x62, 0x0c, 0x91, 0x0e, 0x6d, 0x53, 0x87, 0x18, 0x13,
-	0x06, 0x99, 0x49, 0x70, 0x10, 0xb3, 0x3e, 0x57, 0x03, 0x17, 0xcd, 0x43, 0xe7, 0x40, 0x03, 0x61,
-	0x44, 0x4d, 0x1e, 0xaa, 0xfe, 0x2b, 0x80, 0x7c, 0x8b, 0x1a, 0x6d, 0x64, 0x98, 0x94, 0x21, 0x67,
-	0x07, 0xa1, 0x3d, 0x38, 0x6e, 0x12, 0xcc, 0x1c, 0xd8, 0x63, 0xe2, 0x1b, 0x60, 0x8d, 0x22, 0xdc,
-	0x47, 0x4e, 0x07, 0xf6, 0xfb, 0x0e, 0xa2, 0x34, 0x27, 0x14, 0x85, 0xf2, 0x72, 0x23, 0xf7, 0xf3,
-	0x0f, 0x5b, 0x0f, 0xb9, 0xec, 0x37, 0x7d, 0xe4, 0x5d, 0xe6, 0x98, 0xd8, 0x68, 0x3f, 0xeb, 0xf3,
-	0xf9, 0xa6, 0xb8, 0x0b, 0x1e, 0x0c, 0x10, 0xea, 0xd8, 0x70, 0xdc, 0xe9, 0xf1, 0xa4, 0xb9, 0x74,
-	0x51, 0x28, 0xaf, 0xd4, 0x64, 0x6d, 0xd6, 0x5f, 0x6d, 0xf6, 0xe8, 0xf6, 0xda, 0x60, 0x66, 0x5d,
-	0x7f, 0xeb, 0xd3, 0x13, 0x25, 0xf5, 0xf7, 0x89, 0x92, 0x3a, 0x9e, 0x4e, 0x2a, 0x73, 0xaa, 0x3e,
-	0x9b, 0x4e, 0x2a, 0x4f, 0xbc, 0x32, 0x8f, 0x82, 0x42, 0x63, 0x6b, 0x52, 0x4b, 0xe0, 0x71, 0x2c,
-	0xd8, 0x46, 0xd4, 0x26, 0x98, 0x22, 0xf5, 0x1f, 0x01, 0xac, 0xb7, 0xa8, 0xf1, 0x1e, 0x76, 0x9e,
-	0x92, 0x31, 0x4d, 0xf0, 0x20, 0x30, 0x24, 0x4c, 0x91, 0x4e, 0x48, 0x71, 0x3f, 0x88, 0xe0, 0xdb,
-	0xf5, 0xb7, 0x13, 0x3c, 0xd9, 0x5c, 0xf0, 0x24, 0xae, 0x20, 0x75, 0x03, 0x94, 0xae, 0x81, 0x43,
-	0x5f, 0x7e, 0x4b, 0x83, 0xe7, 0x5b, 0xd4, 0xd8, 0x19, 0xe1, 0xfe, 0x5d, 0x74, 0x44, 0x1c, 0x83,
-	0x25, 0x68, 0x91, 0x11, 0x66, 0xb9, 0x4c, 0x31, 0x53, 0x5e, 0xa9, 0xe5, 0x35, 0x1e, 0xe7, 0xb6,
-	0xa8, 0xc6, 0x5b, 0x54, 0x6b, 0x12, 0x13, 0x37, 0x76, 0x4e, 0x7f, 0x57, 0x52, 0xdf, 0xff, 0xa1,
-	0x94, 0x0d, 0x93, 0xed, 0x8f, 0xba, 0x5a, 0x8f, 0x58, 0xbc, 0x13, 0xf9, 0xbf, 0x2d, 0xda, 0xff,
-	0x58, 0x67, 0x63, 0x1b, 0x51, 0x2f, 0x80, 0x7e, 0x35, 0x9d, 0x54, 0x56, 0x87, 0xc8, 0x80, 0x3d,
-	0xf7, 0x31, 0x9b, 0x98, 0x7e, 0x37, 0x9d, 0x54, 0x84, 0x36, 0x3f, 0xb0, 0xde, 0x4c, 0xb8, 0x8c,
-	0xd2, 0xc2, 0x65, 0x2c, 0xba, 0xa8, 0x2a, 0xa0, 0x10, 0x09, 0x84, 0x17, 0xf0, 0x4d, 0x1a, 0xa8,
-	0xee, 0x45, 0xd9, 0x7d, 0xc8, 0xd0, 0x2c, 0xe7, 0x7d, 0x38, 0x1c, 0x22, 0xf6, 0x8e, 0x69, 0x99,
-	0x77, 0xe5, 0x36, 0x1e, 0x83, 0xd5, 0x43, 0x4f, 0x54, 0x67, 0xe8, 0xaa, 0xca, 0x65, 0x8a, 0x42,
-	0xf9, 0x99, 0xf6, 0xca, 0xe1, 0xa5, 0xd0, 0xfa, 0x5e, 0x82, 0x6b, 0xaf, 0x2c, 0x3e, 0xe1, 0xeb,
-	0x4b, 0x57, 0x5f, 0x06, 0x95, 0x64, 0x56, 0xe8, 0xe7, 0x8f, 0x02, 0xb8, 0x1f, 0xd2, 0xf7, 0xa0,
-	0x03, 0x2d, 0x2a, 0xbe, 0x0a, 0x96, 0xe1, 0x88, 0xed, 0x13, 0xc7, 0x64, 0xe3, 0x44, 0xdf, 0x2e,
-	0xa9, 0xe2, 0xeb, 0x60, 0xc9, 0xf6, 0x32, 0xf0, 0x11, 0xf7, 0x68, 0x7e, 0xc4, 0xf9, 0xf9, 0x1b,
-	0xcb, 0xee, 0xcb, 0xe3, 0x8f, 0xc7, 0x0f, 0xa8, 0xbf, 0x76, 0xd5, 0x86, 0xcb, 0x94, 0xae, 0x03,
-	0x85, 0x18, 0x07, 0xfc, 0x64, 0x6a, 0x1e, 0x64, 0xe7, 0xb6, 0x82, 0xda, 0x6a, 0xff, 0xdd, 0x03,
-	0x99, 0x16, 0x35, 0xc4, 0xaf, 0x05, 0xf0, 0x28, 0x66, 0xc0, 0x6f, 0xce, 0x4b, 0x8c, 0x1d, 0x8d,
-	0x52, 0xf5, 0xc6, 0xd4, 0xd0, 0xdc, 0xd2, 0xf1, 0x2f, 0x7f, 0x7d, 0x99, 0x2e, 0xa8, 0xeb, 0xfa,
-	0xc2, 0x97, 0xac, 0x1e, 0x0c, 0x1a, 0xf1, 0x5b, 0x01, 0xe4, 0x62, 0xe7, 0xec, 0x4b, 0x11, 0x87,
-	0xc6, 0x91, 0xa5, 0xed, 0x5b, 0x90, 0x43, 0x8d, 0x1b, 0x9e, 0x46, 0x45, 0x2d, 0x44, 0x68, 0x1c,
-	0x85, 0xc1, 0xe2, 0xe7, 0x02, 0x10, 0x23, 0xa6, 0xde, 0x46, 0xc4, 0x91, 0x8b, 0x34, 0x69, 0xeb,
-	0x46, 0xb4, 0x50, 0x93, 0xe2, 0x69, 0xca, 0xab, 0xd9, 0x08, 0x4d, 0x83, 0x11, 0xee, 0x8b, 0x3f,
-	0x09, 0x40, 0x49, 0x1a, 0x01, 0xb5, 0x28, 0x37, 0xae, 0x8f, 0x91, 0xea, 0xb7, 0x8f, 0x09, 0x45,
-	0x6b, 0x9e, 0xe8, 0xb2, 0xfa, 0x62, 0x94, 0x91, 0x5e, 0x8e, 0xce, 0xd5, 0x61, 0x20, 0x7e, 0x00,
-	0x56, 0x67, 0xba, 0x4e, 0x89, 0x3d, 0xdb, 0x27, 0x48, 0x4f, 0x12, 0x08, 0x81, 0x12, 0xe9, 0xde,
-	0x27, 0x6e, 0x6f, 0x35, 0x76, 0x4f, 0xcf, 0x65, 0xe1, 0xec, 0x5c, 0x16, 0xfe, 0x3c, 0x97, 0x85,
-	0x2f, 0x2e, 0xe4, 0xd4, 0xd9, 0x85, 0x9c, 0xfa, 0xf5, 0x42, 0x4e, 0x7d, 0xa8, 0x5d, 0x19, 0xf9,
-	0x4d, 0xaf, 0xa9, 0x83, 0xc2, 0xa8, 0x3e, 0xdb, 0x6d, 0xde, 0xf8, 0xef, 0x2e, 0x79, 0xbf, 0x95,
-	0xb6, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x37, 0xf7, 0xc5, 0x0a, 0x2c, 0x0a, 0x00, 0x00,
+	// 1177 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xc1, 0x6f, 0xdc, 0xc4,
+	0x17, 0xde, 0x49, 0xd2, 0xa8, 0x99, 0xe4, 0x97, 0x26, 0x6e, 0xda, 0xec, 0x3a, 0xbf, 0xd8, 0xa9,
+	0xa3, 0x34, 0x9b, 0xa4, 0xb1, 0xd9, 0x54, 0xaa, 0xe8, 0x5e, 0x10, 0x1b, 0x08, 0x45, 0x6a, 0x44,
+	0xd8, 0x0a, 0x05, 0x71, 0x59, 0x79, 0xd7, 0x13, 0xc7, 0x74, 0xd7, 0xb3, 0x78, 0xec, 0x6c, 0xf7,
+	0x86, 0x7a, 0x42, 0x70, 0x00, 0xa9, 0x17, 0x04, 0x97, 0x8a, 0x5e, 0x4a, 0xc5, 0x21, 0x07, 0xae,
+	0x20, 0xb8, 0xf5, 0x58, 0xc1, 0x85, 0x0b, 0x14, 0x25, 0x48, 0xe1, 0x04, 0x17, 0xfe, 0x00, 0xe4,
+	0xf1, 0xd8, 0xeb, 0xdd, 0xb5, 0xd7, 0xbb, 0x52, 0x89, 0x72, 0x49, 0xe2, 0x79, 0xdf, 0x7b, 0xf3,
+	0xbd, 0xef, 0xcd, 0xcc, 0x7b, 0x81, 0xb3, 0xef, 0x3b, 0x26, 0x56, 0xf6, 0x10, 0xaa, 0xab, 0x4d,
+	0xe5, 0x20, 0xa7, 0xd8, 0xf7, 0xe4, 0xba, 0x85, 0x6d, 0xcc, 0x4d, 0xba, 0x06, 0xd9, 0x33, 0xc8,
+	0x07, 0x39, 0x7e, 0x5a, 0xad, 0x19, 0x26, 0x56, 0xe8, 0x4f, 0x0f, 0xc2, 0x0b, 0x15, 0x4c, 0x6a,
+	0x98, 0x28, 0x65, 0x95, 0x20, 0xe5, 0x20, 0x57, 0x46, 0xb6, 0x9a, 0x53, 0x2a, 0xd8, 0x30, 0x99,
+	0x7d, 0x96, 0xd9, 0x6b, 0x44, 0x77, 0x43, 0xd7, 0x88, 0xce, 0x0c, 0x19, 0xcf, 0x50, 0xa2, 0x5f,
+	0x8a, 0xf7, 0xc1, 0x4c, 0x33, 0x3a, 0xd6, 0xb1, 0xb7, 0xee, 0xfe, 0xc5, 0x56, 0xff, 0xaf, 0x63,
+	0xac, 0x57, 0x91, 0xa2, 0xd6, 0x0d, 0x45, 0x35, 0x4d, 0x6c, 0xab, 0xb6, 0x81, 0x4d, 0xdf, 0x47,
+	0x60, 0x56, 0xfa, 0x55, 0x76, 0xf6, 0x14, 0xcd, 0xb1, 0x28, 0x80, 0xd9, 0xe7, 0x3a, 0x72, 0x64,
+	0x49, 0xb1, 0xd0, 0x1d, 0x46, 0x1d, 0x99, 0x88, 0x18, 0x2c, 0xb4, 0xf4, 0x37, 0x80, 0x99, 0x6d,
+	0xa2, 0x17, 0x91, 0x6e, 0x10, 0x1b, 0x59, 0x5b, 0x08, 0xed, 0xa8, 0xcd, 0x4d, 0x6c, 0xda, 0x96,
+	0x5a, 0xb1, 0xb9, 0x57, 0xe0, 0x24, 0x41, 0xa6, 0x86, 0xac, 0x92, 0xaa, 0x69, 0x16, 0x22, 0x24,
+	0x0d, 0x16, 0x40, 0x76, 0xac, 0x90, 0xfe, 0xe9, 0xdb, 0xf5, 0x19, 0x96, 0xd6, 0xab, 0x9e, 0xe5,
+	0x8e, 0x6d, 0x19, 0xa6, 0x5e, 0xfc, 0x9f, 0x87, 0x67, 0x8b, 0xdc, 0x2d, 0x38, 0xb5, 0x87, 0x50,
+	0xa9, 0xae, 0x36, 0x4b, 0x15, 0x16, 0x34, 0x3d, 0xb4, 0x00, 0xb2, 0xe3, 0x1b, 0x82, 0xdc, 0xae,
+	0xbf, 0xdc, 0xbe, 0x75, 0x71, 0x72, 0xaf, 0xed, 0x3b, 0xff, 0xc6, 0x47, 0x0f, 0xc5, 0xd4, 0x9f,
+	0x0f, 0xc5, 0xd4, 0xfd, 0x93, 0xc3, 0xd5, 0x0e, 0x56, 0x1f, 0x9f, 0x1c, 0xae, 0x2e, 0xd3, 0x34,
+	0xef, 0xf9, 0x89, 0xc6, 0xe6, 0x24, 0x2d, 0xc2, 0x2b, 0xb1, 0xc6, 0x22, 0x22, 0x75, 0x6c, 0x12,
+	0x24, 0xfd, 0x05, 0xe0, 0xdc, 0x36, 0xd1, 0xdf, 0x31, 0xad, 0xff, 0x48, 0x98, 0x4d, 0x38, 0xe5,
+	0x0b, 0x12, 0x84, 0x18, 0x4a, 0x08, 0x71, 0xc1, 0xf7, 0x60, 0xcb, 0xf9, 0x37, 0x13, 0x34, 0x59,
+	0xe9, 0xd2, 0x24, 0x2e, 0x21, 0x69, 0x09, 0x2e, 0xf6, 0x30, 0x07, 0xba, 0xfc, 0x3a, 0x04, 0x2f,
+	0x6d, 0x13, 0x7d, 0xcb, 0x31, 0xb5, 0xb3, 0xa8, 0x08, 0xd7, 0x84, 0xa3, 0x6a, 0x0d, 0x3b, 0xa6,
+	0x9d, 0x1e, 0x5e, 0x18, 0xce, 0x8e, 0x6f, 0x64, 0x64, 0xe6, 0xe7, 0x5e, 0x61, 0x99, 0x5d, 0x61,
+	0x79, 0x13, 0x1b, 0x66, 0x61, 0xeb, 0xe9, 0x6f, 0x62, 0xea, 0xc9, 0x73, 0x31, 0xab, 0x1b, 0xf6,
+	0xbe, 0x53, 0x96, 0x2b, 0xb8, 0xc6, 0x6e, 0x2a, 0xfb, 0xb5, 0x4e, 0xb4, 0xbb, 0x8a, 0xdd, 0xac,
+	0x23, 0x42, 0x1d, 0xc8, 0x17, 0x27, 0x87, 0xab, 0x13, 0x55, 0xa4, 0xab, 0x15, 0xf7, 0x30, 0x1b,
+	0x26, 0x79, 0x7c, 0x72, 0xb8, 0x0a, 0x8a, 0x6c, 0xc3, 0xfc, 0x66, 0x42, 0x31, 0x16, 0xbb, 0x8a,
+	0xd1, 0xad, 0xa2, 0x24, 0xc2, 0xf9, 0x48, 0x43, 0x50, 0x80, 0x7f, 0x00, 0x14, 0xb6, 0x89, 0xbe,
+	0x6b, 0xd8, 0xfb, 0x9a, 0xa5, 0x36, 0xda, 0x51, 0xae, 0x1f, 0x71, 0x2b, 0xb1, 0xe7, 0x0c, 0x56,
+	0x09, 0x0f, 0xff, 0x42, 0xcf, 0xe6, 0xed, 0x36, 0x39, 0xda, 0x09, 0xb9, 0x72, 0x5c, 0xeb, 0x92,
+	0xa3, 0x47, 0x4e, 0xd2, 0x23, 0x00, 0xaf, 0xf6, 0x86, 0xf8, 0x0a, 0x85, 0x8e, 0x00, 0x38, 0xe5,
+	0x23, 0x20, 0x3d, 0x1e, 0x86, 0x92, 0x7b, 0x8b, 0xea, 0x9a, 0x6a, 0xa3, 0x76, 0x8e, 0xbb, 0x6a,
+	0xb5, 0x8a, 0xec, 0xdb, 0x46, 0xcd, 0x38, 0x2b, 0x57, 0xe5, 0x0a, 0x9c, 0x68, 0x50, 0x52, 0xa5,
+	0xaa, 0xcb, 0x2a, 0x3d, 0xbc, 0x00, 0xb2, 0x23, 0xc5, 0xf1, 0x46, 0x88, 0xa8, 0x0c, 0x2f, 0x52,
+	0x5b, 0xa9, 0x61, 0x98, 0x1a, 0x6e, 0x94, 0xca, 0x55, 0x5c, 0xb9, 0x4b, 0xd2, 0x23, 0x14, 0x39,
+	0x4d, 0x4d, 0xbb, 0xd4, 0x52, 0xa0, 0x06, 0x6e, 0x17, 0x5e, 0x6a, 0xc3, 0xfb, 0x6d, 0x2a, 0x7d,
+	0x8e, 0x3e, 0xf9, 0x19, 0xd9, 0xeb, 0x63, 0xb2, 0xdf, 0xc7, 0xe4, 0xd7, 0x18, 0xa0, 0x70, 0xde,
+	0xad, 0xc4, 0xe7, 0xcf, 0x45, 0x50, 0xbc, 0x18, 0x0a, 0xeb, 0x9b, 0xf3, 0x3b, 0x09, 0x77, 0xeb,
+	0xa5, 0xee, 0x87, 0xae, 0x77, 0x0d, 0xa4, 0x6b, 0x70, 0x35, 0x19, 0x15, 0xdc, 0xba, 0xaf, 0x87,
+	0x62, 0x0b, 0xfb, 0x7a, 0xd5, 0xd0, 0x8d, 0xb2, 0x51, 0x35, 0xec, 0xe6, 0x19, 0x29, 0xec, 0x1a,
+	0x9c, 0x46, 0x2d, 0x52, 0xa5, 0x0f, 0x1c, 0x64, 0x35, 0x69, 0x75, 0xcf, 0x17, 0xa7, 0x42, 0x86,
+	0xb7, 0xdd, 0xf5, 0x17, 0xa4, 0x6c, 0x48, 0x84, 0x1e, 0xca, 0x86, 0x50, 0x81, 0xb2, 0x5f, 0x8d,
+	0xd0, 0x76, 0x1c, 0x05, 0xbf, 0x53, 0x47, 0xa6, 0x76, 0x96, 0x6e, 0xcc, 0xa7, 0x00, 0x72, 0xec,
+	0xca, 0x10, 0x97, 0x5b, 0x70, 0x71, 0x4e, 0xe9, 0x99, 0x99, 0xf2, 0x36, 0x0f, 0xe9, 0xf2, 0x00,
+	0xc0, 0x99, 0x20, 0xaf, 0x30, 0xa7, 0x91, 0xd3, 0xe2, 0xc4, 0x55, 0xba, 0xaa, 0x95, 0x7f, 0x2b,
+	0xe1, 0x4c, 0x29, 0x7d, 0x9d, 0xa9, 0x56, 0x40, 0x69, 0x0d, 0xae, 0x24, 0x82, 0x82, 0x13, 0xf5,
+	0x3d, 0x80, 0x17, 0x02, 0xf4, 0x8e, 0x6a, 0xa9, 0x35, 0xc2, 0xdd, 0x80, 0x63, 0xaa, 0x63, 0xef,
+	0x63, 0xcb, 0xb0, 0x9b, 0x89, 0x47, 0xa7, 0x05, 0xe5, 0x6e, 0xc2, 0xd1, 0x3a, 0x8d, 0xc0, 0x86,
+	0xd6, 0xcb, 0x9d, 0x43, 0xab, 0x17, 0xbf, 0x30, 0xe6, 0xaa, 0xc9, 0x7a, 0x81, 0xe7, 0x90, 0x7f,
+	0x39, 0x2c, 0x42, 0x2b, 0xa4, 0x9b, 0xff, 0x7c, 0x4c, 0xfe, 0x5e, 0x30, 0x29, 0x03, 0x67, 0x3b,
+	0x96, 0xfc, 0xdc, 0x36, 0xbe, 0x81, 0x70, 0x78, 0x9b, 0xe8, 0xdc, 0x97, 0x00, 0x5e, 0x8e, 0x19,
+	0xd9, 0x57, 0x3a, 0x29, 0xc6, 0x0e, 0xbb, 0x7c, 0xae, 0x6f, 0x68, 0x20, 0xee, 0xe2, 0xfd, 0x9f,
+	0xff, 0x78, 0x30, 0x34, 0x2f, 0xcd, 0x29, 0x5d, 0xff, 0x56, 0x29, 0xfe, 0xe8, 0xc8, 0x3d, 0x02,
+	0x30, 0x1d, 0x3b, 0x39, 0xaf, 0x45, 0x6c, 0x1a, 0x07, 0xe6, 0xaf, 0x0f, 0x00, 0x0e, 0x38, 0x2e,
+	0x51, 0x8e, 0xa2, 0x34, 0x1f, 0xc1, 0xd1, 0x09, 0x9c, 0xb9, 0x4f, 0x00, 0xe4, 0x22, 0xe6, 0xd8,
+	0xa5, 0x88, 0x2d, 0xbb, 0x61, 0xfc, 0x7a, 0x5f, 0xb0, 0x80, 0x93, 0x48, 0x39, 0x65, 0xa4, 0xd9,
+	0x08, 0x4e, 0xee, 0x6c, 0xc4, 0x3d, 0x01, 0x70, 0xae, 0xd7, 0x50, 0x27, 0x47, 0xec, 0xd7, 0x03,
+	0xcf, 0xdf, 0x18, 0x0c, 0xdf, 0x57, 0x81, 0x1b, 0xcc, 0x9f, 0xfb, 0x11, 0x40, 0x31, 0x69, 0xc8,
+	0xd9, 0x88, 0x2a, 0x5d, 0x6f, 0x1f, 0x3e, 0x3f, 0xb8, 0x4f, 0x40, 0x5c, 0xa6, 0xc4, 0xb3, 0xd2,
+	0xd5, 0xa8, 0xaa, 0xd3, 0x18, 0xa5, 0xf0, 0xb8, 0xc3, 0xfd, 0x10, 0x93, 0x43, 0xb8, 0x9f, 0xf7,
+	0x9b, 0x43, 0xc8, 0xa7, 0xef, 0x1c, 0xa2, 0x9a, 0xe1, 0x3a, 0xcd, 0x61, 0x59, 0x5a, 0x8a, 0xcf,
+	0x21, 0xd4, 0xc0, 0xb9, 0xef, 0x00, 0x14, 0x12, 0x1a, 0x67, 0xae, 0x4f, 0x36, 0x2d, 0x17, 0xfe,
+	0xe6, 0xc0, 0x2e, 0x83, 0xf0, 0x0f, 0x35, 0x29, 0xee, 0x5d, 0x38, 0xd1, 0xf6, 0x4a, 0x8b, 0xb1,
+	0x3b, 0x7b, 0x00, 0x7e, 0x39, 0x01, 0xe0, 0x13, 0xe1, 0xcf, 0x7d, 0xe8, 0xbe, 0xc5, 0x85, 0x5b,
+	0x4f, 0x8f, 0x04, 0xf0, 0xec, 0x48, 0x00, 0xbf, 0x1f, 0x09, 0xe0, 0xb3, 0x63, 0x21, 0xf5, 0xec,
+	0x58, 0x48, 0xfd, 0x72, 0x2c, 0xa4, 0xde, 0x93, 0x43, 0x6d, 0x6f, 0x93, 0x36, 0x01, 0x3f, 0x2d,
+	0xd2, 0xd1, 0x9d, 0x68, 0x0b, 0x2c, 0x8f, 0xd2, 0x91, 0xf5, 0xfa, 0xbf, 0x01, 0x00, 0x00, 0xff,
+	0xff, 0x74, 0x34, 0xf0, 0x91, 0x4e, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -525,8 +823,14 @@ type MsgClient interface {
 	UnregisterFeePayContract(ctx context.Context, in *MsgUnregisterFeePayContract, opts ...grpc.CallOption) (*MsgUnregisterFeePayContractResponse, error)
 	// Fund a fee pay contract
 	FundFeePayContract(ctx context.Context, in *MsgFundFeePayContract, opts ...grpc.CallOption) (*MsgFundFeePayContractResponse, error)
+	// Withdraw the share of a funder of the unspent funds of a fee pay contract
+	WithdrawFeePayContractFunds(ctx context.Context, in *MsgWithdrawFeePayContractFunds, opts ...grpc.CallOption) (*MsgWithdrawFeePayContractFundsResponse, error)
 	// Update a fee pay contract wallet limit
 	UpdateFeePayContractWalletLimit(ctx context.Context, in *MsgUpdateFeePayContractWalletLimit, opts ...grpc.CallOption) (*MsgUpdateFeePayContractWalletLimitResponse, error)
+	// Update whether a fee pay contract decides which wallets are sponsored
+	UpdateFeePayContractEligibility(ctx context.Context, in *MsgUpdateFeePayContractEligibility, opts ...grpc.CallOption) (*MsgUpdateFeePayContractEligibilityResponse, error)
+	// Update the fee spend limits of a fee pay contract
+	UpdateFeePayContractSpendLimit(ctx context.Context, in *MsgUpdateFeePayContractSpendLimit, opts ...grpc.CallOption) (*MsgUpdateFeePayContractSpendLimitResponse, error)
 	// Update the params of the module through gov v1 type.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -566,6 +870,15 @@ func (c *msgClient) FundFeePayContract(ctx context.Context, in *MsgFundFeePayCon
 	return out, nil
 }
 
+func (c *msgClient) WithdrawFeePayContractFunds(ctx context.Context, in *MsgWithdrawFeePayContractFunds, opts ...grpc.CallOption) (*MsgWithdrawFeePayContractFundsResponse, error) {
+	out := new(MsgWithdrawFeePayContractFundsResponse)
+	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Msg/WithdrawFeePayContractFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateFeePayContractWalletLimit(ctx context.Context, in *MsgUpdateFeePayContractWalletLimit, opts ...grpc.CallOption) (*MsgUpdateFeePayContractWalletLimitResponse, error) {
 	out := new(MsgUpdateFeePayContractWalletLimitResponse)
 	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Msg/UpdateFeePayContractWalletLimit", in, out, opts...)
@@ -575,6 +888,24 @@ func (c *msgClient) UpdateFeePayContractWalletLimit(ctx context.Context, in *Msg
 	return out, nil
 }
 
+func (c *msgClient) UpdateFeePayContractEligibility(ctx context.Context, in *MsgUpdateFeePayContractEligibility, opts ...grpc.CallOption) (*MsgUpdateFeePayContractEligibilityResponse, error) {
+	out := new(MsgUpdateFeePayContractEligibilityResponse)
+	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Msg/UpdateFeePayContractEligibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeePayContractSpendLimit(ctx context.Context, in *MsgUpdateFeePayContractSpendLimit, opts ...grpc.CallOption) (*MsgUpdateFeePayContractSpendLimitResponse, error) {
+	out := new(MsgUpdateFeePayContractSpendLimitResponse)
+	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Msg/UpdateFeePayContractSpendLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/juno.feepay.v1.Msg/UpdateParams", in, out, opts...)
@@ -592,8 +923,14 @@ type MsgServer interface {
 	UnregisterFeePayContract(context.Context, *MsgUnregisterFeePayContract) (*MsgUnregisterFeePayContractResponse, error)
 	// Fund a fee pay contract
 	FundFeePayContract(context.Context, *MsgFundFeePayContract) (*MsgFundFeePayContractResponse, error)
+	// Withdraw the share of a funder of the unspent funds of a fee pay contract
+	WithdrawFeePayContractFunds(context.Context, *MsgWithdrawFeePayContractFunds) (*MsgWithdrawFeePayContractFundsResponse, error)
 	// Update a fee pay contract wallet limit
 	UpdateFeePayContractWalletLimit(context.Context, *MsgUpdateFeePayContractWalletLimit) (*MsgUpdateFeePayContractWalletLimitResponse, error)
+	// Update whether a fee pay contract decides which wallets are sponsored
+	UpdateFeePayContractEligibility(context.Context, *MsgUpdateFeePayContractEligibility) (*MsgUpdateFeePayContractEligibilityResponse, error)
+	// Update the fee spend limits of a fee pay contract
+	UpdateFeePayContractSpendLimit(context.Context, *MsgUpdateFeePayContractSpendLimit) (*MsgUpdateFeePayContractSpendLimitResponse, error)
 	// Update the params of the module through gov v1 type.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -611,9 +948,18 @@ func (*UnimplementedMsgServer) UnregisterFeePayContract(ctx context.Context, req
 func (*UnimplementedMsgServer) FundFeePayContract(ctx context.Context, req *MsgFundFeePayContract) (*MsgFundFeePayContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundFeePayContract not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawFeePayContractFunds(ctx context.Context, req *MsgWithdrawFeePayContractFunds) (*MsgWithdrawFeePayContractFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFeePayContractFunds not implemented")
+}
 func (*UnimplementedMsgServer) UpdateFeePayContractWalletLimit(ctx context.Context, req *MsgUpdateFeePayContractWalletLimit) (*MsgUpdateFeePayContractWalletLimitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeePayContractWalletLimit not implemented")
 }
+func (*UnimplementedMsgServer) UpdateFeePayContractEligibility(ctx context.Context, req *MsgUpdateFeePayContractEligibility) (*MsgUpdateFeePayContractEligibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeePayContractEligibility not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFeePayContractSpendLimit(ctx context.Context, req *MsgUpdateFeePayContractSpendLimit) (*MsgUpdateFeePayContractSpendLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeePayContractSpendLimit not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -676,6 +1022,24 @@ func _Msg_FundFeePayContract_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawFeePayContractFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFeePayContractFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFeePayContractFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feepay.v1.Msg/WithdrawFeePayContractFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFeePayContractFunds(ctx, req.(*MsgWithdrawFeePayContractFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateFeePayContractWalletLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateFeePayContractWalletLimit)
 	if err := dec(in); err != nil {
@@ -694,6 +1058,42 @@ func _Msg_UpdateFeePayContractWalletLimit_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateFeePayContractEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeePayContractEligibility)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeePayContractEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feepay.v1.Msg/UpdateFeePayContractEligibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeePayContractEligibility(ctx, req.(*MsgUpdateFeePayContractEligibility))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeePayContractSpendLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeePayContractSpendLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeePayContractSpendLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feepay.v1.Msg/UpdateFeePayContractSpendLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeePayContractSpendLimit(ctx, req.(*MsgUpdateFeePayContractSpendLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -729,10 +1129,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FundFeePayContract",
 			Handler:    _Msg_FundFeePayContract_Handler,
 		},
+		{
+			MethodName: "WithdrawFeePayContractFunds",
+			Handler:    _Msg_WithdrawFeePayContractFunds_Handler,
+		},
 		{
 			MethodName: "UpdateFeePayContractWalletLimit",
 			Handler:    _Msg_UpdateFeePayContractWalletLimit_Handler,
 		},
+		{
+			MethodName: "UpdateFeePayContractEligibility",
+			Handler:    _Msg_UpdateFeePayContractEligibility_Handler,
+		},
+		{
+			MethodName: "UpdateFeePayContractSpendLimit",
+			Handler:    _Msg_UpdateFeePayContractSpendLimit_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -941,7 +1353,7 @@ func (m *MsgFundFeePayContractResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateFeePayContractWalletLimit) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawFeePayContractFunds) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -951,21 +1363,16 @@ func (m *MsgUpdateFeePayContractWalletLimit) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateFeePayContractWalletLimit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawFeePayContractFunds) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateFeePayContractWalletLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawFeePayContractFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.WalletLimit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.WalletLimit))
-		i--
-		dAtA[i] = 0x18
-	}
 	if len(m.ContractAddress) > 0 {
 		i -= len(m.ContractAddress)
 		copy(dAtA[i:], m.ContractAddress)
@@ -973,17 +1380,17 @@ func (m *MsgUpdateFeePayContractWalletLimit) MarshalToSizedBuffer(dAtA []byte) (
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.SenderAddress) > 0 {
-		i -= len(m.SenderAddress)
-		copy(dAtA[i:], m.SenderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateFeePayContractWalletLimitResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawFeePayContractFundsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -993,20 +1400,34 @@ func (m *MsgUpdateFeePayContractWalletLimitResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateFeePayContractWalletLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawFeePayContractFundsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateFeePayContractWalletLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawFeePayContractFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateFeePayContractWalletLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1016,20 +1437,256 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateFeePayContractWalletLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateFeePayContractWalletLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LimitWindowDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LimitWindowDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if m.LimitWindowBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LimitWindowBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WalletLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WalletLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeePayContractWalletLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeePayContractWalletLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeePayContractWalletLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeePayContractEligibility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeePayContractEligibility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeePayContractEligibility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EligibilityQuery {
+		i--
+		if m.EligibilityQuery {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeePayContractEligibilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeePayContractEligibilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeePayContractEligibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeePayContractSpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeePayContractSpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeePayContractSpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractSpendLimit) > 0 {
+		for iNdEx := len(m.ContractSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.WalletSpendLimit) > 0 {
+		for iNdEx := len(m.WalletSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WalletSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeePayContractSpendLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeePayContractSpendLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeePayContractSpendLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
 		i -= size
 		i = encodeVarintTx(dAtA, i, uint64(size))
@@ -1164,6 +1821,38 @@ func (m *MsgFundFeePayContractResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawFeePayContractFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFeePayContractFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgUpdateFeePayContractWalletLimit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1181,6 +1870,11 @@ func (m *MsgUpdateFeePayContractWalletLimit) Size() (n int) {
 	if m.WalletLimit != 0 {
 		n += 1 + sovTx(uint64(m.WalletLimit))
 	}
+	if m.LimitWindowBlocks != 0 {
+		n += 1 + sovTx(uint64(m.LimitWindowBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LimitWindowDuration)
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1193,37 +1887,634 @@ func (m *MsgUpdateFeePayContractWalletLimitResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgUpdateFeePayContractEligibility) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	if m.EligibilityQuery {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateFeePayContractEligibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateFeePayContractSpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.WalletSpendLimit) > 0 {
+		for _, e := range m.WalletSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ContractSpendLimit) > 0 {
+		for _, e := range m.ContractSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateFeePayContractSpendLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterFeePayContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterFeePayContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterFeePayContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayContract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeePayContract == nil {
+				m.FeePayContract = &FeePayContract{}
+			}
+			if err := m.FeePayContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterFeePayContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterFeePayContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterFeePayContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterFeePayContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterFeePayContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterFeePayContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnregisterFeePayContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnregisterFeePayContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnregisterFeePayContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundFeePayContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundFeePayContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundFeePayContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundFeePayContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundFeePayContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundFeePayContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRegisterFeePayContract) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFeePayContractFunds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1246,15 +2537,15 @@ func (m *MsgRegisterFeePayContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterFeePayContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFeePayContractFunds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterFeePayContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFeePayContractFunds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1282,13 +2573,13 @@ func (m *MsgRegisterFeePayContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePayContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1298,27 +2589,23 @@ func (m *MsgRegisterFeePayContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeePayContract == nil {
-				m.FeePayContract = &FeePayContract{}
-			}
-			if err := m.FeePayContract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1341,7 +2628,7 @@ func (m *MsgRegisterFeePayContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterFeePayContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFeePayContractFundsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1364,12 +2651,46 @@ func (m *MsgRegisterFeePayContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterFeePayContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFeePayContractFundsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterFeePayContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFeePayContractFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1391,7 +2712,7 @@ func (m *MsgRegisterFeePayContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterFeePayContract) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFeePayContractWalletLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1414,10 +2735,10 @@ func (m *MsgUnregisterFeePayContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterFeePayContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterFeePayContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1484,6 +2805,77 @@ func (m *MsgUnregisterFeePayContract) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletLimit", wireType)
+			}
+			m.WalletLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WalletLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitWindowBlocks", wireType)
+			}
+			m.LimitWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitWindowDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LimitWindowDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1505,7 +2897,7 @@ func (m *MsgUnregisterFeePayContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnregisterFeePayContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFeePayContractWalletLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1528,10 +2920,10 @@ func (m *MsgUnregisterFeePayContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnregisterFeePayContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnregisterFeePayContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1555,7 +2947,7 @@ func (m *MsgUnregisterFeePayContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundFeePayContract) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFeePayContractEligibility) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1578,10 +2970,10 @@ func (m *MsgFundFeePayContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundFeePayContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFeePayContractEligibility: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundFeePayContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFeePayContractEligibility: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1649,10 +3041,10 @@ func (m *MsgFundFeePayContract) Unmarshal(dAtA []byte) error {
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibilityQuery", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1662,26 +3054,12 @@ func (m *MsgFundFeePayContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.EligibilityQuery = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1703,7 +3081,7 @@ func (m *MsgFundFeePayContract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundFeePayContractResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFeePayContractEligibilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1726,10 +3104,10 @@ func (m *MsgFundFeePayContractResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundFeePayContractResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFeePayContractEligibilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundFeePayContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFeePayContractEligibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1753,7 +3131,7 @@ func (m *MsgFundFeePayContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateFeePayContractWalletLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFeePayContractSpendLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1776,10 +3154,10 @@ func (m *MsgUpdateFeePayContractWalletLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFeePayContractSpendLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFeePayContractSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1847,10 +3225,10 @@ func (m *MsgUpdateFeePayContractWalletLimit) Unmarshal(dAtA []byte) error {
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletLimit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletSpendLimit", wireType)
 			}
-			m.WalletLimit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1860,11 +3238,60 @@ func (m *MsgUpdateFeePayContractWalletLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WalletLimit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletSpendLimit = append(m.WalletSpendLimit, types.Coin{})
+			if err := m.WalletSpendLimit[len(m.WalletSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSpendLimit = append(m.ContractSpendLimit, types.Coin{})
+			if err := m.ContractSpendLimit[len(m.ContractSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1886,7 +3313,7 @@ func (m *MsgUpdateFeePayContractWalletLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateFeePayContractWalletLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateFeePayContractSpendLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1909,10 +3336,10 @@ func (m *MsgUpdateFeePayContractWalletLimitResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateFeePayContractSpendLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateFeePayContractWalletLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateFeePayContractSpendLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: